@@ -0,0 +1,354 @@
+package api
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"path"
+	"strings"
+
+	"github.com/TUM-Dev/gocast/dao"
+	"github.com/TUM-Dev/gocast/model"
+	"github.com/TUM-Dev/gocast/tools"
+	"github.com/TUM-Dev/gocast/worker/pb"
+	"github.com/gin-gonic/gin"
+	uuid "github.com/satori/go.uuid"
+	"gorm.io/gorm"
+)
+
+func configGinClipsRouter(router *gin.Engine, daoWrapper dao.DaoWrapper) {
+	routes := clipRoutes{daoWrapper}
+	router.POST("/api/stream/:streamID/clips", tools.InitStream(daoWrapper), tools.LoggedIn, routes.createClip)
+	router.GET("/api/course/:courseID/clips", tools.InitCourse(daoWrapper), routes.getCourseClips)
+
+	clips := router.Group("/api/clips/:slug")
+	clips.GET("", routes.getClip)
+	clips.GET("/file", routes.getClipFile)
+	clips.DELETE("", routes.deleteClip)
+}
+
+type clipRoutes struct {
+	dao.DaoWrapper
+}
+
+type clipDto struct {
+	Slug       string `json:"slug"`
+	Title      string `json:"title"`
+	StreamID   uint   `json:"streamID"`
+	StreamName string `json:"streamName"`
+	Start      uint   `json:"start"`
+	End        uint   `json:"end"`
+	Url        string `json:"url"`                // short url of the clip
+	Playlist   string `json:"playlist,omitempty"` // signed sub-playlist of the VoD
+	File       string `json:"file,omitempty"`     // cut file, if the course allows downloads and a worker cut it already
+}
+
+func newClipDto(clip model.Clip, stream model.Stream) clipDto {
+	return clipDto{
+		Slug:       clip.Slug,
+		Title:      clip.GetName(stream),
+		StreamID:   clip.StreamID,
+		StreamName: stream.GetName(),
+		Start:      clip.Start,
+		End:        clip.End,
+		Url:        fmt.Sprintf("%s/c/%s", tools.Cfg.WebUrl, clip.Slug),
+	}
+}
+
+type createClipRequest struct {
+	Title string `json:"title"`
+	Start uint   `json:"start"` // seconds into the VoD
+	End   uint   `json:"end"`
+}
+
+// createClip saves a clip of the VoD of a stream. If the course allows downloads, a worker cuts it into a file.
+func (r clipRoutes) createClip(c *gin.Context) {
+	tumLiveContext := c.MustGet("TUMLiveContext").(tools.TUMLiveContext)
+	stream, course := *tumLiveContext.Stream, *tumLiveContext.Course
+
+	var req createClipRequest
+	if err := c.BindJSON(&req); err != nil {
+		_ = c.Error(tools.RequestError{
+			Status:        http.StatusBadRequest,
+			CustomMessage: "can not bind body",
+			Err:           err,
+		})
+		return
+	}
+	if !stream.Recording {
+		_ = c.Error(tools.RequestError{
+			Status:        http.StatusBadRequest,
+			CustomMessage: "clips can only be made of recordings",
+		})
+		return
+	}
+	if req.End <= req.Start || (stream.Duration.Int32 > 0 && req.End > uint(stream.Duration.Int32)) {
+		_ = c.Error(tools.RequestError{
+			Status:        http.StatusBadRequest,
+			CustomMessage: "invalid start or end of the clip",
+		})
+		return
+	}
+
+	clip := model.Clip{
+		Slug:     strings.ReplaceAll(uuid.NewV4().String(), "-", "")[:12],
+		StreamID: stream.ID,
+		UserID:   tumLiveContext.User.ID,
+		Title:    strings.TrimSpace(req.Title),
+		Start:    req.Start,
+		End:      req.End,
+	}
+	if err := r.ClipDao.Create(c, &clip); err != nil {
+		_ = c.Error(tools.RequestError{
+			Status:        http.StatusInternalServerError,
+			CustomMessage: "can not create clip",
+			Err:           err,
+		})
+		return
+	}
+	if course.DownloadsEnabled {
+		if err := createCutClipJob(r.DaoWrapper, clip); err != nil {
+			logger.Error("Can't create cut job for clip", "err", err, "clip", clip.ID)
+		}
+	}
+	c.JSON(http.StatusOK, newClipDto(clip, stream))
+}
+
+// createCutClipJob queues the cutting of a clip, it's dispatched with the next run of the dispatcher.
+// Until it's done, the clip plays from the sub-playlist.
+func createCutClipJob(daoWrapper dao.DaoWrapper, clip model.Clip) error {
+	job, err := model.NewJob(model.JobTypeCutClip, clip.StreamID, cutClipJobPayload{Slug: clip.Slug})
+	if err != nil {
+		return err
+	}
+	return daoWrapper.JobDao.Create(context.Background(), &job)
+}
+
+// recutClips cuts the files of the clips of a stream anew after its edits changed, they may contain removed parts.
+// Until the jobs are done, the clips play from their sub-playlists.
+func recutClips(daoWrapper dao.DaoWrapper, stream model.Stream) error {
+	clips, err := daoWrapper.ClipDao.GetForCourse(context.Background(), stream.CourseID)
+	if err != nil {
+		return err
+	}
+	for _, clip := range clips {
+		if clip.StreamID != stream.ID || clip.FilePath == "" {
+			continue
+		}
+		if err = daoWrapper.ClipDao.SetFilePath(context.Background(), clip.ID, ""); err != nil {
+			return err
+		}
+		if err = createCutClipJob(daoWrapper, clip); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// getClipContext returns the clip of the request with its stream and course,
+// the request fails if the user isn't allowed to watch the stream.
+func (r clipRoutes) getClipContext(c *gin.Context) (clip model.Clip, stream model.Stream, course model.Course, ok bool) {
+	clip, err := r.ClipDao.GetBySlug(c, c.Param("slug"))
+	if err != nil {
+		_ = c.Error(tools.RequestError{
+			Status:        http.StatusNotFound,
+			CustomMessage: "can not find clip",
+			Err:           err,
+		})
+		return
+	}
+	stream, err = r.StreamsDao.GetStreamByID(c, fmt.Sprintf("%d", clip.StreamID))
+	if err != nil {
+		_ = c.Error(tools.RequestError{
+			Status:        http.StatusNotFound,
+			CustomMessage: "can not find stream of the clip",
+			Err:           err,
+		})
+		return
+	}
+	course, err = r.CoursesDao.GetCourseById(c, stream.CourseID)
+	if err != nil {
+		_ = c.Error(tools.RequestError{
+			Status:        http.StatusInternalServerError,
+			CustomMessage: "can not get course",
+			Err:           err,
+		})
+		return
+	}
+	if !c.MustGet("TUMLiveContext").(tools.TUMLiveContext).User.IsEligibleToWatchStream(course, stream) {
+		_ = c.Error(tools.RequestError{
+			Status:        http.StatusForbidden,
+			CustomMessage: "not allowed to watch the clip",
+		})
+		return
+	}
+	return clip, stream, course, true
+}
+
+func (r clipRoutes) getClip(c *gin.Context) {
+	clip, stream, course, ok := r.getClipContext(c)
+	if !ok {
+		return
+	}
+	user := c.MustGet("TUMLiveContext").(tools.TUMLiveContext).User
+	playlist, err := ClipPlaylist(clip, stream, user)
+	if err != nil {
+		_ = c.Error(tools.RequestError{
+			Status:        http.StatusInternalServerError,
+			CustomMessage: "can not create signed clip playlist",
+			Err:           err,
+		})
+		return
+	}
+	res := newClipDto(clip, stream)
+	res.Playlist = playlist
+	res.File = ClipFile(clip, course, user)
+	c.JSON(http.StatusOK, res)
+}
+
+// ClipPlaylist returns the signed sub-playlist of the VoD of a clip, preferably of the combined view.
+func ClipPlaylist(clip model.Clip, stream model.Stream, user *model.User) (string, error) {
+	if err := tools.SetSignedPlaylists(&stream, user, false); err != nil {
+		return "", err
+	}
+	playlist := stream.PlaylistUrl
+	if playlist == "" {
+		playlist = stream.PlaylistUrlPRES
+	}
+	if playlist == "" {
+		playlist = stream.PlaylistUrlCAM
+	}
+	if playlist == "" {
+		return "", nil
+	}
+	if strings.Contains(playlist, "?") {
+		return playlist + "&" + clip.PlaylistQuery(), nil
+	}
+	return playlist + "?" + clip.PlaylistQuery(), nil
+}
+
+// ClipFile returns the url of the cut file of a clip if the user may download it, like the download of the VoD.
+func ClipFile(clip model.Clip, course model.Course, user *model.User) string {
+	if clip.FilePath == "" || (!course.DownloadsEnabled && !user.IsAdminOfCourse(course)) {
+		return ""
+	}
+	return fmt.Sprintf("/api/clips/%s/file", clip.Slug)
+}
+
+func (r clipRoutes) getClipFile(c *gin.Context) {
+	clip, _, course, ok := r.getClipContext(c)
+	if !ok {
+		return
+	}
+	if ClipFile(clip, course, c.MustGet("TUMLiveContext").(tools.TUMLiveContext).User) == "" {
+		_ = c.Error(tools.RequestError{
+			Status:        http.StatusNotFound,
+			CustomMessage: "clip has no file",
+		})
+		return
+	}
+	c.Header("Content-Type", "video/mp4")
+	if c.Query("download") == "1" {
+		c.Header("Content-Disposition", "attachment; filename="+clip.Slug+".mp4")
+	}
+	c.File(clip.FilePath)
+}
+
+// getCourseClips returns the clips of the streams of a course the user may watch.
+func (r clipRoutes) getCourseClips(c *gin.Context) {
+	tumLiveContext := c.MustGet("TUMLiveContext").(tools.TUMLiveContext)
+	clips, err := r.ClipDao.GetForCourse(c, tumLiveContext.Course.ID)
+	if err != nil {
+		_ = c.Error(tools.RequestError{
+			Status:        http.StatusInternalServerError,
+			CustomMessage: "can not get clips",
+			Err:           err,
+		})
+		return
+	}
+	res := []clipDto{}
+	for _, clip := range clips {
+		if tumLiveContext.User.IsEligibleToWatchStream(*tumLiveContext.Course, clip.Stream) {
+			res = append(res, newClipDto(clip, clip.Stream))
+		}
+	}
+	c.JSON(http.StatusOK, res)
+}
+
+// deleteClip deletes a clip, only its creator and the admins of the course may do so.
+func (r clipRoutes) deleteClip(c *gin.Context) {
+	clip, _, course, ok := r.getClipContext(c)
+	if !ok {
+		return
+	}
+	user := c.MustGet("TUMLiveContext").(tools.TUMLiveContext).User
+	if user == nil || (user.ID != clip.UserID && !user.IsAdminOfCourse(course)) {
+		_ = c.Error(tools.RequestError{
+			Status:        http.StatusForbidden,
+			CustomMessage: "not allowed to delete the clip",
+		})
+		return
+	}
+	if err := r.ClipDao.Delete(c, clip.ID); err != nil {
+		_ = c.Error(tools.RequestError{
+			Status:        http.StatusInternalServerError,
+			CustomMessage: "can not delete clip",
+			Err:           err,
+		})
+		return
+	}
+	if clip.FilePath != "" {
+		if err := removeStoredFile(r.DaoWrapper, clip.StreamID, clip.FilePath); err != nil {
+			logger.Warn("Can't remove file of deleted clip", "err", err, "file", clip.FilePath)
+		}
+	}
+	c.Status(http.StatusOK)
+}
+
+// sendCutClipJob cuts the part of the VoD a clip plays into a file of its own.
+func sendCutClipJob(daoWrapper dao.DaoWrapper, job *model.Job, worker model.Worker, client pb.ToWorkerClient) error {
+	var payload cutClipJobPayload
+	if err := job.DecodePayload(&payload); err != nil {
+		return err
+	}
+	clip, err := daoWrapper.ClipDao.GetBySlug(context.Background(), payload.Slug)
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil // deleted in the meantime
+	}
+	if err != nil {
+		return err
+	}
+	stream, err := daoWrapper.StreamsDao.GetStreamByID(context.Background(), fmt.Sprintf("%d", clip.StreamID))
+	if err != nil {
+		return err
+	}
+	vod, ok := cutSourceFile(stream)
+	if !ok {
+		return errors.New("stream has no VoD file to cut")
+	}
+	out := path.Join(path.Dir(vod.Path), "clips", clip.Slug+".mp4")
+	// the VoD is the original recording, the clip only gets the parts the edits of the stream keep
+	keep := model.KeptWithin(stream.Edits, clip.Start*1000, clip.End*1000)
+	if len(keep) == 0 {
+		// the edits remove the whole clip, a file cut before they changed is outdated
+		return removeStoredFile(daoWrapper, clip.StreamID, out)
+	}
+	segments := make([]*pb.CutRequest_Segment, len(keep))
+	for i, r := range keep {
+		segments[i] = &pb.CutRequest_Segment{StartTime: int64(r.Start), EndTime: int64(r.End)}
+	}
+	resp, err := client.RequestCut(context.Background(), &pb.CutRequest{
+		WorkerId:   worker.WorkerID,
+		Files:      []string{vod.Path},
+		Segments:   segments,
+		OutputFile: out,
+	})
+	if err != nil {
+		return err
+	}
+	if !resp.Success {
+		return fmt.Errorf("worker could not cut clip: %s", resp.Error)
+	}
+	return daoWrapper.ClipDao.SetFilePath(context.Background(), clip.ID, out)
+}
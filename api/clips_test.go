@@ -0,0 +1,191 @@
+package api
+
+import (
+	"database/sql"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/TUM-Dev/gocast/dao"
+	"github.com/TUM-Dev/gocast/mock_dao"
+	"github.com/TUM-Dev/gocast/model"
+	"github.com/TUM-Dev/gocast/tools"
+	"github.com/TUM-Dev/gocast/tools/testutils"
+	"github.com/gin-gonic/gin"
+	"github.com/golang/mock/gomock"
+	"github.com/matthiasreumann/gomino"
+	"gorm.io/gorm"
+)
+
+func TestClips(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	recording := testutils.StreamFPVNotLive
+	recording.Recording = true
+	recording.Duration = sql.NullInt32{Int32: 5400, Valid: true}
+	private := recording
+	private.Model = gorm.Model{ID: 1970}
+	private.Private = true
+
+	clip := model.Clip{Model: gorm.Model{ID: 7}, Slug: "abc", StreamID: recording.ID, UserID: testutils.Student.ID, Start: 60, End: 120}
+	student := testutils.GetMiddlewares(tools.ErrorHandler, testutils.TUMLiveContext(testutils.TUMLiveContextStudent))
+
+	streamsMock := func(t *testing.T, stream model.Stream) dao.StreamsDao {
+		streams := mock_dao.NewMockStreamsDao(gomock.NewController(t))
+		streams.EXPECT().GetStreamByID(gomock.Any(), fmt.Sprintf("%d", stream.ID)).Return(stream, nil).AnyTimes()
+		return streams
+	}
+	clipsMock := func(t *testing.T, clip model.Clip, err error) *mock_dao.MockClipDao {
+		clips := mock_dao.NewMockClipDao(gomock.NewController(t))
+		clips.EXPECT().GetBySlug(gomock.Any(), "abc").Return(clip, err)
+		return clips
+	}
+
+	t.Run("POST/api/stream/:streamID/clips", func(t *testing.T) {
+		url := fmt.Sprintf("/api/stream/%d/clips", recording.ID)
+		gomino.TestCases{
+			"no recording": {
+				Router: func(r *gin.Engine) {
+					configGinClipsRouter(r, dao.DaoWrapper{StreamsDao: streamsMock(t, testutils.StreamFPVNotLive), CoursesDao: testutils.GetCoursesMock(t)})
+				},
+				Body:         createClipRequest{Start: 60, End: 120},
+				Middlewares:  student,
+				ExpectedCode: http.StatusBadRequest,
+			},
+			"end before start": {
+				Router: func(r *gin.Engine) {
+					configGinClipsRouter(r, dao.DaoWrapper{StreamsDao: streamsMock(t, recording), CoursesDao: testutils.GetCoursesMock(t)})
+				},
+				Body:         createClipRequest{Start: 120, End: 60},
+				Middlewares:  student,
+				ExpectedCode: http.StatusBadRequest,
+			},
+			"end after the VoD": {
+				Router: func(r *gin.Engine) {
+					configGinClipsRouter(r, dao.DaoWrapper{StreamsDao: streamsMock(t, recording), CoursesDao: testutils.GetCoursesMock(t)})
+				},
+				Body:         createClipRequest{Start: 60, End: 6000},
+				Middlewares:  student,
+				ExpectedCode: http.StatusBadRequest,
+			},
+			"success": {
+				Router: func(r *gin.Engine) {
+					clips := mock_dao.NewMockClipDao(gomock.NewController(t))
+					clips.EXPECT().Create(gomock.Any(), gomock.Any()).DoAndReturn(func(_ any, clip *model.Clip) error {
+						if clip.StreamID != recording.ID || clip.UserID != testutils.Student.ID || clip.Slug == "" || clip.Title != "Proof" {
+							t.Errorf("unexpected clip %+v", clip)
+						}
+						return nil
+					})
+					// the course doesn't allow downloads, so the clip isn't cut
+					configGinClipsRouter(r, dao.DaoWrapper{StreamsDao: streamsMock(t, recording), CoursesDao: testutils.GetCoursesMock(t), ClipDao: clips})
+				},
+				Body:         createClipRequest{Title: " Proof ", Start: 60, End: 120},
+				Middlewares:  student,
+				ExpectedCode: http.StatusOK,
+			},
+		}.
+			Method(http.MethodPost).
+			Url(url).
+			Run(t, testutils.Equal)
+	})
+
+	t.Run("GET/api/clips/:slug", func(t *testing.T) {
+		gomino.TestCases{
+			"not found": {
+				Router: func(r *gin.Engine) {
+					configGinClipsRouter(r, dao.DaoWrapper{ClipDao: clipsMock(t, model.Clip{}, gorm.ErrRecordNotFound)})
+				},
+				Middlewares:  student,
+				ExpectedCode: http.StatusNotFound,
+			},
+			"private stream": {
+				Router: func(r *gin.Engine) {
+					privateClip := clip
+					privateClip.StreamID = private.ID
+					configGinClipsRouter(r, dao.DaoWrapper{ClipDao: clipsMock(t, privateClip, nil), StreamsDao: streamsMock(t, private), CoursesDao: testutils.GetCoursesMock(t)})
+				},
+				Middlewares:  student,
+				ExpectedCode: http.StatusForbidden,
+			},
+		}.
+			Method(http.MethodGet).
+			Url("/api/clips/abc").
+			Run(t, testutils.Equal)
+	})
+
+	t.Run("GET/api/clips/:slug/file", func(t *testing.T) {
+		gomino.TestCases{
+			"downloads disabled": {
+				Router: func(r *gin.Engine) {
+					cut := clip
+					cut.FilePath = "/vod/clips/abc.mp4"
+					configGinClipsRouter(r, dao.DaoWrapper{ClipDao: clipsMock(t, cut, nil), StreamsDao: streamsMock(t, recording), CoursesDao: testutils.GetCoursesMock(t)})
+				},
+				Middlewares:  student,
+				ExpectedCode: http.StatusNotFound,
+			},
+		}.
+			Method(http.MethodGet).
+			Url("/api/clips/abc/file").
+			Run(t, testutils.Equal)
+	})
+
+	t.Run("GET/api/course/:courseID/clips", func(t *testing.T) {
+		gomino.TestCases{
+			"private streams are left out": {
+				Router: func(r *gin.Engine) {
+					privateClip := clip
+					privateClip.Slug, privateClip.StreamID, privateClip.Stream = "def", private.ID, private
+					publicClip := clip
+					publicClip.Stream = recording
+					clips := mock_dao.NewMockClipDao(gomock.NewController(t))
+					clips.EXPECT().GetForCourse(gomock.Any(), testutils.CourseFPV.ID).Return([]model.Clip{privateClip, publicClip}, nil)
+					configGinClipsRouter(r, dao.DaoWrapper{CoursesDao: testutils.GetCoursesMock(t), ClipDao: clips})
+				},
+				Middlewares:      student,
+				ExpectedCode:     http.StatusOK,
+				ExpectedResponse: []clipDto{newClipDto(clip, recording)},
+			},
+		}.
+			Method(http.MethodGet).
+			Url(fmt.Sprintf("/api/course/%d/clips", testutils.CourseFPV.ID)).
+			Run(t, testutils.Equal)
+	})
+
+	t.Run("DELETE/api/clips/:slug", func(t *testing.T) {
+		gomino.TestCases{
+			"not the creator": {
+				Router: func(r *gin.Engine) {
+					other := clip
+					other.UserID = testutils.Lecturer.ID
+					configGinClipsRouter(r, dao.DaoWrapper{ClipDao: clipsMock(t, other, nil), StreamsDao: streamsMock(t, recording), CoursesDao: testutils.GetCoursesMock(t)})
+				},
+				Middlewares:  student,
+				ExpectedCode: http.StatusForbidden,
+			},
+			"creator": {
+				Router: func(r *gin.Engine) {
+					clips := clipsMock(t, clip, nil)
+					clips.EXPECT().Delete(gomock.Any(), clip.ID).Return(nil)
+					configGinClipsRouter(r, dao.DaoWrapper{ClipDao: clips, StreamsDao: streamsMock(t, recording), CoursesDao: testutils.GetCoursesMock(t)})
+				},
+				Middlewares:  student,
+				ExpectedCode: http.StatusOK,
+			},
+		}.
+			Method(http.MethodDelete).
+			Url("/api/clips/abc").
+			Run(t, testutils.Equal)
+	})
+}
+
+func TestClipPlaylistQuery(t *testing.T) {
+	clip := model.Clip{Start: 34 * 60, End: 41 * 60}
+	if q := clip.PlaylistQuery(); q != "wowzaplaystart=2040000&wowzaplayduration=420000" {
+		t.Errorf("unexpected query %s", q)
+	}
+	if name := clip.GetName(model.Stream{Name: "Lecture 5"}); name != "Lecture 5 (34:00 - 41:00)" {
+		t.Errorf("unexpected name %s", name)
+	}
+}
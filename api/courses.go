@@ -67,6 +67,8 @@ func configGinCourseRouter(router *gin.Engine, daoWrapper dao.DaoWrapper) {
 			courses.POST("/copy", routes.copyCourse)
 			courses.POST("/createLecture", routes.createLecture)
 			courses.POST("/presets", routes.updateSourceSettings)
+			courses.GET("/subtitleLanguages", routes.getSubtitleLanguages)
+			courses.PUT("/subtitleLanguages", routes.updateSubtitleLanguages)
 			courses.POST("/deleteLectures", routes.deleteLectures)
 			courses.POST("/renameLecture/:streamID", routes.renameLecture)
 			courses.POST("/updateLectureSeries/:streamID", routes.updateLectureSeries)
@@ -489,16 +491,15 @@ func (r coursesRoutes) uploadVODMedia(c *gin.Context) {
 		})
 		return
 	}
-	workers := r.WorkerDao.GetAliveWorkers()
-	if len(workers) == 0 {
+	w, reason := scheduleWorker(r.WorkerDao.GetAliveWorkers(), schedulingRequest{requiredTags: requiredTags("upload")})
+	if w == nil {
 		_ = c.Error(tools.RequestError{
 			Status:        http.StatusInternalServerError,
-			CustomMessage: "no workers available",
+			CustomMessage: "no workers available: " + reason,
 			Err:           err,
 		})
 		return
 	}
-	w := workers[getWorkerWithLeastWorkload(workers)]
 	u, err := url.Parse("http://" + w.Host + ":" + WorkerHTTPPort + "/upload?" + c.Request.URL.Query().Encode() + "&key=" + key)
 	if err != nil {
 		_ = c.Error(tools.RequestError{
@@ -583,6 +584,55 @@ func (r coursesRoutes) updateSourceSettings(c *gin.Context) {
 	}
 }
 
+func (r coursesRoutes) getSubtitleLanguages(c *gin.Context) {
+	tumLiveContext := c.MustGet("TUMLiveContext").(tools.TUMLiveContext)
+	c.JSON(http.StatusOK, tumLiveContext.Course.GetSubtitleLanguages())
+}
+
+// updateSubtitleLanguages sets the languages generated subtitles of the course are translated into.
+func (r coursesRoutes) updateSubtitleLanguages(c *gin.Context) {
+	tumLiveContext := c.MustGet("TUMLiveContext").(tools.TUMLiveContext)
+	course := tumLiveContext.Course
+
+	var languages []string
+	if err := c.BindJSON(&languages); err != nil {
+		_ = c.Error(tools.RequestError{
+			Status:        http.StatusBadRequest,
+			CustomMessage: "invalid body",
+			Err:           err,
+		})
+		return
+	}
+	for _, lang := range languages {
+		if !validSubtitleLanguage(lang) {
+			_ = c.Error(tools.RequestError{
+				Status:        http.StatusBadRequest,
+				CustomMessage: "invalid language: " + lang,
+			})
+			return
+		}
+	}
+
+	if err := r.AuditDao.Create(&model.Audit{
+		User:    tumLiveContext.User,
+		Message: fmt.Sprintf("%s:'%s' subtitle languages: %v", course.Name, course.Slug, languages),
+		Type:    model.AuditCourseEdit,
+	}); err != nil {
+		logger.Error("Create Audit:", "err", err)
+	}
+
+	course.SetSubtitleLanguages(languages)
+	if err := r.CoursesDao.UpdateCourse(c, *course); err != nil {
+		logger.Error("failed to update course", "err", err)
+		_ = c.Error(tools.RequestError{
+			Status:        http.StatusInternalServerError,
+			CustomMessage: "failed to update course",
+			Err:           err,
+		})
+		return
+	}
+}
+
 func (r coursesRoutes) activateCourseByToken(c *gin.Context) {
 	tlctx := c.MustGet("TUMLiveContext").(tools.TUMLiveContext)
 	t := c.Param("token")
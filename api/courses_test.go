@@ -2531,6 +2531,64 @@ func TestPresets(t *testing.T) {
 			Url(url).
 			Run(t, testutils.Equal)
 	})
+
+	t.Run("PUT/api/course/:courseID/subtitleLanguages", func(t *testing.T) {
+		url := fmt.Sprintf("/api/course/%d/subtitleLanguages", testutils.CourseFPV.ID)
+
+		afterChanges := testutils.CourseFPV
+		afterChanges.SetSubtitleLanguages([]string{"de", "pt-BR"})
+
+		router := func(updated bool, updateErr error) func(r *gin.Engine) {
+			return func(r *gin.Engine) {
+				coursesMock := mock_dao.NewMockCoursesDao(gomock.NewController(t))
+				coursesMock.
+					EXPECT().
+					GetCourseById(gomock.Any(), testutils.CourseFPV.ID).
+					Return(testutils.CourseFPV, nil).
+					AnyTimes()
+				coursesMock.
+					EXPECT().
+					UpdateCourse(gomock.Any(), afterChanges).
+					Return(updateErr).
+					AnyTimes()
+				wrapper := dao.DaoWrapper{CoursesDao: coursesMock}
+				if updated {
+					wrapper.AuditDao = testutils.GetAuditMock(t)
+				}
+				configGinCourseRouter(r, wrapper)
+			}
+		}
+
+		gomino.TestCases{
+			"not admin": {
+				Router:       router(false, nil),
+				Middlewares:  testutils.GetMiddlewares(tools.ErrorHandler, testutils.TUMLiveContext(testutils.TUMLiveContextStudent)),
+				Body:         []string{"de", "pt-BR"},
+				ExpectedCode: http.StatusForbidden,
+			},
+			"invalid language": {
+				Router:       router(false, nil),
+				Middlewares:  testutils.GetMiddlewares(tools.ErrorHandler, testutils.TUMLiveContext(testutils.TUMLiveContextAdmin)),
+				Body:         []string{"de", "../en"},
+				ExpectedCode: http.StatusBadRequest,
+			},
+			"can not update course": {
+				Router:       router(true, errors.New("")),
+				Middlewares:  testutils.GetMiddlewares(tools.ErrorHandler, testutils.TUMLiveContext(testutils.TUMLiveContextAdmin)),
+				Body:         []string{"de", "pt-BR"},
+				ExpectedCode: http.StatusInternalServerError,
+			},
+			"success": {
+				Router:       router(true, nil),
+				Middlewares:  testutils.GetMiddlewares(tools.ErrorHandler, testutils.TUMLiveContext(testutils.TUMLiveContextAdmin)),
+				Body:         []string{"de", "pt-BR"},
+				ExpectedCode: http.StatusOK,
+			},
+		}.
+			Method(http.MethodPut).
+			Url(url).
+			Run(t, testutils.Equal)
+	})
 }
 
 func TestCreateVOD(t *testing.T) {
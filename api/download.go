@@ -72,6 +72,10 @@ func (r downloadRoutes) download(c *gin.Context) {
 		})
 		return
 	}
+	if hiddenByEdits(stream, file) && !tumLiveContext.User.IsAdminOfCourse(course) {
+		_ = c.Error(dlErr)
+		return
+	}
 
 	switch c.Query("type") {
 	case "serve":
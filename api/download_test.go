@@ -105,6 +105,20 @@ func TestDownload(t *testing.T) {
 				Middlewares:  testutils.GetMiddlewares(tools.ErrorHandler, testutils.TUMLiveContext(testutils.TUMLiveContextStudent)),
 				ExpectedCode: http.StatusForbidden,
 			},
+			"GET[original of edited stream]": {
+				Router: func(r *gin.Engine) {
+					files := mock_dao.NewMockFileDao(gomock.NewController(t))
+					files.EXPECT().GetFileById(gomock.Eq(fileId)).Return(model.File{StreamID: streamId, Path: filePath, Type: model.FILETYPE_VOD}, nil)
+					streams := mock_dao.NewMockStreamsDao(gomock.NewController(t))
+					streams.EXPECT().GetStreamByID(gomock.Any(), fmt.Sprintf("%d", streamId)).
+						Return(model.Stream{CourseID: courseId, Edits: []model.StreamEdit{{Start: 0, End: 60000, Discard: true}}}, nil)
+					courses := mock_dao.NewMockCoursesDao(gomock.NewController(t))
+					courses.EXPECT().GetCourseById(gomock.Any(), courseId).Return(model.Course{UserID: 1, DownloadsEnabled: true}, nil)
+					configGinDownloadRouter(r, dao.DaoWrapper{FileDao: files, StreamsDao: streams, CoursesDao: courses})
+				},
+				Middlewares:  testutils.GetMiddlewares(tools.ErrorHandler, testutils.TUMLiveContext(testutils.TUMLiveContextStudent)),
+				ExpectedCode: http.StatusForbidden,
+			},
 			"GET[File not found]": {
 				Router: func(r *gin.Engine) {
 					wrapper := dao.DaoWrapper{
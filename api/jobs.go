@@ -0,0 +1,156 @@
+package api
+
+import (
+	"errors"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/TUM-Dev/gocast/dao"
+	"github.com/TUM-Dev/gocast/model"
+	"github.com/TUM-Dev/gocast/tools"
+	"github.com/gin-gonic/gin"
+	"gorm.io/gorm"
+)
+
+func configJobsRouter(router *gin.Engine, daoWrapper dao.DaoWrapper) {
+	routes := jobRoutes{daoWrapper}
+
+	g := router.Group("/api/jobs")
+	g.Use(tools.Admin)
+	{
+		g.GET("", routes.getJobs)
+		g.GET("/:id", routes.getJob)
+		g.POST("/:id/retry", routes.retryJob)
+		g.POST("/:id/cancel", routes.cancelJob)
+	}
+}
+
+type jobRoutes struct {
+	dao.DaoWrapper
+}
+
+const (
+	defaultJobsLimit = 50
+	maxJobsLimit     = 500
+)
+
+type getJobsQuery struct {
+	State  model.JobState `form:"state" binding:"omitempty,oneof=queued assigned running succeeded failed"`
+	Type   model.JobType  `form:"type"`
+	Limit  int            `form:"limit" binding:"omitempty,min=1"`
+	Offset int            `form:"offset" binding:"omitempty,min=0"`
+}
+
+// getJobs lists jobs, optionally filtered by state and type. state=failed lists the dead-lettered jobs.
+func (r jobRoutes) getJobs(c *gin.Context) {
+	var query getJobsQuery
+	if err := c.ShouldBindQuery(&query); err != nil {
+		_ = c.Error(tools.RequestError{
+			Status:        http.StatusBadRequest,
+			CustomMessage: "invalid query",
+			Err:           err,
+		})
+		return
+	}
+	if query.Limit == 0 {
+		query.Limit = defaultJobsLimit
+	}
+	if query.Limit > maxJobsLimit {
+		query.Limit = maxJobsLimit
+	}
+	jobs, err := r.JobDao.Find(c, query.State, query.Type, query.Limit, query.Offset)
+	if err != nil {
+		_ = c.Error(tools.RequestError{
+			Status:        http.StatusInternalServerError,
+			CustomMessage: "can't get jobs",
+			Err:           err,
+		})
+		return
+	}
+	c.JSON(http.StatusOK, jobs)
+}
+
+func (r jobRoutes) getJob(c *gin.Context) {
+	job, ok := r.jobFromParam(c)
+	if !ok {
+		return
+	}
+	c.JSON(http.StatusOK, job)
+}
+
+// retryJob queues a failed job again. It is sent to a worker with the next run of the dispatcher.
+func (r jobRoutes) retryJob(c *gin.Context) {
+	job, ok := r.jobFromParam(c)
+	if !ok {
+		return
+	}
+	if err := job.Retry(time.Now()); err != nil {
+		_ = c.Error(tools.RequestError{
+			Status:        http.StatusBadRequest,
+			CustomMessage: err.Error(),
+			Err:           err,
+		})
+		return
+	}
+	r.saveJob(c, &job)
+}
+
+// cancelJob fails a job without further attempts. Workers that already run the job are not interrupted.
+func (r jobRoutes) cancelJob(c *gin.Context) {
+	job, ok := r.jobFromParam(c)
+	if !ok {
+		return
+	}
+	if err := job.Cancel("canceled by admin"); err != nil {
+		_ = c.Error(tools.RequestError{
+			Status:        http.StatusBadRequest,
+			CustomMessage: err.Error(),
+			Err:           err,
+		})
+		return
+	}
+	r.saveJob(c, &job)
+}
+
+func (r jobRoutes) saveJob(c *gin.Context, job *model.Job) {
+	if err := r.JobDao.Save(c, job); err != nil {
+		_ = c.Error(tools.RequestError{
+			Status:        http.StatusInternalServerError,
+			CustomMessage: "can't save job",
+			Err:           err,
+		})
+		return
+	}
+	c.JSON(http.StatusOK, job)
+}
+
+func (r jobRoutes) jobFromParam(c *gin.Context) (model.Job, bool) {
+	id, err := strconv.ParseUint(c.Param("id"), 10, 32)
+	if err != nil {
+		_ = c.Error(tools.RequestError{
+			Status:        http.StatusBadRequest,
+			CustomMessage: "can't parse id",
+			Err:           err,
+		})
+		return model.Job{}, false
+	}
+	job, err := r.JobDao.Get(c, uint(id))
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		_ = c.Error(tools.RequestError{
+			Status:        http.StatusNotFound,
+			CustomMessage: "job not found",
+			Err:           err,
+		})
+		return job, false
+	}
+	if err != nil {
+		_ = c.Error(tools.RequestError{
+			Status:        http.StatusInternalServerError,
+			CustomMessage: "can't get job",
+			Err:           err,
+		})
+		return job, false
+	}
+	return job, true
+}
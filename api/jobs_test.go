@@ -0,0 +1,222 @@
+package api
+
+import (
+	"errors"
+	"html/template"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/Masterminds/sprig/v3"
+	"github.com/TUM-Dev/gocast/dao"
+	"github.com/TUM-Dev/gocast/mock_dao"
+	"github.com/TUM-Dev/gocast/model"
+	"github.com/TUM-Dev/gocast/tools"
+	"github.com/TUM-Dev/gocast/tools/testutils"
+	"github.com/gin-gonic/gin"
+	"github.com/golang/mock/gomock"
+	"github.com/matthiasreumann/gomino"
+	"gorm.io/gorm"
+)
+
+func TestJobs(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	templateExecutor := tools.ReleaseTemplateExecutor{
+		Template: template.Must(template.New("base").Funcs(sprig.FuncMap()).
+			ParseFiles("../web/template/error.gohtml")),
+	}
+	tools.SetTemplateExecutor(templateExecutor)
+
+	failedJob := model.Job{Model: gorm.Model{ID: 1}, Type: model.JobTypeThumbnails, State: model.JobFailed, Attempts: 5, MaxAttempts: 5}
+	succeededJob := model.Job{Model: gorm.Model{ID: 2}, Type: model.JobTypeThumbnails, State: model.JobSucceeded, Attempts: 1, MaxAttempts: 5}
+
+	admin := testutils.GetMiddlewares(tools.ErrorHandler, testutils.TUMLiveContext(testutils.TUMLiveContextAdmin))
+
+	jobDao := func(t *testing.T) dao.JobDao {
+		mock := mock_dao.NewMockJobDao(gomock.NewController(t))
+		mock.EXPECT().Get(gomock.Any(), uint(1)).Return(failedJob, nil).AnyTimes()
+		mock.EXPECT().Get(gomock.Any(), uint(2)).Return(succeededJob, nil).AnyTimes()
+		mock.EXPECT().Get(gomock.Any(), uint(3)).Return(model.Job{}, gorm.ErrRecordNotFound).AnyTimes()
+		mock.EXPECT().Save(gomock.Any(), gomock.Any()).Return(nil).AnyTimes()
+		return mock
+	}
+
+	t.Run("GET/api/jobs", func(t *testing.T) {
+		gomino.TestCases{
+			"not admin": {
+				Router: func(r *gin.Engine) {
+					configJobsRouter(r, dao.DaoWrapper{})
+				},
+				Middlewares:  testutils.GetMiddlewares(tools.ErrorHandler, testutils.TUMLiveContext(testutils.TUMLiveContextStudent)),
+				ExpectedCode: http.StatusForbidden,
+			},
+			"invalid state": {
+				Router: func(r *gin.Engine) {
+					configJobsRouter(r, dao.DaoWrapper{})
+				},
+				Url:          "/api/jobs?state=unknown",
+				Middlewares:  admin,
+				ExpectedCode: http.StatusBadRequest,
+			},
+			"can not get jobs": {
+				Router: func(r *gin.Engine) {
+					mock := mock_dao.NewMockJobDao(gomock.NewController(t))
+					mock.EXPECT().Find(gomock.Any(), model.JobState(""), model.JobType(""), defaultJobsLimit, 0).Return(nil, errors.New("")).AnyTimes()
+					configJobsRouter(r, dao.DaoWrapper{JobDao: mock})
+				},
+				Middlewares:  admin,
+				ExpectedCode: http.StatusInternalServerError,
+			},
+			"dead letter queue": {
+				Router: func(r *gin.Engine) {
+					mock := mock_dao.NewMockJobDao(gomock.NewController(t))
+					mock.EXPECT().Find(gomock.Any(), model.JobFailed, model.JobTypeThumbnails, maxJobsLimit, 10).Return([]model.Job{failedJob}, nil).AnyTimes()
+					configJobsRouter(r, dao.DaoWrapper{JobDao: mock})
+				},
+				Url:              "/api/jobs?state=failed&type=thumbnails&limit=1000&offset=10",
+				Middlewares:      admin,
+				ExpectedCode:     http.StatusOK,
+				ExpectedResponse: []model.Job{failedJob},
+			},
+		}.Method(http.MethodGet).Url("/api/jobs").Run(t, testutils.Equal)
+	})
+
+	t.Run("GET/api/jobs/:id", func(t *testing.T) {
+		gomino.TestCases{
+			"invalid id": {
+				Router: func(r *gin.Engine) {
+					configJobsRouter(r, dao.DaoWrapper{JobDao: jobDao(t)})
+				},
+				Url:          "/api/jobs/abc",
+				Middlewares:  admin,
+				ExpectedCode: http.StatusBadRequest,
+			},
+			"not found": {
+				Router: func(r *gin.Engine) {
+					configJobsRouter(r, dao.DaoWrapper{JobDao: jobDao(t)})
+				},
+				Url:          "/api/jobs/3",
+				Middlewares:  admin,
+				ExpectedCode: http.StatusNotFound,
+			},
+			"success": {
+				Router: func(r *gin.Engine) {
+					configJobsRouter(r, dao.DaoWrapper{JobDao: jobDao(t)})
+				},
+				Url:              "/api/jobs/1",
+				Middlewares:      admin,
+				ExpectedCode:     http.StatusOK,
+				ExpectedResponse: failedJob,
+			},
+		}.Method(http.MethodGet).Run(t, testutils.Equal)
+	})
+
+	t.Run("POST/api/jobs/:id/retry", func(t *testing.T) {
+		gomino.TestCases{
+			"not failed": {
+				Router: func(r *gin.Engine) {
+					configJobsRouter(r, dao.DaoWrapper{JobDao: jobDao(t)})
+				},
+				Url:          "/api/jobs/2/retry",
+				Middlewares:  admin,
+				ExpectedCode: http.StatusBadRequest,
+			},
+			"success": {
+				Router: func(r *gin.Engine) {
+					mock := mock_dao.NewMockJobDao(gomock.NewController(t))
+					mock.EXPECT().Get(gomock.Any(), uint(1)).Return(failedJob, nil)
+					mock.EXPECT().Save(gomock.Any(), gomock.Any()).DoAndReturn(func(_ interface{}, job *model.Job) error {
+						if job.State != model.JobQueued || job.Attempts != 0 || job.RunAfter.After(time.Now()) {
+							t.Errorf("retried job should be queued with fresh attempts, got %+v", job)
+						}
+						return nil
+					})
+					configJobsRouter(r, dao.DaoWrapper{JobDao: mock})
+				},
+				Url:          "/api/jobs/1/retry",
+				Middlewares:  admin,
+				ExpectedCode: http.StatusOK,
+			},
+		}.Method(http.MethodPost).Run(t, testutils.Equal)
+	})
+
+	t.Run("POST/api/jobs/:id/cancel", func(t *testing.T) {
+		gomino.TestCases{
+			"already done": {
+				Router: func(r *gin.Engine) {
+					configJobsRouter(r, dao.DaoWrapper{JobDao: jobDao(t)})
+				},
+				Url:          "/api/jobs/2/cancel",
+				Middlewares:  admin,
+				ExpectedCode: http.StatusBadRequest,
+			},
+			"success": {
+				Router: func(r *gin.Engine) {
+					queued := model.Job{Model: gorm.Model{ID: 4}, Type: model.JobTypeStream, State: model.JobQueued, MaxAttempts: 10}
+					mock := mock_dao.NewMockJobDao(gomock.NewController(t))
+					mock.EXPECT().Get(gomock.Any(), uint(4)).Return(queued, nil)
+					mock.EXPECT().Save(gomock.Any(), gomock.Any()).DoAndReturn(func(_ interface{}, job *model.Job) error {
+						if job.State != model.JobFailed {
+							t.Errorf("canceled job should be failed, got %s", job.State)
+						}
+						return nil
+					})
+					configJobsRouter(r, dao.DaoWrapper{JobDao: mock})
+				},
+				Url:          "/api/jobs/4/cancel",
+				Middlewares:  admin,
+				ExpectedCode: http.StatusOK,
+			},
+		}.Method(http.MethodPost).Run(t, testutils.Equal)
+	})
+}
+
+func TestJobFail(t *testing.T) {
+	now := time.Now()
+	job, err := model.NewJob(model.JobTypeStreamEnd, 1, streamEndJobPayload{DiscardVoD: true})
+	if err != nil {
+		t.Fatal(err)
+	}
+	var payload streamEndJobPayload
+	if err = job.DecodePayload(&payload); err != nil || !payload.DiscardVoD {
+		t.Fatalf("payload was not preserved, got %+v (%v)", payload, err)
+	}
+	policy := model.JobTypeStreamEnd.RetryPolicy()
+	for i := uint(1); i < policy.MaxAttempts; i++ {
+		job.Assign("worker", now)
+		job.Fail(errors.New("unavailable"), now)
+		if job.State != model.JobQueued {
+			t.Fatalf("attempt %d should be retried, got %s", i, job.State)
+		}
+		if expected := now.Add(policy.Delay(i)); !job.RunAfter.Equal(expected) {
+			t.Errorf("attempt %d should be retried at %s, got %s", i, expected, job.RunAfter)
+		}
+	}
+	job.Assign("worker", now)
+	job.Fail(errors.New("unavailable"), now)
+	if job.State != model.JobFailed || job.LastError != "unavailable" {
+		t.Errorf("job should be dead-lettered after %d attempts, got %s", policy.MaxAttempts, job.State)
+	}
+}
+
+func TestSelectWorkerForJob(t *testing.T) {
+	workers := []model.Worker{{WorkerID: "a", Host: "h1", Workload: 5, LastSeen: time.Now()}, {WorkerID: "b", Host: "h2", Workload: 1, LastSeen: time.Now()}}
+	placement := newJobPlacement(workers, nil)
+	job := model.Job{Type: model.JobTypeThumbnails}
+	if w, _ := selectWorkerForJob(dao.DaoWrapper{}, job, workers, placement); w == nil || w.WorkerID != "b" {
+		t.Errorf("expected worker with least workload, got %v", w)
+	}
+	job.TargetWorkerID = "a"
+	if w, _ := selectWorkerForJob(dao.DaoWrapper{}, job, workers, placement); w == nil || w.WorkerID != "a" {
+		t.Errorf("expected target worker, got %v", w)
+	}
+	job.TargetWorkerID = "c"
+	if w, _ := selectWorkerForJob(dao.DaoWrapper{}, job, workers, placement); w != nil {
+		t.Errorf("expected no worker if the target worker is not alive, got %v", w)
+	}
+	retried := model.Job{Type: model.JobTypeThumbnails, WorkerID: "b", Attempts: 1}
+	if w, _ := selectWorkerForJob(dao.DaoWrapper{}, retried, workers, placement); w == nil || w.WorkerID != "a" {
+		t.Errorf("expected retry on another host, got %v", w)
+	}
+}
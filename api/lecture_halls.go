@@ -47,11 +47,12 @@ type lectureHallRoutes struct {
 }
 
 type updateLectureHallReq struct {
-	CamIp     string `json:"camIp"`
-	CombIp    string `json:"combIp"`
-	PresIP    string `json:"presIp"`
-	CameraIp  string `json:"cameraIp"`
-	PwrCtrlIp string `json:"pwrCtrlIp"`
+	CamIp      string `json:"camIp"`
+	CombIp     string `json:"combIp"`
+	PresIP     string `json:"presIp"`
+	CameraIp   string `json:"cameraIp"`
+	PwrCtrlIp  string `json:"pwrCtrlIp"`
+	WorkerTags string `json:"workerTags"`
 }
 
 func (r lectureHallRoutes) updateLectureHall(c *gin.Context) {
@@ -89,6 +90,7 @@ func (r lectureHallRoutes) updateLectureHall(c *gin.Context) {
 	lectureHall.PresIP = req.PresIP
 	lectureHall.CameraIP = req.CameraIp
 	lectureHall.PwrCtrlIp = req.PwrCtrlIp
+	lectureHall.WorkerTags = req.WorkerTags
 	err = r.LectureHallsDao.SaveLectureHall(lectureHall)
 	if err != nil {
 		logger.Error("error while updating lecture hall", "err", err)
@@ -25,6 +25,8 @@ func configMaintenanceRouter(router *gin.Engine, daoWrapper dao.DaoWrapper) {
 
 		g.GET("/emailFailures", routes.getEmailFailures)
 		g.DELETE("/emailFailures/:id", routes.deleteEmailFailure)
+
+		g.POST("/search/rebuild", routes.rebuildSearchIndex)
 	}
 
 	cronGroup := g.Group("/cron")
@@ -71,7 +73,7 @@ func (r *maintenanceRoutes) generateThumbnails(c *gin.Context) {
 						continue
 					}
 					// Request thumbnail for VoD.
-					err := RegenerateThumbs(dao.NewDaoWrapper(), file, &stream, &course)
+					err := RegenerateThumbs(dao.NewDaoWrapper(), file, &stream)
 					if err != nil {
 						logger.Error(fmt.Sprintf(
 							"Can't regenerate thumbnail for stream %d with file %s",
@@ -115,6 +117,24 @@ func (r *maintenanceRoutes) runCronJob(c *gin.Context) {
 	tools.Cron.RunJob(jobName)
 }
 
+// rebuildSearchIndex repairs the search index by exporting everything again.
+func (r *maintenanceRoutes) rebuildSearchIndex(c *gin.Context) {
+	exporter := tools.NewMeiliExporter(r.DaoWrapper)
+	if exporter == nil {
+		_ = c.Error(tools.RequestError{
+			Status:        http.StatusServiceUnavailable,
+			CustomMessage: "search backend is not configured",
+		})
+		return
+	}
+	logger.Info("rebuilding search index")
+	go func() {
+		exporter.SetIndexSettings()
+		exporter.Export()
+	}()
+	c.Status(http.StatusAccepted)
+}
+
 func (r *maintenanceRoutes) getTranscodingFailures(c *gin.Context) {
 	all, err := r.TranscodingFailureDao.All()
 	if err != nil {
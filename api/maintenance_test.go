@@ -0,0 +1,26 @@
+package api
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/TUM-Dev/gocast/dao"
+	"github.com/TUM-Dev/gocast/tools"
+	"github.com/TUM-Dev/gocast/tools/testutils"
+	"github.com/gin-gonic/gin"
+	"github.com/matthiasreumann/gomino"
+)
+
+func TestMaintenance(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	t.Run("POST/api/maintenance/search/rebuild", func(t *testing.T) {
+		gomino.TestCases{
+			"search not configured": {
+				Router:       func(r *gin.Engine) { configMaintenanceRouter(r, dao.DaoWrapper{}) },
+				Middlewares:  testutils.GetMiddlewares(tools.ErrorHandler, testutils.TUMLiveContext(testutils.TUMLiveContextAdmin)),
+				ExpectedCode: http.StatusServiceUnavailable,
+			},
+		}.Method(http.MethodPost).Url("/api/maintenance/search/rebuild").Run(t, testutils.Equal)
+	})
+}
@@ -0,0 +1,150 @@
+// ocr_service_grpc.go handles communication between tum-live and the ocr-service, which extracts the text of slides
+
+package api
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net"
+	"path/filepath"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/TUM-Dev/gocast/dao"
+	"github.com/TUM-Dev/gocast/model"
+	"github.com/TUM-Dev/gocast/ocr-service/pb"
+	"github.com/TUM-Dev/gocast/tools"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/keepalive"
+	"google.golang.org/grpc/reflection"
+	"google.golang.org/protobuf/types/known/emptypb"
+)
+
+type slideTextReceiverServer struct {
+	pb.UnimplementedSlideTextReceiverServer
+	dao.DaoWrapper
+}
+
+// Receive stores the text of the slides of a stream, the search index picks it up with the next update.
+func (s slideTextReceiverServer) Receive(ctx context.Context, request *pb.ReceiveRequest) (*emptypb.Empty, error) {
+	texts := make([]model.SlideText, 0, len(request.GetSlides()))
+	for _, slide := range request.GetSlides() {
+		text := strings.TrimSpace(slide.GetText())
+		if text == "" {
+			continue
+		}
+		texts = append(texts, model.SlideText{StreamID: uint(request.GetStreamId()), Start: uint(slide.GetStart()), Text: text})
+	}
+	if err := s.SlideTextDao.Replace(ctx, uint(request.GetStreamId()), texts); err != nil {
+		return nil, err
+	}
+	removeSlideImages(s.DaoWrapper, uint(request.GetStreamId()))
+	return &emptypb.Empty{}, nil
+}
+
+func (s slideTextReceiverServer) ReportFailure(_ context.Context, request *pb.FailureReport) (*emptypb.Empty, error) {
+	logger.Warn("ocr-service can't extract slide text", "stream", request.GetStreamId(), "err", request.GetError())
+	removeSlideImages(s.DaoWrapper, uint(request.GetStreamId()))
+	return &emptypb.Empty{}, nil
+}
+
+// slideImageDirs are the directories of the frames sent to the ocr-service by stream id. They are only needed
+// until the ocr-service reports the text, frames of requests lost in a restart stay until the stream is transcoded again.
+var slideImageDirs sync.Map
+
+// removeSlideImages queues the removal of the frames of a stream the ocr-service is done with.
+func removeSlideImages(daoWrapper dao.DaoWrapper, streamID uint) {
+	dir, ok := slideImageDirs.LoadAndDelete(streamID)
+	if !ok {
+		return
+	}
+	if err := removeStoredFile(daoWrapper, streamID, dir.(string)); err != nil {
+		logger.Error("can't queue removal of slide images", "err", err, "stream", streamID)
+	}
+}
+
+// slideImage is a frame of a slide change a worker saved to the shared storage.
+type slideImage struct {
+	Start uint // seconds since the start of the video
+	Path  string
+}
+
+// requestSlideText requests the text of the slides of a stream from the ocr-service, which sends it to Receive.
+// The frames are removed once the ocr-service is done with them.
+func requestSlideText(ctx context.Context, daoWrapper dao.DaoWrapper, streamID uint, images []slideImage) error {
+	slideImageDirs.Store(streamID, filepath.Dir(images[0].Path))
+	client, err := GetSlideRecognizerClient()
+	if err != nil {
+		removeSlideImages(daoWrapper, streamID)
+		return err
+	}
+	defer client.CloseConn()
+	slides := make([]*pb.Slide, len(images))
+	for i, image := range images {
+		slides[i] = &pb.Slide{Start: int32(image.Start), ImageFile: image.Path}
+	}
+	if _, err = client.Recognize(ctx, &pb.RecognizeRequest{StreamId: int32(streamID), Slides: slides}); err != nil {
+		removeSlideImages(daoWrapper, streamID)
+		return err
+	}
+	return nil
+}
+
+// ServeOcrReceiverGRPC initializes the gRPC server the ocr-service sends slide texts to on port 50054
+func ServeOcrReceiverGRPC() {
+	if tools.Cfg.OcrService == nil {
+		return
+	}
+	logger.Info("starting grpc ocr-receiver")
+	lis, err := net.Listen("tcp", ":50054")
+	if err != nil {
+		logger.Error("failed to init ocr-receiver server", "err", err)
+		return
+	}
+	opts := []grpc.ServerOption{grpc.KeepaliveParams(keepalive.ServerParameters{
+		MaxConnectionIdle:     time.Minute,
+		MaxConnectionAge:      time.Minute,
+		MaxConnectionAgeGrace: time.Second * 5,
+		Time:                  time.Minute * 10,
+		Timeout:               time.Second * 20,
+	})}
+	if tools.WorkerCA != nil {
+		opts = append(opts, grpc.Creds(credentials.NewTLS(serviceTLSConfig(tools.OcrServiceCommonName))))
+	}
+	grpcServer := grpc.NewServer(opts...)
+	pb.RegisterSlideTextReceiverServer(grpcServer, &slideTextReceiverServer{DaoWrapper: dao.NewDaoWrapper()})
+
+	reflection.Register(grpcServer)
+	go func() {
+		if err := grpcServer.Serve(lis); err != nil {
+			logger.Error("failed to serve", "err", err)
+		}
+	}()
+}
+
+type SlideRecognizerClient struct {
+	pb.SlideRecognizerClient
+	*grpc.ClientConn
+}
+
+func GetSlideRecognizerClient() (SlideRecognizerClient, error) {
+	if tools.Cfg.OcrService == nil {
+		return SlideRecognizerClient{}, errors.New("ocr-service is not configured")
+	}
+	ocrAddr := fmt.Sprintf("%s:%s", tools.Cfg.OcrService.Host, tools.Cfg.OcrService.Port)
+	conn, err := grpc.Dial(ocrAddr, grpc.WithTransportCredentials(clientCredentials(tools.Cfg.OcrService.Host)))
+	if err != nil {
+		return SlideRecognizerClient{}, err
+	}
+	return SlideRecognizerClient{pb.NewSlideRecognizerClient(conn), conn}, nil
+}
+
+func (s SlideRecognizerClient) CloseConn() {
+	err := s.ClientConn.Close()
+	if err != nil {
+		logger.Error("could not close ocr-service connection", "err", err)
+	}
+}
@@ -0,0 +1,62 @@
+package api
+
+import (
+	"context"
+	"testing"
+
+	"github.com/TUM-Dev/gocast/dao"
+	"github.com/TUM-Dev/gocast/mock_dao"
+	"github.com/TUM-Dev/gocast/model"
+	"github.com/TUM-Dev/gocast/ocr-service/pb"
+	"github.com/golang/mock/gomock"
+)
+
+func TestReceiveSlideText(t *testing.T) {
+	slideTexts := mock_dao.NewMockSlideTextDao(gomock.NewController(t))
+	slideTexts.EXPECT().Replace(gomock.Any(), uint(1), []model.SlideText{
+		{StreamID: 1, Start: 0, Text: "Algorithms and Data Structures"},
+		{StreamID: 1, Start: 754, Text: "B-Trees"},
+	}).Return(nil)
+
+	slideImageDirs.Store(uint(1), "/srv/eidi-PRES-slides")
+	s := slideTextReceiverServer{DaoWrapper: dao.DaoWrapper{SlideTextDao: slideTexts, JobDao: expectSlideImageRemoval(t, 1, "/srv/eidi-PRES-slides")}}
+	_, err := s.Receive(context.Background(), &pb.ReceiveRequest{
+		StreamId: 1,
+		Slides: []*pb.SlideText{
+			{Start: 0, Text: "Algorithms and Data Structures\n"},
+			{Start: 300, Text: "  "}, // e.g. a photo
+			{Start: 754, Text: "B-Trees"},
+		},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestReportSlideTextFailure(t *testing.T) {
+	slideImageDirs.Store(uint(2), "/srv/gbs-PRES-slides")
+	s := slideTextReceiverServer{DaoWrapper: dao.DaoWrapper{JobDao: expectSlideImageRemoval(t, 2, "/srv/gbs-PRES-slides")}}
+	if _, err := s.ReportFailure(context.Background(), &pb.FailureReport{StreamId: 2, Error: "timeout"}); err != nil {
+		t.Fatal(err)
+	}
+	// the frames are removed only once
+	if _, err := s.ReportFailure(context.Background(), &pb.FailureReport{StreamId: 2, Error: "timeout"}); err != nil {
+		t.Fatal(err)
+	}
+}
+
+// expectSlideImageRemoval returns a JobDao that expects the removal of the slide images in dir to be queued once.
+func expectSlideImageRemoval(t *testing.T, streamID uint, dir string) dao.JobDao {
+	jobs := mock_dao.NewMockJobDao(gomock.NewController(t))
+	jobs.EXPECT().Create(gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, job *model.Job) error {
+		var payload deleteSectionImageJobPayload
+		if err := job.DecodePayload(&payload); err != nil {
+			t.Fatal(err)
+		}
+		if job.Type != model.JobTypeDeleteSectionImage || job.StreamID != streamID || payload.Path != dir {
+			t.Errorf("unexpected job %+v with payload %+v", job, payload)
+		}
+		return nil
+	})
+	return jobs
+}
@@ -0,0 +1,359 @@
+package api
+
+import (
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"os"
+	"strconv"
+	"time"
+
+	"github.com/TUM-Dev/gocast/dao"
+	"github.com/TUM-Dev/gocast/model"
+	"github.com/TUM-Dev/gocast/tools"
+	"github.com/gin-gonic/gin"
+	uuid "github.com/satori/go.uuid"
+	"gorm.io/gorm"
+)
+
+func configGinPodcastRouter(router *gin.Engine, daoWrapper dao.DaoWrapper) {
+	routes := podcastRoutes{daoWrapper}
+	g := router.Group("/api/podcast")
+	g.GET("/:year/:term/:slug/audio.xml", routes.audioFeed)
+	g.GET("/:year/:term/:slug/video.xml", routes.videoFeed)
+	g.GET("/file/:id", routes.podcastFile)
+
+	tokens := g.Group("/token")
+	tokens.Use(tools.LoggedIn)
+	tokens.POST("", routes.getFeedToken)
+	tokens.DELETE("", routes.revokeFeedTokens)
+}
+
+type podcastRoutes struct {
+	dao.DaoWrapper
+}
+
+// podcastMimeTypes are the content types of the files enclosed in podcast feeds.
+var podcastMimeTypes = map[model.FileType]string{
+	model.FILETYPE_VOD:        "video/mp4",
+	model.FILETYPE_VOD_EDITED: "video/mp4",
+	model.FILETYPE_AUDIO_M4A:  "audio/mp4",
+}
+
+var errFeedToken = tools.RequestError{
+	Status:        http.StatusUnauthorized,
+	CustomMessage: "invalid feed token",
+}
+
+// feedUser returns the user of the feed token in the query or otherwise the logged-in user, which may be nil.
+// Podcast apps don't log in, so feeds of courses that aren't public carry the revocable token of the user.
+func (r podcastRoutes) feedUser(c *gin.Context) (*model.User, string, error) {
+	tokenStr := c.Query("token")
+	if tokenStr == "" {
+		if foundContext, exists := c.Get("TUMLiveContext"); exists {
+			return foundContext.(tools.TUMLiveContext).User, "", nil
+		}
+		return nil, "", nil
+	}
+	token, err := r.TokenDao.GetToken(tokenStr)
+	if err != nil || token.Scope != model.TokenScopeFeed {
+		return nil, "", errFeedToken
+	}
+	user, err := r.UsersDao.GetUserByID(c, token.UserID)
+	if err != nil {
+		return nil, "", errFeedToken
+	}
+	if err = r.TokenDao.TokenUsed(token); err != nil {
+		logger.Warn("can not update token usage", "err", err)
+	}
+	return &user, tokenStr, nil
+}
+
+// canListen returns whether the user may download the recordings of the course, like the download button.
+func canListen(user *model.User, course model.Course) bool {
+	if user.IsAdminOfCourse(course) {
+		return true
+	}
+	if !course.DownloadsEnabled {
+		return false
+	}
+	if course.Visibility == "public" || course.Visibility == "hidden" {
+		return true
+	}
+	return user != nil && user.IsEligibleToWatchCourse(course)
+}
+
+// canListenToStream additionally hides private recordings from everyone but the admins of the course.
+func canListenToStream(user *model.User, course model.Course, stream model.Stream) bool {
+	if !canListen(user, course) || !stream.Recording {
+		return false
+	}
+	return !stream.Private || user.IsAdminOfCourse(course)
+}
+
+func (r podcastRoutes) audioFeed(c *gin.Context) {
+	r.feed(c, model.FILETYPE_AUDIO_M4A)
+}
+
+func (r podcastRoutes) videoFeed(c *gin.Context) {
+	r.feed(c, model.FILETYPE_VOD)
+}
+
+func (r podcastRoutes) feed(c *gin.Context, fileType model.FileType) {
+	year, err := strconv.Atoi(c.Param("year"))
+	if err != nil {
+		_ = c.Error(tools.RequestError{
+			Status:        http.StatusBadRequest,
+			CustomMessage: "invalid year",
+			Err:           err,
+		})
+		return
+	}
+	user, token, err := r.feedUser(c)
+	if err != nil {
+		_ = c.Error(err)
+		return
+	}
+	course, err := r.CoursesDao.GetCourseBySlugYearAndTerm(c, c.Param("slug"), c.Param("term"), year)
+	if err != nil {
+		_ = c.Error(tools.RequestError{
+			Status:        http.StatusNotFound,
+			CustomMessage: "can not find course",
+			Err:           err,
+		})
+		return
+	}
+	if !canListen(user, course) {
+		_ = c.Error(tools.RequestError{
+			Status:        http.StatusForbidden,
+			CustomMessage: "not allowed to get the feed of this course",
+		})
+		return
+	}
+
+	var streams []model.Stream
+	var streamIDs, editedIDs []uint
+	for _, stream := range course.Streams {
+		if !canListenToStream(user, course, stream) {
+			continue
+		}
+		switch {
+		case len(stream.Edits) == 0:
+			streamIDs = append(streamIDs, stream.ID)
+		case fileType == model.FILETYPE_VOD:
+			// edited videos are enclosed as the file the edits were rendered into, once it exists
+			editedIDs = append(editedIDs, stream.ID)
+		default:
+			continue // the audio still contains what the edits remove
+		}
+		streams = append(streams, stream)
+	}
+	files, err := r.FileDao.GetForStreams(streamIDs, fileType)
+	if err == nil && len(editedIDs) != 0 {
+		var edited []model.File
+		edited, err = r.FileDao.GetForStreams(editedIDs, model.FILETYPE_VOD_EDITED)
+		files = append(files, edited...)
+	}
+	if err != nil {
+		_ = c.Error(tools.RequestError{
+			Status:        http.StatusInternalServerError,
+			CustomMessage: "can not get files",
+			Err:           err,
+		})
+		return
+	}
+	enclosures := map[uint]model.File{}
+	for _, file := range files {
+		// the combined view is the one to listen to, the other views only if a stream has no combined one
+		if _, ok := enclosures[file.StreamID]; !ok || file.GetVodTypeByName() == "COMB" {
+			enclosures[file.StreamID] = file
+		}
+	}
+
+	feed := newPodcastFeed(course)
+	for _, stream := range streams { // streams are ordered newest first
+		if file, ok := enclosures[stream.ID]; ok {
+			feed.Channel.Items = append(feed.Channel.Items, newPodcastItem(course, stream, file, token))
+		}
+	}
+	c.Header("Content-Type", "application/rss+xml; charset=utf-8")
+	c.Status(http.StatusOK)
+	_, _ = c.Writer.WriteString(xml.Header)
+	enc := xml.NewEncoder(c.Writer)
+	enc.Indent("", "  ")
+	if err = enc.Encode(feed); err != nil {
+		logger.Error("can not encode podcast feed", "err", err)
+	}
+}
+
+// podcastFile serves the file enclosed in a podcast feed. The url carries the feed token, podcast apps don't log in.
+func (r podcastRoutes) podcastFile(c *gin.Context) {
+	user, _, err := r.feedUser(c)
+	if err != nil {
+		_ = c.Error(err)
+		return
+	}
+	file, err := r.FileDao.GetFileById(c.Param("id"))
+	if err != nil {
+		_ = c.Error(tools.RequestError{
+			Status:        http.StatusNotFound,
+			CustomMessage: "can not find file",
+			Err:           err,
+		})
+		return
+	}
+	mimeType, ok := podcastMimeTypes[file.Type]
+	if !ok {
+		_ = c.Error(tools.RequestError{
+			Status:        http.StatusNotFound,
+			CustomMessage: "can not find file",
+		})
+		return
+	}
+	stream, err := r.StreamsDao.GetStreamByID(c, fmt.Sprintf("%d", file.StreamID))
+	if err != nil {
+		_ = c.Error(tools.RequestError{
+			Status:        http.StatusInternalServerError,
+			CustomMessage: "can not get stream",
+			Err:           err,
+		})
+		return
+	}
+	course, err := r.CoursesDao.GetCourseById(c, stream.CourseID)
+	if err != nil {
+		_ = c.Error(tools.RequestError{
+			Status:        http.StatusInternalServerError,
+			CustomMessage: "can not get course",
+			Err:           err,
+		})
+		return
+	}
+	if !canListenToStream(user, course, stream) || (hiddenByEdits(stream, file) && !user.IsAdminOfCourse(course)) {
+		_ = c.Error(dlErr)
+		return
+	}
+	c.Header("Content-Type", mimeType)
+	c.File(file.Path)
+}
+
+// getFeedToken returns the feed token of the user, a new one if the user has none yet.
+func (r podcastRoutes) getFeedToken(c *gin.Context) {
+	tumLiveContext := c.MustGet("TUMLiveContext").(tools.TUMLiveContext)
+	token, err := r.TokenDao.GetUserToken(tumLiveContext.User.ID, model.TokenScopeFeed)
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		token = model.Token{
+			UserID: tumLiveContext.User.ID,
+			Token:  uuid.NewV4().String(),
+			Scope:  model.TokenScopeFeed,
+		}
+		err = r.TokenDao.AddToken(token)
+	}
+	if err != nil {
+		_ = c.Error(tools.RequestError{
+			Status:        http.StatusInternalServerError,
+			CustomMessage: "can not get feed token",
+			Err:           err,
+		})
+		return
+	}
+	c.JSON(http.StatusOK, gin.H{"token": token.Token})
+}
+
+// revokeFeedTokens invalidates the feed urls of the user in all podcast apps.
+func (r podcastRoutes) revokeFeedTokens(c *gin.Context) {
+	tumLiveContext := c.MustGet("TUMLiveContext").(tools.TUMLiveContext)
+	if err := r.TokenDao.DeleteUserTokens(tumLiveContext.User.ID, model.TokenScopeFeed); err != nil {
+		_ = c.Error(tools.RequestError{
+			Status:        http.StatusInternalServerError,
+			CustomMessage: "can not revoke feed tokens",
+			Err:           err,
+		})
+		return
+	}
+	c.Status(http.StatusOK)
+}
+
+// podcastFeed is an RSS 2.0 feed with the iTunes tags podcast apps expect.
+type podcastFeed struct {
+	XMLName xml.Name       `xml:"rss"`
+	Version string         `xml:"version,attr"`
+	ITunes  string         `xml:"xmlns:itunes,attr"`
+	Channel podcastChannel `xml:"channel"`
+}
+
+type podcastChannel struct {
+	Title       string          `xml:"title"`
+	Link        string          `xml:"link"`
+	Description string          `xml:"description"`
+	Author      string          `xml:"itunes:author"`
+	Explicit    string          `xml:"itunes:explicit"`
+	Category    podcastCategory `xml:"itunes:category"`
+	Items       []podcastItem   `xml:"item"`
+}
+
+type podcastCategory struct {
+	Text string `xml:"text,attr"`
+}
+
+type podcastItem struct {
+	Title       string           `xml:"title"`
+	Link        string           `xml:"link"`
+	Description string           `xml:"description,omitempty"`
+	GUID        podcastGUID      `xml:"guid"`
+	PubDate     string           `xml:"pubDate"`
+	Enclosure   podcastEnclosure `xml:"enclosure"`
+	Duration    int32            `xml:"itunes:duration,omitempty"`
+}
+
+type podcastGUID struct {
+	IsPermaLink bool   `xml:"isPermaLink,attr"`
+	Value       string `xml:",chardata"`
+}
+
+type podcastEnclosure struct {
+	URL    string `xml:"url,attr"`
+	Length int64  `xml:"length,attr"`
+	Type   string `xml:"type,attr"`
+}
+
+func newPodcastFeed(course model.Course) podcastFeed {
+	return podcastFeed{
+		Version: "2.0",
+		ITunes:  "http://www.itunes.com/dtds/podcast-1.0.dtd",
+		Channel: podcastChannel{
+			Title:       course.Name,
+			Link:        fmt.Sprintf("%s/course/%d/%s/%s", tools.Cfg.WebUrl, course.Year, course.TeachingTerm, course.Slug),
+			Description: fmt.Sprintf("Recordings of %s", course.Name),
+			Author:      "TUM-Live",
+			Explicit:    "false",
+			Category:    podcastCategory{Text: "Education"},
+		},
+	}
+}
+
+// newPodcastItem returns the episode of a stream. The url of its enclosure carries the feed token if there is one.
+func newPodcastItem(course model.Course, stream model.Stream, file model.File, token string) podcastItem {
+	title := stream.Name
+	if title == "" {
+		title = fmt.Sprintf("%s, %s", course.Name, stream.Start.Format("02.01.2006"))
+	}
+	enclosureUrl := fmt.Sprintf("%s/api/podcast/file/%d", tools.Cfg.WebUrl, file.ID)
+	if token != "" {
+		enclosureUrl += "?token=" + url.QueryEscape(token)
+	}
+	var length int64
+	if stat, err := os.Stat(file.Path); err == nil {
+		length = stat.Size()
+	}
+	return podcastItem{
+		Title:       title,
+		Link:        fmt.Sprintf("%s/w/%s/%d", tools.Cfg.WebUrl, course.Slug, stream.ID),
+		Description: stream.Description,
+		GUID:        podcastGUID{Value: fmt.Sprintf("%s-%d-%d", tools.Cfg.WebUrl, stream.ID, file.Type)},
+		PubDate:     stream.Start.Format(time.RFC1123Z),
+		Enclosure:   podcastEnclosure{URL: enclosureUrl, Length: length, Type: podcastMimeTypes[file.Type]},
+		Duration:    stream.Duration.Int32,
+	}
+}
@@ -0,0 +1,226 @@
+package api
+
+import (
+	"database/sql"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/TUM-Dev/gocast/dao"
+	"github.com/TUM-Dev/gocast/mock_dao"
+	"github.com/TUM-Dev/gocast/model"
+	"github.com/TUM-Dev/gocast/tools"
+	"github.com/TUM-Dev/gocast/tools/testutils"
+	"github.com/gin-gonic/gin"
+	"github.com/golang/mock/gomock"
+	"github.com/matthiasreumann/gomino"
+	"gorm.io/gorm"
+)
+
+func TestPodcastFeed(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	course := testutils.CourseFPV
+	course.Visibility = "enrolled"
+	course.DownloadsEnabled = true
+	course.Streams = []model.Stream{
+		{Model: gorm.Model{ID: 4}, CourseID: course.ID, Recording: true, Edits: []model.StreamEdit{{Start: 0, End: 60000, Discard: true}}},
+		{Model: gorm.Model{ID: 3}, CourseID: course.ID, Recording: true},
+		{Model: gorm.Model{ID: 2}, CourseID: course.ID, Recording: true, Private: true},
+		{Model: gorm.Model{ID: 1}, CourseID: course.ID},
+	}
+	enrolled := model.User{Model: gorm.Model{ID: 42}, Role: model.StudentType, Courses: []model.Course{course}}
+	token := model.Token{Model: gorm.Model{ID: 5}, UserID: enrolled.ID, Token: "secret", Scope: model.TokenScopeFeed}
+
+	url := fmt.Sprintf("/api/podcast/%d/%s/%s/audio.xml", course.Year, course.TeachingTerm, course.Slug)
+
+	coursesMock := func(t *testing.T) dao.CoursesDao {
+		courses := mock_dao.NewMockCoursesDao(gomock.NewController(t))
+		courses.EXPECT().GetCourseBySlugYearAndTerm(gomock.Any(), course.Slug, course.TeachingTerm, course.Year).Return(course, nil).AnyTimes()
+		return courses
+	}
+	tokenMock := func(t *testing.T, token model.Token, err error) dao.TokenDao {
+		tokens := mock_dao.NewMockTokenDao(gomock.NewController(t))
+		tokens.EXPECT().GetToken("secret").Return(token, err)
+		tokens.EXPECT().TokenUsed(gomock.Any()).Return(nil).AnyTimes()
+		return tokens
+	}
+
+	gomino.TestCases{
+		"invalid year": {
+			Router: func(r *gin.Engine) {
+				configGinPodcastRouter(r, dao.DaoWrapper{})
+			},
+			Url:          "/api/podcast/abc/W/fpv/audio.xml",
+			Middlewares:  testutils.GetMiddlewares(tools.ErrorHandler),
+			ExpectedCode: http.StatusBadRequest,
+		},
+		"invalid token": {
+			Router: func(r *gin.Engine) {
+				configGinPodcastRouter(r, dao.DaoWrapper{TokenDao: tokenMock(t, model.Token{}, errors.New(""))})
+			},
+			Url:          url + "?token=secret",
+			Middlewares:  testutils.GetMiddlewares(tools.ErrorHandler),
+			ExpectedCode: http.StatusUnauthorized,
+		},
+		"lecturer token": {
+			Router: func(r *gin.Engine) {
+				configGinPodcastRouter(r, dao.DaoWrapper{TokenDao: tokenMock(t, model.Token{Token: "secret", Scope: model.TokenScopeLecturer}, nil)})
+			},
+			Url:          url + "?token=secret",
+			Middlewares:  testutils.GetMiddlewares(tools.ErrorHandler),
+			ExpectedCode: http.StatusUnauthorized,
+		},
+		"not enrolled": {
+			Router: func(r *gin.Engine) {
+				configGinPodcastRouter(r, dao.DaoWrapper{CoursesDao: coursesMock(t)})
+			},
+			Url:          url,
+			Middlewares:  testutils.GetMiddlewares(tools.ErrorHandler, testutils.TUMLiveContext(testutils.TUMLiveContextStudent)),
+			ExpectedCode: http.StatusForbidden,
+		},
+		"success": {
+			Router: func(r *gin.Engine) {
+				users := mock_dao.NewMockUsersDao(gomock.NewController(t))
+				users.EXPECT().GetUserByID(gomock.Any(), enrolled.ID).Return(enrolled, nil)
+				files := mock_dao.NewMockFileDao(gomock.NewController(t))
+				// private streams, streams without recording and the unedited audio of edited streams are left out
+				files.EXPECT().GetForStreams([]uint{3}, model.FileType(model.FILETYPE_AUDIO_M4A)).Return([]model.File{{StreamID: 3, Path: "/vod/3.m4a", Type: model.FILETYPE_AUDIO_M4A}}, nil)
+				configGinPodcastRouter(r, dao.DaoWrapper{CoursesDao: coursesMock(t), TokenDao: tokenMock(t, token, nil), UsersDao: users, FileDao: files})
+			},
+			Url:          url + "?token=secret",
+			Middlewares:  testutils.GetMiddlewares(tools.ErrorHandler),
+			ExpectedCode: http.StatusOK,
+		},
+		"video of edited streams": {
+			Router: func(r *gin.Engine) {
+				users := mock_dao.NewMockUsersDao(gomock.NewController(t))
+				users.EXPECT().GetUserByID(gomock.Any(), enrolled.ID).Return(enrolled, nil)
+				files := mock_dao.NewMockFileDao(gomock.NewController(t))
+				files.EXPECT().GetForStreams([]uint{3}, model.FileType(model.FILETYPE_VOD)).Return([]model.File{{StreamID: 3, Path: "/vod/3.mp4", Type: model.FILETYPE_VOD}}, nil)
+				// edited streams are enclosed as the rendered edits
+				files.EXPECT().GetForStreams([]uint{4}, model.FileType(model.FILETYPE_VOD_EDITED)).Return([]model.File{{StreamID: 4, Path: "/vod/4-edited-1.mp4", Type: model.FILETYPE_VOD_EDITED}}, nil)
+				configGinPodcastRouter(r, dao.DaoWrapper{CoursesDao: coursesMock(t), TokenDao: tokenMock(t, token, nil), UsersDao: users, FileDao: files})
+			},
+			Url:          strings.Replace(url, "audio.xml", "video.xml", 1) + "?token=secret",
+			Middlewares:  testutils.GetMiddlewares(tools.ErrorHandler),
+			ExpectedCode: http.StatusOK,
+		},
+	}.
+		Method(http.MethodGet).
+		Run(t, testutils.Equal)
+}
+
+func TestNewPodcastItem(t *testing.T) {
+	stream := model.Stream{Model: gorm.Model{ID: 3}, Name: "Induction", Start: time.Date(2022, 10, 24, 10, 15, 0, 0, time.UTC), Duration: sql.NullInt32{Int32: 5400, Valid: true}}
+	item := newPodcastItem(testutils.CourseFPV, stream, model.File{Model: gorm.Model{ID: 9}, StreamID: 3, Path: "/vod/3.m4a", Type: model.FILETYPE_AUDIO_M4A}, "se cret")
+	if !strings.HasSuffix(item.Enclosure.URL, "/api/podcast/file/9?token=se+cret") {
+		t.Errorf("expected enclosure with token, got %s", item.Enclosure.URL)
+	}
+	if item.Enclosure.Type != "audio/mp4" || item.Duration != 5400 || item.Title != "Induction" {
+		t.Errorf("unexpected item %+v", item)
+	}
+	if item.PubDate != "Mon, 24 Oct 2022 10:15:00 +0000" {
+		t.Errorf("expected RFC 1123 date, got %s", item.PubDate)
+	}
+}
+
+func TestPodcastFile(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	gomino.TestCases{
+		"no podcast file": {
+			Router: func(r *gin.Engine) {
+				files := mock_dao.NewMockFileDao(gomock.NewController(t))
+				files.EXPECT().GetFileById("9").Return(model.File{StreamID: 3, Path: "/vod/3-thumb.jpg", Type: model.FILETYPE_THUMB_COMB}, nil)
+				configGinPodcastRouter(r, dao.DaoWrapper{FileDao: files})
+			},
+			Middlewares:  testutils.GetMiddlewares(tools.ErrorHandler),
+			ExpectedCode: http.StatusNotFound,
+		},
+		"downloads disabled": {
+			Router: func(r *gin.Engine) {
+				files := mock_dao.NewMockFileDao(gomock.NewController(t))
+				files.EXPECT().GetFileById("9").Return(model.File{StreamID: testutils.StreamFPVLive.ID, Path: "/vod/3.m4a", Type: model.FILETYPE_AUDIO_M4A}, nil)
+				configGinPodcastRouter(r, dao.DaoWrapper{FileDao: files, StreamsDao: testutils.GetStreamMock(t), CoursesDao: testutils.GetCoursesMock(t)})
+			},
+			Middlewares:  testutils.GetMiddlewares(tools.ErrorHandler, testutils.TUMLiveContext(testutils.TUMLiveContextStudent)),
+			ExpectedCode: http.StatusForbidden,
+		},
+		"original of edited stream": {
+			Router: func(r *gin.Engine) {
+				files := mock_dao.NewMockFileDao(gomock.NewController(t))
+				files.EXPECT().GetFileById("9").Return(model.File{StreamID: 3, Path: "/vod/3.mp4", Type: model.FILETYPE_VOD}, nil)
+				streams := mock_dao.NewMockStreamsDao(gomock.NewController(t))
+				streams.EXPECT().GetStreamByID(gomock.Any(), "3").Return(model.Stream{Model: gorm.Model{ID: 3}, CourseID: 1, Recording: true, Edits: []model.StreamEdit{{Start: 0, End: 60000, Discard: true}}}, nil)
+				courses := mock_dao.NewMockCoursesDao(gomock.NewController(t))
+				courses.EXPECT().GetCourseById(gomock.Any(), uint(1)).Return(model.Course{Model: gorm.Model{ID: 1}, Visibility: "public", DownloadsEnabled: true}, nil)
+				configGinPodcastRouter(r, dao.DaoWrapper{FileDao: files, StreamsDao: streams, CoursesDao: courses})
+			},
+			Middlewares:  testutils.GetMiddlewares(tools.ErrorHandler, testutils.TUMLiveContext(testutils.TUMLiveContextStudent)),
+			ExpectedCode: http.StatusForbidden,
+		},
+	}.
+		Method(http.MethodGet).
+		Url("/api/podcast/file/9").
+		Run(t, testutils.Equal)
+}
+
+func TestPodcastToken(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	student := testutils.GetMiddlewares(tools.ErrorHandler, testutils.TUMLiveContext(testutils.TUMLiveContextStudent))
+
+	t.Run("POST/api/podcast/token", func(t *testing.T) {
+		gomino.TestCases{
+			"existing token": {
+				Router: func(r *gin.Engine) {
+					tokens := mock_dao.NewMockTokenDao(gomock.NewController(t))
+					tokens.EXPECT().GetUserToken(testutils.Student.ID, model.TokenScopeFeed).Return(model.Token{Token: "secret", Scope: model.TokenScopeFeed}, nil)
+					configGinPodcastRouter(r, dao.DaoWrapper{TokenDao: tokens})
+				},
+				Middlewares:      student,
+				ExpectedCode:     http.StatusOK,
+				ExpectedResponse: gin.H{"token": "secret"},
+			},
+			"new token": {
+				Router: func(r *gin.Engine) {
+					tokens := mock_dao.NewMockTokenDao(gomock.NewController(t))
+					tokens.EXPECT().GetUserToken(testutils.Student.ID, model.TokenScopeFeed).Return(model.Token{}, gorm.ErrRecordNotFound)
+					tokens.EXPECT().AddToken(gomock.Any()).DoAndReturn(func(token model.Token) error {
+						if token.UserID != testutils.Student.ID || token.Scope != model.TokenScopeFeed || token.Token == "" {
+							t.Errorf("unexpected token %+v", token)
+						}
+						return nil
+					})
+					configGinPodcastRouter(r, dao.DaoWrapper{TokenDao: tokens})
+				},
+				Middlewares:  student,
+				ExpectedCode: http.StatusOK,
+			},
+		}.
+			Method(http.MethodPost).
+			Url("/api/podcast/token").
+			Run(t, testutils.Equal)
+	})
+
+	t.Run("DELETE/api/podcast/token", func(t *testing.T) {
+		gomino.TestCases{
+			"success": {
+				Router: func(r *gin.Engine) {
+					tokens := mock_dao.NewMockTokenDao(gomock.NewController(t))
+					tokens.EXPECT().DeleteUserTokens(testutils.Student.ID, model.TokenScopeFeed).Return(nil)
+					configGinPodcastRouter(r, dao.DaoWrapper{TokenDao: tokens})
+				},
+				Middlewares:  student,
+				ExpectedCode: http.StatusOK,
+			},
+		}.
+			Method(http.MethodDelete).
+			Url("/api/podcast/token").
+			Run(t, testutils.Equal)
+	})
+}
@@ -21,6 +21,8 @@ func ConfigRealtimeRouter(router *gin.RouterGroup) {
 	// Register Channels
 	RegisterLiveUpdateRealtimeChannel()
 	RegisterRealtimeChatChannel()
+	RegisterSubtitleJobsRealtimeChannel()
+	RegisterStreamIncidentsRealtimeChannel()
 }
 
 // ConfigGinRouter for non ws endpoints
@@ -34,6 +36,8 @@ func ConfigGinRouter(router *gin.Engine) {
 	configGinCourseRouter(router, daoWrapper)
 	configGinDownloadRouter(router, daoWrapper)
 	configGinDownloadICSRouter(router, daoWrapper)
+	configGinPodcastRouter(router, daoWrapper)
+	configGinClipsRouter(router, daoWrapper)
 	configGinLectureHallApiRouter(router, daoWrapper, tools.NewPresetUtility(daoWrapper.LectureHallsDao))
 	configProgressRouter(router, daoWrapper)
 	configSeekStatsRouter(router, daoWrapper)
@@ -47,4 +51,5 @@ func ConfigGinRouter(router *gin.Engine) {
 	configGinBookmarksRouter(router, daoWrapper)
 	configMaintenanceRouter(router, daoWrapper)
 	configSemestersRouter(router, daoWrapper)
+	configJobsRouter(router, daoWrapper)
 }
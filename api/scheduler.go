@@ -0,0 +1,149 @@
+package api
+
+// scheduler.go selects the worker a job runs on
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/TUM-Dev/gocast/model"
+	"github.com/TUM-Dev/gocast/tools"
+)
+
+// Workers that report resources beyond these limits don't get new jobs.
+const (
+	maxCpuPercent   = 95
+	maxDiskPercent  = 95
+	minMemAvailable = 512 << 20 // bytes
+	maxWorkload     = 12        // see model.Worker, e.g. four streams
+)
+
+// Weights of the scheduling score. The headroom of a worker scores up to 100.
+const (
+	workloadPenalty   = 5   // per workload unit, see model.Worker
+	affinityBonus     = 100 // the worker runs other sources of the same stream
+	hostStreamPenalty = 30  // per other stream running on the host of the worker
+	failedHostPenalty = 200 // the last attempt of the job failed on the host of the worker
+)
+
+// schedulingRequest describes the requirements and placement preferences of a job.
+type schedulingRequest struct {
+	cost         uint     // workload the job adds to the worker
+	requiredTags []string // capabilities the worker needs
+	streamID     uint     // stream the job belongs to, 0 if none
+	affinity     []string // ids of workers that run other sources of the stream
+	avoidHost    string   // host of the last failed attempt
+
+	// hostStreams are the ids of the streams running on each host. Streams are spread across hosts,
+	// so a failing host takes down as few lectures as possible.
+	hostStreams map[string]map[uint]bool
+}
+
+// requiredTags returns the configured tags a worker needs to run a job of kind.
+func requiredTags(kind string) []string {
+	return tools.Cfg.Scheduler.RequiredTags[kind]
+}
+
+// scheduleWorker returns the worker that fits req best and the reason of the decision.
+// The worker is nil if no worker fits, the reason explains why.
+func scheduleWorker(workers []model.Worker, req schedulingRequest) (*model.Worker, string) {
+	var best *model.Worker
+	var bestScore float64
+	var bestReason string
+	var rejected []string
+	for i := range workers {
+		w := &workers[i]
+		if reason := rejectWorker(w, req); reason != "" {
+			rejected = append(rejected, fmt.Sprintf("%s %s", w.WorkerID, reason))
+			continue
+		}
+		score, reason := scoreWorker(w, req)
+		if best == nil || score > bestScore {
+			best, bestScore, bestReason = w, score, reason
+		}
+	}
+	if len(rejected) != 0 {
+		sort.Strings(rejected)
+		bestReason += "; rejected: " + strings.Join(rejected, ", ")
+	}
+	if best == nil {
+		if len(workers) == 0 {
+			return nil, "no alive workers"
+		}
+		return nil, "no worker fits" + bestReason
+	}
+	return best, bestReason
+}
+
+// rejectWorker returns why w can't run the job or an empty string if it can.
+func rejectWorker(w *model.Worker, req schedulingRequest) string {
+	if w.HeartbeatMissing(time.Now()) {
+		return "missed heartbeats"
+	}
+	if missing := w.MissingTags(req.requiredTags); len(missing) != 0 {
+		return fmt.Sprintf("missing tags %v", missing)
+	}
+	if w.Workload+req.cost > maxWorkload {
+		return fmt.Sprintf("workload %d can't take %d more", w.Workload, req.cost)
+	}
+	if !w.ReportsResources() {
+		return ""
+	}
+	if w.CpuPercent >= maxCpuPercent {
+		return fmt.Sprintf("cpu at %.f%%", w.CpuPercent)
+	}
+	if disk := diskPercent(w); disk >= maxDiskPercent {
+		return fmt.Sprintf("disk at %.f%%", disk)
+	}
+	if w.MemAvailable < minMemAvailable {
+		return fmt.Sprintf("only %dM memory available", w.MemAvailable>>20)
+	}
+	return ""
+}
+
+// scoreWorker rates how well w fits the job, higher is better.
+func scoreWorker(w *model.Worker, req schedulingRequest) (float64, string) {
+	var reasons []string
+	var score float64
+	if w.ReportsResources() {
+		cpuFree := 100 - w.CpuPercent
+		memFree := float64(w.MemAvailable) / float64(w.MemTotal) * 100
+		diskFree := 100 - diskPercent(w)
+		score = 0.5*cpuFree + 0.3*memFree + 0.2*diskFree
+		reasons = append(reasons, fmt.Sprintf("cpu %.f%% free, memory %.f%% free, disk %.f%% free", cpuFree, memFree, diskFree))
+	} else {
+		score = 50
+		reasons = append(reasons, "resources unknown")
+	}
+	// the workload the worker would have with the job
+	score -= float64((w.Workload + req.cost) * workloadPenalty)
+	reasons = append(reasons, fmt.Sprintf("workload %d+%d", w.Workload, req.cost))
+
+	for _, id := range req.affinity {
+		if id == w.WorkerID {
+			score += affinityBonus
+			reasons = append(reasons, "runs other sources of the stream")
+			break
+		}
+	}
+	otherStreams := 0
+	for streamID := range req.hostStreams[w.Host] {
+		if streamID != req.streamID {
+			otherStreams++
+		}
+	}
+	if otherStreams != 0 {
+		score -= float64(otherStreams * hostStreamPenalty)
+		reasons = append(reasons, fmt.Sprintf("host runs %d other streams", otherStreams))
+	}
+	if req.avoidHost != "" && w.Host == req.avoidHost {
+		score -= failedHostPenalty
+		reasons = append(reasons, "last attempt failed on this host")
+	}
+	return score, fmt.Sprintf("%s on %s scored %.1f (%s)", w.WorkerID, w.Host, score, strings.Join(reasons, ", "))
+}
+
+func diskPercent(w *model.Worker) float64 {
+	return float64(w.DiskUsed) / float64(w.DiskTotal) * 100
+}
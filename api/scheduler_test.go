@@ -0,0 +1,84 @@
+package api
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/TUM-Dev/gocast/model"
+)
+
+func TestScheduleWorker(t *testing.T) {
+	const gib = 1 << 30
+	now := time.Now()
+	idle := model.Worker{WorkerID: "idle", Host: "h1", LastSeen: now, CpuPercent: 10, MemAvailable: 14 * gib, MemTotal: 16 * gib, DiskUsed: 10, DiskTotal: 100}
+	busy := model.Worker{WorkerID: "busy", Host: "h2", LastSeen: now, CpuPercent: 80, MemAvailable: 4 * gib, MemTotal: 16 * gib, DiskUsed: 50, DiskTotal: 100}
+
+	t.Run("no workers", func(t *testing.T) {
+		if w, reason := scheduleWorker(nil, schedulingRequest{}); w != nil || reason == "" {
+			t.Errorf("expected no worker with a reason, got %v (%s)", w, reason)
+		}
+	})
+	t.Run("headroom", func(t *testing.T) {
+		if w, _ := scheduleWorker([]model.Worker{busy, idle}, schedulingRequest{}); w == nil || w.WorkerID != "idle" {
+			t.Errorf("expected worker with most headroom, got %v", w)
+		}
+	})
+	t.Run("overloaded", func(t *testing.T) {
+		full := idle
+		full.DiskUsed = 99
+		w, reason := scheduleWorker([]model.Worker{full}, schedulingRequest{})
+		if w != nil || !strings.Contains(reason, "disk at 99%") {
+			t.Errorf("expected worker with full disk to be rejected, got %v (%s)", w, reason)
+		}
+	})
+	t.Run("capacity", func(t *testing.T) {
+		loaded := idle
+		loaded.Workload = maxWorkload - 1
+		// a thumbnail job still fits, another stream doesn't
+		if w, _ := scheduleWorker([]model.Worker{loaded}, schedulingRequest{cost: jobCost(model.JobTypeThumbnails)}); w == nil {
+			t.Error("expected loaded worker to take a cheap job")
+		}
+		w, reason := scheduleWorker([]model.Worker{loaded}, schedulingRequest{cost: jobCost(model.JobTypeStream)})
+		if w != nil || !strings.Contains(reason, "can't take 3 more") {
+			t.Errorf("expected loaded worker to be rejected for a stream, got %v (%s)", w, reason)
+		}
+		cheap, _ := scoreWorker(&idle, schedulingRequest{cost: 1})
+		costly, _ := scoreWorker(&idle, schedulingRequest{cost: 3})
+		if costly >= cheap {
+			t.Errorf("expected costly job to score lower, got %.1f and %.1f", cheap, costly)
+		}
+	})
+	t.Run("missed heartbeats", func(t *testing.T) {
+		silent := idle
+		silent.LastSeen = now.Add(-model.WorkerHeartbeatTimeout * 2)
+		if w, _ := scheduleWorker([]model.Worker{silent, busy}, schedulingRequest{}); w == nil || w.WorkerID != "busy" {
+			t.Errorf("expected worker that missed heartbeats to be rejected, got %v", w)
+		}
+	})
+	t.Run("tags", func(t *testing.T) {
+		tagged := busy
+		tagged.Tags = "campus-network,gpu"
+		w, reason := scheduleWorker([]model.Worker{idle, tagged}, schedulingRequest{requiredTags: []string{"gpu"}})
+		if w == nil || w.WorkerID != "busy" || !strings.Contains(reason, "idle missing tags [gpu]") {
+			t.Errorf("expected only tagged worker to fit, got %v (%s)", w, reason)
+		}
+	})
+	t.Run("affinity", func(t *testing.T) {
+		req := schedulingRequest{streamID: 1, affinity: []string{"busy"}}
+		if w, _ := scheduleWorker([]model.Worker{idle, busy}, req); w == nil || w.WorkerID != "busy" {
+			t.Errorf("expected worker running other sources of the stream, got %v", w)
+		}
+	})
+	t.Run("anti affinity", func(t *testing.T) {
+		req := schedulingRequest{streamID: 1, hostStreams: map[string]map[uint]bool{"h1": {2: true, 3: true, 4: true}}}
+		if w, _ := scheduleWorker([]model.Worker{idle, busy}, req); w == nil || w.WorkerID != "busy" {
+			t.Errorf("expected worker on host with less streams, got %v", w)
+		}
+	})
+	t.Run("failed host", func(t *testing.T) {
+		if w, _ := scheduleWorker([]model.Worker{idle, busy}, schedulingRequest{avoidHost: "h1"}); w == nil || w.WorkerID != "busy" {
+			t.Errorf("expected worker on another host than the failed attempt, got %v", w)
+		}
+	})
+}
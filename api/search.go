@@ -1,9 +1,13 @@
 package api
 
 import (
+	"context"
+	"fmt"
 	"net/http"
+	"strconv"
 
 	"github.com/TUM-Dev/gocast/dao"
+	"github.com/TUM-Dev/gocast/model"
 	"github.com/TUM-Dev/gocast/tools"
 	"github.com/gin-gonic/gin"
 )
@@ -12,6 +16,7 @@ func configGinSearchRouter(router *gin.Engine, daoWrapper dao.DaoWrapper) {
 	routes := searchRoutes{daoWrapper}
 
 	searchGroup := router.Group("/api/search")
+	searchGroup.GET("", routes.search)
 	withStream := searchGroup.Group("/stream/:streamID")
 	withStream.Use(tools.InitStream(daoWrapper))
 	withStream.GET("/subtitles", routes.searchSubtitles)
@@ -26,3 +31,205 @@ func (r searchRoutes) searchSubtitles(c *gin.Context) {
 	q := c.Query("q")
 	c.JSON(http.StatusOK, tools.SearchSubtitles(q, s.ID))
 }
+
+// searchFunc searches meilisearch with a filter, it's replaced in tests.
+var searchFunc = tools.SearchAll
+
+const (
+	defaultSearchLimit = 10
+	maxSearchLimit     = 50
+)
+
+type searchRequest struct {
+	Q     string `form:"q" binding:"required"`
+	Year  int    `form:"year"`
+	Term  string `form:"term" binding:"omitempty,oneof=W S"`
+	Limit int64  `form:"limit" binding:"omitempty,min=1"`
+}
+
+type searchResultDto struct {
+	Courses   []searchHitDto `json:"courses"`
+	Streams   []searchHitDto `json:"streams"`
+	Sections  []searchHitDto `json:"sections"`
+	Subtitles []searchHitDto `json:"subtitles"`
+	Chat      []searchHitDto `json:"chat"`
+	Slides    []searchHitDto `json:"slides"`
+}
+
+// searchHitDto is a hit of the global search with a link to the course or to the moment in the lecture.
+type searchHitDto struct {
+	CourseID  uint   `json:"courseID"`
+	StreamID  uint   `json:"streamID,omitempty"`
+	Title     string `json:"title"`
+	Text      string `json:"text,omitempty"`
+	Timestamp int64  `json:"timestamp,omitempty"` // seconds since the start of the video
+	Link      string `json:"link"`
+}
+
+// search searches courses, lectures, sections, subtitles, chat messages and slides the user may see.
+// The index is filtered by the permissions it was exported with, hits are checked again against the database
+// as visibilities may have changed since.
+func (r searchRoutes) search(c *gin.Context) {
+	tumLiveContext := c.MustGet("TUMLiveContext").(tools.TUMLiveContext)
+	var request searchRequest
+	if err := c.ShouldBindQuery(&request); err != nil {
+		_ = c.Error(tools.RequestError{
+			Status:        http.StatusBadRequest,
+			CustomMessage: "invalid search request",
+			Err:           err,
+		})
+		return
+	}
+	if (request.Year == 0) != (request.Term == "") {
+		_ = c.Error(tools.RequestError{
+			Status:        http.StatusBadRequest,
+			CustomMessage: "year and term must be given together",
+		})
+		return
+	}
+	if request.Limit == 0 {
+		request.Limit = defaultSearchLimit
+	}
+	request.Limit = min(request.Limit, maxSearchLimit)
+
+	filter := tools.SearchPermissionFilter(tumLiveContext.User)
+	if request.Year != 0 {
+		filter = tools.SearchSemesterFilter(filter, request.Year, request.Term)
+	}
+	hits, err := searchFunc(request.Q, filter, request.Limit)
+	if err != nil {
+		_ = c.Error(tools.RequestError{
+			Status:        http.StatusInternalServerError,
+			CustomMessage: "can not search",
+			Err:           err,
+		})
+		return
+	}
+
+	p := newSearchPermissions(r.DaoWrapper, tumLiveContext.User)
+	res := searchResultDto{
+		Courses:   []searchHitDto{},
+		Streams:   []searchHitDto{},
+		Sections:  []searchHitDto{},
+		Subtitles: []searchHitDto{},
+		Chat:      []searchHitDto{},
+		Slides:    []searchHitDto{},
+	}
+	for _, course := range hits.Courses {
+		if p.canSeeCourse(course.CourseID) {
+			res.Courses = append(res.Courses, searchHitDto{
+				CourseID: course.CourseID,
+				Title:    course.Name,
+				Link:     fmt.Sprintf("/course/%d/%s/%s", course.Year, course.TeachingTerm, course.CourseSlug),
+			})
+		}
+	}
+	for _, stream := range hits.Streams {
+		if p.canSeeStream(stream.CourseID, stream.ID) {
+			res.Streams = append(res.Streams, newSearchHitDto(stream.MeiliPermissions, stream.ID, stream.Name, stream.Description, 0))
+		}
+	}
+	for _, section := range hits.Sections {
+		if p.canSeeStream(section.CourseID, section.StreamID) {
+			res.Sections = append(res.Sections, newSearchHitDto(section.MeiliPermissions, section.StreamID, section.StreamName, section.Description, section.Timestamp))
+		}
+	}
+	for _, sub := range hits.Subtitles {
+		if p.canSeeStream(sub.CourseID, sub.StreamID) {
+			res.Subtitles = append(res.Subtitles, newSearchHitDto(sub.MeiliPermissions, sub.StreamID, p.streamName(sub.StreamID), sub.Text, sub.Timestamp/1000))
+		}
+	}
+	for _, chat := range hits.Chat {
+		if p.canSeeStream(chat.CourseID, chat.StreamID) {
+			res.Chat = append(res.Chat, newSearchHitDto(chat.MeiliPermissions, chat.StreamID, p.streamName(chat.StreamID), chat.Message, chat.Timestamp))
+		}
+	}
+	for _, slide := range hits.Slides {
+		if p.canSeeStream(slide.CourseID, slide.StreamID) {
+			res.Slides = append(res.Slides, newSearchHitDto(slide.MeiliPermissions, slide.StreamID, p.streamName(slide.StreamID), slide.Text, slide.Timestamp))
+		}
+	}
+	c.JSON(http.StatusOK, res)
+}
+
+func newSearchHitDto(p tools.MeiliPermissions, streamID uint, title, text string, timestamp int64) searchHitDto {
+	link := fmt.Sprintf("/w/%s/%d", p.CourseSlug, streamID)
+	if timestamp > 0 {
+		link += fmt.Sprintf("?t=%d", timestamp)
+	}
+	return searchHitDto{
+		CourseID:  p.CourseID,
+		StreamID:  streamID,
+		Title:     title,
+		Text:      text,
+		Timestamp: timestamp,
+		Link:      link,
+	}
+}
+
+// searchPermissions checks whether a user may see the courses and streams of search hits.
+// Results are cached for the request, as many hits share their course and stream.
+type searchPermissions struct {
+	dao.DaoWrapper
+	user    *model.User
+	courses map[uint]*model.Course // nil if the course can't be seen
+	streams map[uint]*model.Stream // nil if the stream can't be seen
+}
+
+func newSearchPermissions(daoWrapper dao.DaoWrapper, user *model.User) *searchPermissions {
+	return &searchPermissions{
+		DaoWrapper: daoWrapper,
+		user:       user,
+		courses:    map[uint]*model.Course{},
+		streams:    map[uint]*model.Stream{},
+	}
+}
+
+func (p *searchPermissions) canSeeCourse(courseID uint) bool {
+	course, ok := p.courses[courseID]
+	if ok {
+		return course != nil
+	}
+	found, err := p.CoursesDao.GetCourseById(context.Background(), courseID)
+	if err == nil && found.ID == courseID && p.mayWatch(found) {
+		course = &found
+	}
+	p.courses[courseID] = course
+	return course != nil
+}
+
+// mayWatch mirrors the checks of tools.InitCourse, except that hidden courses are only found by their students.
+func (p *searchPermissions) mayWatch(course model.Course) bool {
+	switch course.Visibility {
+	case "public":
+		return true
+	case "loggedin":
+		return p.user != nil
+	default:
+		return p.user != nil && p.user.IsEligibleToWatchCourse(course)
+	}
+}
+
+func (p *searchPermissions) canSeeStream(courseID, streamID uint) bool {
+	if !p.canSeeCourse(courseID) {
+		return false
+	}
+	stream, ok := p.streams[streamID]
+	if ok {
+		return stream != nil
+	}
+	found, err := p.StreamsDao.GetStreamByID(context.Background(), strconv.Itoa(int(streamID)))
+	if err == nil && found.CourseID == courseID && (!found.Private || p.user.IsAdminOfCourse(*p.courses[courseID])) {
+		stream = &found
+	}
+	p.streams[streamID] = stream
+	return stream != nil
+}
+
+// streamName returns the name of a stream checked with canSeeStream.
+func (p *searchPermissions) streamName(streamID uint) string {
+	if stream := p.streams[streamID]; stream != nil {
+		return stream.Name
+	}
+	return ""
+}
@@ -0,0 +1,114 @@
+package api
+
+import (
+	"errors"
+	"net/http"
+	"testing"
+
+	"github.com/TUM-Dev/gocast/dao"
+	"github.com/TUM-Dev/gocast/mock_dao"
+	"github.com/TUM-Dev/gocast/model"
+	"github.com/TUM-Dev/gocast/tools"
+	"github.com/TUM-Dev/gocast/tools/testutils"
+	"github.com/gin-gonic/gin"
+	"github.com/golang/mock/gomock"
+	"github.com/matthiasreumann/gomino"
+	"gorm.io/gorm"
+)
+
+func TestGlobalSearch(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	fpv := tools.MeiliPermissions{CourseID: testutils.CourseFPV.ID, CourseSlug: "fpv", Visibility: "public", Year: 2022, TeachingTerm: "W"}
+	enrolledOnly := tools.MeiliPermissions{CourseID: 99, CourseSlug: "secret", Visibility: "public"} // stale index, course is enrolled only by now
+	hits := &tools.MeiliSearchResults{
+		Courses:   []tools.MeiliCourse{{ID: fpv.CourseID, Name: testutils.CourseFPV.Name, MeiliPermissions: fpv}, {ID: 99, Name: "Secret", MeiliPermissions: enrolledOnly}},
+		Streams:   []tools.MeiliStream{{ID: testutils.StreamFPVLive.ID, Name: "Lecture 1", MeiliPermissions: fpv}, {ID: 7, Name: "Private", MeiliPermissions: fpv}},
+		Sections:  []tools.MeiliSection{{ID: 1, StreamID: testutils.StreamFPVLive.ID, StreamName: "Lecture 1", Description: "Induction", Timestamp: 90, MeiliPermissions: fpv}},
+		Subtitles: []tools.MeiliSubtitles{{ID: "1-5000", StreamID: testutils.StreamFPVLive.ID, Timestamp: 5000, Text: "induction", MeiliPermissions: fpv}},
+		Chat:      []tools.MeiliChat{{ID: 3, StreamID: 7, Message: "induction?", MeiliPermissions: fpv}},
+		Slides:    []tools.MeiliSlide{{ID: 4, StreamID: testutils.StreamFPVLive.ID, Text: "Proof by induction", Timestamp: 120, MeiliPermissions: fpv}},
+	}
+
+	searchFunc = func(q string, filter string, limit int64) (*tools.MeiliSearchResults, error) {
+		if q == "fail" {
+			return nil, errors.New("meili is down")
+		}
+		return hits, nil
+	}
+	defer func() { searchFunc = tools.SearchAll }()
+
+	daoWrapper := func() dao.DaoWrapper {
+		ctrl := gomock.NewController(t)
+		coursesMock := mock_dao.NewMockCoursesDao(ctrl)
+		coursesMock.EXPECT().GetCourseById(gomock.Any(), testutils.CourseFPV.ID).Return(testutils.CourseFPV, nil).AnyTimes()
+		coursesMock.EXPECT().GetCourseById(gomock.Any(), uint(99)).Return(model.Course{Model: gorm.Model{ID: 99}, Visibility: "enrolled"}, nil).AnyTimes()
+		streamsMock := mock_dao.NewMockStreamsDao(ctrl)
+		streamsMock.EXPECT().GetStreamByID(gomock.Any(), "1969").Return(testutils.StreamFPVLive, nil).AnyTimes()
+		streamsMock.EXPECT().GetStreamByID(gomock.Any(), "7").Return(model.Stream{Model: gorm.Model{ID: 7}, CourseID: testutils.CourseFPV.ID, Private: true}, nil).AnyTimes()
+		return dao.DaoWrapper{CoursesDao: coursesMock, StreamsDao: streamsMock}
+	}
+
+	url := "/api/search"
+	gomino.TestCases{
+		"no query": {
+			Router:       func(r *gin.Engine) { configGinSearchRouter(r, daoWrapper()) },
+			Middlewares:  testutils.GetMiddlewares(tools.ErrorHandler, testutils.TUMLiveContext(testutils.TUMLiveContextStudent)),
+			ExpectedCode: http.StatusBadRequest,
+		},
+		"year without term": {
+			Router:       func(r *gin.Engine) { configGinSearchRouter(r, daoWrapper()) },
+			Url:          url + "?q=induction&year=2022",
+			Middlewares:  testutils.GetMiddlewares(tools.ErrorHandler, testutils.TUMLiveContext(testutils.TUMLiveContextStudent)),
+			ExpectedCode: http.StatusBadRequest,
+		},
+		"search backend error": {
+			Router:       func(r *gin.Engine) { configGinSearchRouter(r, daoWrapper()) },
+			Url:          url + "?q=fail",
+			Middlewares:  testutils.GetMiddlewares(tools.ErrorHandler, testutils.TUMLiveContext(testutils.TUMLiveContextStudent)),
+			ExpectedCode: http.StatusInternalServerError,
+		},
+		"success": {
+			Router:       func(r *gin.Engine) { configGinSearchRouter(r, daoWrapper()) },
+			Url:          url + "?q=induction&year=2022&term=W",
+			Middlewares:  testutils.GetMiddlewares(tools.ErrorHandler, testutils.TUMLiveContext(testutils.TUMLiveContextStudent)),
+			ExpectedCode: http.StatusOK,
+			ExpectedResponse: searchResultDto{
+				Courses: []searchHitDto{{CourseID: 40, Title: testutils.CourseFPV.Name, Link: "/course/2022/W/fpv"}},
+				Streams: []searchHitDto{{CourseID: 40, StreamID: 1969, Title: "Lecture 1", Link: "/w/fpv/1969"}},
+				Sections: []searchHitDto{
+					{CourseID: 40, StreamID: 1969, Title: "Lecture 1", Text: "Induction", Timestamp: 90, Link: "/w/fpv/1969?t=90"},
+				},
+				Subtitles: []searchHitDto{
+					{CourseID: 40, StreamID: 1969, Title: testutils.StreamFPVLive.Name, Text: "induction", Timestamp: 5, Link: "/w/fpv/1969?t=5"},
+				},
+				Chat: []searchHitDto{},
+				Slides: []searchHitDto{
+					{CourseID: 40, StreamID: 1969, Title: testutils.StreamFPVLive.Name, Text: "Proof by induction", Timestamp: 120, Link: "/w/fpv/1969?t=120"},
+				},
+			},
+		},
+	}.Method(http.MethodGet).Url(url).Run(t, testutils.Equal)
+
+	t.Run("permission filter", func(t *testing.T) {
+		for name, tc := range map[string]struct {
+			user     *model.User
+			expected string
+		}{
+			"visitor": {nil, `visibility = "public" AND private = false`},
+			"admin":   {&testutils.Admin, ""},
+			"student": {
+				&model.User{Courses: []model.Course{{Model: gorm.Model{ID: 1}}, {Model: gorm.Model{ID: 2}}}, AdministeredCourses: []model.Course{{Model: gorm.Model{ID: 3}}}},
+				`((visibility IN ["public", "loggedin"] OR courseID IN [1, 2]) AND private = false) OR courseID IN [3]`,
+			},
+		} {
+			if got := tools.SearchPermissionFilter(tc.user); got != tc.expected {
+				t.Errorf("%s: expected filter %s, got %s", name, tc.expected, got)
+			}
+		}
+		filter := tools.SearchSemesterFilter(tools.SearchPermissionFilter(&testutils.Student), 2022, "W")
+		if filter != `(visibility IN ["public", "loggedin"] AND private = false) AND year = 2022 AND semester = "W"` {
+			t.Errorf("unexpected filter for student in semester: %s", filter)
+		}
+	})
+}
@@ -0,0 +1,205 @@
+package api
+
+// section_suggestions.go handles the chapters workers suggest from the slide changes of presentations.
+// Lecturers accept (and possibly edit) them as video sections or reject them.
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"strconv"
+
+	"github.com/TUM-Dev/gocast/dao"
+	"github.com/TUM-Dev/gocast/model"
+	"github.com/TUM-Dev/gocast/tools"
+	"github.com/TUM-Dev/gocast/worker/pb"
+	"github.com/gin-gonic/gin"
+	"gorm.io/gorm"
+)
+
+// NotifySectionSuggestions replaces the suggested chapters of a stream with the ones the worker detected.
+func (s server) NotifySectionSuggestions(ctx context.Context, req *pb.SectionSuggestions) (*pb.Status, error) {
+	if _, err := s.WorkerDao.GetWorkerByID(ctx, req.GetWorkerID()); err != nil {
+		return nil, err
+	}
+	stream, err := s.StreamsDao.GetStreamByID(ctx, fmt.Sprintf("%d", req.GetStreamID()))
+	if err != nil {
+		return nil, err
+	}
+	suggestions := make([]model.SectionSuggestion, len(req.GetSuggestions()))
+	thumbnails := make([]model.File, len(req.GetSuggestions()))
+	for i, suggestion := range req.GetSuggestions() {
+		suggestions[i] = model.SectionSuggestion{StreamID: stream.ID, Start: uint(suggestion.GetStart())}
+		thumbnails[i] = model.File{StreamID: stream.ID, Path: suggestion.GetThumbnailPath(), Type: model.FILETYPE_IMAGE_JPG}
+	}
+	if err = s.SectionSuggestionDao.Replace(ctx, stream.ID, suggestions, thumbnails); err != nil {
+		return nil, err
+	}
+	return &pb.Status{Ok: true}, nil
+}
+
+type sectionSuggestionDto struct {
+	ID           uint `json:"id"`
+	StartHours   uint `json:"startHours"`
+	StartMinutes uint `json:"startMinutes"`
+	StartSeconds uint `json:"startSeconds"`
+	FileID       uint `json:"fileID"`
+}
+
+func (r streamRoutes) getSectionSuggestions(c *gin.Context) {
+	tumLiveContext := c.MustGet("TUMLiveContext").(tools.TUMLiveContext)
+	suggestions, err := r.SectionSuggestionDao.GetForStream(c, tumLiveContext.Stream.ID)
+	if err != nil {
+		_ = c.Error(tools.RequestError{
+			Status:        http.StatusInternalServerError,
+			CustomMessage: "can not get section suggestions",
+			Err:           err,
+		})
+		return
+	}
+	res := make([]sectionSuggestionDto, len(suggestions))
+	for i, suggestion := range suggestions {
+		res[i] = sectionSuggestionDto{
+			ID:           suggestion.ID,
+			StartHours:   suggestion.StartHours(),
+			StartMinutes: suggestion.StartMinutes(),
+			StartSeconds: suggestion.StartSeconds(),
+			FileID:       suggestion.FileID,
+		}
+	}
+	c.JSON(http.StatusOK, res)
+}
+
+// acceptSectionSuggestion creates a video section from a suggestion.
+// The body sets the description and optionally moves the start, the images of the sections are regenerated afterwards.
+func (r streamRoutes) acceptSectionSuggestion(c *gin.Context) {
+	tumLiveContext := c.MustGet("TUMLiveContext").(tools.TUMLiveContext)
+	suggestion, ok := r.getSectionSuggestion(c, tumLiveContext.Stream.ID)
+	if !ok {
+		return
+	}
+
+	req := UpdateVideoSectionRequest{
+		StartHours:   suggestion.StartHours(),
+		StartMinutes: suggestion.StartMinutes(),
+		StartSeconds: suggestion.StartSeconds(),
+	}
+	if err := c.ShouldBindJSON(&req); err != nil && !errors.Is(err, io.EOF) {
+		_ = c.Error(tools.RequestError{
+			Status:        http.StatusBadRequest,
+			CustomMessage: "can not bind body",
+			Err:           err,
+		})
+		return
+	}
+	if req.Description == "" || req.StartMinutes > 59 || req.StartSeconds > 59 {
+		_ = c.Error(tools.RequestError{
+			Status:        http.StatusBadRequest,
+			CustomMessage: "section needs a description and a valid start",
+		})
+		return
+	}
+
+	sections := []model.VideoSection{{
+		Description:  req.Description,
+		StartHours:   req.StartHours,
+		StartMinutes: req.StartMinutes,
+		StartSeconds: req.StartSeconds,
+		StreamID:     suggestion.StreamID,
+	}}
+	if err := r.VideoSectionDao.Create(sections); err != nil {
+		_ = c.Error(tools.RequestError{
+			Status:        http.StatusInternalServerError,
+			CustomMessage: "can not create video section",
+			Err:           err,
+		})
+		return
+	}
+	if err := r.deleteSectionSuggestion(c, suggestion); err != nil {
+		_ = c.Error(tools.RequestError{
+			Status:        http.StatusInternalServerError,
+			CustomMessage: "can not delete section suggestion",
+			Err:           err,
+		})
+		return
+	}
+	if err := GenerateVideoSectionImages(r.DaoWrapper, suggestion.StreamID); err != nil {
+		logger.Error("failed to generate video section images", "err", err)
+	}
+	c.JSON(http.StatusOK, sections[0])
+}
+
+func (r streamRoutes) rejectSectionSuggestion(c *gin.Context) {
+	tumLiveContext := c.MustGet("TUMLiveContext").(tools.TUMLiveContext)
+	suggestion, ok := r.getSectionSuggestion(c, tumLiveContext.Stream.ID)
+	if !ok {
+		return
+	}
+	if err := r.deleteSectionSuggestion(c, suggestion); err != nil {
+		_ = c.Error(tools.RequestError{
+			Status:        http.StatusInternalServerError,
+			CustomMessage: "can not delete section suggestion",
+			Err:           err,
+		})
+		return
+	}
+	c.Status(http.StatusAccepted)
+}
+
+// getSectionSuggestion returns the suggestion of the url of a stream, or sets an error and returns false.
+func (r streamRoutes) getSectionSuggestion(c *gin.Context, streamID uint) (model.SectionSuggestion, bool) {
+	id, err := strconv.ParseUint(c.Param("id"), 10, 32)
+	if err != nil {
+		_ = c.Error(tools.RequestError{
+			Status:        http.StatusBadRequest,
+			CustomMessage: "can not parse section suggestion id in request url",
+			Err:           err,
+		})
+		return model.SectionSuggestion{}, false
+	}
+	suggestion, err := r.SectionSuggestionDao.Get(c, uint(id))
+	if err == nil && suggestion.StreamID != streamID {
+		err = gorm.ErrRecordNotFound
+	}
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		_ = c.Error(tools.RequestError{
+			Status:        http.StatusNotFound,
+			CustomMessage: "section suggestion not found",
+			Err:           err,
+		})
+		return model.SectionSuggestion{}, false
+	} else if err != nil {
+		_ = c.Error(tools.RequestError{
+			Status:        http.StatusInternalServerError,
+			CustomMessage: "can not get section suggestion",
+			Err:           err,
+		})
+		return model.SectionSuggestion{}, false
+	}
+	return suggestion, true
+}
+
+// deleteSectionSuggestion deletes a suggestion and its thumbnail.
+func (r streamRoutes) deleteSectionSuggestion(ctx context.Context, suggestion model.SectionSuggestion) error {
+	if err := r.SectionSuggestionDao.Delete(ctx, suggestion.ID); err != nil {
+		return err
+	}
+	deleteSectionSuggestionThumbnail(r.DaoWrapper, suggestion.FileID)
+	return nil
+}
+
+// deleteSectionSuggestionThumbnail removes the thumbnail of a suggestion from the database and the storage.
+func deleteSectionSuggestionThumbnail(daoWrapper dao.DaoWrapper, fileID uint) {
+	file, err := daoWrapper.FileDao.GetFileById(fmt.Sprintf("%d", fileID))
+	if err != nil {
+		logger.Error("can't get thumbnail of section suggestion", "err", err, "file", fileID)
+		return
+	}
+	if err = daoWrapper.FileDao.DeleteFile(file.ID); err != nil {
+		logger.Error("can't delete thumbnail of section suggestion", "err", err, "file", fileID)
+	}
+	if err = DeleteVideoSectionImage(daoWrapper, file.Path); err != nil {
+		logger.Error("failed to delete section suggestion image", "err", err)
+	}
+}
@@ -0,0 +1,143 @@
+package api
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/TUM-Dev/gocast/dao"
+	"github.com/TUM-Dev/gocast/mock_dao"
+	"github.com/TUM-Dev/gocast/model"
+	"github.com/TUM-Dev/gocast/tools"
+	"github.com/TUM-Dev/gocast/tools/testutils"
+	"github.com/TUM-Dev/gocast/worker/pb"
+	"github.com/gin-gonic/gin"
+	"github.com/golang/mock/gomock"
+	"github.com/matthiasreumann/gomino"
+	"gorm.io/gorm"
+)
+
+func TestNotifySectionSuggestions(t *testing.T) {
+	workerMock := mock_dao.NewMockWorkerDao(gomock.NewController(t))
+	workerMock.EXPECT().GetWorkerByID(gomock.Any(), "w1").Return(model.Worker{WorkerID: "w1"}, nil)
+	streamsMock := mock_dao.NewMockStreamsDao(gomock.NewController(t))
+	streamsMock.EXPECT().GetStreamByID(gomock.Any(), "1").Return(model.Stream{Model: gorm.Model{ID: 1}}, nil)
+
+	suggestionsMock := mock_dao.NewMockSectionSuggestionDao(gomock.NewController(t))
+	suggestionsMock.EXPECT().Replace(gomock.Any(), uint(1), []model.SectionSuggestion{
+		{StreamID: 1, Start: 0},
+		{StreamID: 1, Start: 754},
+	}, []model.File{
+		{StreamID: 1, Path: "/srv/chapters/0001.jpg", Type: model.FILETYPE_IMAGE_JPG},
+		{StreamID: 1, Path: "/srv/chapters/0004.jpg", Type: model.FILETYPE_IMAGE_JPG},
+	}).Return(nil)
+
+	s := server{DaoWrapper: dao.DaoWrapper{WorkerDao: workerMock, StreamsDao: streamsMock, SectionSuggestionDao: suggestionsMock}}
+	_, err := s.NotifySectionSuggestions(context.Background(), &pb.SectionSuggestions{
+		WorkerID: "w1",
+		StreamID: 1,
+		Suggestions: []*pb.SectionSuggestion{
+			{Start: 0, ThumbnailPath: "/srv/chapters/0001.jpg"},
+			{Start: 754, ThumbnailPath: "/srv/chapters/0004.jpg"},
+		},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestSectionSuggestions(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	baseUrl := fmt.Sprintf("/api/stream/%d/sections/suggestions", testutils.StreamFPVLive.ID)
+	suggestion := model.SectionSuggestion{Model: gorm.Model{ID: 7}, StreamID: testutils.StreamFPVLive.ID, Start: 3754, FileID: 3}
+
+	admin := testutils.GetMiddlewares(tools.ErrorHandler, testutils.TUMLiveContext(testutils.TUMLiveContextAdmin))
+
+	router := func(t *testing.T, suggestions dao.SectionSuggestionDao) func(r *gin.Engine) {
+		return func(r *gin.Engine) {
+			configGinStreamRestRouter(r, dao.DaoWrapper{
+				StreamsDao:           testutils.GetStreamMock(t),
+				CoursesDao:           testutils.GetCoursesMock(t),
+				SectionSuggestionDao: suggestions,
+			})
+		}
+	}
+
+	t.Run("GET/api/stream/:streamID/sections/suggestions", func(t *testing.T) {
+		gomino.TestCases{
+			"Not Admin": {
+				Router:       StreamDefaultRouter(t),
+				Middlewares:  testutils.GetMiddlewares(tools.ErrorHandler, testutils.TUMLiveContext(testutils.TUMLiveContextStudent)),
+				ExpectedCode: http.StatusForbidden,
+			},
+			"success": {
+				Router: router(t, func() dao.SectionSuggestionDao {
+					suggestions := mock_dao.NewMockSectionSuggestionDao(gomock.NewController(t))
+					suggestions.EXPECT().GetForStream(gomock.Any(), testutils.StreamFPVLive.ID).Return([]model.SectionSuggestion{suggestion}, nil)
+					return suggestions
+				}()),
+				Middlewares:  admin,
+				ExpectedCode: http.StatusOK,
+				ExpectedResponse: []sectionSuggestionDto{
+					{ID: 7, StartHours: 1, StartMinutes: 2, StartSeconds: 34, FileID: 3},
+				},
+			},
+		}.
+			Method(http.MethodGet).
+			Url(baseUrl).
+			Run(t, testutils.Equal)
+	})
+
+	t.Run("POST/api/stream/:streamID/sections/suggestions/:id/accept", func(t *testing.T) {
+		gomino.TestCases{
+			"Invalid ID": {
+				Router:       StreamDefaultRouter(t),
+				Url:          baseUrl + "/abc/accept",
+				Middlewares:  admin,
+				ExpectedCode: http.StatusBadRequest,
+			},
+			"Other stream": {
+				Router: router(t, func() dao.SectionSuggestionDao {
+					suggestions := mock_dao.NewMockSectionSuggestionDao(gomock.NewController(t))
+					suggestions.EXPECT().Get(gomock.Any(), uint(8)).Return(model.SectionSuggestion{Model: gorm.Model{ID: 8}, StreamID: 1}, nil)
+					return suggestions
+				}()),
+				Url:          baseUrl + "/8/accept",
+				Middlewares:  admin,
+				Body:         UpdateVideoSectionRequest{Description: "Graph algorithms"},
+				ExpectedCode: http.StatusNotFound,
+			},
+			"No description": {
+				Router: router(t, func() dao.SectionSuggestionDao {
+					suggestions := mock_dao.NewMockSectionSuggestionDao(gomock.NewController(t))
+					suggestions.EXPECT().Get(gomock.Any(), suggestion.ID).Return(suggestion, nil)
+					return suggestions
+				}()),
+				Url:          fmt.Sprintf("%s/%d/accept", baseUrl, suggestion.ID),
+				Middlewares:  admin,
+				ExpectedCode: http.StatusBadRequest,
+			},
+		}.
+			Method(http.MethodPost).
+			Run(t, testutils.Equal)
+	})
+
+	t.Run("DELETE/api/stream/:streamID/sections/suggestions/:id", func(t *testing.T) {
+		gomino.TestCases{
+			"Not found": {
+				Router: router(t, func() dao.SectionSuggestionDao {
+					suggestions := mock_dao.NewMockSectionSuggestionDao(gomock.NewController(t))
+					suggestions.EXPECT().Get(gomock.Any(), suggestion.ID).Return(model.SectionSuggestion{}, gorm.ErrRecordNotFound)
+					return suggestions
+				}()),
+				Middlewares:  admin,
+				ExpectedCode: http.StatusNotFound,
+			},
+		}.
+			Method(http.MethodDelete).
+			Url(fmt.Sprintf("%s/%d", baseUrl, suggestion.ID)).
+			Run(t, testutils.Equal)
+	})
+}
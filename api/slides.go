@@ -0,0 +1,42 @@
+package api
+
+import (
+	"context"
+	"fmt"
+	"path/filepath"
+
+	"github.com/TUM-Dev/gocast/tools"
+	"github.com/TUM-Dev/gocast/worker/pb"
+)
+
+// NotifySlides forwards the frames of the slide changes of a VoD to the ocr-service, if one is configured.
+// Otherwise, the frames are removed right away.
+func (s server) NotifySlides(ctx context.Context, req *pb.Slides) (*pb.Status, error) {
+	if _, err := s.WorkerDao.GetWorkerByID(ctx, req.GetWorkerID()); err != nil {
+		return nil, err
+	}
+	stream, err := s.StreamsDao.GetStreamByID(ctx, fmt.Sprintf("%d", req.GetStreamID()))
+	if err != nil {
+		return nil, err
+	}
+	if len(req.GetSlides()) == 0 {
+		return &pb.Status{Ok: true}, nil
+	}
+	if tools.Cfg.OcrService == nil {
+		// the worker was asked for the frames before the ocr-service was removed from the config
+		if err = removeStoredFile(s.DaoWrapper, stream.ID, filepath.Dir(req.GetSlides()[0].GetPath())); err != nil {
+			logger.Error("can't queue removal of slide images", "err", err, "stream", stream.ID)
+		}
+		return &pb.Status{Ok: true}, nil
+	}
+	images := make([]slideImage, len(req.GetSlides()))
+	for i, slide := range req.GetSlides() {
+		images[i] = slideImage{Start: uint(slide.GetStart()), Path: slide.GetPath()}
+	}
+	go func() {
+		if err := requestSlideText(context.Background(), s.DaoWrapper, stream.ID, images); err != nil {
+			logger.Error("can't request slide text", "err", err, "stream", stream.ID)
+		}
+	}()
+	return &pb.Status{Ok: true}, nil
+}
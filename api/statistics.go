@@ -442,11 +442,28 @@ type chartJs struct {
 }
 
 type chartJsScales struct {
+	X *chartJsLinearAxis `json:"x,omitempty"`
 	Y struct {
 		BeginAtZero bool `json:"beginAtZero"`
 	} `json:"y"`
 }
 
+// chartJsLinearAxis is a numeric axis, e.g. for datasets of {x, y} points
+type chartJsLinearAxis struct {
+	Type  string `json:"type"`
+	Title struct {
+		Display bool   `json:"display"`
+		Text    string `json:"text"`
+	} `json:"title"`
+}
+
+func newChartJsLinearAxis(title string) *chartJsLinearAxis {
+	a := &chartJsLinearAxis{Type: "linear"}
+	a.Title.Display = true
+	a.Title.Text = title
+	return a
+}
+
 func newChartJsScales() chartJsScales {
 	return chartJsScales{Y: struct {
 		BeginAtZero bool `json:"beginAtZero"`
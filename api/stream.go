@@ -17,6 +17,7 @@ import (
 	"github.com/TUM-Dev/gocast/model"
 	"github.com/TUM-Dev/gocast/tools"
 	"github.com/TUM-Dev/gocast/tools/bot"
+	"github.com/TUM-Dev/gocast/tools/subtitles"
 	"github.com/TUM-Dev/gocast/voice-service/pb"
 	"github.com/getsentry/sentry-go"
 	"github.com/gin-gonic/gin"
@@ -41,9 +42,11 @@ func configGinStreamRestRouter(router *gin.Engine, daoWrapper dao.DaoWrapper) {
 		{
 			// All User Endpoints
 			streamById.GET("/sections", routes.getVideoSections)
+			streamById.GET("/subtitles/tracks", routes.getSubtitleTracks)
 			streamById.GET("/subtitles/:lang", routes.getSubtitles)
 
 			streamById.GET("/playlist", routes.getStreamPlaylist)
+			streamById.GET("/dvr", routes.getDvr)
 
 			thumbs := streamById.Group("/thumbs")
 			{
@@ -63,11 +66,24 @@ func configGinStreamRestRouter(router *gin.Engine, daoWrapper dao.DaoWrapper) {
 			admins.POST("/issue", routes.reportStreamIssue)
 			admins.PATCH("/visibility", routes.updateStreamVisibility)
 			admins.PATCH("/chat/enabled", routes.updateChatEnabled)
+			admins.GET("/health", routes.getStreamHealth)
 			sections := admins.Group("/sections")
 			{
 				sections.POST("", routes.createVideoSectionBatch)
 				sections.PUT("/:id", routes.updateVideoSection)
 				sections.DELETE("/:id", routes.deleteVideoSection)
+
+				suggestions := sections.Group("/suggestions")
+				suggestions.GET("", routes.getSectionSuggestions)
+				suggestions.POST("/:id/accept", routes.acceptSectionSuggestion)
+				suggestions.DELETE("/:id", routes.rejectSectionSuggestion)
+			}
+
+			edits := admins.Group("/edits")
+			{
+				edits.GET("", routes.getStreamEdits)
+				edits.PUT("", routes.updateStreamEdits)
+				edits.POST("/render", routes.renderStreamEdits)
 			}
 
 			files := admins.Group("files")
@@ -79,6 +95,16 @@ func configGinStreamRestRouter(router *gin.Engine, daoWrapper dao.DaoWrapper) {
 			subtitles := admins.Group("subtitles")
 			{
 				subtitles.POST("", routes.requestSubtitles)
+				subtitles.GET("", routes.getSubtitleVersions)
+				subtitles.GET("/jobs", routes.getSubtitleJobs)
+				subtitles.POST("/:lang", routes.uploadSubtitles)
+				subtitles.GET("/:lang/:version", routes.getSubtitleCues)
+				subtitles.POST("/:lang/:version/activate", routes.activateSubtitles)
+				subtitles.POST("/:lang/:version/shift", routes.shiftSubtitles)
+				subtitles.POST("/:lang/:version/translate", routes.requestSubtitleTranslation)
+				subtitles.POST("/:lang/:version/cues", routes.addSubtitleCue)
+				subtitles.PUT("/:lang/:version/cues/:cue", routes.updateSubtitleCue)
+				subtitles.DELETE("/:lang/:version/cues/:cue", routes.deleteSubtitleCue)
 			}
 		}
 	}
@@ -209,6 +235,24 @@ func (r streamRoutes) getSubtitles(c *gin.Context) {
 		}
 		return
 	}
+	// older versions contain the .srt of the voice-service, convert them as well
+	format := c.DefaultQuery("format", subtitles.FormatWebVTT)
+	cues, err := subtitles.Parse([]byte(subtitlesObj.Content), "")
+	if err == nil {
+		var content string
+		if content, err = subtitles.Write(cues, format); err == nil {
+			c.Data(http.StatusOK, subtitles.ContentType(format), []byte(content))
+			return
+		}
+	}
+	if errors.Is(err, subtitles.ErrUnknownFormat) {
+		_ = c.Error(tools.RequestError{
+			Status:        http.StatusBadRequest,
+			CustomMessage: "unknown format, supported formats are vtt and srt",
+		})
+		return
+	}
+	logger.Warn("can not convert subtitles", "err", err, "stream", subtitlesObj.StreamID)
 	c.Data(http.StatusOK, "text/vtt", []byte(subtitlesObj.Content))
 }
 
@@ -364,11 +408,52 @@ func (r streamRoutes) getStream(c *gin.Context) {
 		"start":       stream.Start,
 		"end":         stream.End,
 		"ingest":      fmt.Sprintf("%s%s-%d?secret=%s", tools.Cfg.IngestBase, course.Slug, stream.ID, stream.StreamKey),
+		"srtIngest":   tools.SrtIngestURL(course.Slug, stream.ID, stream.StreamKey),
 		"live":        stream.LiveNow,
 		"vod":         stream.Recording,
 	})
 }
 
+type dvrDto struct {
+	COMB          string    `json:"comb,omitempty"`
+	PRES          string    `json:"pres,omitempty"`
+	CAM           string    `json:"cam,omitempty"`
+	SeekableStart time.Time `json:"seekableStart"`
+	SeekableEnd   time.Time `json:"seekableEnd"`
+	Window        uint32    `json:"window"` // seconds, 0 if viewers can rewind to the start
+}
+
+// getDvr returns the signed DVR playlists of a running live stream and the range viewers can rewind to.
+func (r streamRoutes) getDvr(c *gin.Context) {
+	tumLiveContext := c.MustGet("TUMLiveContext").(tools.TUMLiveContext)
+
+	stream := *tumLiveContext.Stream
+	if !stream.LiveNow || (stream.DvrPlaylistUrl == "" && stream.DvrPlaylistUrlPRES == "" && stream.DvrPlaylistUrlCAM == "") {
+		_ = c.Error(tools.RequestError{
+			Status:        http.StatusNotFound,
+			CustomMessage: "stream can't be rewound",
+		})
+		return
+	}
+	if err := tools.SetSignedPlaylists(&stream, tumLiveContext.User, false); err != nil {
+		_ = c.Error(tools.RequestError{
+			Status:        http.StatusInternalServerError,
+			CustomMessage: "can not create signed stream playlists",
+			Err:           err,
+		})
+		return
+	}
+	start, end := tools.DvrSeekableRange(stream, time.Now())
+	c.JSON(http.StatusOK, dvrDto{
+		COMB:          stream.DvrPlaylistUrl,
+		PRES:          stream.DvrPlaylistUrlPRES,
+		CAM:           stream.DvrPlaylistUrlCAM,
+		SeekableStart: start,
+		SeekableEnd:   end,
+		Window:        uint32(tools.DvrWindow().Seconds()),
+	})
+}
+
 func (r streamRoutes) getStreamPlaylist(c *gin.Context) {
 	type StreamPlaylistEntry struct {
 		StreamID       uint                 `json:"streamId"`
@@ -437,41 +522,20 @@ func (r streamRoutes) getVideoSections(c *gin.Context) {
 func (r streamRoutes) RegenerateThumbs(c *gin.Context) {
 	tumLiveContext := c.MustGet("TUMLiveContext").(tools.TUMLiveContext)
 	stream := tumLiveContext.Stream
-	course := tumLiveContext.Course
-	_ = stream
 	for _, file := range stream.Files {
 		if file.Type == model.FILETYPE_VOD {
 			// Unlike for generating video sections, we need a new method here, as there is no API in place.
 			// The thumbnails are generated automatically by the worker which then notifies the backend.
-			err := RegenerateThumbs(r.DaoWrapper, file, stream, course)
+			err := RegenerateThumbs(r.DaoWrapper, file, stream)
 			if err != nil {
 				logger.Error(fmt.Sprintf("Can't regenerate thumbnail for stream %d with file %s", stream.ID, file.Path))
 				continue
 			}
-			sections, err := r.DaoWrapper.VideoSectionDao.GetByStreamId(stream.ID)
-			if err != nil {
-				logger.Error(fmt.Sprintf("Can't get video sections for stream %d", stream.ID))
-				continue
-			}
-			err = tools.SetSignedPlaylists(stream, nil, false)
+			// Completely redo the video section image generation. This also updates the database, if the naming scheme has changed.
+			err = GenerateVideoSectionImages(r.DaoWrapper, stream.ID)
 			if err != nil {
-				logger.Error(fmt.Sprintf("Can't set signed playlists for stream %d", stream.ID))
-				continue
+				logger.Error("failed to generate video section images", "err", err)
 			}
-			// Completely redo the video section image generation. This also updates the database, if the naming scheme has changed.
-			go func() {
-				parameters := generateVideoSectionImagesParameters{
-					sections:           sections,
-					playlistUrl:        stream.PlaylistUrl,
-					courseName:         course.Name,
-					courseTeachingTerm: course.TeachingTerm,
-					courseYear:         uint32(tumLiveContext.Course.Year),
-				}
-				err := GenerateVideoSectionImages(r.DaoWrapper, &parameters)
-				if err != nil {
-					logger.Error("failed to generate video section images", "err", err)
-				}
-			}()
 		}
 	}
 }
@@ -512,29 +576,10 @@ func (r streamRoutes) createVideoSectionBatch(c *gin.Context) {
 		return
 	}
 
-	err = tools.SetSignedPlaylists(stream, nil, false)
+	err = GenerateVideoSectionImages(r.DaoWrapper, stream.ID)
 	if err != nil {
-		logger.Error("failed to set signed playlists", "err", err)
-		_ = c.Error(tools.RequestError{
-			Status:        http.StatusInternalServerError,
-			CustomMessage: "failed to set signed playlists",
-			Err:           err,
-		})
-		return
+		logger.Error("failed to generate video section images", "err", err)
 	}
-	go func() {
-		parameters := generateVideoSectionImagesParameters{
-			sections:           sections,
-			playlistUrl:        stream.PlaylistUrl,
-			courseName:         context.Course.Name,
-			courseTeachingTerm: context.Course.TeachingTerm,
-			courseYear:         uint32(context.Course.Year),
-		}
-		err := GenerateVideoSectionImages(r.DaoWrapper, &parameters)
-		if err != nil {
-			logger.Error("failed to generate video section images", "err", err)
-		}
-	}()
 
 	c.JSON(http.StatusOK, sections)
 }
@@ -634,12 +679,10 @@ func (r streamRoutes) deleteVideoSection(c *gin.Context) {
 		})
 		return
 	} else {
-		go func() {
-			err := DeleteVideoSectionImage(r.DaoWrapper.WorkerDao, file.Path)
-			if err != nil {
-				logger.Error("failed to generate video section images", "err", err)
-			}
-		}()
+		err := DeleteVideoSectionImage(r.DaoWrapper, file.Path)
+		if err != nil {
+			logger.Error("failed to delete video section image", "err", err)
+		}
 	}
 
 	c.Status(http.StatusAccepted)
@@ -813,10 +856,21 @@ func (r streamRoutes) requestSubtitles(c *gin.Context) {
 		return
 	}
 
+	job, err := createSubtitleJob(r.DaoWrapper, stream.ID, request.Language, "")
+	if err != nil {
+		_ = c.Error(tools.RequestError{
+			Status:        http.StatusInternalServerError,
+			CustomMessage: "can not create subtitle job",
+			Err:           err,
+		})
+		return
+	}
+
 	// request to voice-service for subtitles
 	client, err := GetSubtitleGeneratorClient()
 	if err != nil {
 		sentry.CaptureException(err)
+		failSubtitleJob(r.DaoWrapper, job, err)
 		_ = c.Error(tools.RequestError{
 			Status:        http.StatusInternalServerError,
 			CustomMessage: "could not connect to voice-service",
@@ -833,6 +887,7 @@ func (r streamRoutes) requestSubtitles(c *gin.Context) {
 	})
 	if err != nil {
 		sentry.CaptureException(err)
+		failSubtitleJob(r.DaoWrapper, job, err)
 		_ = c.Error(tools.RequestError{
 			Status:        http.StatusInternalServerError,
 			CustomMessage: "could not call generate on voice_client",
@@ -841,7 +896,7 @@ func (r streamRoutes) requestSubtitles(c *gin.Context) {
 		return
 	}
 
-	c.Status(http.StatusCreated)
+	c.JSON(http.StatusCreated, newSubtitleJobDto(job))
 }
 
 func (r streamRoutes) updateStreamVisibility(c *gin.Context) {
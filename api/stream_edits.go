@@ -0,0 +1,226 @@
+package api
+
+// stream_edits.go stores the edit decision lists of streams and renders them into new files on workers.
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"path"
+	"sort"
+	"strings"
+
+	"github.com/TUM-Dev/gocast/dao"
+	"github.com/TUM-Dev/gocast/model"
+	"github.com/TUM-Dev/gocast/tools"
+	"github.com/TUM-Dev/gocast/worker/pb"
+	"github.com/gin-gonic/gin"
+)
+
+type streamEditsDto struct {
+	Edits        []model.StreamEdit `json:"edits"`
+	Keep         []model.EditRange  `json:"keep"`         // the parts of the VoD that are played
+	RenderedFile uint               `json:"renderedFile"` // id of the file the edits were rendered into, 0 if there is none
+}
+
+func newStreamEditsDto(stream model.Stream) streamEditsDto {
+	res := streamEditsDto{Edits: stream.Edits, Keep: model.KeepRanges(stream.Edits)}
+	if res.Edits == nil {
+		res.Edits = []model.StreamEdit{}
+	}
+	for _, file := range stream.Files {
+		if file.Type == model.FILETYPE_VOD_EDITED {
+			res.RenderedFile = file.ID
+		}
+	}
+	return res
+}
+
+type streamEditRequest struct {
+	Start   uint `json:"start"` // milliseconds into the VoD
+	End     uint `json:"end"`
+	Discard bool `json:"discard"`
+}
+
+func (r streamRoutes) getStreamEdits(c *gin.Context) {
+	tumLiveContext := c.MustGet("TUMLiveContext").(tools.TUMLiveContext)
+	c.JSON(http.StatusOK, newStreamEditsDto(*tumLiveContext.Stream))
+}
+
+// updateStreamEdits replaces the edit decision list of a stream. The original VoD is kept,
+// an empty list plays it as it is again. Renders of the previous list are removed and clips are cut anew.
+func (r streamRoutes) updateStreamEdits(c *gin.Context) {
+	tumLiveContext := c.MustGet("TUMLiveContext").(tools.TUMLiveContext)
+	stream := *tumLiveContext.Stream
+
+	var req []streamEditRequest
+	if err := c.BindJSON(&req); err != nil {
+		_ = c.Error(tools.RequestError{
+			Status:        http.StatusBadRequest,
+			CustomMessage: "can not bind body",
+			Err:           err,
+		})
+		return
+	}
+	if !stream.Recording {
+		_ = c.Error(tools.RequestError{
+			Status:        http.StatusBadRequest,
+			CustomMessage: "only recordings can be edited",
+		})
+		return
+	}
+	sort.Slice(req, func(i, j int) bool { return req[i].Start < req[j].Start })
+	edits := make([]model.StreamEdit, len(req))
+	for i, e := range req {
+		if e.End <= e.Start || (stream.Duration.Int32 > 0 && e.End > uint(stream.Duration.Int32)*1000) {
+			_ = c.Error(tools.RequestError{
+				Status:        http.StatusBadRequest,
+				CustomMessage: fmt.Sprintf("invalid range %d-%d", e.Start, e.End),
+			})
+			return
+		}
+		if i > 0 && e.Start < req[i-1].End {
+			_ = c.Error(tools.RequestError{
+				Status:        http.StatusBadRequest,
+				CustomMessage: "ranges must not overlap",
+			})
+			return
+		}
+		edits[i] = model.StreamEdit{StreamID: stream.ID, Start: e.Start, End: e.End, Discard: e.Discard}
+	}
+	if err := r.StreamsDao.UpdateEdits(stream.ID, edits); err != nil {
+		_ = c.Error(tools.RequestError{
+			Status:        http.StatusInternalServerError,
+			CustomMessage: "can not save edits",
+			Err:           err,
+		})
+		return
+	}
+	var files []model.File
+	for _, file := range stream.Files {
+		if file.Type != model.FILETYPE_VOD_EDITED {
+			files = append(files, file)
+		} else if err := removeRenderedEdits(r.DaoWrapper, file); err != nil {
+			logger.Warn("Can't remove outdated render of edits", "err", err, "file", file.ID)
+		}
+	}
+	if err := recutClips(r.DaoWrapper, stream); err != nil {
+		logger.Warn("Can't cut clips of edited stream anew", "err", err, "stream", stream.ID)
+	}
+	stream.Edits, stream.Files = edits, files
+	c.JSON(http.StatusOK, newStreamEditsDto(stream))
+}
+
+// renderStreamEdits queues the rendering of the edit decision list of a stream into a new file.
+func (r streamRoutes) renderStreamEdits(c *gin.Context) {
+	tumLiveContext := c.MustGet("TUMLiveContext").(tools.TUMLiveContext)
+	stream := *tumLiveContext.Stream
+	if len(stream.Edits) == 0 {
+		_ = c.Error(tools.RequestError{
+			Status:        http.StatusBadRequest,
+			CustomMessage: "stream has no edits",
+		})
+		return
+	}
+	if _, ok := cutSourceFile(stream); !ok {
+		_ = c.Error(tools.RequestError{
+			Status:        http.StatusBadRequest,
+			CustomMessage: "stream has no VoD file to render",
+		})
+		return
+	}
+	job, err := model.NewJob(model.JobTypeRenderEdit, stream.ID, renderEditJobPayload{Keep: model.KeepQuery(stream.Edits)})
+	if err == nil {
+		// dispatched with the next run of the dispatcher
+		err = r.JobDao.Create(c, &job)
+	}
+	if err != nil {
+		_ = c.Error(tools.RequestError{
+			Status:        http.StatusInternalServerError,
+			CustomMessage: "can not queue rendering",
+			Err:           err,
+		})
+		return
+	}
+	c.Status(http.StatusAccepted)
+}
+
+// hiddenByEdits returns whether a file is a recording of an edited stream that still contains the parts the edits
+// remove. Only the admins of the course may get those, everyone else gets the file the edits were rendered into.
+func hiddenByEdits(stream model.Stream, file model.File) bool {
+	if len(stream.Edits) == 0 {
+		return false
+	}
+	switch file.Type {
+	case model.FILETYPE_VOD, model.FILETYPE_VOD_HEVC, model.FILETYPE_VOD_AV1, model.FILETYPE_AUDIO_M4A:
+		return true
+	}
+	return false
+}
+
+// removeRenderedEdits deletes a file the edits of a stream were rendered into.
+func removeRenderedEdits(daoWrapper dao.DaoWrapper, file model.File) error {
+	if err := daoWrapper.FileDao.DeleteFile(file.ID); err != nil {
+		return err
+	}
+	return removeStoredFile(daoWrapper, file.StreamID, file.Path)
+}
+
+// renderEditJobPayload pins a render to the edits it was queued for, see model.KeepQuery.
+type renderEditJobPayload struct {
+	Keep string
+}
+
+// sendRenderEditJob renders the edit decision list of a stream into a new file next to its VoD.
+// The VoD itself is kept, renders of earlier edits are replaced.
+func sendRenderEditJob(daoWrapper dao.DaoWrapper, job *model.Job, worker model.Worker, client pb.ToWorkerClient) error {
+	var payload renderEditJobPayload
+	if err := job.DecodePayload(&payload); err != nil {
+		return err
+	}
+	stream, err := daoWrapper.StreamsDao.GetStreamByID(context.Background(), fmt.Sprintf("%d", job.StreamID))
+	if err != nil {
+		return err
+	}
+	if len(stream.Edits) == 0 || model.KeepQuery(stream.Edits) != payload.Keep {
+		return nil // edits were changed in the meantime, a render of them has to be requested anew
+	}
+	vod, ok := cutSourceFile(stream)
+	if !ok {
+		return errors.New("stream has no VoD file to render")
+	}
+	// every render gets a new name, removing an outdated one never hits a newer one
+	out := fmt.Sprintf("%s-edited-%d.mp4", strings.TrimSuffix(vod.Path, path.Ext(vod.Path)), job.ID)
+	segments := make([]*pb.CutRequest_Segment, len(stream.Edits))
+	for i, e := range stream.Edits {
+		segments[i] = &pb.CutRequest_Segment{StartTime: int64(e.Start), EndTime: int64(e.End), Discard: e.Discard}
+	}
+	resp, err := client.RequestCut(context.Background(), &pb.CutRequest{
+		WorkerId:   worker.WorkerID,
+		Files:      []string{vod.Path},
+		Segments:   segments,
+		OutputFile: out,
+	})
+	if err != nil {
+		return err
+	}
+	if !resp.Success {
+		return fmt.Errorf("worker could not render edits: %s", resp.Error)
+	}
+	// the edits may have changed while the worker was rendering, that render must not replace the current one
+	stream, err = daoWrapper.StreamsDao.GetStreamByID(context.Background(), fmt.Sprintf("%d", job.StreamID))
+	if err != nil {
+		return err
+	}
+	if model.KeepQuery(stream.Edits) != payload.Keep {
+		return removeStoredFile(daoWrapper, stream.ID, out)
+	}
+	for _, file := range stream.Files {
+		if file.Type == model.FILETYPE_VOD_EDITED {
+			if err = removeRenderedEdits(daoWrapper, file); err != nil {
+				logger.Warn("Can't remove outdated render of edits", "err", err, "file", file.ID)
+			}
+		}
+	}
+	return daoWrapper.FileDao.NewFile(&model.File{StreamID: stream.ID, Path: out, Filename: "Edited", Type: model.FILETYPE_VOD_EDITED})
+}
@@ -0,0 +1,272 @@
+package api
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/TUM-Dev/gocast/dao"
+	"github.com/TUM-Dev/gocast/mock_dao"
+	"github.com/TUM-Dev/gocast/model"
+	"github.com/TUM-Dev/gocast/tools"
+	"github.com/TUM-Dev/gocast/tools/testutils"
+	"github.com/TUM-Dev/gocast/worker/pb"
+	"github.com/gin-gonic/gin"
+	"github.com/golang/mock/gomock"
+	"github.com/matthiasreumann/gomino"
+	"google.golang.org/grpc"
+	"gorm.io/gorm"
+)
+
+func TestStreamEdits(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	recording := testutils.StreamFPVNotLive
+	recording.Recording = true
+	recording.Duration = sql.NullInt32{Int32: 5400, Valid: true}
+	recording.Files = []model.File{
+		{Model: gorm.Model{ID: 3}, StreamID: recording.ID, Path: "/vod/fpv.mp4", Type: model.FILETYPE_VOD},
+		{Model: gorm.Model{ID: 4}, StreamID: recording.ID, Path: "/vod/fpv-edited-9.mp4", Type: model.FILETYPE_VOD_EDITED},
+	}
+	edited := recording
+	edited.Edits = []model.StreamEdit{{StreamID: recording.ID, Start: 2700000, End: 3300000, Discard: true}}
+
+	streamsMock := func(t *testing.T, stream model.Stream) *mock_dao.MockStreamsDao {
+		streams := mock_dao.NewMockStreamsDao(gomock.NewController(t))
+		streams.EXPECT().GetStreamByID(gomock.Any(), fmt.Sprintf("%d", stream.ID)).Return(stream, nil).AnyTimes()
+		return streams
+	}
+	admin := testutils.GetMiddlewares(tools.ErrorHandler, testutils.TUMLiveContext(testutils.TUMLiveContextAdmin))
+	url := fmt.Sprintf("/api/stream/%d/edits", recording.ID)
+
+	t.Run("GET/api/stream/:streamID/edits", func(t *testing.T) {
+		gomino.TestCases{
+			"not admin of course": {
+				Router: func(r *gin.Engine) {
+					configGinStreamRestRouter(r, dao.DaoWrapper{StreamsDao: streamsMock(t, edited), CoursesDao: testutils.GetCoursesMock(t)})
+				},
+				Middlewares:  testutils.GetMiddlewares(tools.ErrorHandler, testutils.TUMLiveContext(testutils.TUMLiveContextStudent)),
+				ExpectedCode: http.StatusForbidden,
+			},
+			"success": {
+				Router: func(r *gin.Engine) {
+					configGinStreamRestRouter(r, dao.DaoWrapper{StreamsDao: streamsMock(t, edited), CoursesDao: testutils.GetCoursesMock(t)})
+				},
+				Middlewares:      admin,
+				ExpectedCode:     http.StatusOK,
+				ExpectedResponse: newStreamEditsDto(edited),
+			},
+		}.
+			Method(http.MethodGet).
+			Url(url).
+			Run(t, testutils.Equal)
+	})
+
+	t.Run("PUT/api/stream/:streamID/edits", func(t *testing.T) {
+		gomino.TestCases{
+			"no recording": {
+				Router: func(r *gin.Engine) {
+					configGinStreamRestRouter(r, dao.DaoWrapper{StreamsDao: streamsMock(t, testutils.StreamFPVNotLive), CoursesDao: testutils.GetCoursesMock(t)})
+				},
+				Body:         []streamEditRequest{{Start: 0, End: 1000, Discard: true}},
+				Middlewares:  admin,
+				ExpectedCode: http.StatusBadRequest,
+			},
+			"end before start": {
+				Router: func(r *gin.Engine) {
+					configGinStreamRestRouter(r, dao.DaoWrapper{StreamsDao: streamsMock(t, recording), CoursesDao: testutils.GetCoursesMock(t)})
+				},
+				Body:         []streamEditRequest{{Start: 2000, End: 1000, Discard: true}},
+				Middlewares:  admin,
+				ExpectedCode: http.StatusBadRequest,
+			},
+			"end after the VoD": {
+				Router: func(r *gin.Engine) {
+					configGinStreamRestRouter(r, dao.DaoWrapper{StreamsDao: streamsMock(t, recording), CoursesDao: testutils.GetCoursesMock(t)})
+				},
+				Body:         []streamEditRequest{{Start: 0, End: 5401000, Discard: true}},
+				Middlewares:  admin,
+				ExpectedCode: http.StatusBadRequest,
+			},
+			"overlapping ranges": {
+				Router: func(r *gin.Engine) {
+					configGinStreamRestRouter(r, dao.DaoWrapper{StreamsDao: streamsMock(t, recording), CoursesDao: testutils.GetCoursesMock(t)})
+				},
+				Body:         []streamEditRequest{{Start: 5000, End: 9000, Discard: true}, {Start: 1000, End: 6000}},
+				Middlewares:  admin,
+				ExpectedCode: http.StatusBadRequest,
+			},
+			"success": {
+				Router: func(r *gin.Engine) {
+					streams := streamsMock(t, recording)
+					streams.EXPECT().UpdateEdits(recording.ID, []model.StreamEdit{
+						{StreamID: recording.ID, Start: 0, End: 60000},
+						{StreamID: recording.ID, Start: 2700000, End: 3300000, Discard: true},
+					}).Return(nil)
+					// the render of the previous edits is outdated
+					files := mock_dao.NewMockFileDao(gomock.NewController(t))
+					files.EXPECT().DeleteFile(uint(4)).Return(nil)
+					// clips cut before may contain removed parts
+					clips := mock_dao.NewMockClipDao(gomock.NewController(t))
+					clips.EXPECT().GetForCourse(gomock.Any(), recording.CourseID).Return([]model.Clip{
+						{Model: gorm.Model{ID: 1}, StreamID: recording.ID, Slug: "cut", FilePath: "/vod/clips/cut.mp4"},
+						{Model: gorm.Model{ID: 2}, StreamID: recording.ID, Slug: "uncut"},
+						{Model: gorm.Model{ID: 3}, StreamID: recording.ID + 1, Slug: "other", FilePath: "/vod/clips/other.mp4"},
+					}, nil)
+					clips.EXPECT().SetFilePath(gomock.Any(), uint(1), "").Return(nil)
+					jobs := mock_dao.NewMockJobDao(gomock.NewController(t))
+					jobs.EXPECT().Create(gomock.Any(), gomock.Any()).Return(nil).Times(2)
+					configGinStreamRestRouter(r, dao.DaoWrapper{StreamsDao: streams, CoursesDao: testutils.GetCoursesMock(t), FileDao: files, JobDao: jobs, ClipDao: clips})
+				},
+				Body:         []streamEditRequest{{Start: 2700000, End: 3300000, Discard: true}, {Start: 0, End: 60000}},
+				Middlewares:  admin,
+				ExpectedCode: http.StatusOK,
+			},
+		}.
+			Method(http.MethodPut).
+			Url(url).
+			Run(t, testutils.Equal)
+	})
+
+	t.Run("POST/api/stream/:streamID/edits/render", func(t *testing.T) {
+		gomino.TestCases{
+			"no edits": {
+				Router: func(r *gin.Engine) {
+					configGinStreamRestRouter(r, dao.DaoWrapper{StreamsDao: streamsMock(t, recording), CoursesDao: testutils.GetCoursesMock(t)})
+				},
+				Middlewares:  admin,
+				ExpectedCode: http.StatusBadRequest,
+			},
+			"success": {
+				Router: func(r *gin.Engine) {
+					jobs := mock_dao.NewMockJobDao(gomock.NewController(t))
+					jobs.EXPECT().Create(gomock.Any(), gomock.Any()).DoAndReturn(func(_ any, job *model.Job) error {
+						var payload renderEditJobPayload
+						if job.Type != model.JobTypeRenderEdit || job.StreamID != recording.ID {
+							t.Errorf("unexpected job %+v", job)
+						} else if err := job.DecodePayload(&payload); err != nil || payload.Keep != model.KeepQuery(edited.Edits) {
+							t.Errorf("expected the render pinned to the edits, got %+v (%v)", payload, err)
+						}
+						return nil
+					})
+					configGinStreamRestRouter(r, dao.DaoWrapper{StreamsDao: streamsMock(t, edited), CoursesDao: testutils.GetCoursesMock(t), JobDao: jobs})
+				},
+				Middlewares:  admin,
+				ExpectedCode: http.StatusAccepted,
+			},
+		}.
+			Method(http.MethodPost).
+			Url(url+"/render").
+			Run(t, testutils.Equal)
+	})
+}
+
+// cutWorker answers cut requests, every other call of the worker is out of scope.
+type cutWorker struct {
+	pb.ToWorkerClient
+	cut func()
+}
+
+func (w cutWorker) RequestCut(context.Context, *pb.CutRequest, ...grpc.CallOption) (*pb.CutResponse, error) {
+	w.cut()
+	return &pb.CutResponse{Success: true}, nil
+}
+
+func TestSendRenderEditJob(t *testing.T) {
+	recording := testutils.StreamFPVNotLive
+	recording.Files = []model.File{
+		{Model: gorm.Model{ID: 3}, StreamID: recording.ID, Path: "/vod/fpv.mp4", Type: model.FILETYPE_VOD},
+		{Model: gorm.Model{ID: 4}, StreamID: recording.ID, Path: "/vod/fpv-edited-9.mp4", Type: model.FILETYPE_VOD_EDITED},
+	}
+	recording.Edits = []model.StreamEdit{{StreamID: recording.ID, Start: 2700000, End: 3300000, Discard: true}}
+	changed := recording
+	changed.Edits = []model.StreamEdit{{StreamID: recording.ID, Start: 600000, End: 900000, Discard: true}}
+	job, err := model.NewJob(model.JobTypeRenderEdit, recording.ID, renderEditJobPayload{Keep: model.KeepQuery(recording.Edits)})
+	if err != nil {
+		t.Fatal(err)
+	}
+	job.ID = 12
+
+	t.Run("outdated before the cut", func(t *testing.T) {
+		streams := mock_dao.NewMockStreamsDao(gomock.NewController(t))
+		streams.EXPECT().GetStreamByID(gomock.Any(), gomock.Any()).Return(changed, nil)
+		worker := cutWorker{cut: func() { t.Error("outdated edits must not be rendered") }}
+		if err := sendRenderEditJob(dao.DaoWrapper{StreamsDao: streams}, &job, model.Worker{}, worker); err != nil {
+			t.Error(err)
+		}
+	})
+
+	t.Run("outdated during the cut", func(t *testing.T) {
+		streams := mock_dao.NewMockStreamsDao(gomock.NewController(t))
+		current := recording
+		streams.EXPECT().GetStreamByID(gomock.Any(), gomock.Any()).DoAndReturn(func(any, string) (model.Stream, error) {
+			return current, nil
+		}).Times(2)
+		// the render is removed again, neither registered nor replacing the current one
+		jobs := mock_dao.NewMockJobDao(gomock.NewController(t))
+		jobs.EXPECT().Create(gomock.Any(), gomock.Any()).DoAndReturn(func(_ any, job *model.Job) error {
+			var payload deleteSectionImageJobPayload
+			if err := job.DecodePayload(&payload); err != nil || payload.Path != "/vod/fpv-edited-12.mp4" {
+				t.Errorf("expected the render to be removed, got %+v (%v)", payload, err)
+			}
+			return nil
+		})
+		worker := cutWorker{cut: func() { current = changed }}
+		if err := sendRenderEditJob(dao.DaoWrapper{StreamsDao: streams, JobDao: jobs}, &job, model.Worker{}, worker); err != nil {
+			t.Error(err)
+		}
+	})
+
+	t.Run("current", func(t *testing.T) {
+		streams := mock_dao.NewMockStreamsDao(gomock.NewController(t))
+		streams.EXPECT().GetStreamByID(gomock.Any(), gomock.Any()).Return(recording, nil).Times(2)
+		files := mock_dao.NewMockFileDao(gomock.NewController(t))
+		files.EXPECT().DeleteFile(uint(4)).Return(nil)
+		files.EXPECT().NewFile(gomock.Any()).DoAndReturn(func(file *model.File) error {
+			if file.Path != "/vod/fpv-edited-12.mp4" || file.Type != model.FILETYPE_VOD_EDITED {
+				t.Errorf("unexpected render %+v", file)
+			}
+			return nil
+		})
+		jobs := mock_dao.NewMockJobDao(gomock.NewController(t))
+		jobs.EXPECT().Create(gomock.Any(), gomock.Any()).Return(nil) // removal of the previous render
+		worker := cutWorker{cut: func() {}}
+		if err := sendRenderEditJob(dao.DaoWrapper{StreamsDao: streams, FileDao: files, JobDao: jobs}, &job, model.Worker{}, worker); err != nil {
+			t.Error(err)
+		}
+	})
+}
+
+func TestKeptWithin(t *testing.T) {
+	if keep := model.KeptWithin(nil, 60000, 120000); len(keep) != 1 || keep[0] != (model.EditRange{Start: 60000, End: 120000}) {
+		t.Errorf("expected the whole range without edits, got %v", keep)
+	}
+	// a clip across a removed break keeps the parts around it
+	edits := []model.StreamEdit{{Start: 2700000, End: 3300000, Discard: true}}
+	keep := model.KeptWithin(edits, 2400000, 3600000)
+	want := []model.EditRange{{Start: 2400000, End: 2700000}, {Start: 3300000, End: 3600000}}
+	if len(keep) != len(want) || keep[0] != want[0] || keep[1] != want[1] {
+		t.Errorf("expected %v, got %v", want, keep)
+	}
+	if keep = model.KeptWithin(edits, 2800000, 3000000); len(keep) != 0 {
+		t.Errorf("expected nothing of a removed range, got %v", keep)
+	}
+}
+
+func TestKeepQuery(t *testing.T) {
+	if q := model.KeepQuery(nil); q != "" {
+		t.Errorf("expected no query without edits, got %s", q)
+	}
+	// removing a break keeps everything around it
+	edits := []model.StreamEdit{{Start: 2700000, End: 3300000, Discard: true}}
+	if q := model.KeepQuery(edits); q != "0-2700000,3300000-" {
+		t.Errorf("unexpected query %s", q)
+	}
+	// if there are parts to keep, everything else is removed
+	edits = append(edits, model.StreamEdit{Start: 3600000, End: 4000000}, model.StreamEdit{Start: 60000, End: 600000})
+	if q := model.KeepQuery(edits); q != "60000-600000,3600000-4000000" {
+		t.Errorf("unexpected query %s", q)
+	}
+}
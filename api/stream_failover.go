@@ -0,0 +1,222 @@
+package api
+
+// stream_failover.go moves live streams of workers that stopped sending heartbeats to other workers
+// and stitches the recording parts of such streams into one VoD.
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/TUM-Dev/gocast/dao"
+	"github.com/TUM-Dev/gocast/model"
+)
+
+// failoverStreams requeues the stream jobs of workers that missed their heartbeats. The jobs keep their
+// stream slot, so viewers keep watching the same playlist, and record the next part of the VoD.
+func failoverStreams(daoWrapper dao.DaoWrapper, now time.Time) {
+	ctx := context.Background()
+	active, err := daoWrapper.JobDao.GetActive(ctx)
+	if err != nil {
+		logger.Error("Can't get active jobs", "err", err)
+		return
+	}
+	for i := range active {
+		job := &active[i]
+		if job.Type != model.JobTypeStream {
+			continue
+		}
+		worker, err := daoWrapper.WorkerDao.GetWorkerByID(ctx, job.WorkerID)
+		if err == nil && !worker.HeartbeatMissing(now) {
+			continue
+		}
+		var payload streamJobPayload
+		if err = job.DecodePayload(&payload); err != nil {
+			logger.Error("Can't decode stream job", "err", err, "job", job.ID)
+			continue
+		}
+		lostWorker := job.WorkerID
+		logger.Warn("Worker stopped sending heartbeats, failing over stream", "stream", job.StreamID, "version", job.Version, "worker", lostWorker, "part", payload.Part)
+		err = saveRecordingPart(daoWrapper, model.RecordingPart{StreamID: job.StreamID, Version: job.Version, Part: payload.Part, WorkerID: lostWorker})
+		if err != nil {
+			logger.Error("Can't save recording part", "err", err, "stream", job.StreamID)
+		}
+		payload.Part++
+		if err = job.SetPayload(payload); err != nil {
+			logger.Error("Can't encode stream job", "err", err, "job", job.ID)
+			continue
+		}
+		job.Fail(fmt.Errorf("worker %s stopped sending heartbeats", lostWorker), now)
+		if job.State == model.JobQueued {
+			job.RunAfter = now // the lecture is running, don't back off
+		}
+		if err = daoWrapper.JobDao.Save(ctx, job); err != nil {
+			logger.Error("Can't save job", "err", err, "job", job.ID)
+			continue
+		}
+		stopLostWorker(daoWrapper, job.StreamID, lostWorker)
+	}
+}
+
+// stopLostWorker asks a worker that lost its stream to stop pushing to the slot and to keep its recording
+// as a part of the VoD, in case it comes back.
+func stopLostWorker(daoWrapper dao.DaoWrapper, streamID uint, workerID string) {
+	ctx := context.Background()
+	unfinished, err := daoWrapper.JobDao.GetUnfinished(ctx, model.JobTypeStreamEnd, streamID)
+	if err != nil {
+		logger.Error("Can't get stream end jobs", "err", err)
+		return
+	}
+	for _, job := range unfinished {
+		if job.TargetWorkerID == workerID {
+			return // already requested, e.g. for another version of the stream
+		}
+	}
+	stream, err := daoWrapper.StreamsDao.GetStreamByID(ctx, fmt.Sprintf("%d", streamID))
+	if err != nil {
+		logger.Error("Can't get stream", "err", err, "stream", streamID)
+		return
+	}
+	job, err := model.NewJob(model.JobTypeStreamEnd, streamID, streamEndJobPayload{FailedOver: true})
+	if err != nil {
+		logger.Error("Can't create stream end job", "err", err)
+		return
+	}
+	// the worker stops by itself 10 minutes after the scheduled end of the stream
+	expiry := stream.End.Add(time.Minute * 10)
+	job.TargetWorkerID = workerID
+	job.ExpiresAt = &expiry
+	if err = daoWrapper.JobDao.Create(ctx, &job); err != nil {
+		logger.Error("Can't create stream end job", "err", err)
+	}
+}
+
+// saveRecordingPart stores the worker that records a part of a stream version.
+func saveRecordingPart(daoWrapper dao.DaoWrapper, part model.RecordingPart) error {
+	ctx := context.Background()
+	parts, err := daoWrapper.RecordingPartDao.GetForStream(ctx, part.StreamID, part.Version)
+	if err != nil {
+		return err
+	}
+	for i := range parts {
+		if parts[i].Part == part.Part {
+			// an earlier attempt for this part failed before recording anything
+			parts[i].WorkerID = part.WorkerID
+			return daoWrapper.RecordingPartDao.Save(ctx, &parts[i])
+		}
+	}
+	return daoWrapper.RecordingPartDao.Create(ctx, &part)
+}
+
+// streamFailedOver returns whether the stream version of a worker continues on another worker.
+func streamFailedOver(daoWrapper dao.DaoWrapper, streamID uint, version string, workerID string) bool {
+	unfinished, err := daoWrapper.JobDao.GetUnfinished(context.Background(), model.JobTypeStream, streamID)
+	if err != nil {
+		logger.Error("Can't get stream jobs", "err", err, "stream", streamID)
+		return false
+	}
+	for _, job := range unfinished {
+		if job.Version == version && (job.WorkerID != workerID || job.State == model.JobQueued) {
+			return true
+		}
+	}
+	return false
+}
+
+// getRecordingPart returns the recording part a worker transcoded if the stream version failed over.
+// ok is false if the recording isn't a part.
+func getRecordingPart(daoWrapper dao.DaoWrapper, streamID uint, version string, workerID string) (part model.RecordingPart, ok bool, err error) {
+	parts, err := daoWrapper.RecordingPartDao.GetForStream(context.Background(), streamID, version)
+	if err != nil {
+		return part, false, err
+	}
+	for _, p := range parts {
+		if p.WorkerID == workerID && p.FilePath == "" {
+			part, ok = p, true // workers that failed over several times report their parts in order
+			break
+		}
+	}
+	return part, ok, nil
+}
+
+// hasUnstitchedParts returns whether the VoD of a stream version waits for its parts to be stitched.
+func hasUnstitchedParts(daoWrapper dao.DaoWrapper, streamID uint, version string) bool {
+	parts, err := daoWrapper.RecordingPartDao.GetForStream(context.Background(), streamID, version)
+	if err != nil {
+		logger.Error("Can't get recording parts", "err", err, "stream", streamID)
+		return false
+	}
+	for _, p := range parts {
+		if !p.Stitched {
+			return true
+		}
+	}
+	return false
+}
+
+// StitchRecordings stitches the recordings of streams that failed over once all their parts are available.
+func StitchRecordings(daoWrapper dao.DaoWrapper) func() {
+	return func() {
+		parts, err := daoWrapper.RecordingPartDao.GetUnstitched(context.Background())
+		if err != nil {
+			logger.Error("Can't get unstitched recording parts", "err", err)
+			return
+		}
+		type streamVersion struct {
+			streamID uint
+			version  string
+		}
+		done := map[streamVersion]bool{}
+		for _, p := range parts {
+			sv := streamVersion{streamID: p.StreamID, version: p.Version}
+			if !done[sv] {
+				done[sv] = true
+				stitchRecording(daoWrapper, p.StreamID, p.Version, time.Now())
+			}
+		}
+	}
+}
+
+// stitchRecording enqueues a job that stitches the transcoded parts of a stream version into one VoD.
+// It waits for parts that are not transcoded yet unless their worker is gone.
+func stitchRecording(daoWrapper dao.DaoWrapper, streamID uint, version string, now time.Time) {
+	ctx := context.Background()
+	parts, err := daoWrapper.RecordingPartDao.GetForStream(ctx, streamID, version)
+	if err != nil {
+		logger.Error("Can't get recording parts", "err", err, "stream", streamID)
+		return
+	}
+	var files []string
+	for _, p := range parts {
+		if p.Stitched {
+			return
+		}
+		if p.FilePath != "" {
+			files = append(files, p.FilePath)
+			continue
+		}
+		worker, err := daoWrapper.WorkerDao.GetWorkerByID(ctx, p.WorkerID)
+		if err == nil && !worker.HeartbeatMissing(now) {
+			return // the worker is still recording or transcoding
+		}
+	}
+	if len(files) != 0 {
+		job, err := model.NewJob(model.JobTypeStitchRecording, streamID, stitchRecordingJobPayload{Parts: files})
+		if err != nil {
+			logger.Error("Can't create stitch job", "err", err)
+			return
+		}
+		job.Version = version
+		// dispatched with the next run of the dispatcher, the recording isn't urgent
+		if err = daoWrapper.JobDao.Create(ctx, &job); err != nil {
+			logger.Error("Can't create stitch job", "err", err)
+			return
+		}
+	}
+	logger.Info("Stitching recording", "stream", streamID, "version", version, "parts", len(files), "lost", len(parts)-len(files))
+	for i := range parts {
+		parts[i].Stitched = true
+		if err := daoWrapper.RecordingPartDao.Save(ctx, &parts[i]); err != nil {
+			logger.Error("Can't save recording part", "err", err, "part", parts[i].ID)
+		}
+	}
+}
@@ -0,0 +1,118 @@
+package api
+
+import (
+	"testing"
+	"time"
+
+	"github.com/TUM-Dev/gocast/dao"
+	"github.com/TUM-Dev/gocast/mock_dao"
+	"github.com/TUM-Dev/gocast/model"
+	"github.com/golang/mock/gomock"
+	"gorm.io/gorm"
+)
+
+func TestFailoverStreams(t *testing.T) {
+	now := time.Now()
+	job, err := model.NewJob(model.JobTypeStream, 1, streamJobPayload{SourceType: "COMB", Source: "10.0.0.1", SlotID: 7})
+	if err != nil {
+		t.Fatal(err)
+	}
+	job.ID = 1
+	job.Version = "COMB"
+	job.Assign("lost", now.Add(-time.Hour))
+	job.Acknowledge()
+	running, _ := model.NewJob(model.JobTypeStream, 2, streamJobPayload{SourceType: "CAM"})
+	running.Assign("alive", now)
+	running.Acknowledge()
+
+	ctrl := gomock.NewController(t)
+	jobDao := mock_dao.NewMockJobDao(ctrl)
+	workerDao := mock_dao.NewMockWorkerDao(ctrl)
+	partDao := mock_dao.NewMockRecordingPartDao(ctrl)
+	streamsDao := mock_dao.NewMockStreamsDao(ctrl)
+
+	jobDao.EXPECT().GetActive(gomock.Any()).Return([]model.Job{job, running}, nil)
+	workerDao.EXPECT().GetWorkerByID(gomock.Any(), "lost").Return(model.Worker{WorkerID: "lost", LastSeen: now.Add(-time.Minute * 3)}, nil)
+	workerDao.EXPECT().GetWorkerByID(gomock.Any(), "alive").Return(model.Worker{WorkerID: "alive", LastSeen: now}, nil)
+	partDao.EXPECT().GetForStream(gomock.Any(), uint(1), "COMB").Return(nil, nil)
+	partDao.EXPECT().Create(gomock.Any(), &model.RecordingPart{StreamID: 1, Version: "COMB", Part: 0, WorkerID: "lost"}).Return(nil)
+	jobDao.EXPECT().Save(gomock.Any(), gomock.Any()).DoAndReturn(func(_ interface{}, j *model.Job) error {
+		var payload streamJobPayload
+		if err := j.DecodePayload(&payload); err != nil {
+			t.Fatal(err)
+		}
+		if j.State != model.JobQueued || j.RunAfter.After(now) {
+			t.Errorf("failed over job should be queued right away, got %s at %s", j.State, j.RunAfter)
+		}
+		if payload.Part != 1 || payload.SlotID != 7 {
+			t.Errorf("failed over job should keep its slot and record the next part, got %+v", payload)
+		}
+		return nil
+	})
+	jobDao.EXPECT().GetUnfinished(gomock.Any(), model.JobTypeStreamEnd, uint(1)).Return(nil, nil)
+	streamsDao.EXPECT().GetStreamByID(gomock.Any(), "1").Return(model.Stream{Model: gorm.Model{ID: 1}, End: now.Add(time.Hour)}, nil)
+	jobDao.EXPECT().Create(gomock.Any(), gomock.Any()).DoAndReturn(func(_ interface{}, j *model.Job) error {
+		var payload streamEndJobPayload
+		if err := j.DecodePayload(&payload); err != nil {
+			t.Fatal(err)
+		}
+		if j.Type != model.JobTypeStreamEnd || j.TargetWorkerID != "lost" || !payload.FailedOver {
+			t.Errorf("lost worker should be asked to stop the failed over stream, got %+v", j)
+		}
+		return nil
+	})
+
+	failoverStreams(dao.DaoWrapper{JobDao: jobDao, WorkerDao: workerDao, RecordingPartDao: partDao, StreamsDao: streamsDao}, now)
+}
+
+func TestStitchRecording(t *testing.T) {
+	now := time.Now()
+	parts := func() []model.RecordingPart {
+		return []model.RecordingPart{
+			{StreamID: 1, Version: "COMB", Part: 0, WorkerID: "lost"},
+			{StreamID: 1, Version: "COMB", Part: 1, WorkerID: "a", FilePath: "/mass/part1.mp4"},
+			{StreamID: 1, Version: "COMB", Part: 2, WorkerID: "b"},
+		}
+	}
+
+	t.Run("waits for alive workers", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		partDao := mock_dao.NewMockRecordingPartDao(ctrl)
+		workerDao := mock_dao.NewMockWorkerDao(ctrl)
+		partDao.EXPECT().GetForStream(gomock.Any(), uint(1), "COMB").Return(parts(), nil)
+		workerDao.EXPECT().GetWorkerByID(gomock.Any(), "lost").Return(model.Worker{}, gorm.ErrRecordNotFound)
+		workerDao.EXPECT().GetWorkerByID(gomock.Any(), "b").Return(model.Worker{WorkerID: "b", LastSeen: now}, nil)
+
+		stitchRecording(dao.DaoWrapper{RecordingPartDao: partDao, WorkerDao: workerDao}, 1, "COMB", now)
+	})
+
+	t.Run("skips lost parts", func(t *testing.T) {
+		p := parts()
+		p[2].FilePath = "/mass/part2.mp4"
+		ctrl := gomock.NewController(t)
+		partDao := mock_dao.NewMockRecordingPartDao(ctrl)
+		workerDao := mock_dao.NewMockWorkerDao(ctrl)
+		jobDao := mock_dao.NewMockJobDao(ctrl)
+		partDao.EXPECT().GetForStream(gomock.Any(), uint(1), "COMB").Return(p, nil)
+		workerDao.EXPECT().GetWorkerByID(gomock.Any(), "lost").Return(model.Worker{WorkerID: "lost", LastSeen: now.Add(-time.Hour)}, nil)
+		jobDao.EXPECT().Create(gomock.Any(), gomock.Any()).DoAndReturn(func(_ interface{}, j *model.Job) error {
+			var payload stitchRecordingJobPayload
+			if err := j.DecodePayload(&payload); err != nil {
+				t.Fatal(err)
+			}
+			if j.Type != model.JobTypeStitchRecording || j.Version != "COMB" || len(payload.Parts) != 2 ||
+				payload.Parts[0] != "/mass/part1.mp4" || payload.Parts[1] != "/mass/part2.mp4" {
+				t.Errorf("expected stitch job for the transcoded parts in order, got %+v", j)
+			}
+			return nil
+		})
+		partDao.EXPECT().Save(gomock.Any(), gomock.Any()).DoAndReturn(func(_ interface{}, part *model.RecordingPart) error {
+			if !part.Stitched {
+				t.Errorf("part %d should be marked as stitched", part.Part)
+			}
+			return nil
+		}).Times(3)
+
+		stitchRecording(dao.DaoWrapper{RecordingPartDao: partDao, WorkerDao: workerDao, JobDao: jobDao}, 1, "COMB", now)
+	})
+}
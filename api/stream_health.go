@@ -0,0 +1,148 @@
+package api
+
+// stream_health.go stores the ffmpeg progress workers report for running streams and charts it for course admins.
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"time"
+
+	"github.com/TUM-Dev/gocast/dao"
+	"github.com/TUM-Dev/gocast/model"
+	"github.com/TUM-Dev/gocast/tools"
+	"github.com/TUM-Dev/gocast/worker/pb"
+	"github.com/gin-gonic/gin"
+)
+
+// streamHealthRetention is how long samples are kept after they were taken.
+const streamHealthRetention = time.Hour * 24 * 30
+
+// NotifyStreamHealth receives the samples of a running stream from a worker until the worker closes the stream.
+func (s server) NotifyStreamHealth(srv pb.FromWorker_NotifyStreamHealthServer) error {
+	workerID := ""
+	for {
+		req, err := srv.Recv()
+		if err == io.EOF || errors.Is(err, context.Canceled) {
+			return srv.SendAndClose(&pb.Status{Ok: true})
+		}
+		if err != nil {
+			logger.Warn("cannot receive stream health", "err", err)
+			return nil
+		}
+		if req.GetWorkerID() != workerID {
+			// only check the worker once per stream, samples arrive every few seconds
+			if _, err = s.WorkerDao.GetWorkerByID(srv.Context(), req.GetWorkerID()); err != nil {
+				return err
+			}
+			workerID = req.GetWorkerID()
+		}
+		err = s.StreamHealthDao.Create(srv.Context(), &model.StreamHealth{
+			StreamID:         uint(req.GetStreamID()),
+			Version:          model.StreamVersion(req.GetVersion()),
+			WorkerID:         req.GetWorkerID(),
+			Time:             req.GetTime().AsTime(),
+			Bitrate:          float64(req.GetBitrate()),
+			Fps:              float64(req.GetFps()),
+			DroppedFrames:    req.GetDroppedFrames(),
+			DuplicatedFrames: req.GetDuplicatedFrames(),
+			Speed:            float64(req.GetSpeed()),
+			Stalled:          req.GetStalled(),
+		})
+		if err != nil {
+			logger.Error("can't save stream health", "err", err, "stream", req.GetStreamID())
+			return err
+		}
+	}
+}
+
+// DeleteStreamHealth deletes samples older than streamHealthRetention.
+func DeleteStreamHealth(daoWrapper dao.DaoWrapper) func() {
+	return func() {
+		err := daoWrapper.StreamHealthDao.DeleteOlderThan(context.Background(), time.Now().Add(-streamHealthRetention))
+		if err != nil {
+			logger.Error("can't delete old stream health", "err", err)
+		}
+	}
+}
+
+// streamHealthMetrics are the metrics course admins can chart, by the query parameter that selects them.
+var streamHealthMetrics = map[string]struct {
+	label string
+	value func(model.StreamHealth) float64
+}{
+	"bitrate": {"Bitrate (kbit/s)", func(h model.StreamHealth) float64 { return h.Bitrate }},
+	"fps":     {"FPS", func(h model.StreamHealth) float64 { return h.Fps }},
+	"speed":   {"Speed", func(h model.StreamHealth) float64 { return h.Speed }},
+	"dropped": {"Dropped frames", func(h model.StreamHealth) float64 { return float64(h.DroppedFrames) }},
+	"dup":     {"Duplicated frames", func(h model.StreamHealth) float64 { return float64(h.DuplicatedFrames) }},
+}
+
+type chartJsPoint struct {
+	X float64 `json:"x"`
+	Y float64 `json:"y"`
+}
+
+// getStreamHealth returns a Chart.js line chart of a metric of the samples of a stream, one line per stream version.
+func (r streamRoutes) getStreamHealth(c *gin.Context) {
+	tumLiveContext := c.MustGet("TUMLiveContext").(tools.TUMLiveContext)
+
+	metric, ok := streamHealthMetrics[c.DefaultQuery("metric", "bitrate")]
+	if !ok {
+		_ = c.Error(tools.RequestError{
+			Status:        http.StatusBadRequest,
+			CustomMessage: "unknown metric",
+		})
+		return
+	}
+	samples, err := r.StreamHealthDao.GetForStream(c, tumLiveContext.Stream.ID)
+	if err != nil {
+		_ = c.Error(tools.RequestError{
+			Status:        http.StatusInternalServerError,
+			CustomMessage: "can not get stream health",
+			Err:           err,
+		})
+		return
+	}
+	c.JSON(http.StatusOK, newStreamHealthChart(samples, metric.label, metric.value))
+}
+
+var streamHealthColors = map[model.StreamVersion]string{model.COMB: "#427dbd", model.PRES: "#e4a11b", model.CAM: "#3cb371"}
+
+// newStreamHealthChart charts value of the samples over the minutes since the first sample.
+// Stalled samples are drawn as gaps.
+func newStreamHealthChart(samples []model.StreamHealth, label string, value func(model.StreamHealth) float64) chartJs {
+	resp := chartJs{
+		ChartType: "line",
+		Data:      chartJsData{Datasets: []chartJsDataset{}},
+		Options:   newChartJsOptions(),
+	}
+	resp.Options.Scales.X = newChartJsLinearAxis("Minutes")
+	if len(samples) == 0 {
+		return resp
+	}
+	start := samples[0].Time
+	points := map[model.StreamVersion][]*chartJsPoint{}
+	var versions []model.StreamVersion
+	for _, s := range samples {
+		if _, ok := points[s.Version]; !ok {
+			versions = append(versions, s.Version)
+		}
+		var p *chartJsPoint // null in the dataset
+		if !s.Stalled {
+			p = &chartJsPoint{X: s.Time.Sub(start).Minutes(), Y: value(s)}
+		}
+		points[s.Version] = append(points[s.Version], p)
+	}
+	for _, v := range versions {
+		dataset := newChartJsDataset()
+		dataset.Label = fmt.Sprintf("%s %s", label, v)
+		if color, ok := streamHealthColors[v]; ok {
+			dataset.BorderColor, dataset.BackgroundColor = color, color
+		}
+		dataset.Data = points[v]
+		resp.Data.Datasets = append(resp.Data.Datasets, dataset)
+	}
+	return resp
+}
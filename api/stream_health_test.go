@@ -0,0 +1,132 @@
+package api
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/TUM-Dev/gocast/dao"
+	"github.com/TUM-Dev/gocast/mock_dao"
+	"github.com/TUM-Dev/gocast/model"
+	"github.com/TUM-Dev/gocast/tools"
+	"github.com/TUM-Dev/gocast/tools/testutils"
+	"github.com/TUM-Dev/gocast/worker/pb"
+	"github.com/gin-gonic/gin"
+	"github.com/golang/mock/gomock"
+	"github.com/matthiasreumann/gomino"
+	"google.golang.org/grpc"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func TestStreamHealth(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	start := time.Date(2023, 4, 1, 10, 0, 0, 0, time.UTC)
+	samples := []model.StreamHealth{
+		{StreamID: testutils.StreamFPVLive.ID, Version: model.COMB, Time: start, Bitrate: 2400},
+		{StreamID: testutils.StreamFPVLive.ID, Version: model.PRES, Time: start, Bitrate: 1800},
+		{StreamID: testutils.StreamFPVLive.ID, Version: model.COMB, Time: start.Add(time.Minute), Stalled: true},
+		{StreamID: testutils.StreamFPVLive.ID, Version: model.COMB, Time: start.Add(2 * time.Minute), Bitrate: 2500},
+	}
+
+	url := fmt.Sprintf("/api/stream/%d/health", testutils.StreamFPVLive.ID)
+	gomino.TestCases{
+		"not admin of course": {
+			Router: func(r *gin.Engine) {
+				configGinStreamRestRouter(r, dao.DaoWrapper{StreamsDao: testutils.GetStreamMock(t), CoursesDao: testutils.GetCoursesMock(t)})
+			},
+			Middlewares:  testutils.GetMiddlewares(tools.ErrorHandler, testutils.TUMLiveContext(testutils.TUMLiveContextStudent)),
+			ExpectedCode: http.StatusForbidden,
+		},
+		"unknown metric": {
+			Router: func(r *gin.Engine) {
+				configGinStreamRestRouter(r, dao.DaoWrapper{StreamsDao: testutils.GetStreamMock(t), CoursesDao: testutils.GetCoursesMock(t)})
+			},
+			Url:          url + "?metric=temperature",
+			Middlewares:  testutils.GetMiddlewares(tools.ErrorHandler, testutils.TUMLiveContext(testutils.TUMLiveContextAdmin)),
+			ExpectedCode: http.StatusBadRequest,
+		},
+		"database error": {
+			Router: func(r *gin.Engine) {
+				healthMock := mock_dao.NewMockStreamHealthDao(gomock.NewController(t))
+				healthMock.EXPECT().GetForStream(gomock.Any(), testutils.StreamFPVLive.ID).Return(nil, errors.New(""))
+				configGinStreamRestRouter(r, dao.DaoWrapper{StreamsDao: testutils.GetStreamMock(t), CoursesDao: testutils.GetCoursesMock(t), StreamHealthDao: healthMock})
+			},
+			Middlewares:  testutils.GetMiddlewares(tools.ErrorHandler, testutils.TUMLiveContext(testutils.TUMLiveContextAdmin)),
+			ExpectedCode: http.StatusInternalServerError,
+		},
+		"success": {
+			Router: func(r *gin.Engine) {
+				healthMock := mock_dao.NewMockStreamHealthDao(gomock.NewController(t))
+				healthMock.EXPECT().GetForStream(gomock.Any(), testutils.StreamFPVLive.ID).Return(samples, nil)
+				configGinStreamRestRouter(r, dao.DaoWrapper{StreamsDao: testutils.GetStreamMock(t), CoursesDao: testutils.GetCoursesMock(t), StreamHealthDao: healthMock})
+			},
+			Middlewares:  testutils.GetMiddlewares(tools.ErrorHandler, testutils.TUMLiveContext(testutils.TUMLiveContextAdmin)),
+			ExpectedCode: http.StatusOK,
+			ExpectedResponse: func() chartJs {
+				comb, pres := newChartJsDataset(), newChartJsDataset()
+				comb.Label, comb.Data = "Bitrate (kbit/s) COMB", []*chartJsPoint{{X: 0, Y: 2400}, nil, {X: 2, Y: 2500}}
+				pres.Label, pres.Data = "Bitrate (kbit/s) PRES", []*chartJsPoint{{X: 0, Y: 1800}}
+				pres.BorderColor, pres.BackgroundColor = "#e4a11b", "#e4a11b"
+				resp := chartJs{ChartType: "line", Data: chartJsData{Datasets: []chartJsDataset{comb, pres}}, Options: newChartJsOptions()}
+				resp.Options.Scales.X = newChartJsLinearAxis("Minutes")
+				return resp
+			}(),
+		},
+	}.Method(http.MethodGet).Url(url).Run(t, testutils.Equal)
+}
+
+// healthStream is a FromWorker_NotifyStreamHealthServer that receives samples from a slice.
+type healthStream struct {
+	grpc.ServerStream
+	samples []*pb.StreamHealth
+	closed  *pb.Status
+}
+
+func (h *healthStream) Recv() (*pb.StreamHealth, error) {
+	if len(h.samples) == 0 {
+		return nil, io.EOF
+	}
+	s := h.samples[0]
+	h.samples = h.samples[1:]
+	return s, nil
+}
+
+func (h *healthStream) SendAndClose(s *pb.Status) error {
+	h.closed = s
+	return nil
+}
+
+func (h *healthStream) Context() context.Context {
+	return context.Background()
+}
+
+func TestNotifyStreamHealth(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	workerMock := mock_dao.NewMockWorkerDao(ctrl)
+	workerMock.EXPECT().GetWorkerByID(gomock.Any(), "w1").Return(model.Worker{WorkerID: "w1"}, nil).Times(1)
+	healthMock := mock_dao.NewMockStreamHealthDao(ctrl)
+	healthMock.EXPECT().Create(gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, h *model.StreamHealth) error {
+		if h.StreamID != 1 || h.Version != model.COMB || h.Bitrate != 2400 {
+			t.Errorf("unexpected sample %+v", h)
+		}
+		return nil
+	}).Times(2)
+
+	now := timestamppb.Now()
+	srv := &healthStream{samples: []*pb.StreamHealth{
+		{WorkerID: "w1", StreamID: 1, Version: "COMB", Time: now, Bitrate: 2400},
+		{WorkerID: "w1", StreamID: 1, Version: "COMB", Time: now, Bitrate: 2400},
+	}}
+	s := server{DaoWrapper: dao.DaoWrapper{WorkerDao: workerMock, StreamHealthDao: healthMock}}
+	if err := s.NotifyStreamHealth(srv); err != nil {
+		t.Fatal(err)
+	}
+	if srv.closed == nil || !srv.closed.Ok {
+		t.Error("expected stream to be closed with ok status")
+	}
+}
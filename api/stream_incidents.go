@@ -0,0 +1,226 @@
+package api
+
+// stream_incidents.go handles the problems the live analysis of workers detects, e.g. black screens or muted microphones.
+// Operators are alerted through the bot, course admins are notified on the watch page.
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/TUM-Dev/gocast/dao"
+	"github.com/TUM-Dev/gocast/model"
+	"github.com/TUM-Dev/gocast/tools"
+	"github.com/TUM-Dev/gocast/tools/bot"
+	"github.com/TUM-Dev/gocast/tools/realtime"
+	"github.com/TUM-Dev/gocast/worker/pb"
+	"github.com/getsentry/sentry-go"
+	"github.com/gin-gonic/gin"
+	"gorm.io/gorm"
+)
+
+const (
+	StreamIncidentsRoomName     = "stream-incidents/:streamID"
+	UpdateTypeStreamIncidents   = "stream_incidents" // all incidents of the stream, sent on subscription
+	UpdateTypeStreamIncidentSet = "stream_incident"  // a created or resolved incident
+)
+
+var (
+	streamIncidentListenerMutex sync.RWMutex
+	streamIncidentListener      = map[uint][]*realtime.Context{}
+)
+
+// alertBot sends the alerts of incidents, replaced in tests.
+var alertBot = func() *bot.Bot {
+	var b bot.Bot
+	b.SetMessagingMethod(&bot.Matrix{})
+	return &b
+}
+
+type streamIncidentDto struct {
+	ID          uint       `json:"id"`
+	Version     string     `json:"version"`
+	Type        string     `json:"type"`
+	Description string     `json:"description"`
+	StartedAt   time.Time  `json:"startedAt"`
+	EndedAt     *time.Time `json:"endedAt,omitempty"`
+}
+
+func newStreamIncidentDto(i model.StreamIncident) streamIncidentDto {
+	return streamIncidentDto{
+		ID:          i.ID,
+		Version:     string(i.Version),
+		Type:        i.Type,
+		Description: i.Description(),
+		StartedAt:   i.StartedAt,
+		EndedAt:     i.EndedAt,
+	}
+}
+
+// NotifyStreamIncident opens an incident and alerts operators and course admins, or resolves an open incident.
+func (s server) NotifyStreamIncident(ctx context.Context, req *pb.StreamIncident) (*pb.Status, error) {
+	if _, err := s.WorkerDao.GetWorkerByID(ctx, req.GetWorkerID()); err != nil {
+		return nil, err
+	}
+	switch req.GetType() {
+	case model.StreamIncidentBlack, model.StreamIncidentFreeze, model.StreamIncidentSilence:
+	default:
+		return nil, fmt.Errorf("unknown incident type %q", req.GetType())
+	}
+	version := model.StreamVersion(req.GetVersion())
+
+	open, err := s.StreamIncidentDao.GetOpen(ctx, uint(req.GetStreamID()), version, req.GetType())
+	if err != nil && !errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, err
+	}
+	if req.GetEnd() != nil {
+		if err != nil {
+			return &pb.Status{Ok: true}, nil // nothing to resolve, e.g. the server restarted
+		}
+		end := req.GetEnd().AsTime()
+		open.EndedAt = &end
+		if err = s.StreamIncidentDao.Save(ctx, &open); err != nil {
+			return nil, err
+		}
+		notifyStreamIncident(open)
+		return &pb.Status{Ok: true}, nil
+	}
+	if err == nil {
+		return &pb.Status{Ok: true}, nil // already open
+	}
+
+	incident := model.StreamIncident{
+		StreamID:  uint(req.GetStreamID()),
+		Version:   version,
+		Type:      req.GetType(),
+		StartedAt: req.GetStart().AsTime(),
+	}
+	if err = s.StreamIncidentDao.Create(ctx, &incident); err != nil {
+		return nil, err
+	}
+	notifyStreamIncident(incident)
+	go func() {
+		if err := alertStreamIncident(s.DaoWrapper, incident); err != nil {
+			logger.Error("can't send alert for stream incident", "err", err, "stream", incident.StreamID)
+		}
+	}()
+	return &pb.Status{Ok: true}, nil
+}
+
+// alertStreamIncident sends an alert about a new incident to the operators, if alerts are configured.
+func alertStreamIncident(daoWrapper dao.DaoWrapper, incident model.StreamIncident) error {
+	if tools.Cfg.Alerts == nil {
+		return nil
+	}
+	stream, err := daoWrapper.StreamsDao.GetStreamByID(context.Background(), strconv.Itoa(int(incident.StreamID)))
+	if err != nil {
+		return err
+	}
+	course, err := daoWrapper.CoursesDao.GetCourseById(context.Background(), stream.CourseID)
+	if err != nil {
+		return err
+	}
+	alert := bot.AlertMessage{
+		Categories: "🎬 Video",
+		Comment:    incident.Description(),
+		CourseName: course.Name,
+		StreamUrl:  tools.Cfg.WebUrl + "/w/" + course.Slug + "/" + fmt.Sprintf("%d", stream.ID),
+		Incident:   true,
+		Stream:     stream,
+	}
+	if incident.Type == model.StreamIncidentSilence {
+		alert.Categories = "🎤 Microphone"
+	}
+	if !stream.IsSelfStream() {
+		lectureHall, err := daoWrapper.LectureHallsDao.GetLectureHallByID(stream.LectureHallID)
+		if err != nil {
+			return err
+		}
+		alert.LectureHall, alert.CombIP, alert.CameraIP = lectureHall.Name, lectureHall.CombIP, lectureHall.CameraIP
+	}
+	return alertBot().SendAlert(alert, daoWrapper.StatisticsDao)
+}
+
+// RegisterStreamIncidentsRealtimeChannel lets course admins subscribe to the incidents of a stream.
+func RegisterStreamIncidentsRealtimeChannel() {
+	RealtimeInstance.RegisterChannel(StreamIncidentsRoomName, realtime.ChannelHandlers{
+		SubscriptionMiddlewares: []realtime.SubscriptionMiddleware{
+			tools.InitStreamRealtime(),
+			streamIncidentsAdminMiddleware,
+		},
+		OnSubscribe:   streamIncidentsOnSubscribe,
+		OnUnsubscribe: streamIncidentsOnUnsubscribe,
+	})
+}
+
+func streamIncidentsAdminMiddleware(psc *realtime.Context) *realtime.Error {
+	foundContext, exists := psc.Get("TUMLiveContext")
+	if !exists {
+		return realtime.NewError(http.StatusBadRequest, "context should exist but doesn't")
+	}
+	tumLiveContext := foundContext.(tools.TUMLiveContext)
+	if tumLiveContext.User == nil || !tumLiveContext.User.IsAdminOfCourse(*tumLiveContext.Course) {
+		return realtime.NewError(http.StatusForbidden, "forbidden to see stream incidents")
+	}
+	return nil
+}
+
+func streamIncidentsOnSubscribe(psc *realtime.Context) {
+	foundContext, exists := psc.Get("TUMLiveContext")
+	if !exists {
+		sentry.CaptureException(errors.New("context should exist but doesn't"))
+		return
+	}
+	streamID := foundContext.(tools.TUMLiveContext).Stream.ID
+	daoWrapper, _ := psc.Client.Get("dao")
+
+	streamIncidentListenerMutex.Lock()
+	streamIncidentListener[streamID] = append(streamIncidentListener[streamID], psc)
+	streamIncidentListenerMutex.Unlock()
+
+	incidents, err := daoWrapper.(dao.DaoWrapper).StreamIncidentDao.GetForStream(context.Background(), streamID)
+	if err != nil {
+		logger.Error("could not fetch stream incidents", "err", err, "stream", streamID)
+		return
+	}
+	dtos := make([]streamIncidentDto, len(incidents))
+	for i, incident := range incidents {
+		dtos[i] = newStreamIncidentDto(incident)
+	}
+	msg, _ := json.Marshal(gin.H{"type": UpdateTypeStreamIncidents, "data": dtos})
+	_ = psc.Send(msg)
+}
+
+func streamIncidentsOnUnsubscribe(psc *realtime.Context) {
+	streamID, err := strconv.ParseUint(psc.Param("streamID"), 10, 32)
+	if err != nil {
+		return
+	}
+	streamIncidentListenerMutex.Lock()
+	defer streamIncidentListenerMutex.Unlock()
+	var sessions []*realtime.Context
+	for _, session := range streamIncidentListener[uint(streamID)] {
+		if session != psc {
+			sessions = append(sessions, session)
+		}
+	}
+	if len(sessions) == 0 {
+		delete(streamIncidentListener, uint(streamID))
+	} else {
+		streamIncidentListener[uint(streamID)] = sessions
+	}
+}
+
+// notifyStreamIncident sends an incident to the admins subscribed to its stream.
+func notifyStreamIncident(incident model.StreamIncident) {
+	msg, _ := json.Marshal(gin.H{"type": UpdateTypeStreamIncidentSet, "data": newStreamIncidentDto(incident)})
+	streamIncidentListenerMutex.RLock()
+	defer streamIncidentListenerMutex.RUnlock()
+	for _, session := range streamIncidentListener[incident.StreamID] {
+		_ = session.Send(msg)
+	}
+}
@@ -0,0 +1,86 @@
+package api
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/TUM-Dev/gocast/dao"
+	"github.com/TUM-Dev/gocast/mock_dao"
+	"github.com/TUM-Dev/gocast/model"
+	"github.com/TUM-Dev/gocast/worker/pb"
+	"github.com/golang/mock/gomock"
+	"google.golang.org/protobuf/types/known/timestamppb"
+	"gorm.io/gorm"
+)
+
+func TestNotifyStreamIncident(t *testing.T) {
+	start := time.Date(2023, 4, 1, 10, 0, 0, 0, time.UTC)
+	open := model.StreamIncident{Model: gorm.Model{ID: 5}, StreamID: 1, Version: model.PRES, Type: model.StreamIncidentBlack, StartedAt: start}
+
+	newServer := func(t *testing.T, incidents dao.StreamIncidentDao) server {
+		workerMock := mock_dao.NewMockWorkerDao(gomock.NewController(t))
+		workerMock.EXPECT().GetWorkerByID(gomock.Any(), "w1").Return(model.Worker{WorkerID: "w1"}, nil).AnyTimes()
+		return server{DaoWrapper: dao.DaoWrapper{WorkerDao: workerMock, StreamIncidentDao: incidents}}
+	}
+
+	t.Run("unknown type", func(t *testing.T) {
+		s := newServer(t, mock_dao.NewMockStreamIncidentDao(gomock.NewController(t)))
+		if _, err := s.NotifyStreamIncident(context.Background(), &pb.StreamIncident{WorkerID: "w1", StreamID: 1, Type: "smoke"}); err == nil {
+			t.Error("expected error for unknown incident type")
+		}
+	})
+
+	t.Run("open", func(t *testing.T) {
+		incidents := mock_dao.NewMockStreamIncidentDao(gomock.NewController(t))
+		incidents.EXPECT().GetOpen(gomock.Any(), uint(1), model.PRES, model.StreamIncidentBlack).Return(model.StreamIncident{}, gorm.ErrRecordNotFound)
+		incidents.EXPECT().Create(gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, i *model.StreamIncident) error {
+			if i.StreamID != 1 || i.Version != model.PRES || i.Type != model.StreamIncidentBlack || !i.StartedAt.Equal(start) || i.EndedAt != nil {
+				t.Errorf("unexpected incident %+v", i)
+			}
+			return nil
+		})
+		s := newServer(t, incidents)
+		_, err := s.NotifyStreamIncident(context.Background(), &pb.StreamIncident{WorkerID: "w1", StreamID: 1, Version: "PRES", Type: "black", Start: timestamppb.New(start)})
+		if err != nil {
+			t.Fatal(err)
+		}
+	})
+
+	t.Run("already open", func(t *testing.T) {
+		incidents := mock_dao.NewMockStreamIncidentDao(gomock.NewController(t))
+		incidents.EXPECT().GetOpen(gomock.Any(), uint(1), model.PRES, model.StreamIncidentBlack).Return(open, nil)
+		s := newServer(t, incidents)
+		_, err := s.NotifyStreamIncident(context.Background(), &pb.StreamIncident{WorkerID: "w1", StreamID: 1, Version: "PRES", Type: "black", Start: timestamppb.New(start)})
+		if err != nil {
+			t.Fatal(err)
+		}
+	})
+
+	t.Run("resolve", func(t *testing.T) {
+		end := start.Add(time.Minute)
+		incidents := mock_dao.NewMockStreamIncidentDao(gomock.NewController(t))
+		incidents.EXPECT().GetOpen(gomock.Any(), uint(1), model.PRES, model.StreamIncidentBlack).Return(open, nil)
+		incidents.EXPECT().Save(gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, i *model.StreamIncident) error {
+			if i.ID != open.ID || i.EndedAt == nil || !i.EndedAt.Equal(end) {
+				t.Errorf("expected open incident to be resolved, got %+v", i)
+			}
+			return nil
+		})
+		s := newServer(t, incidents)
+		_, err := s.NotifyStreamIncident(context.Background(), &pb.StreamIncident{WorkerID: "w1", StreamID: 1, Version: "PRES", Type: "black", Start: timestamppb.New(start), End: timestamppb.New(end)})
+		if err != nil {
+			t.Fatal(err)
+		}
+	})
+
+	t.Run("resolve without open incident", func(t *testing.T) {
+		incidents := mock_dao.NewMockStreamIncidentDao(gomock.NewController(t))
+		incidents.EXPECT().GetOpen(gomock.Any(), uint(1), model.COMB, model.StreamIncidentSilence).Return(model.StreamIncident{}, gorm.ErrRecordNotFound)
+		s := newServer(t, incidents)
+		_, err := s.NotifyStreamIncident(context.Background(), &pb.StreamIncident{WorkerID: "w1", StreamID: 1, Version: "COMB", Type: "silence", End: timestamppb.Now()})
+		if err != nil {
+			t.Fatal(err)
+		}
+	})
+}
@@ -146,6 +146,7 @@ func TestStream(t *testing.T) {
 			"start":       stream.Start,
 			"end":         stream.End,
 			"ingest":      fmt.Sprintf("%s%s-%d?secret=%s", tools.Cfg.IngestBase, course.Slug, stream.ID, stream.StreamKey),
+			"srtIngest":   tools.SrtIngestURL(course.Slug, stream.ID, stream.StreamKey),
 			"live":        stream.LiveNow,
 			"vod":         stream.Recording,
 		}
@@ -1048,4 +1049,64 @@ func TestSubtitles(t *testing.T) {
 		Method(http.MethodGet).
 		Url(endpoint).
 		Run(t, testutils.Equal)
+
+	gomino.TestCases{
+		"srt": {
+			Router: func(r *gin.Engine) {
+				wrapper := dao.DaoWrapper{
+					StreamsDao: testutils.GetStreamMock(t),
+					CoursesDao: testutils.GetCoursesMock(t),
+					SubtitlesDao: func() dao.SubtitlesDao {
+						subMock := mock_dao.NewMockSubtitlesDao(gomock.NewController(t))
+						subMock.
+							EXPECT().
+							GetByStreamIDandLang(gomock.Any(), testutils.StreamFPVLive.ID, "en").
+							Return(testutils.SubtitlesFPVLive, nil)
+						return subMock
+					}(),
+				}
+				configGinStreamRestRouter(r, wrapper)
+			},
+			Middlewares:      testutils.GetMiddlewares(tools.ErrorHandler, testutils.TUMLiveContext(testutils.TUMLiveContextEmpty)),
+			ExpectedCode:     http.StatusOK,
+			ExpectedResponse: "1\n00:00:01,000 --> 00:00:02,500\nwonderful\n\n",
+		},
+	}.
+		Router(StreamDefaultRouter(t)).
+		Method(http.MethodGet).
+		Url(endpoint+"?format=srt").
+		Run(t, testutils.Equal)
+}
+
+func TestStreamDvr(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	url := fmt.Sprintf("/api/stream/%d/dvr", testutils.StreamFPVLive.ID)
+	gomino.TestCases{
+		"no dvr playlists": {
+			Router: func(r *gin.Engine) {
+				configGinStreamRestRouter(r, dao.DaoWrapper{
+					StreamsDao: testutils.GetStreamMock(t),
+					CoursesDao: testutils.GetCoursesMock(t),
+				})
+			},
+			Middlewares:  testutils.GetMiddlewares(tools.ErrorHandler, testutils.TUMLiveContext(testutils.TUMLiveContextStudent)),
+			ExpectedCode: http.StatusNotFound,
+		},
+		"stream not live": {
+			Router: func(r *gin.Engine) {
+				stream := testutils.StreamFPVLive
+				stream.LiveNow = false
+				stream.DvrPlaylistUrl = "https://edge/worker/dvr/1969/COMB/playlist.m3u8"
+				streamsMock := mock_dao.NewMockStreamsDao(gomock.NewController(t))
+				streamsMock.EXPECT().GetStreamByID(gomock.Any(), gomock.Any()).Return(stream, nil).AnyTimes()
+				configGinStreamRestRouter(r, dao.DaoWrapper{
+					StreamsDao: streamsMock,
+					CoursesDao: testutils.GetCoursesMock(t),
+				})
+			},
+			Middlewares:  testutils.GetMiddlewares(tools.ErrorHandler, testutils.TUMLiveContext(testutils.TUMLiveContextStudent)),
+			ExpectedCode: http.StatusNotFound,
+		},
+	}.Method(http.MethodGet).Url(url).Run(t, testutils.Equal)
 }
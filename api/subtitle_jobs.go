@@ -0,0 +1,229 @@
+package api
+
+// subtitle_jobs.go tracks the requests to the voice-service and notifies course admins about their progress.
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/TUM-Dev/gocast/dao"
+	"github.com/TUM-Dev/gocast/model"
+	"github.com/TUM-Dev/gocast/tools"
+	"github.com/TUM-Dev/gocast/tools/realtime"
+	"github.com/TUM-Dev/gocast/voice-service/pb"
+	"github.com/getsentry/sentry-go"
+	"github.com/gin-gonic/gin"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/emptypb"
+	"gorm.io/gorm"
+)
+
+const (
+	SubtitleJobsRoomName     = "subtitle-jobs/:streamID"
+	UpdateTypeSubtitleJobs   = "subtitle_jobs" // all jobs of the stream, sent on subscription
+	UpdateTypeSubtitleJobSet = "subtitle_job"  // a created or updated job
+)
+
+var (
+	subtitleJobListenerMutex sync.RWMutex
+	subtitleJobListener      = map[uint][]*realtime.Context{}
+)
+
+type subtitleJobDto struct {
+	ID             uint      `json:"id"`
+	Language       string    `json:"language"`
+	SourceLanguage string    `json:"sourceLanguage,omitempty"`
+	State          string    `json:"state"`
+	Progress       int       `json:"progress"`
+	Error          string    `json:"error,omitempty"`
+	UpdatedAt      time.Time `json:"updatedAt"`
+}
+
+func newSubtitleJobDto(j model.SubtitleJob) subtitleJobDto {
+	return subtitleJobDto{
+		ID:             j.ID,
+		Language:       j.Language,
+		SourceLanguage: j.SourceLanguage,
+		State:          j.State,
+		Progress:       j.Progress,
+		Error:          j.Error,
+		UpdatedAt:      j.UpdatedAt,
+	}
+}
+
+func newSubtitleJobDtos(jobs []model.SubtitleJob) []subtitleJobDto {
+	res := make([]subtitleJobDto, len(jobs))
+	for i, j := range jobs {
+		res[i] = newSubtitleJobDto(j)
+	}
+	return res
+}
+
+// getSubtitleJobs returns the subtitle jobs of a stream, newest first.
+func (r streamRoutes) getSubtitleJobs(c *gin.Context) {
+	tumLiveContext := c.MustGet("TUMLiveContext").(tools.TUMLiveContext)
+
+	jobs, err := r.SubtitleJobDao.GetForStream(context.Background(), tumLiveContext.Stream.ID)
+	if err != nil {
+		_ = c.Error(tools.RequestError{
+			Status:        http.StatusInternalServerError,
+			CustomMessage: "can not get subtitle jobs",
+			Err:           err,
+		})
+		return
+	}
+	c.JSON(http.StatusOK, newSubtitleJobDtos(jobs))
+}
+
+// RegisterSubtitleJobsRealtimeChannel lets course admins subscribe to the subtitle jobs of a stream.
+func RegisterSubtitleJobsRealtimeChannel() {
+	RealtimeInstance.RegisterChannel(SubtitleJobsRoomName, realtime.ChannelHandlers{
+		SubscriptionMiddlewares: []realtime.SubscriptionMiddleware{
+			tools.InitStreamRealtime(),
+			subtitleJobsAdminMiddleware,
+		},
+		OnSubscribe:   subtitleJobsOnSubscribe,
+		OnUnsubscribe: subtitleJobsOnUnsubscribe,
+	})
+}
+
+func subtitleJobsAdminMiddleware(psc *realtime.Context) *realtime.Error {
+	foundContext, exists := psc.Get("TUMLiveContext")
+	if !exists {
+		return realtime.NewError(http.StatusBadRequest, "context should exist but doesn't")
+	}
+	tumLiveContext := foundContext.(tools.TUMLiveContext)
+	if tumLiveContext.User == nil || !tumLiveContext.User.IsAdminOfCourse(*tumLiveContext.Course) {
+		return realtime.NewError(http.StatusForbidden, "forbidden to see subtitle jobs")
+	}
+	return nil
+}
+
+func subtitleJobsOnSubscribe(psc *realtime.Context) {
+	foundContext, exists := psc.Get("TUMLiveContext")
+	if !exists {
+		sentry.CaptureException(errors.New("context should exist but doesn't"))
+		return
+	}
+	streamID := foundContext.(tools.TUMLiveContext).Stream.ID
+	daoWrapper, _ := psc.Client.Get("dao")
+
+	subtitleJobListenerMutex.Lock()
+	subtitleJobListener[streamID] = append(subtitleJobListener[streamID], psc)
+	subtitleJobListenerMutex.Unlock()
+
+	jobs, err := daoWrapper.(dao.DaoWrapper).SubtitleJobDao.GetForStream(context.Background(), streamID)
+	if err != nil {
+		logger.Error("could not fetch subtitle jobs", "err", err, "stream", streamID)
+		return
+	}
+	msg, _ := json.Marshal(gin.H{"type": UpdateTypeSubtitleJobs, "data": newSubtitleJobDtos(jobs)})
+	_ = psc.Send(msg)
+}
+
+func subtitleJobsOnUnsubscribe(psc *realtime.Context) {
+	streamID, err := strconv.ParseUint(psc.Param("streamID"), 10, 32)
+	if err != nil {
+		return
+	}
+	subtitleJobListenerMutex.Lock()
+	defer subtitleJobListenerMutex.Unlock()
+	var sessions []*realtime.Context
+	for _, session := range subtitleJobListener[uint(streamID)] {
+		if session != psc {
+			sessions = append(sessions, session)
+		}
+	}
+	if len(sessions) == 0 {
+		delete(subtitleJobListener, uint(streamID))
+	} else {
+		subtitleJobListener[uint(streamID)] = sessions
+	}
+}
+
+// notifySubtitleJob sends a job to the admins subscribed to its stream.
+func notifySubtitleJob(job model.SubtitleJob) {
+	msg, _ := json.Marshal(gin.H{"type": UpdateTypeSubtitleJobSet, "data": newSubtitleJobDto(job)})
+	subtitleJobListenerMutex.RLock()
+	defer subtitleJobListenerMutex.RUnlock()
+	for _, session := range subtitleJobListener[job.StreamID] {
+		_ = session.Send(msg)
+	}
+}
+
+// createSubtitleJob queues a job for a request to the voice-service.
+func createSubtitleJob(daoWrapper dao.DaoWrapper, streamID uint, lang, sourceLang string) (model.SubtitleJob, error) {
+	job := model.SubtitleJob{
+		StreamID:       streamID,
+		Language:       lang,
+		SourceLanguage: sourceLang,
+		State:          model.SubtitleJobQueued,
+	}
+	if err := daoWrapper.SubtitleJobDao.Create(context.Background(), &job); err != nil {
+		return job, err
+	}
+	notifySubtitleJob(job)
+	return job, nil
+}
+
+// saveSubtitleJob saves a job and notifies subscribed admins.
+func saveSubtitleJob(daoWrapper dao.DaoWrapper, job *model.SubtitleJob) error {
+	if err := daoWrapper.SubtitleJobDao.Save(context.Background(), job); err != nil {
+		return err
+	}
+	notifySubtitleJob(*job)
+	return nil
+}
+
+// failSubtitleJob marks a job as failed, e.g. if the voice-service can't be reached.
+func failSubtitleJob(daoWrapper dao.DaoWrapper, job model.SubtitleJob, cause error) {
+	job.State = model.SubtitleJobFailed
+	job.Error = cause.Error()
+	if err := saveSubtitleJob(daoWrapper, &job); err != nil {
+		logger.Error("can't save failed subtitle job", "err", err, "job", job.ID)
+	}
+}
+
+// updateUnfinishedSubtitleJob applies update to the unfinished job of a stream and language.
+func updateUnfinishedSubtitleJob(ctx context.Context, daoWrapper dao.DaoWrapper, streamID uint, lang string, update func(*model.SubtitleJob)) error {
+	job, err := daoWrapper.SubtitleJobDao.GetUnfinished(ctx, streamID, lang)
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return status.Errorf(codes.NotFound, "no unfinished subtitle job for stream %d in %s", streamID, lang)
+	}
+	if err != nil {
+		return err
+	}
+	update(&job)
+	return saveSubtitleJob(daoWrapper, &job)
+}
+
+// ReportProgress marks the job of the stream and language as running.
+func (s subtitleReceiverServer) ReportProgress(ctx context.Context, request *pb.ProgressReport) (*emptypb.Empty, error) {
+	progress := min(max(int(request.GetProgress()), 0), 100)
+	err := updateUnfinishedSubtitleJob(ctx, s.DaoWrapper, uint(request.GetStreamId()), request.GetLanguage(), func(job *model.SubtitleJob) {
+		job.State = model.SubtitleJobRunning
+		job.Progress = progress
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &emptypb.Empty{}, nil
+}
+
+// ReportFailure marks the job of the stream and language as failed.
+func (s subtitleReceiverServer) ReportFailure(ctx context.Context, request *pb.FailureReport) (*emptypb.Empty, error) {
+	logger.Warn("voice-service failed to create subtitles", "stream", request.GetStreamId(), "language", request.GetLanguage(), "err", request.GetError())
+	err := updateUnfinishedSubtitleJob(ctx, s.DaoWrapper, uint(request.GetStreamId()), request.GetLanguage(), func(job *model.SubtitleJob) {
+		job.State = model.SubtitleJobFailed
+		job.Error = request.GetError()
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &emptypb.Empty{}, nil
+}
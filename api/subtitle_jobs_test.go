@@ -0,0 +1,134 @@
+package api
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/TUM-Dev/gocast/dao"
+	"github.com/TUM-Dev/gocast/mock_dao"
+	"github.com/TUM-Dev/gocast/model"
+	"github.com/TUM-Dev/gocast/tools"
+	"github.com/TUM-Dev/gocast/tools/testutils"
+	"github.com/TUM-Dev/gocast/voice-service/pb"
+	"github.com/gin-gonic/gin"
+	"github.com/golang/mock/gomock"
+	"github.com/matthiasreumann/gomino"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"gorm.io/gorm"
+)
+
+func TestSubtitleJobs(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	job := model.SubtitleJob{Model: gorm.Model{ID: 3}, StreamID: testutils.StreamFPVLive.ID, Language: "de", SourceLanguage: "en", State: model.SubtitleJobRunning, Progress: 40}
+
+	t.Run("GET/api/stream/:streamID/subtitles/jobs", func(t *testing.T) {
+		url := fmt.Sprintf("/api/stream/%d/subtitles/jobs", testutils.StreamFPVLive.ID)
+		gomino.TestCases{
+			"not admin of course": {
+				Router: func(r *gin.Engine) {
+					configGinStreamRestRouter(r, dao.DaoWrapper{
+						StreamsDao: testutils.GetStreamMock(t),
+						CoursesDao: testutils.GetCoursesMock(t),
+					})
+				},
+				Middlewares:  testutils.GetMiddlewares(tools.ErrorHandler, testutils.TUMLiveContext(testutils.TUMLiveContextStudent)),
+				ExpectedCode: http.StatusForbidden,
+			},
+			"database error": {
+				Router: func(r *gin.Engine) {
+					jobMock := mock_dao.NewMockSubtitleJobDao(gomock.NewController(t))
+					jobMock.EXPECT().GetForStream(gomock.Any(), testutils.StreamFPVLive.ID).Return(nil, errors.New(""))
+					configGinStreamRestRouter(r, dao.DaoWrapper{
+						StreamsDao:     testutils.GetStreamMock(t),
+						CoursesDao:     testutils.GetCoursesMock(t),
+						SubtitleJobDao: jobMock,
+					})
+				},
+				Middlewares:  testutils.GetMiddlewares(tools.ErrorHandler, testutils.TUMLiveContext(testutils.TUMLiveContextAdmin)),
+				ExpectedCode: http.StatusInternalServerError,
+			},
+			"success": {
+				Router: func(r *gin.Engine) {
+					jobMock := mock_dao.NewMockSubtitleJobDao(gomock.NewController(t))
+					jobMock.EXPECT().GetForStream(gomock.Any(), testutils.StreamFPVLive.ID).Return([]model.SubtitleJob{job}, nil)
+					configGinStreamRestRouter(r, dao.DaoWrapper{
+						StreamsDao:     testutils.GetStreamMock(t),
+						CoursesDao:     testutils.GetCoursesMock(t),
+						SubtitleJobDao: jobMock,
+					})
+				},
+				Middlewares:      testutils.GetMiddlewares(tools.ErrorHandler, testutils.TUMLiveContext(testutils.TUMLiveContextAdmin)),
+				ExpectedCode:     http.StatusOK,
+				ExpectedResponse: []subtitleJobDto{newSubtitleJobDto(job)},
+			},
+		}.Method(http.MethodGet).Url(url).Run(t, testutils.Equal)
+	})
+}
+
+func TestSubtitleJobReports(t *testing.T) {
+	queued := model.SubtitleJob{Model: gorm.Model{ID: 1}, StreamID: 1, Language: "en", State: model.SubtitleJobQueued}
+
+	t.Run("progress", func(t *testing.T) {
+		jobMock := mock_dao.NewMockSubtitleJobDao(gomock.NewController(t))
+		jobMock.EXPECT().GetUnfinished(gomock.Any(), uint(1), "en").Return(queued, nil)
+		jobMock.EXPECT().Save(gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, job *model.SubtitleJob) error {
+			if job.State != model.SubtitleJobRunning || job.Progress != 100 {
+				t.Errorf("expected running job with progress clamped to 100, got %s with %d", job.State, job.Progress)
+			}
+			return nil
+		})
+		s := subtitleReceiverServer{DaoWrapper: dao.DaoWrapper{SubtitleJobDao: jobMock}}
+		if _, err := s.ReportProgress(context.Background(), &pb.ProgressReport{StreamId: 1, Language: "en", Progress: 120}); err != nil {
+			t.Fatal(err)
+		}
+	})
+
+	t.Run("failure", func(t *testing.T) {
+		jobMock := mock_dao.NewMockSubtitleJobDao(gomock.NewController(t))
+		jobMock.EXPECT().GetUnfinished(gomock.Any(), uint(1), "en").Return(queued, nil)
+		jobMock.EXPECT().Save(gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, job *model.SubtitleJob) error {
+			if job.State != model.SubtitleJobFailed || job.Error != "no audio" {
+				t.Errorf("expected failed job with error, got %+v", job)
+			}
+			return nil
+		})
+		s := subtitleReceiverServer{DaoWrapper: dao.DaoWrapper{SubtitleJobDao: jobMock}}
+		if _, err := s.ReportFailure(context.Background(), &pb.FailureReport{StreamId: 1, Language: "en", Error: "no audio"}); err != nil {
+			t.Fatal(err)
+		}
+	})
+
+	t.Run("unknown job", func(t *testing.T) {
+		jobMock := mock_dao.NewMockSubtitleJobDao(gomock.NewController(t))
+		jobMock.EXPECT().GetUnfinished(gomock.Any(), uint(1), "fr").Return(model.SubtitleJob{}, gorm.ErrRecordNotFound)
+		s := subtitleReceiverServer{DaoWrapper: dao.DaoWrapper{SubtitleJobDao: jobMock}}
+		_, err := s.ReportProgress(context.Background(), &pb.ProgressReport{StreamId: 1, Language: "fr", Progress: 10})
+		if status.Code(err) != codes.NotFound {
+			t.Errorf("expected NotFound, got %v", err)
+		}
+	})
+
+	t.Run("receive finishes job", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		jobMock := mock_dao.NewMockSubtitleJobDao(ctrl)
+		subMock := mock_dao.NewMockSubtitlesDao(ctrl)
+		subMock.EXPECT().CreateOrUpsert(gomock.Any(), gomock.Any()).Return(nil)
+		jobMock.EXPECT().GetUnfinished(gomock.Any(), uint(1), "de").Return(model.SubtitleJob{StreamID: 1, Language: "de", SourceLanguage: "en", State: model.SubtitleJobRunning}, nil)
+		jobMock.EXPECT().Save(gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, job *model.SubtitleJob) error {
+			if job.State != model.SubtitleJobDone {
+				t.Errorf("expected done job, got %s", job.State)
+			}
+			return nil
+		})
+		s := subtitleReceiverServer{DaoWrapper: dao.DaoWrapper{SubtitleJobDao: jobMock, SubtitlesDao: subMock}}
+		_, err := s.Receive(context.Background(), &pb.ReceiveRequest{StreamId: 1, Language: "de", SourceLanguage: "en", Subtitles: testutils.SubtitlesFPVLive.Content})
+		if err != nil {
+			t.Fatal(err)
+		}
+	})
+}
@@ -0,0 +1,428 @@
+package api
+
+// subtitles.go lists the subtitle tracks of streams and implements the subtitle editor of course admins.
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"path/filepath"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/TUM-Dev/gocast/model"
+	"github.com/TUM-Dev/gocast/tools"
+	"github.com/TUM-Dev/gocast/tools/subtitles"
+	"github.com/gin-gonic/gin"
+	"gorm.io/gorm"
+)
+
+type subtitlesVersionDto struct {
+	Language  string          `json:"language"`
+	Version   uint            `json:"version"`
+	Source    string          `json:"source"`
+	Active    bool            `json:"active"`
+	UpdatedAt time.Time       `json:"updatedAt"`
+	Cues      []subtitles.Cue `json:"cues,omitempty"`
+}
+
+func newSubtitlesVersionDto(s model.Subtitles, cues []subtitles.Cue) subtitlesVersionDto {
+	return subtitlesVersionDto{
+		Language:  s.Language,
+		Version:   s.Version,
+		Source:    s.Source,
+		Active:    s.Active,
+		UpdatedAt: s.UpdatedAt,
+		Cues:      cues,
+	}
+}
+
+type subtitleTrackDto struct {
+	Language string `json:"language"`
+	Source   string `json:"source"` // translated tracks are machine translations
+	Src      string `json:"src"`
+}
+
+// getSubtitleTracks lists the subtitle tracks viewers can choose from, one per language.
+func (r streamRoutes) getSubtitleTracks(c *gin.Context) {
+	tumLiveContext := c.MustGet("TUMLiveContext").(tools.TUMLiveContext)
+
+	versions, err := r.SubtitlesDao.GetForStream(context.Background(), tumLiveContext.Stream.ID)
+	if err != nil {
+		_ = c.Error(tools.RequestError{
+			Status:        http.StatusInternalServerError,
+			CustomMessage: "can not get subtitles",
+			Err:           err,
+		})
+		return
+	}
+	tracks := make([]subtitleTrackDto, 0, len(versions))
+	for _, v := range versions {
+		if v.Active {
+			tracks = append(tracks, subtitleTrackDto{
+				Language: v.Language,
+				Source:   v.Source,
+				Src:      fmt.Sprintf("/api/stream/%d/subtitles/%s", v.StreamID, v.Language),
+			})
+		}
+	}
+	c.JSON(http.StatusOK, tracks)
+}
+
+// getSubtitleVersions lists all versions of the subtitles of a stream without their cues.
+func (r streamRoutes) getSubtitleVersions(c *gin.Context) {
+	tumLiveContext := c.MustGet("TUMLiveContext").(tools.TUMLiveContext)
+
+	versions, err := r.SubtitlesDao.GetForStream(context.Background(), tumLiveContext.Stream.ID)
+	if err != nil {
+		_ = c.Error(tools.RequestError{
+			Status:        http.StatusInternalServerError,
+			CustomMessage: "can not get subtitles",
+			Err:           err,
+		})
+		return
+	}
+	res := make([]subtitlesVersionDto, len(versions))
+	for i, v := range versions {
+		res[i] = newSubtitlesVersionDto(v, nil)
+	}
+	c.JSON(http.StatusOK, res)
+}
+
+// getSubtitleCues returns a version of the subtitles of a stream with its cues.
+func (r streamRoutes) getSubtitleCues(c *gin.Context) {
+	version, cues, ok := r.getSubtitlesVersion(c)
+	if !ok {
+		return
+	}
+	c.JSON(http.StatusOK, newSubtitlesVersionDto(version, cues))
+}
+
+// uploadSubtitles saves a human made SRT, WebVTT or TTML file as a new active version.
+func (r streamRoutes) uploadSubtitles(c *gin.Context) {
+	tumLiveContext := c.MustGet("TUMLiveContext").(tools.TUMLiveContext)
+
+	if !validSubtitleLanguage(c.Param("lang")) {
+		_ = c.Error(tools.RequestError{
+			Status:        http.StatusBadRequest,
+			CustomMessage: "invalid language",
+		})
+		return
+	}
+	file, err := c.FormFile("file")
+	if err != nil {
+		_ = c.Error(tools.RequestError{
+			Status:        http.StatusBadRequest,
+			CustomMessage: "missing form parameter 'file'",
+			Err:           err,
+		})
+		return
+	}
+	if file.Size > MAX_FILE_SIZE {
+		_ = c.Error(tools.RequestError{
+			Status:        http.StatusBadRequest,
+			CustomMessage: "file too large (limit is 50mb)",
+		})
+		return
+	}
+	f, err := file.Open()
+	if err != nil {
+		_ = c.Error(tools.RequestError{
+			Status:        http.StatusInternalServerError,
+			CustomMessage: "can not open file",
+			Err:           err,
+		})
+		return
+	}
+	defer f.Close()
+	data, err := io.ReadAll(f)
+	if err != nil {
+		_ = c.Error(tools.RequestError{
+			Status:        http.StatusInternalServerError,
+			CustomMessage: "can not read file",
+			Err:           err,
+		})
+		return
+	}
+
+	cues, err := subtitles.Parse(data, subtitleFormatOfFile(file.Filename))
+	if err != nil {
+		_ = c.Error(tools.RequestError{
+			Status:        http.StatusBadRequest,
+			CustomMessage: "can not parse subtitles, supported formats are SRT, WebVTT and TTML",
+			Err:           err,
+		})
+		return
+	}
+	version := model.Subtitles{
+		StreamID: tumLiveContext.Stream.ID,
+		Language: c.Param("lang"),
+		Source:   model.SubtitlesSourceUploaded,
+		Active:   true,
+	}
+	if !r.saveSubtitlesVersion(c, &version, cues) {
+		return
+	}
+	c.JSON(http.StatusOK, newSubtitlesVersionDto(version, cues))
+}
+
+// activateSubtitles serves a version of the subtitles to viewers instead of the current one.
+func (r streamRoutes) activateSubtitles(c *gin.Context) {
+	version, _, ok := r.getSubtitlesVersion(c)
+	if !ok {
+		return
+	}
+	if err := r.SubtitlesDao.Activate(context.Background(), &version); err != nil {
+		_ = c.Error(tools.RequestError{
+			Status:        http.StatusInternalServerError,
+			CustomMessage: "can not activate subtitles",
+			Err:           err,
+		})
+		return
+	}
+	c.JSON(http.StatusOK, newSubtitlesVersionDto(version, nil))
+}
+
+// requestSubtitleTranslation asks the voice-service to translate a version into other languages, by default
+// into the subtitle languages of the course. Translations become active unless the language has corrected subtitles.
+func (r streamRoutes) requestSubtitleTranslation(c *gin.Context) {
+	var request struct {
+		Languages []string `json:"languages"`
+	}
+	if err := c.ShouldBindJSON(&request); err != nil && !errors.Is(err, io.EOF) {
+		_ = c.Error(tools.RequestError{
+			Status:        http.StatusBadRequest,
+			CustomMessage: "can not bind body",
+			Err:           err,
+		})
+		return
+	}
+	for _, lang := range request.Languages {
+		if !validSubtitleLanguage(lang) {
+			_ = c.Error(tools.RequestError{
+				Status:        http.StatusBadRequest,
+				CustomMessage: "invalid language: " + lang,
+			})
+			return
+		}
+	}
+	version, _, ok := r.getSubtitlesVersion(c)
+	if !ok {
+		return
+	}
+	if err := translateSubtitles(r.DaoWrapper, version, request.Languages); err != nil {
+		_ = c.Error(tools.RequestError{
+			Status:        http.StatusInternalServerError,
+			CustomMessage: "could not request translation from voice-service",
+			Err:           err,
+		})
+		return
+	}
+}
+
+func (r streamRoutes) addSubtitleCue(c *gin.Context) {
+	var cue subtitles.Cue
+	if !bindSubtitleCue(c, &cue) {
+		return
+	}
+	r.editSubtitles(c, func(cues []subtitles.Cue) ([]subtitles.Cue, error) {
+		cues = append(cues, cue)
+		subtitles.Sort(cues)
+		return cues, nil
+	})
+}
+
+func (r streamRoutes) updateSubtitleCue(c *gin.Context) {
+	var cue subtitles.Cue
+	if !bindSubtitleCue(c, &cue) {
+		return
+	}
+	r.editSubtitles(c, func(cues []subtitles.Cue) ([]subtitles.Cue, error) {
+		i, err := subtitleCueIndex(c, cues)
+		if err != nil {
+			return nil, err
+		}
+		cues[i] = cue
+		subtitles.Sort(cues)
+		return cues, nil
+	})
+}
+
+func (r streamRoutes) deleteSubtitleCue(c *gin.Context) {
+	r.editSubtitles(c, func(cues []subtitles.Cue) ([]subtitles.Cue, error) {
+		i, err := subtitleCueIndex(c, cues)
+		if err != nil {
+			return nil, err
+		}
+		return append(cues[:i], cues[i+1:]...), nil
+	})
+}
+
+// shiftSubtitles moves all cues of a version, e.g. if the recording was cut.
+func (r streamRoutes) shiftSubtitles(c *gin.Context) {
+	var request struct {
+		Offset int64 `json:"offset"` // milliseconds, negative to show cues earlier
+	}
+	if err := c.BindJSON(&request); err != nil {
+		_ = c.Error(tools.RequestError{
+			Status:        http.StatusBadRequest,
+			CustomMessage: "can not bind body",
+			Err:           err,
+		})
+		return
+	}
+	r.editSubtitles(c, func(cues []subtitles.Cue) ([]subtitles.Cue, error) {
+		return subtitles.Shift(cues, time.Duration(request.Offset)*time.Millisecond), nil
+	})
+}
+
+// editSubtitles applies edit to the cues of a version. Generated and translated versions are kept as they are,
+// their edits are saved as a new version that replaces them if they were active. This way the voice-service
+// never overwrites edits when it generates or translates the subtitles again.
+func (r streamRoutes) editSubtitles(c *gin.Context, edit func([]subtitles.Cue) ([]subtitles.Cue, error)) {
+	version, cues, ok := r.getSubtitlesVersion(c)
+	if !ok {
+		return
+	}
+	cues, err := edit(cues)
+	if err != nil {
+		_ = c.Error(tools.RequestError{
+			Status:        http.StatusBadRequest,
+			CustomMessage: err.Error(),
+			Err:           err,
+		})
+		return
+	}
+	if version.Source == model.SubtitlesSourceGenerated || version.Source == model.SubtitlesSourceTranslated {
+		version = model.Subtitles{
+			StreamID: version.StreamID,
+			Language: version.Language,
+			Source:   model.SubtitlesSourceEdited,
+			Active:   version.Active,
+		}
+	}
+	if !r.saveSubtitlesVersion(c, &version, cues) {
+		return
+	}
+	c.JSON(http.StatusOK, newSubtitlesVersionDto(version, cues))
+}
+
+// saveSubtitlesVersion stores cues as WebVTT. New versions are created, active ones replace the current version.
+func (r streamRoutes) saveSubtitlesVersion(c *gin.Context, version *model.Subtitles, cues []subtitles.Cue) bool {
+	content, err := subtitles.Write(cues, subtitles.FormatWebVTT)
+	if err != nil {
+		_ = c.Error(tools.RequestError{
+			Status:        http.StatusInternalServerError,
+			CustomMessage: "can not write subtitles",
+			Err:           err,
+		})
+		return false
+	}
+	version.Content = content
+
+	ctx := context.Background()
+	if version.ID != 0 {
+		err = r.SubtitlesDao.Save(ctx, version)
+	} else if err = r.SubtitlesDao.Create(ctx, version); err == nil && version.Active {
+		err = r.SubtitlesDao.Activate(ctx, version)
+	}
+	if err != nil {
+		_ = c.Error(tools.RequestError{
+			Status:        http.StatusInternalServerError,
+			CustomMessage: "can not save subtitles",
+			Err:           err,
+		})
+		return false
+	}
+	return true
+}
+
+// getSubtitlesVersion returns the version of the subtitles in the url with its cues.
+func (r streamRoutes) getSubtitlesVersion(c *gin.Context) (model.Subtitles, []subtitles.Cue, bool) {
+	tumLiveContext := c.MustGet("TUMLiveContext").(tools.TUMLiveContext)
+
+	versionNumber, err := strconv.ParseUint(c.Param("version"), 10, 32)
+	if err != nil {
+		_ = c.Error(tools.RequestError{
+			Status:        http.StatusBadRequest,
+			CustomMessage: "invalid version",
+			Err:           err,
+		})
+		return model.Subtitles{}, nil, false
+	}
+	version, err := r.SubtitlesDao.GetVersion(context.Background(), tumLiveContext.Stream.ID, c.Param("lang"), uint(versionNumber))
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			_ = c.Error(tools.RequestError{
+				Status:        http.StatusNotFound,
+				CustomMessage: "subtitles not found",
+			})
+		} else {
+			_ = c.Error(tools.RequestError{
+				Status:        http.StatusInternalServerError,
+				CustomMessage: "can not get subtitles",
+				Err:           err,
+			})
+		}
+		return model.Subtitles{}, nil, false
+	}
+	cues, err := subtitles.Parse([]byte(version.Content), "")
+	if err != nil {
+		_ = c.Error(tools.RequestError{
+			Status:        http.StatusInternalServerError,
+			CustomMessage: "can not parse subtitles",
+			Err:           err,
+		})
+		return model.Subtitles{}, nil, false
+	}
+	return version, cues, true
+}
+
+func bindSubtitleCue(c *gin.Context, cue *subtitles.Cue) bool {
+	err := c.BindJSON(cue)
+	if err == nil {
+		err = cue.Validate()
+	}
+	if err != nil {
+		_ = c.Error(tools.RequestError{
+			Status:        http.StatusBadRequest,
+			CustomMessage: "invalid cue",
+			Err:           err,
+		})
+		return false
+	}
+	return true
+}
+
+// subtitleCueIndex returns the index of the cue in the url. Cues are numbered by their start, beginning at 0.
+func subtitleCueIndex(c *gin.Context, cues []subtitles.Cue) (int, error) {
+	i, err := strconv.Atoi(c.Param("cue"))
+	if err != nil || i < 0 || i >= len(cues) {
+		return 0, fmt.Errorf("cue %s doesn't exist", c.Param("cue"))
+	}
+	return i, nil
+}
+
+// subtitleFormatOfFile returns the format of a subtitle file by its extension, empty if unknown.
+func subtitleFormatOfFile(filename string) string {
+	switch strings.ToLower(filepath.Ext(filename)) {
+	case ".srt":
+		return subtitles.FormatSRT
+	case ".vtt":
+		return subtitles.FormatWebVTT
+	case ".ttml", ".dfxp", ".xml":
+		return subtitles.FormatTTML
+	default:
+		return ""
+	}
+}
+
+var subtitleLanguageRegex = regexp.MustCompile(`^[a-z]{2,3}(-[A-Za-z0-9]{2,8})?$`)
+
+// validSubtitleLanguage returns whether lang is a language tag like de or pt-BR.
+func validSubtitleLanguage(lang string) bool {
+	return subtitleLanguageRegex.MatchString(lang)
+}
@@ -0,0 +1,194 @@
+package api
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/TUM-Dev/gocast/dao"
+	"github.com/TUM-Dev/gocast/mock_dao"
+	"github.com/TUM-Dev/gocast/model"
+	"github.com/TUM-Dev/gocast/tools"
+	"github.com/TUM-Dev/gocast/tools/subtitles"
+	"github.com/TUM-Dev/gocast/tools/testutils"
+	"github.com/gin-gonic/gin"
+	"github.com/golang/mock/gomock"
+	"github.com/matthiasreumann/gomino"
+	"gorm.io/gorm"
+)
+
+func TestSubtitleEditor(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	generated := testutils.SubtitlesFPVLive
+	generated.ID = 1
+	uploaded := generated
+	uploaded.Source = model.SubtitlesSourceUploaded
+	translated := generated
+	translated.Source = model.SubtitlesSourceTranslated
+	cue := gin.H{"start": 1000, "end": 3000, "text": "corrected"}
+	editedContent := "WEBVTT\n\n00:00:01.000 --> 00:00:03.000\ncorrected\n"
+
+	router := func(version model.Subtitles, expect func(m *mock_dao.MockSubtitlesDao)) func(r *gin.Engine) {
+		return func(r *gin.Engine) {
+			subMock := mock_dao.NewMockSubtitlesDao(gomock.NewController(t))
+			subMock.
+				EXPECT().
+				GetVersion(gomock.Any(), testutils.StreamFPVLive.ID, "en", uint(1)).
+				Return(version, nil).
+				AnyTimes()
+			if expect != nil {
+				expect(subMock)
+			}
+			configGinStreamRestRouter(r, dao.DaoWrapper{
+				StreamsDao:   testutils.GetStreamMock(t),
+				CoursesDao:   testutils.GetCoursesMock(t),
+				SubtitlesDao: subMock,
+			})
+		}
+	}
+
+	t.Run("PUT/api/stream/:streamID/subtitles/:lang/:version/cues/:cue", func(t *testing.T) {
+		url := fmt.Sprintf("/api/stream/%d/subtitles/en/1/cues/0", testutils.StreamFPVLive.ID)
+		gomino.TestCases{
+			"invalid cue": {
+				Router:       router(generated, nil),
+				Body:         gin.H{"start": 3000, "end": 1000, "text": "backwards"},
+				Middlewares:  testutils.GetMiddlewares(tools.ErrorHandler, testutils.TUMLiveContext(testutils.TUMLiveContextAdmin)),
+				ExpectedCode: http.StatusBadRequest,
+			},
+			"unknown cue": {
+				Router:       router(generated, nil),
+				Url:          fmt.Sprintf("/api/stream/%d/subtitles/en/1/cues/1", testutils.StreamFPVLive.ID),
+				Body:         cue,
+				Middlewares:  testutils.GetMiddlewares(tools.ErrorHandler, testutils.TUMLiveContext(testutils.TUMLiveContextAdmin)),
+				ExpectedCode: http.StatusBadRequest,
+			},
+			"version not found": {
+				Router: func(r *gin.Engine) {
+					subMock := mock_dao.NewMockSubtitlesDao(gomock.NewController(t))
+					subMock.
+						EXPECT().
+						GetVersion(gomock.Any(), testutils.StreamFPVLive.ID, "en", uint(1)).
+						Return(model.Subtitles{}, gorm.ErrRecordNotFound)
+					configGinStreamRestRouter(r, dao.DaoWrapper{
+						StreamsDao:   testutils.GetStreamMock(t),
+						CoursesDao:   testutils.GetCoursesMock(t),
+						SubtitlesDao: subMock,
+					})
+				},
+				Body:         cue,
+				Middlewares:  testutils.GetMiddlewares(tools.ErrorHandler, testutils.TUMLiveContext(testutils.TUMLiveContextAdmin)),
+				ExpectedCode: http.StatusNotFound,
+			},
+			"generated version is kept": {
+				Router: router(generated, func(m *mock_dao.MockSubtitlesDao) {
+					edited := &model.Subtitles{
+						StreamID: generated.StreamID,
+						Language: "en",
+						Source:   model.SubtitlesSourceEdited,
+						Active:   true,
+						Content:  editedContent,
+					}
+					m.EXPECT().Create(gomock.Any(), edited).DoAndReturn(func(_ interface{}, s *model.Subtitles) error {
+						s.ID, s.Version = 2, 2
+						return nil
+					})
+					m.EXPECT().Activate(gomock.Any(), gomock.Any()).Return(nil)
+				}),
+				Body:         cue,
+				Middlewares:  testutils.GetMiddlewares(tools.ErrorHandler, testutils.TUMLiveContext(testutils.TUMLiveContextAdmin)),
+				ExpectedCode: http.StatusOK,
+				ExpectedResponse: subtitlesVersionDto{
+					Language: "en",
+					Version:  2,
+					Source:   model.SubtitlesSourceEdited,
+					Active:   true,
+					Cues:     []subtitles.Cue{{Start: 1e9, End: 3e9, Text: "corrected"}},
+				},
+			},
+			"translated version is kept": {
+				Router: router(translated, func(m *mock_dao.MockSubtitlesDao) {
+					edited := &model.Subtitles{
+						StreamID: translated.StreamID,
+						Language: "en",
+						Source:   model.SubtitlesSourceEdited,
+						Active:   true,
+						Content:  editedContent,
+					}
+					m.EXPECT().Create(gomock.Any(), edited).Return(nil)
+					m.EXPECT().Activate(gomock.Any(), gomock.Any()).Return(nil)
+				}),
+				Body:         cue,
+				Middlewares:  testutils.GetMiddlewares(tools.ErrorHandler, testutils.TUMLiveContext(testutils.TUMLiveContextAdmin)),
+				ExpectedCode: http.StatusOK,
+			},
+			"uploaded version is edited": {
+				Router: router(uploaded, func(m *mock_dao.MockSubtitlesDao) {
+					saved := uploaded
+					saved.Content = editedContent
+					m.EXPECT().Save(gomock.Any(), &saved).Return(nil)
+				}),
+				Body:         cue,
+				Middlewares:  testutils.GetMiddlewares(tools.ErrorHandler, testutils.TUMLiveContext(testutils.TUMLiveContextAdmin)),
+				ExpectedCode: http.StatusOK,
+			},
+		}.
+			Method(http.MethodPut).
+			Url(url).
+			Run(t, testutils.Equal)
+	})
+
+	t.Run("POST/api/stream/:streamID/subtitles/:lang/:version/shift", func(t *testing.T) {
+		url := fmt.Sprintf("/api/stream/%d/subtitles/en/1/shift", testutils.StreamFPVLive.ID)
+		gomino.TestCases{
+			"success": {
+				Router: router(uploaded, func(m *mock_dao.MockSubtitlesDao) {
+					saved := uploaded
+					saved.Content = "WEBVTT\n\n00:00:00.500 --> 00:00:02.000\nwonderful\n"
+					m.EXPECT().Save(gomock.Any(), &saved).Return(nil)
+				}),
+				Body:         gin.H{"offset": -500},
+				Middlewares:  testutils.GetMiddlewares(tools.ErrorHandler, testutils.TUMLiveContext(testutils.TUMLiveContextAdmin)),
+				ExpectedCode: http.StatusOK,
+			},
+		}.
+			Method(http.MethodPost).
+			Url(url).
+			Run(t, testutils.Equal)
+	})
+
+	t.Run("GET/api/stream/:streamID/subtitles/tracks", func(t *testing.T) {
+		url := fmt.Sprintf("/api/stream/%d/subtitles/tracks", testutils.StreamFPVLive.ID)
+		inactive := generated
+		inactive.Active = false
+		translated := generated
+		translated.Language = "de"
+		translated.Source = model.SubtitlesSourceTranslated
+		gomino.TestCases{
+			"success": {
+				Router: func(r *gin.Engine) {
+					subMock := mock_dao.NewMockSubtitlesDao(gomock.NewController(t))
+					subMock.
+						EXPECT().
+						GetForStream(gomock.Any(), testutils.StreamFPVLive.ID).
+						Return([]model.Subtitles{translated, inactive, uploaded}, nil)
+					configGinStreamRestRouter(r, dao.DaoWrapper{
+						StreamsDao:   testutils.GetStreamMock(t),
+						CoursesDao:   testutils.GetCoursesMock(t),
+						SubtitlesDao: subMock,
+					})
+				},
+				Middlewares:  testutils.GetMiddlewares(tools.ErrorHandler, testutils.TUMLiveContext(testutils.TUMLiveContextStudent)),
+				ExpectedCode: http.StatusOK,
+				ExpectedResponse: []subtitleTrackDto{
+					{Language: "de", Source: model.SubtitlesSourceTranslated, Src: url[:len(url)-len("tracks")] + "de"},
+					{Language: "en", Source: model.SubtitlesSourceUploaded, Src: url[:len(url)-len("tracks")] + "en"},
+				},
+			},
+		}.
+			Method(http.MethodGet).
+			Url(url).
+			Run(t, testutils.Equal)
+	})
+}
@@ -4,18 +4,23 @@ package api
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"net"
+	"slices"
 	"time"
 
 	"github.com/TUM-Dev/gocast/dao"
 	"github.com/TUM-Dev/gocast/model"
 	"github.com/TUM-Dev/gocast/tools"
+	"github.com/TUM-Dev/gocast/tools/subtitles"
 	"github.com/TUM-Dev/gocast/voice-service/pb"
 	"google.golang.org/grpc"
-	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials"
 	"google.golang.org/grpc/keepalive"
 	"google.golang.org/grpc/reflection"
+	"google.golang.org/grpc/status"
 	"google.golang.org/protobuf/types/known/emptypb"
 )
 
@@ -24,33 +29,123 @@ type subtitleReceiverServer struct {
 	dao.DaoWrapper
 }
 
-func (s subtitleReceiverServer) Receive(_ context.Context, request *pb.ReceiveRequest) (*emptypb.Empty, error) {
+func (s subtitleReceiverServer) Receive(ctx context.Context, request *pb.ReceiveRequest) (*emptypb.Empty, error) {
+	// the voice-service sends .srt, players need WebVTT
+	cues, err := subtitles.Parse([]byte(request.GetSubtitles()), "")
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "can't parse subtitles: %v", err)
+	}
+	content, err := subtitles.Write(cues, subtitles.FormatWebVTT)
+	if err != nil {
+		return nil, err
+	}
 	subtitlesEntry := model.Subtitles{
 		StreamID: uint(request.GetStreamId()),
-		Content:  request.GetSubtitles(),
+		Content:  content,
 		Language: request.GetLanguage(),
+		Source:   model.SubtitlesSourceGenerated,
+	}
+	if request.GetSourceLanguage() != "" {
+		subtitlesEntry.Source = model.SubtitlesSourceTranslated
 	}
-	err := s.SubtitlesDao.CreateOrUpsert(context.Background(), &subtitlesEntry)
+	err = s.SubtitlesDao.CreateOrUpsert(context.Background(), &subtitlesEntry)
 	if err != nil {
 		return nil, err
 	}
+	err = updateUnfinishedSubtitleJob(ctx, s.DaoWrapper, subtitlesEntry.StreamID, subtitlesEntry.Language, func(job *model.SubtitleJob) {
+		job.State = model.SubtitleJobDone
+		job.Progress = 100
+	})
+	if err != nil && status.Code(err) != codes.NotFound {
+		logger.Error("can't finish subtitle job", "err", err, "stream", subtitlesEntry.StreamID)
+	}
+	if subtitlesEntry.Source == model.SubtitlesSourceGenerated {
+		// translate into the languages of the course, the voice-service sends the translations back to Receive
+		go func() {
+			if err := translateSubtitles(s.DaoWrapper, subtitlesEntry, nil); err != nil {
+				logger.Error("can't translate subtitles", "err", err, "stream", subtitlesEntry.StreamID)
+			}
+		}()
+	}
 	return &emptypb.Empty{}, nil
 }
 
-func init() {
+// translateSubtitles requests translations of subtitles from the voice-service.
+// If languages is nil, the subtitles are translated into the subtitle languages of the course.
+func translateSubtitles(daoWrapper dao.DaoWrapper, source model.Subtitles, languages []string) error {
+	ctx := context.Background()
+	if languages == nil {
+		stream, err := daoWrapper.StreamsDao.GetStreamByID(ctx, fmt.Sprintf("%d", source.StreamID))
+		if err != nil {
+			return err
+		}
+		course, err := daoWrapper.CoursesDao.GetCourseById(ctx, stream.CourseID)
+		if err != nil {
+			return err
+		}
+		languages = course.GetSubtitleLanguages()
+	}
+	targets := make([]string, 0, len(languages))
+	for _, lang := range languages {
+		if lang != source.Language && !slices.Contains(targets, lang) {
+			targets = append(targets, lang)
+		}
+	}
+	if len(targets) == 0 {
+		return nil
+	}
+
+	jobs := make([]model.SubtitleJob, 0, len(targets))
+	for _, lang := range targets {
+		job, err := createSubtitleJob(daoWrapper, source.StreamID, lang, source.Language)
+		if err != nil {
+			return err
+		}
+		jobs = append(jobs, job)
+	}
+	err := requestTranslation(ctx, source, targets)
+	if err != nil {
+		for _, job := range jobs {
+			failSubtitleJob(daoWrapper, job, err)
+		}
+	}
+	return err
+}
+
+func requestTranslation(ctx context.Context, source model.Subtitles, targets []string) error {
+	client, err := GetSubtitleGeneratorClient()
+	if err != nil {
+		return err
+	}
+	defer client.CloseConn()
+	_, err = client.Translate(ctx, &pb.TranslateRequest{
+		StreamId:        int32(source.StreamID),
+		Subtitles:       source.Content,
+		SourceLanguage:  source.Language,
+		TargetLanguages: targets,
+	})
+	return err
+}
+
+// ServeVoiceReceiverGRPC initializes the gRPC server the voice service sends subtitles to on port 50053
+func ServeVoiceReceiverGRPC() {
 	logger.Info("starting grpc voice-receiver")
 	lis, err := net.Listen("tcp", ":50053")
 	if err != nil {
 		logger.Error("failed to init voice-receiver server", "err", err)
 		return
 	}
-	grpcServer := grpc.NewServer(grpc.KeepaliveParams(keepalive.ServerParameters{
+	opts := []grpc.ServerOption{grpc.KeepaliveParams(keepalive.ServerParameters{
 		MaxConnectionIdle:     time.Minute,
 		MaxConnectionAge:      time.Minute,
 		MaxConnectionAgeGrace: time.Second * 5,
 		Time:                  time.Minute * 10,
 		Timeout:               time.Second * 20,
-	}))
+	})}
+	if tools.WorkerCA != nil {
+		opts = append(opts, grpc.Creds(credentials.NewTLS(serviceTLSConfig(tools.VoiceServiceCommonName))))
+	}
+	grpcServer := grpc.NewServer(opts...)
 	pb.RegisterSubtitleReceiverServer(grpcServer, &subtitleReceiverServer{DaoWrapper: dao.NewDaoWrapper()})
 
 	reflection.Register(grpcServer)
@@ -67,8 +162,11 @@ type SubtitleGeneratorClient struct {
 }
 
 func GetSubtitleGeneratorClient() (SubtitleGeneratorClient, error) {
+	if tools.Cfg.VoiceService == nil {
+		return SubtitleGeneratorClient{}, errors.New("voice-service is not configured")
+	}
 	voiceAddr := fmt.Sprintf("%s:%s", tools.Cfg.VoiceService.Host, tools.Cfg.VoiceService.Port)
-	conn, err := grpc.Dial(voiceAddr, grpc.WithTransportCredentials(insecure.NewCredentials()))
+	conn, err := grpc.Dial(voiceAddr, grpc.WithTransportCredentials(clientCredentials(tools.Cfg.VoiceService.Host)))
 	if err != nil {
 		return SubtitleGeneratorClient{}, err
 	}
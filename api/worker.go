@@ -1,6 +1,7 @@
 package api
 
 import (
+	"context"
 	"net/http"
 
 	"github.com/TUM-Dev/gocast/dao"
@@ -12,17 +13,30 @@ func configWorkerRouter(r *gin.Engine, daoWrapper dao.DaoWrapper) {
 	g := r.Group("/api/workers")
 	g.Use(tools.Admin)
 
-	routes := workerRoutes{dao: daoWrapper.WorkerDao}
+	routes := workerRoutes{dao: daoWrapper.WorkerDao, certificateDao: daoWrapper.WorkerCertificateDao}
 
 	g.DELETE("/:id", routes.deleteWorker)
+	g.GET("/:id/certificates", routes.getCertificates)
+	g.POST("/:id/certificates/revoke", routes.revokeCertificates)
 }
 
 type workerRoutes struct {
-	dao dao.WorkerDao
+	dao            dao.WorkerDao
+	certificateDao dao.WorkerCertificateDao
 }
 
 func (r workerRoutes) deleteWorker(c *gin.Context) {
 	id := c.Param("id")
+	// a deleted worker must not be able to call TUM-Live with its old certificate
+	if err := r.certificateDao.RevokeForWorker(context.Background(), id); err != nil {
+		logger.Error("can not revoke certificates of worker", "err", err)
+		_ = c.Error(tools.RequestError{
+			Status:        http.StatusInternalServerError,
+			CustomMessage: "can not revoke certificates of worker",
+			Err:           err,
+		})
+		return
+	}
 	err := r.dao.DeleteWorker(id)
 	if err != nil {
 		logger.Error("can not delete worker", "err", err)
@@ -34,3 +48,29 @@ func (r workerRoutes) deleteWorker(c *gin.Context) {
 		return
 	}
 }
+
+func (r workerRoutes) getCertificates(c *gin.Context) {
+	certificates, err := r.certificateDao.GetForWorker(context.Background(), c.Param("id"))
+	if err != nil {
+		_ = c.Error(tools.RequestError{
+			Status:        http.StatusInternalServerError,
+			CustomMessage: "can not get certificates of worker",
+			Err:           err,
+		})
+		return
+	}
+	c.JSON(http.StatusOK, certificates)
+}
+
+// revokeCertificates revokes all certificates of a worker. The worker has to join again with the worker token.
+func (r workerRoutes) revokeCertificates(c *gin.Context) {
+	if err := r.certificateDao.RevokeForWorker(context.Background(), c.Param("id")); err != nil {
+		logger.Error("can not revoke certificates of worker", "err", err)
+		_ = c.Error(tools.RequestError{
+			Status:        http.StatusInternalServerError,
+			CustomMessage: "can not revoke certificates of worker",
+			Err:           err,
+		})
+		return
+	}
+}
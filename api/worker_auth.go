@@ -0,0 +1,161 @@
+package api
+
+// worker_auth.go authenticates workers with the client certificates TUM-Live issues to them.
+import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"fmt"
+	"time"
+
+	"github.com/TUM-Dev/gocast/dao"
+	"github.com/TUM-Dev/gocast/model"
+	"github.com/TUM-Dev/gocast/tools"
+	"github.com/TUM-Dev/gocast/worker/pb"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/peer"
+	"google.golang.org/grpc/status"
+)
+
+// workerIDRequest is implemented by all requests of workers except JoinWorkersRequest.
+type workerIDRequest interface {
+	GetWorkerID() string
+}
+
+// clientCredentials returns the credentials to dial a worker or the voice service with.
+// Without mutual TLS the connection is insecure.
+func clientCredentials(host string) credentials.TransportCredentials {
+	if tools.WorkerCA == nil {
+		return insecure.NewCredentials()
+	}
+	return credentials.NewTLS(tools.WorkerCA.ClientTLSConfig(host))
+}
+
+// issueWorkerCertificate signs the certificate request of a worker and stores the certificate,
+// so it can be revoked later.
+func issueWorkerCertificate(ctx context.Context, daoWrapper dao.DaoWrapper, worker model.Worker, csr string) (string, error) {
+	certPEM, cert, err := tools.WorkerCA.IssueCertificate([]byte(csr), worker.WorkerID, []string{worker.Host}, tools.WorkerCertValidity())
+	if err != nil {
+		return "", status.Errorf(codes.InvalidArgument, "can't issue certificate: %v", err)
+	}
+	err = daoWrapper.WorkerCertificateDao.Create(ctx, &model.WorkerCertificate{
+		WorkerID: worker.WorkerID,
+		Serial:   fmt.Sprintf("%x", cert.SerialNumber),
+		NotAfter: cert.NotAfter,
+	})
+	if err != nil {
+		return "", status.Errorf(codes.Internal, "can't save certificate: %v", err)
+	}
+	logger.Info("Issued worker certificate", "worker", worker.WorkerID, "notAfter", cert.NotAfter)
+	return string(certPEM), nil
+}
+
+// RenewCertificate issues a new client certificate to a worker authenticated with its current certificate.
+func (s server) RenewCertificate(ctx context.Context, request *pb.RenewCertificateRequest) (*pb.RenewCertificateResponse, error) {
+	if tools.WorkerCA == nil {
+		return nil, status.Error(codes.FailedPrecondition, "mutual TLS is disabled")
+	}
+	worker, err := s.DaoWrapper.WorkerDao.GetWorkerByID(ctx, request.GetWorkerID())
+	if err != nil {
+		return nil, status.Error(codes.NotFound, "worker not found")
+	}
+	cert, err := issueWorkerCertificate(ctx, s.DaoWrapper, worker, request.GetCertificateRequest())
+	if err != nil {
+		return nil, err
+	}
+	return &pb.RenewCertificateResponse{Certificate: cert}, nil
+}
+
+// peerCertificate returns the verified client certificate of the caller.
+func peerCertificate(ctx context.Context) (*x509.Certificate, error) {
+	p, ok := peer.FromContext(ctx)
+	if !ok {
+		return nil, status.Error(codes.Unauthenticated, "no peer")
+	}
+	tlsInfo, ok := p.AuthInfo.(credentials.TLSInfo)
+	if !ok || len(tlsInfo.State.VerifiedChains) == 0 || len(tlsInfo.State.VerifiedChains[0]) == 0 {
+		return nil, status.Error(codes.Unauthenticated, "client certificate required")
+	}
+	return tlsInfo.State.VerifiedChains[0][0], nil
+}
+
+// authenticateWorker returns the id of the worker the client certificate of the caller belongs to.
+func authenticateWorker(ctx context.Context, daoWrapper dao.DaoWrapper) (string, error) {
+	cert, err := peerCertificate(ctx)
+	if err != nil {
+		return "", err
+	}
+	stored, err := daoWrapper.WorkerCertificateDao.GetBySerial(ctx, fmt.Sprintf("%x", cert.SerialNumber))
+	if err != nil {
+		return "", status.Error(codes.Unauthenticated, "unknown certificate")
+	}
+	if !stored.Valid(time.Now()) || stored.WorkerID != cert.Subject.CommonName {
+		return "", status.Error(codes.Unauthenticated, "certificate revoked")
+	}
+	return stored.WorkerID, nil
+}
+
+// checkWorkerID rejects requests on behalf of another worker than the authenticated one.
+func checkWorkerID(workerID string, req interface{}) error {
+	r, ok := req.(workerIDRequest)
+	if !ok || r.GetWorkerID() != workerID {
+		return status.Errorf(codes.PermissionDenied, "certificate doesn't belong to the worker of the request")
+	}
+	return nil
+}
+
+// workerUnaryInterceptor authenticates all calls of workers except JoinWorkers, which is authenticated by the worker token.
+func workerUnaryInterceptor(daoWrapper dao.DaoWrapper) grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		if info.FullMethod == pb.FromWorker_JoinWorkers_FullMethodName {
+			return handler(ctx, req)
+		}
+		workerID, err := authenticateWorker(ctx, daoWrapper)
+		if err != nil {
+			return nil, err
+		}
+		if err = checkWorkerID(workerID, req); err != nil {
+			logger.Warn("Rejected worker request", "err", err, "method", info.FullMethod, "worker", workerID)
+			return nil, err
+		}
+		return handler(ctx, req)
+	}
+}
+
+// workerStreamInterceptor authenticates streaming calls of workers and checks every received message.
+func workerStreamInterceptor(daoWrapper dao.DaoWrapper) grpc.StreamServerInterceptor {
+	return func(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		workerID, err := authenticateWorker(ss.Context(), daoWrapper)
+		if err != nil {
+			return err
+		}
+		return handler(srv, &workerServerStream{ServerStream: ss, workerID: workerID})
+	}
+}
+
+type workerServerStream struct {
+	grpc.ServerStream
+	workerID string
+}
+
+func (s *workerServerStream) RecvMsg(m interface{}) error {
+	if err := s.ServerStream.RecvMsg(m); err != nil {
+		return err
+	}
+	return checkWorkerID(s.workerID, m)
+}
+
+// serviceTLSConfig only accepts the certificate issued to a service, e.g. tools.VoiceServiceCommonName.
+func serviceTLSConfig(commonName string) *tls.Config {
+	config := tools.WorkerCA.ServerTLSConfig(tls.RequireAndVerifyClientCert)
+	config.VerifyPeerCertificate = func(_ [][]byte, chains [][]*x509.Certificate) error {
+		if len(chains) == 0 || chains[0][0].Subject.CommonName != commonName {
+			return fmt.Errorf("client is not the %s", commonName)
+		}
+		return nil
+	}
+	return config
+}
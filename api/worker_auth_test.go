@@ -0,0 +1,81 @@
+package api
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
+	"encoding/pem"
+	"fmt"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/TUM-Dev/gocast/dao"
+	"github.com/TUM-Dev/gocast/mock_dao"
+	"github.com/TUM-Dev/gocast/model"
+	"github.com/TUM-Dev/gocast/tools"
+	"github.com/TUM-Dev/gocast/worker/pb"
+	"github.com/golang/mock/gomock"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/peer"
+	"google.golang.org/grpc/status"
+)
+
+func TestWorkerUnaryInterceptor(t *testing.T) {
+	dir := t.TempDir()
+	ca, err := tools.LoadOrCreateCA(filepath.Join(dir, "ca.pem"), filepath.Join(dir, "ca-key.pem"), nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	key, _ := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	csr, _ := x509.CreateCertificateRequest(rand.Reader, &x509.CertificateRequest{}, key)
+	_, cert, err := ca.IssueCertificate(pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE REQUEST", Bytes: csr}), "worker-1", nil, time.Hour)
+	if err != nil {
+		t.Fatal(err)
+	}
+	serial := fmt.Sprintf("%x", cert.SerialNumber)
+	ctx := peer.NewContext(context.Background(), &peer.Peer{AuthInfo: credentials.TLSInfo{
+		State: tls.ConnectionState{VerifiedChains: [][]*x509.Certificate{{cert}}},
+	}})
+	revoked := time.Now()
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) { return &pb.Status{Ok: true}, nil }
+	info := &grpc.UnaryServerInfo{FullMethod: pb.FromWorker_SendHeartBeat_FullMethodName}
+
+	tests := map[string]struct {
+		ctx    context.Context
+		stored *model.WorkerCertificate
+		req    interface{}
+		method string
+		code   codes.Code
+	}{
+		"matching worker": {ctx: ctx, stored: &model.WorkerCertificate{WorkerID: "worker-1", NotAfter: cert.NotAfter}, req: &pb.HeartBeat{WorkerID: "worker-1"}, code: codes.OK},
+		"other worker":    {ctx: ctx, stored: &model.WorkerCertificate{WorkerID: "worker-1", NotAfter: cert.NotAfter}, req: &pb.HeartBeat{WorkerID: "worker-2"}, code: codes.PermissionDenied},
+		"revoked":         {ctx: ctx, stored: &model.WorkerCertificate{WorkerID: "worker-1", NotAfter: cert.NotAfter, RevokedAt: &revoked}, req: &pb.HeartBeat{WorkerID: "worker-1"}, code: codes.Unauthenticated},
+		"unknown":         {ctx: ctx, req: &pb.HeartBeat{WorkerID: "worker-1"}, code: codes.Unauthenticated},
+		"no certificate":  {ctx: context.Background(), req: &pb.HeartBeat{WorkerID: "worker-1"}, code: codes.Unauthenticated},
+		"join":            {ctx: context.Background(), req: &pb.JoinWorkersRequest{}, method: pb.FromWorker_JoinWorkers_FullMethodName, code: codes.OK},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			certificateDao := mock_dao.NewMockWorkerCertificateDao(gomock.NewController(t))
+			if tc.stored != nil {
+				certificateDao.EXPECT().GetBySerial(gomock.Any(), serial).Return(*tc.stored, nil)
+			} else {
+				certificateDao.EXPECT().GetBySerial(gomock.Any(), serial).Return(model.WorkerCertificate{}, fmt.Errorf("not found")).AnyTimes()
+			}
+			i := info
+			if tc.method != "" {
+				i = &grpc.UnaryServerInfo{FullMethod: tc.method}
+			}
+			_, err := workerUnaryInterceptor(dao.DaoWrapper{WorkerCertificateDao: certificateDao})(tc.ctx, tc.req, i, handler)
+			if status.Code(err) != tc.code {
+				t.Errorf("expected %s, got %v", tc.code, err)
+			}
+		})
+	}
+}
@@ -3,6 +3,7 @@ package api
 // worker_grpc.go handles communication with workers via grpc
 import (
 	"context"
+	"crypto/tls"
 	"database/sql"
 	"encoding/json"
 	"errors"
@@ -29,7 +30,7 @@ import (
 	uuid "github.com/satori/go.uuid"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/codes"
-	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/credentials"
 	"google.golang.org/grpc/keepalive"
 	"google.golang.org/grpc/reflection"
 	"google.golang.org/grpc/status"
@@ -47,9 +48,8 @@ type server struct {
 }
 
 func dialIn(targetWorker model.Worker) (*grpc.ClientConn, error) {
-	credentials := insecure.NewCredentials()
 	logger.Info("Connecting to:" + fmt.Sprintf("%s:50051", targetWorker.Host))
-	conn, err := grpc.Dial(fmt.Sprintf("%s:50051", targetWorker.Host), grpc.WithTransportCredentials(credentials))
+	conn, err := grpc.Dial(fmt.Sprintf("%s:50051", targetWorker.Host), grpc.WithTransportCredentials(clientCredentials(targetWorker.Host)))
 	return conn, err
 }
 
@@ -70,27 +70,29 @@ func (s server) JoinWorkers(ctx context.Context, request *pb.JoinWorkersRequest)
 	if err != nil && err != gorm.ErrRecordNotFound {
 		return nil, status.Errorf(codes.Internal, "get worker by hostname: %v", err)
 	}
-	if err == nil {
-		// worker already exists, return its ID
-		return &pb.JoinWorkersResponse{
-			WorkerId: worker.WorkerID,
-		}, nil
+	if tools.WorkerCA != nil && request.CertificateRequest == "" {
+		return nil, status.Error(codes.InvalidArgument, "certificate request required")
 	}
-
-	// worker does not exist, create it
-	worker = model.Worker{
-		Host:     request.Hostname,
-		WorkerID: uuid.NewV4().String(),
-		LastSeen: time.Now(),
+	if err != nil {
+		// worker does not exist, create it
+		worker = model.Worker{
+			Host:     request.Hostname,
+			WorkerID: uuid.NewV4().String(),
+			LastSeen: time.Now(),
+		}
+		if err := s.DaoWrapper.WorkerDao.CreateWorker(&worker); err != nil {
+			logger.Error("Could not add worker to database", "err", err)
+			return nil, status.Errorf(codes.Internal, "Could not add worker to database")
+		}
+		logger.Info("Added worker to database")
 	}
-	if err := s.DaoWrapper.WorkerDao.CreateWorker(&worker); err != nil {
-		logger.Error("Could not add worker to database", "err", err)
-		return nil, status.Errorf(codes.Internal, "Could not add worker to database")
+	res := &pb.JoinWorkersResponse{WorkerId: worker.WorkerID}
+	if tools.WorkerCA != nil {
+		if res.Certificate, err = issueWorkerCertificate(ctx, s.DaoWrapper, worker, request.CertificateRequest); err != nil {
+			return nil, err
+		}
 	}
-	logger.Info("Added worker to database")
-	return &pb.JoinWorkersResponse{
-		WorkerId: worker.WorkerID,
-	}, nil
+	return res, nil
 }
 
 // NotifySilenceResults handles the results of silence detection sent by a worker
@@ -153,6 +155,7 @@ func (s server) SendSelfStreamRequest(ctx context.Context, request *pb.SelfStrea
 	}
 	slot.StreamID = stream.ID
 	s.DaoWrapper.IngestServerDao.SaveSlot(slot)
+	output := getLiveOutput(ingestServer, course)
 
 	return &pb.SelfStreamResponse{
 		StreamID:     uint32(stream.ID),
@@ -161,9 +164,14 @@ func (s server) SendSelfStreamRequest(ctx context.Context, request *pb.SelfStrea
 		StreamStart:  timestamppb.New(stream.Start),
 		CourseTerm:   course.TeachingTerm,
 		UploadVoD:    course.VODEnabled,
-		IngestServer: ingestServer.Url,
+		IngestServer: output.ingestServer,
 		StreamName:   slot.StreamName,
-		OutUrl:       ingestServer.OutUrl,
+		OutUrl:       output.outUrl,
+		Renditions:   output.renditions,
+		LowLatency:   output.lowLatency,
+		Dvr:          output.dvr,
+		DvrWindow:    output.dvrWindow,
+		Profile:      getEncodingProfile(course, "COMB"),
 	}, nil
 }
 
@@ -207,6 +215,10 @@ func (s server) NotifyStreamFinished(ctx context.Context, request *pb.StreamFini
 	if _, err := s.DaoWrapper.WorkerDao.GetWorkerByID(ctx, request.GetWorkerID()); err != nil {
 		return nil, errors.New("authentication failed: invalid worker id")
 	} else {
+		if streamFailedOver(s.DaoWrapper, uint(request.StreamID), request.SourceType, request.WorkerID) {
+			logger.Info("Stream continues on another worker", "stream", request.StreamID, "version", request.SourceType, "worker", request.WorkerID)
+			return &pb.Status{Ok: true}, nil
+		}
 		stream, err := s.StreamsDao.GetStreamByID(ctx, fmt.Sprintf("%d", request.StreamID))
 		if err != nil {
 			logger.Error("Can't find stream to set not live", "err", err)
@@ -232,6 +244,8 @@ func (s server) NotifyStreamFinished(ctx context.Context, request *pb.StreamFini
 			logger.Error("Can't set stream not live", "err", err)
 		}
 		NotifyViewersLiveState(uint(request.StreamID), false)
+		completeJobs(s.DaoWrapper, model.JobTypeStream, uint(request.StreamID), request.WorkerID)
+		completeJobs(s.DaoWrapper, model.JobTypePremiere, uint(request.StreamID), request.WorkerID)
 	}
 	return &pb.Status{Ok: true}, nil
 }
@@ -351,10 +365,21 @@ func (s server) SendHeartBeat(ctx context.Context, request *pb.HeartBeat) (*pb.S
 		worker.Disk = request.Disk
 		worker.Uptime = request.Uptime
 		worker.Version = request.Version
+		worker.CpuPercent = request.CpuPercent
+		worker.MemAvailable = request.MemAvailable
+		worker.MemTotal = request.MemTotal
+		worker.DiskUsed = request.DiskUsed
+		worker.DiskTotal = request.DiskTotal
+		worker.Tags = strings.Join(request.Tags, ",")
 		err := s.DaoWrapper.SaveWorker(worker)
 		if err != nil {
 			return nil, err
 		}
+		// the worker is alive regardless, its jobs are only reassigned once their leases expire
+		err = s.DaoWrapper.JobDao.RenewLeases(ctx, worker.WorkerID, time.Now().Add(model.JobLease))
+		if err != nil {
+			logger.Error("Can't renew job leases of worker", "err", err, "worker", worker.WorkerID)
+		}
 		return &pb.Status{Ok: true}, nil
 	}
 }
@@ -369,11 +394,33 @@ func (s server) NotifyTranscodingFinished(ctx context.Context, request *pb.Trans
 		return nil, err
 	}
 
+	if request.Codec != "" {
+		return s.addVodCodecFile(stream, request)
+	}
+
 	err = s.StreamsDao.RemoveTranscodingProgress(model.StreamVersion(request.SourceType), stream.ID)
 	if err != nil {
 		logger.Error("error removing transcoding progress", "err", err)
 	}
 
+	// parts of streams that failed over are published once they are stitched
+	part, isPart, err := getRecordingPart(s.DaoWrapper, stream.ID, request.SourceType, request.WorkerID)
+	if err != nil {
+		return nil, err
+	}
+	if (isPart && !part.Stitched) || request.Partial {
+		if !isPart || part.Stitched {
+			logger.Warn("Recording part arrived after stitching, it's not part of the VoD", "stream", stream.ID, "file", request.FilePath)
+			return &pb.Status{Ok: true}, nil
+		}
+		part.FilePath = request.FilePath
+		if err = s.DaoWrapper.RecordingPartDao.Save(ctx, &part); err != nil {
+			return nil, err
+		}
+		stitchRecording(s.DaoWrapper, stream.ID, request.SourceType, time.Now())
+		return &pb.Status{Ok: true}, nil
+	}
+
 	// look for file to prevent duplication
 	shouldAddFile := true
 	for _, file := range stream.Files {
@@ -394,6 +441,31 @@ func (s server) NotifyTranscodingFinished(ctx context.Context, request *pb.Trans
 		logger.Error("Can't save stream", "err", err)
 		return nil, err
 	}
+	// SaveStream only writes the columns it knows
+	if loudness := request.GetLoudness(); loudness != nil {
+		if err = s.DaoWrapper.StreamsDao.SaveLoudness(stream.ID, loudness.GetIntegrated(), loudness.GetTruePeak()); err != nil {
+			logger.Error("Can't save loudness of stream", "err", err, "stream", stream.ID)
+			return nil, err
+		}
+	}
+	return &pb.Status{Ok: true}, nil
+}
+
+// addVodCodecFile saves the additional rendition of the VoD of stream a worker encoded.
+func (s server) addVodCodecFile(stream model.Stream, request *pb.TranscodingFinished) (*pb.Status, error) {
+	fileType, ok := model.VodCodecFileTypes[request.Codec]
+	if !ok {
+		return nil, fmt.Errorf("unknown codec %q", request.Codec)
+	}
+	for _, file := range stream.Files {
+		if file.Path == request.FilePath {
+			return &pb.Status{Ok: true}, nil
+		}
+	}
+	stream.Files = append(stream.Files, model.File{StreamID: stream.ID, Path: request.FilePath, Type: fileType})
+	if err := s.DaoWrapper.StreamsDao.SaveStream(&stream); err != nil {
+		return nil, err
+	}
 	return &pb.Status{Ok: true}, nil
 }
 
@@ -416,6 +488,10 @@ func (s server) NotifyUploadFinished(ctx context.Context, req *pb.UploadFinished
 		logger.Warn("VoD not saved, stream is live.", "req", req)
 		return nil, nil
 	}
+	if hasUnstitchedParts(s.DaoWrapper, stream.ID, req.SourceType) {
+		logger.Warn("VoD not saved, the recording parts of the stream are not stitched yet.", "req", req)
+		return &pb.Status{Ok: true}, nil
+	}
 	stream.Recording = true
 	stream.Private = course.VodPrivate
 	switch req.SourceType {
@@ -470,51 +546,18 @@ func (s server) NotifyThumbnailsFinished(ctx context.Context, req *pb.Thumbnails
 		return nil, err
 	}
 
-	go generateCombinedThumb(stream.ID, s.DaoWrapper)
+	generateCombinedThumb(stream.ID, s.DaoWrapper)
 	return &pb.Status{Ok: true}, nil
 }
 
-// generateCombinedThumb generates a combined thumbnail from the two source thumbnails CAM and PRES if both exist
-func generateCombinedThumb(streamID uint, dao dao.DaoWrapper) {
-	stream, err := dao.StreamsDao.GetStreamByID(context.Background(), fmt.Sprintf("%d", streamID))
-	if err != nil {
-		logger.Warn("error getting stream", "err", err)
-		return
-	}
-	var thumbCam, thumbPres string
-	for _, file := range stream.Files {
-		if file.Type == model.FILETYPE_THUMB_LG_CAM {
-			thumbCam = file.Path
-		}
-		if file.Type == model.FILETYPE_THUMB_LG_PRES {
-			thumbPres = file.Path
-		}
-	}
-	if thumbCam == "" || thumbPres == "" {
-		return // nothing to do
-	}
-	workers := dao.GetAliveWorkers()
-	if len(workers) == 0 {
-		return
-	}
-	w := workers[getWorkerWithLeastWorkload(workers)]
-	wConn, err := dialIn(w)
-	if err != nil {
-		logger.Warn("error dialing in", "err", err)
-		return
+// generateCombinedThumb requests a combined thumbnail from the two source thumbnails CAM and PRES if both exist
+func generateCombinedThumb(streamID uint, daoWrapper dao.DaoWrapper) {
+	job, err := model.NewJob(model.JobTypeCombineThumbnails, streamID, nil)
+	if err == nil {
+		err = enqueueJob(daoWrapper, job)
 	}
-	client := pb.NewToWorkerClient(wConn)
-	thumbnails, err := client.CombineThumbnails(context.Background(), &pb.CombineThumbnailsRequest{
-		PrimaryThumbnail:   thumbPres,
-		SecondaryThumbnail: thumbCam,
-		Path:               strings.ReplaceAll(thumbPres, "PRES", "CAM_PRES"),
-	})
 	if err != nil {
-		logger.Warn("error combining thumbnails", "err", err)
-		return
-	}
-	if err := dao.FileDao.SetThumbnail(stream.ID, model.File{StreamID: stream.ID, Path: thumbnails.FilePath, Type: model.FILETYPE_THUMB_LG_CAM_PRES}); err != nil {
-		logger.Warn("error saving thumbnail", "err", err)
+		logger.Warn("error enqueueing thumbnail combination", "err", err)
 	}
 }
 
@@ -545,6 +588,7 @@ func (s server) GetStreamInfoForUpload(ctx context.Context, request *pb.GetStrea
 		StreamEnd:   timestamppb.New(key.Stream.End),
 		StreamID:    uint32(key.StreamID),
 		VideoType:   string(key.VideoType),
+		SlideText:   tools.Cfg.OcrService != nil,
 	}, nil
 }
 
@@ -615,6 +659,11 @@ func (s server) NotifyStreamStarted(ctx context.Context, request *pb.StreamStart
 		default:
 			s.StreamsDao.SaveCOMBURL(&stream, request.HlsUrl)
 		}
+		if dvrUrl := tools.DvrPlaylistURL(worker.Host, stream.ID, request.GetSourceType()); dvrUrl != "" {
+			if err := s.StreamsDao.SaveDvrURL(&stream, request.GetSourceType(), dvrUrl); err != nil {
+				logger.Error("Can't save DVR playlist", "err", err)
+			}
+		}
 		NotifyViewersLiveState(stream.Model.ID, true)
 		NotifyLiveUpdateCourseWentLive(stream.Model.ID)
 	}()
@@ -644,6 +693,10 @@ func (s server) NotifyTranscodingProgress(srv pb.FromWorker_NotifyTranscodingPro
 	}
 }
 
+// variantPlaylistRe matches the first playlist referenced by a master playlist
+var variantPlaylistRe = regexp.MustCompile(`(?m)^[^#\s]\S*\.m3u8\S*`)
+
+// isHlsUrlOk checks whether the first variant of the master playlist at url is available.
 func isHlsUrlOk(url string) bool {
 	r, err := http.Get(url)
 	if err != nil {
@@ -653,13 +706,15 @@ func isHlsUrlOk(url string) bool {
 	if err != nil {
 		return false
 	}
-	re, _ := regexp.Compile(`chunklist.*\.m3u8`)
-	x := re.Find(all)
+	x := variantPlaylistRe.Find(all)
 	if x == nil {
 		return false
 	}
-	y := strings.ReplaceAll(r.Request.URL.String(), "playlist.m3u8", string(x))
-	get, err := http.Get(y)
+	variant, err := r.Request.URL.Parse(string(x))
+	if err != nil {
+		return false
+	}
+	get, err := http.Get(variant.String())
 	if err != nil {
 		return false
 	}
@@ -669,76 +724,127 @@ func isHlsUrlOk(url string) bool {
 	return true
 }
 
-func CreateStreamRequest(daoWrapper dao.DaoWrapper, stream model.Stream, course model.Course, workers []model.Worker, sourceType string, source string) {
+// CreateStreamRequest enqueues a job that streams the source of a lecture hall unless the stream is already requested.
+func CreateStreamRequest(daoWrapper dao.DaoWrapper, stream model.Stream, sourceType string, source string) {
 	if source == "" {
 		return
 	}
-	server, err := daoWrapper.IngestServerDao.GetBestIngestServer()
+	unfinished, err := daoWrapper.JobDao.GetUnfinished(context.Background(), model.JobTypeStream, stream.ID)
 	if err != nil {
-		logger.Error("Can't find ingest server", "err", err)
+		logger.Error("Can't get stream jobs", "err", err)
 		return
 	}
-	var slot model.StreamName
-	if sourceType == "COMB" { // try to find a transcoding slot for comb view:
-		slot, err = daoWrapper.IngestServerDao.GetTranscodedStreamSlot(server.ID)
-	}
-	if sourceType != "COMB" || err != nil {
-		slot, err = daoWrapper.IngestServerDao.GetStreamSlot(server.ID)
-		if err != nil {
-			logger.Error("No free stream slot", "err", err)
-			return
+	for _, job := range unfinished {
+		if job.Version == sourceType {
+			return // already requested
 		}
 	}
-	slot.StreamID = stream.ID
-	daoWrapper.IngestServerDao.SaveSlot(slot)
-	req := pb.StreamRequest{
-		SourceType:   sourceType,
-		SourceUrl:    source,
-		CourseSlug:   course.Slug,
-		Start:        timestamppb.New(stream.Start),
-		End:          timestamppb.New(stream.End),
-		PublishVoD:   course.VODEnabled,
-		StreamID:     uint32(stream.ID),
-		CourseTerm:   course.TeachingTerm,
-		CourseYear:   uint32(course.Year),
-		StreamName:   slot.StreamName,
-		IngestServer: server.Url,
-		OutUrl:       server.OutUrl,
-	}
-	workerIndex := getWorkerWithLeastWorkload(workers)
-	workers[workerIndex].Workload += 3
-	err = daoWrapper.StreamsDao.SaveWorkerForStream(stream, workers[workerIndex])
+	job, err := model.NewJob(model.JobTypeStream, stream.ID, streamJobPayload{SourceType: sourceType, Source: source})
 	if err != nil {
-		logger.Error("Could not save worker for stream", "err", err)
+		logger.Error("Can't create stream job", "err", err)
 		return
 	}
-	conn, err := dialIn(workers[workerIndex])
-	if err != nil {
-		logger.Error("Unable to dial server", "err", err)
-		workers[workerIndex].Workload -= 1 // decrease workers load only by one (backoff)
-		return
+	job.Version = sourceType
+	job.ExpiresAt = streamJobExpiry(stream)
+	if err = enqueueJob(daoWrapper, job); err != nil {
+		logger.Error("Can't enqueue stream job", "err", err)
+	}
+}
+
+// streamJobExpiry returns when a job for stream is pointless: once the stream is over.
+func streamJobExpiry(stream model.Stream) *time.Time {
+	if !stream.End.After(stream.Start) {
+		return nil
+	}
+	end := stream.End
+	return &end
+}
+
+// liveOutput describes where a worker pushes a live stream to and where viewers can watch it.
+type liveOutput struct {
+	ingestServer string
+	outUrl       string
+	renditions   []*pb.Rendition
+	lowLatency   bool
+	dvr          bool
+	dvrWindow    uint32 // seconds
+}
+
+// getLiveOutput returns how a live stream of course is pushed to server.
+// Low latency courses push a single rendition to the LL-HLS ingest of servers supporting it, all others the ladder.
+func getLiveOutput(server model.IngestServer, course model.Course) liveOutput {
+	output := liveOutput{
+		ingestServer: server.Url,
+		dvr:          tools.Cfg.Dvr != nil,
+		dvrWindow:    uint32(tools.DvrWindow().Seconds()),
+	}
+	if course.LowLatency && server.SupportsLowLatency() {
+		output.ingestServer, output.outUrl, output.lowLatency = server.LowLatencyUrl, server.LowLatencyOutUrl, true
+		return output
+	}
+	output.renditions = getLiveRenditions(server)
+	output.outUrl = server.GetOutUrl(len(output.renditions) > 0)
+	return output
+}
+
+// getLiveRenditions returns the configured adaptive bitrate ladder for a stream pushed to server.
+// Servers that can't build a master playlist get no ladder and a single rendition is pushed instead.
+func getLiveRenditions(server model.IngestServer) []*pb.Rendition {
+	if !server.SupportsRenditions() {
+		return nil
+	}
+	renditions := make([]*pb.Rendition, 0, len(tools.Cfg.LiveLadder))
+	for _, r := range tools.Cfg.LiveLadder {
+		renditions = append(renditions, &pb.Rendition{
+			Name:         r.Name,
+			Height:       r.Height,
+			VideoBitrate: r.VideoBitrate,
+			AudioBitrate: r.AudioBitrate,
+		})
+	}
+	return renditions
+}
+
+// getEncodingProfile returns the encoding profile course selected, or the default profile of sourceType if it selected
+// none or one that isn't configured anymore. Nil lets the worker use its built-in settings.
+func getEncodingProfile(course model.Course, sourceType string) *pb.EncodingProfile {
+	name := course.EncodingProfile
+	profile, ok := tools.Cfg.EncodingProfiles[name]
+	if !ok {
+		if name != "" {
+			logger.Warn("encoding profile of course is not configured", "course", course.ID, "profile", name)
+		}
+		// viper lowercases the keys of maps
+		name = tools.Cfg.DefaultEncodingProfiles[strings.ToLower(sourceType)]
+		if profile, ok = tools.Cfg.EncodingProfiles[name]; !ok {
+			return nil
+		}
 	}
-	client := pb.NewToWorkerClient(conn)
-	req.WorkerId = workers[workerIndex].WorkerID
-	resp, err := client.RequestStream(context.Background(), &req)
-	if err != nil || !resp.Ok {
-		logger.Error("could not assign stream!", "err", err)
-		workers[workerIndex].Workload -= 1 // decrease workers load only by one (backoff)
+	return &pb.EncodingProfile{
+		Name:            name,
+		VideoCodec:      profile.VideoCodec,
+		Crf:             profile.Crf,
+		VideoBitrate:    profile.VideoBitrate,
+		Preset:          profile.Preset,
+		Tune:            profile.Tune,
+		Height:          profile.Height,
+		AudioCodec:      profile.AudioCodec,
+		AudioBitrate:    profile.AudioBitrate,
+		AudioChannels:   profile.AudioChannels,
+		AudioSampleRate: profile.AudioSampleRate,
+		VodCodecs:       profile.VodCodecs,
 	}
-	endConnection(conn)
 }
 
 // NotifyWorkers collects all streams that are due to stream
 // (starts in the next 10 minutes from a lecture hall)
-// and invokes the corresponding calls at the workers with the least workload via gRPC
+// and enqueues the corresponding jobs that are dispatched to the workers with the least workload
 func NotifyWorkers(daoWrapper dao.DaoWrapper) func() {
 	return func() {
 		notifyWorkersPremieres(daoWrapper)
 		streams := daoWrapper.StreamsDao.GetDueStreamsForWorkers()
-		workers := daoWrapper.WorkerDao.GetAliveWorkers()
-		if len(workers) == 0 && len(streams) != 0 {
-			logger.Error("not enough workers to handle streams")
-			return
+		if len(streams) != 0 && len(daoWrapper.WorkerDao.GetAliveWorkers()) == 0 {
+			logger.Error("not enough workers to handle streams, jobs stay queued")
 		}
 		for i := range streams {
 			err := daoWrapper.StreamsDao.SaveEndedState(streams[i].ID, false)
@@ -763,33 +869,35 @@ func NotifyWorkers(daoWrapper dao.DaoWrapper) func() {
 			switch courseForStream.GetSourceModeForLectureHall(streams[i].LectureHallID) {
 			// SourceMode == 1 -> Presentation Only
 			case 1:
-				CreateStreamRequest(daoWrapper, streams[i], courseForStream, workers, "PRES", lectureHallForStream.PresIP)
+				CreateStreamRequest(daoWrapper, streams[i], "PRES", lectureHallForStream.PresIP)
 				return
 			// SourceMode == 2 -> Camera Only
 			case 2:
-				CreateStreamRequest(daoWrapper, streams[i], courseForStream, workers, "CAM", lectureHallForStream.CamIP)
+				CreateStreamRequest(daoWrapper, streams[i], "CAM", lectureHallForStream.CamIP)
 				return
 			// SourceMode != 1,2 -> Combination view
 			default:
-				CreateStreamRequest(daoWrapper, streams[i], courseForStream, workers, "PRES", lectureHallForStream.PresIP)
-				CreateStreamRequest(daoWrapper, streams[i], courseForStream, workers, "CAM", lectureHallForStream.CamIP)
-				CreateStreamRequest(daoWrapper, streams[i], courseForStream, workers, "COMB", lectureHallForStream.CombIP)
+				CreateStreamRequest(daoWrapper, streams[i], "PRES", lectureHallForStream.PresIP)
+				CreateStreamRequest(daoWrapper, streams[i], "CAM", lectureHallForStream.CamIP)
+				CreateStreamRequest(daoWrapper, streams[i], "COMB", lectureHallForStream.CombIP)
 			}
 		}
 	}
 }
 
-// notifyWorkersPremieres looks for premieres that should be streamed and assigns them to workers.
+// notifyWorkersPremieres looks for premieres that should be streamed and enqueues them.
 func notifyWorkersPremieres(daoWrapper dao.DaoWrapper) {
 	streams := daoWrapper.StreamsDao.GetDuePremieresForWorkers()
-	workers := daoWrapper.WorkerDao.GetAliveWorkers()
-
-	if len(workers) == 0 && len(streams) != 0 {
-		logger.Error("Not enough alive workers for premiere")
-		return
-	}
 	for i := range streams {
-		err := daoWrapper.StreamsDao.SaveEndedState(streams[i].ID, false)
+		unfinished, err := daoWrapper.JobDao.GetUnfinished(context.Background(), model.JobTypePremiere, streams[i].ID)
+		if err != nil {
+			logger.Error("Can't get premiere jobs", "err", err)
+			continue
+		}
+		if len(unfinished) != 0 {
+			continue // already requested
+		}
+		err = daoWrapper.StreamsDao.SaveEndedState(streams[i].ID, false)
 		if err != nil {
 			logger.Warn("Can't set stream undone", "err", err)
 			sentry.CaptureException(err)
@@ -799,38 +907,20 @@ func notifyWorkersPremieres(daoWrapper dao.DaoWrapper) {
 			logger.Warn("Request to self stream without file", "streamID", streams[i].ID)
 			continue
 		}
-		workerIndex := getWorkerWithLeastWorkload(workers)
-		workers[workerIndex].Workload += 3
-		ingestServer, err := daoWrapper.IngestServerDao.GetBestIngestServer()
-		if err != nil {
-			logger.Error("Can't find ingest server", "err", err)
-			continue
-		}
-		req := pb.PremiereRequest{
-			StreamID:     uint32(streams[i].ID),
-			FilePath:     streams[i].Files[0].Path,
-			IngestServer: ingestServer.Url,
-			OutUrl:       ingestServer.OutUrl,
-		}
-		conn, err := dialIn(workers[workerIndex])
+		job, err := model.NewJob(model.JobTypePremiere, streams[i].ID, nil)
 		if err != nil {
-			logger.Error("Unable to dial server", "err", err)
-			endConnection(conn)
-			workers[workerIndex].Workload -= 1
+			logger.Error("Can't create premiere job", "err", err)
 			continue
 		}
-		client := pb.NewToWorkerClient(conn)
-		req.WorkerID = workers[workerIndex].WorkerID
-		resp, err := client.RequestPremiere(context.Background(), &req)
-		if err != nil || !resp.Ok {
-			logger.Error("could not assign premiere!", "err", err)
-			workers[workerIndex].Workload -= 1
+		job.ExpiresAt = streamJobExpiry(streams[i])
+		if err = enqueueJob(daoWrapper, job); err != nil {
+			logger.Error("Can't enqueue premiere job", "err", err)
 		}
-		endConnection(conn)
 	}
 }
 
 // FetchLivePreviews gets a live thumbnail from a worker.
+// Previews are outdated after a minute, so unlike other worker RPCs they are not persisted as jobs.
 func FetchLivePreviews(daoWrapper dao.DaoWrapper) func() {
 	return func() {
 		workers := daoWrapper.WorkerDao.GetAliveWorkers()
@@ -845,25 +935,26 @@ func FetchLivePreviews(daoWrapper dao.DaoWrapper) func() {
 			if s.PlaylistUrl == "" {
 				continue
 			}
-			workerIndex := getWorkerWithLeastWorkload(workers)
-			if len(workers) == 0 {
+			worker, reason := scheduleWorker(workers, schedulingRequest{cost: 1, requiredTags: requiredTags("livePreview")})
+			if worker == nil {
+				logger.Warn("Can't fetch live preview", "reason", reason)
 				return
 			}
-			conn, err := dialIn(workers[workerIndex])
+			conn, err := dialIn(*worker)
 			if err != nil {
 				logger.Error("Could not connect to worker", "err", err)
 				endConnection(conn)
 				continue
 			}
 			client := pb.NewToWorkerClient(conn)
-			workers[workerIndex].Workload += 1
-			if err := getLivePreviewFromWorker(&s, workers[workerIndex].WorkerID, client); err != nil {
-				workers[workerIndex].Workload -= 1
+			worker.Workload += 1
+			if err := getLivePreviewFromWorker(&s, worker.WorkerID, client); err != nil {
+				worker.Workload -= 1
 				logger.Error("Could not generate live preview", "err", err)
 				endConnection(conn)
 				continue
 			}
-			workers[workerIndex].Workload -= 1
+			worker.Workload -= 1
 		}
 		return
 	}
@@ -897,120 +988,37 @@ func getLivePreviewFromWorker(s *model.Stream, workerID string, client pb.ToWork
 
 // RegenerateThumbs regenerates the thumbnails for the timeline. This is useful for video with faulty thumbnails
 // and for VoDs that were created before the thumbnail feature.
-func RegenerateThumbs(daoWrapper dao.DaoWrapper, file model.File, stream *model.Stream, course *model.Course) error {
-	workers := daoWrapper.WorkerDao.GetAliveWorkers()
-	workerIndex := getWorkerWithLeastWorkload(workers)
-	if len(workers) == 0 {
-		return errors.New("no workers available")
-	}
-	conn, err := dialIn(workers[workerIndex])
-	defer func() {
-		endConnection(conn)
-	}()
+func RegenerateThumbs(daoWrapper dao.DaoWrapper, file model.File, stream *model.Stream) error {
+	job, err := model.NewJob(model.JobTypeThumbnails, stream.ID, thumbnailsJobPayload{Path: file.Path})
 	if err != nil {
-		logger.Error("Unable to dial server", "err", err)
 		return err
 	}
-	client := pb.NewToWorkerClient(conn)
-	res, err := client.GenerateThumbnails(context.Background(),
-		&pb.GenerateThumbnailRequest{
-			Path:          file.Path,
-			WorkerID:      workers[workerIndex].WorkerID,
-			StreamID:      uint32(stream.ID),
-			StreamVersion: file.GetVodTypeByName(),
-			CourseSlug:    course.Slug,
-			CourseYear:    uint32(course.Year),
-			TeachingTerm:  course.TeachingTerm,
-			Start:         timestamppb.New(stream.Start),
-		})
-	if !res.Ok {
-		logger.Error("did not get response from worker for thumbnail generation request", "err", err)
-	}
-
-	return nil
-}
-
-type generateVideoSectionImagesParameters struct {
-	sections                                    []model.VideoSection
-	playlistUrl, courseName, courseTeachingTerm string
-	courseYear                                  uint32
+	job.Version = file.GetVodTypeByName()
+	return enqueueJob(daoWrapper, job)
 }
 
-func DeleteVideoSectionImage(workerDao dao.WorkerDao, path string) error {
-	workers := workerDao.GetAliveWorkers()
-	if len(workers) == 0 {
-		return errors.New("no workers available")
-	}
-	workerIndex := getWorkerWithLeastWorkload(workers)
-	conn, err := dialIn(workers[workerIndex])
-	defer func() {
-		endConnection(conn)
-	}()
+// DeleteVideoSectionImage deletes the image of a video section at path.
+func DeleteVideoSectionImage(daoWrapper dao.DaoWrapper, path string) error {
+	job, err := model.NewJob(model.JobTypeDeleteSectionImage, 0, deleteSectionImageJobPayload{Path: path})
 	if err != nil {
-		logger.Error("Unable to dial server", "err", err)
 		return err
 	}
-
-	client := pb.NewToWorkerClient(conn)
-
-	_, err = client.DeleteSectionImage(context.Background(), &pb.DeleteSectionImageRequest{Path: path})
-	return err
+	return enqueueJob(daoWrapper, job)
 }
 
-func GenerateVideoSectionImages(daoWrapper dao.DaoWrapper, parameters *generateVideoSectionImagesParameters) error {
-	workers := daoWrapper.WorkerDao.GetAliveWorkers()
-	if len(workers) == 0 {
-		return errors.New("no workers available")
-	}
-	workerIndex := getWorkerWithLeastWorkload(workers)
-	conn, err := dialIn(workers[workerIndex])
-	defer func() {
-		endConnection(conn)
-	}()
-	if err != nil {
-		logger.Error("Unable to dial server", "err", err)
-		return err
-	}
-
-	client := pb.NewToWorkerClient(conn)
-
-	// collect timestamps
-	sectionTimestamps := make([]*pb.Section, len(parameters.sections))
-	for i, section := range parameters.sections {
-		sectionTimestamps[i] = &pb.Section{
-			Hours:   uint32(section.StartHours),
-			Minutes: uint32(section.StartMinutes),
-			Seconds: uint32(section.StartSeconds),
-		}
-	}
-
-	// make request
-	res, err := client.GenerateSectionImages(context.Background(), &pb.GenerateSectionImageRequest{
-		PlaylistURL:        parameters.playlistUrl,
-		CourseName:         parameters.courseName,
-		CourseYear:         parameters.courseYear,
-		CourseTeachingTerm: parameters.courseTeachingTerm,
-		Sections:           sectionTimestamps,
-	})
+// GenerateVideoSectionImages (re-)generates the images of all video sections of a stream.
+func GenerateVideoSectionImages(daoWrapper dao.DaoWrapper, streamID uint) error {
+	job, err := model.NewJob(model.JobTypeSectionImages, streamID, nil)
 	if err != nil {
 		return err
 	}
-
-	// update database
-	for i, section := range parameters.sections {
-		imageFile := model.File{StreamID: section.StreamID, Path: res.Paths[i], Type: model.FILETYPE_IMAGE_JPG}
-		if err := daoWrapper.FileDao.NewFile(&imageFile); err != nil {
-			return err
-		}
-
-		update := model.VideoSection{Model: gorm.Model{ID: section.ID}, FileID: imageFile.ID}
-		if err := daoWrapper.VideoSectionDao.Update(&update); err != nil {
-			return err
-		}
-	}
-	return nil
+	return enqueueJob(daoWrapper, job)
 }
 
+// streamEndJobTimeout is how long a worker has to receive a request to stop a stream.
+// The worker stops at the scheduled end of the stream anyway.
+const streamEndJobTimeout = time.Minute * 10
+
 // NotifyWorkersToStopStream notifies all workers for a given stream to quit encoding
 func NotifyWorkersToStopStream(stream model.Stream, discardVoD bool, daoWrapper dao.DaoWrapper) {
 	workers, err := daoWrapper.StreamsDao.GetWorkersForStream(stream)
@@ -1024,24 +1032,19 @@ func NotifyWorkersToStopStream(stream model.Stream, discardVoD bool, daoWrapper
 		return
 	}
 
-	// Iterate over all workers that are used for the given stream
+	// Enqueue a job for all workers that are used for the given stream
+	expiry := time.Now().Add(streamEndJobTimeout)
 	for _, currentWorker := range workers {
-		req := pb.EndStreamRequest{
-			StreamID:   uint32(stream.ID),
-			WorkerID:   currentWorker.WorkerID,
-			DiscardVoD: discardVoD,
-		}
-		conn, err := dialIn(currentWorker)
+		job, err := model.NewJob(model.JobTypeStreamEnd, stream.ID, streamEndJobPayload{DiscardVoD: discardVoD})
 		if err != nil {
-			logger.Error("Unable to dial server", "err", err)
+			logger.Error("Could not create stream end job", "err", err)
 			continue
 		}
-		client := pb.NewToWorkerClient(conn)
-		resp, err := client.RequestStreamEnd(context.Background(), &req)
-		if err != nil || !resp.Ok {
+		job.TargetWorkerID = currentWorker.WorkerID
+		job.ExpiresAt = &expiry
+		if err = enqueueJob(daoWrapper, job); err != nil {
 			logger.Error("Could not end stream", "err", err)
 		}
-		endConnection(conn)
 	}
 
 	// All workers for stream are assumed to be done
@@ -1077,18 +1080,6 @@ func (s server) NotifyTranscodingFailure(ctx context.Context, request *pb.Notify
 	return &pb.NotifyTranscodingFailureResponse{}, err
 }
 
-// getWorkerWithLeastWorkload Gets the index of the worker from workers with the least workload.
-// workers must not be empty!
-func getWorkerWithLeastWorkload(workers []model.Worker) int {
-	foundWorker := 0
-	for i := range workers {
-		if workers[i].Workload < workers[foundWorker].Workload {
-			foundWorker = i
-		}
-	}
-	return foundWorker
-}
-
 // ServeWorkerGRPC initializes a gRPC server on port 50052
 func ServeWorkerGRPC() {
 	logger.Info("Serving heartbeat")
@@ -1097,14 +1088,23 @@ func ServeWorkerGRPC() {
 		logger.Error("Failed to init grpc server", "err", err)
 		return
 	}
-	grpcServer := grpc.NewServer(grpc.KeepaliveParams(keepalive.ServerParameters{
+	daoWrapper := dao.NewDaoWrapper()
+	opts := []grpc.ServerOption{grpc.KeepaliveParams(keepalive.ServerParameters{
 		MaxConnectionIdle:     time.Minute,
 		MaxConnectionAge:      time.Minute * 5,
 		MaxConnectionAgeGrace: time.Second * 5,
 		Time:                  time.Minute * 10,
 		Timeout:               time.Second * 20,
-	}))
-	pb.RegisterFromWorkerServer(grpcServer, &server{DaoWrapper: dao.NewDaoWrapper()})
+	})}
+	if tools.WorkerCA != nil {
+		// workers without a certificate may only join
+		opts = append(opts,
+			grpc.Creds(credentials.NewTLS(tools.WorkerCA.ServerTLSConfig(tls.VerifyClientCertIfGiven))),
+			grpc.UnaryInterceptor(workerUnaryInterceptor(daoWrapper)),
+			grpc.StreamInterceptor(workerStreamInterceptor(daoWrapper)))
+	}
+	grpcServer := grpc.NewServer(opts...)
+	pb.RegisterFromWorkerServer(grpcServer, &server{DaoWrapper: daoWrapper})
 	reflection.Register(grpcServer)
 	go func() {
 		if err = grpcServer.Serve(lis); err != nil {
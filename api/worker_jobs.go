@@ -0,0 +1,628 @@
+package api
+
+// worker_jobs.go dispatches persisted jobs to workers
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/TUM-Dev/gocast/dao"
+	"github.com/TUM-Dev/gocast/model"
+	"github.com/TUM-Dev/gocast/tools"
+	"github.com/TUM-Dev/gocast/worker/pb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+	"gorm.io/gorm"
+)
+
+// jobDispatchMutex serializes dispatching within this instance, dao.JobDao.Claim ensures that other instances
+// don't dispatch the same job.
+var jobDispatchMutex = sync.Mutex{}
+
+// maxJobsPerDispatch limits the number of jobs sent to workers in one run of the dispatcher.
+const maxJobsPerDispatch = 100
+
+type streamJobPayload struct {
+	SourceType string
+	Source     string
+	SlotID     uint // stream slot of the first attempt, kept when the stream fails over to another worker
+	Part       uint // recording part of the current attempt, incremented with every failover
+}
+
+type streamEndJobPayload struct {
+	DiscardVoD bool
+	FailedOver bool
+}
+
+type thumbnailsJobPayload struct {
+	Path string
+}
+
+type deleteSectionImageJobPayload struct {
+	Path string
+}
+
+type stitchRecordingJobPayload struct {
+	Parts []string
+}
+
+type cutClipJobPayload struct {
+	Slug string
+}
+
+// enqueueJob persists a job and dispatches it right away.
+func enqueueJob(daoWrapper dao.DaoWrapper, job model.Job) error {
+	if err := daoWrapper.JobDao.Create(context.Background(), &job); err != nil {
+		return err
+	}
+	go dispatchJobs(daoWrapper)
+	return nil
+}
+
+// DispatchJobs retries the jobs of workers whose lease expired and sends all due jobs to workers.
+func DispatchJobs(daoWrapper dao.DaoWrapper) func() {
+	return func() {
+		dispatchJobs(daoWrapper)
+	}
+}
+
+func dispatchJobs(daoWrapper dao.DaoWrapper) {
+	jobDispatchMutex.Lock()
+	defer jobDispatchMutex.Unlock()
+
+	ctx := context.Background()
+	failoverStreams(daoWrapper, time.Now())
+	expired, err := daoWrapper.JobDao.GetExpiredLeases(ctx)
+	if err != nil {
+		logger.Error("Can't get jobs with expired leases", "err", err)
+		return
+	}
+	for i := range expired {
+		expired[i].Fail(fmt.Errorf("lease of worker %s expired", expired[i].WorkerID), time.Now())
+		if err := daoWrapper.JobDao.Save(ctx, &expired[i]); err != nil {
+			logger.Error("Can't save job", "err", err, "job", expired[i].ID)
+		}
+	}
+
+	jobs, err := daoWrapper.JobDao.GetDue(ctx, maxJobsPerDispatch)
+	if err != nil {
+		logger.Error("Can't get due jobs", "err", err)
+		return
+	}
+	if len(jobs) == 0 {
+		return
+	}
+	workers := daoWrapper.WorkerDao.GetAliveWorkers()
+	active, err := daoWrapper.JobDao.GetActive(ctx)
+	if err != nil {
+		logger.Error("Can't get active jobs", "err", err)
+		return
+	}
+	placement := newJobPlacement(workers, active)
+	for i := range jobs {
+		if jobs[i].Expired(time.Now()) {
+			_ = jobs[i].Cancel("job expired")
+			if err := daoWrapper.JobDao.Save(ctx, &jobs[i]); err != nil {
+				logger.Error("Can't save job", "err", err, "job", jobs[i].ID)
+			}
+			continue
+		}
+		worker, reason := selectWorkerForJob(daoWrapper, jobs[i], workers, placement)
+		if worker == nil {
+			logger.Info("Job stays queued", "job", jobs[i].ID, "type", jobs[i].Type, "reason", reason)
+			continue
+		}
+		jobs[i].Assign(worker.WorkerID, time.Now())
+		claimed, err := daoWrapper.JobDao.Claim(ctx, &jobs[i])
+		if err != nil {
+			logger.Error("Can't claim job", "err", err, "job", jobs[i].ID)
+			continue
+		}
+		if !claimed {
+			continue
+		}
+		logger.Info("Scheduled job", "job", jobs[i].ID, "type", jobs[i].Type, "worker", worker.WorkerID, "host", worker.Host, "reason", reason)
+		worker.Workload += jobCost(jobs[i].Type)
+		placement.add(jobs[i], *worker)
+		go runJob(daoWrapper, jobs[i], *worker)
+	}
+}
+
+// jobPlacement tracks where the streams of assigned and running jobs are placed.
+type jobPlacement struct {
+	streamWorkers map[uint][]string        // ids of the workers running jobs of each stream
+	hostStreams   map[string]map[uint]bool // ids of the streams running on each host
+}
+
+func newJobPlacement(workers []model.Worker, active []model.Job) jobPlacement {
+	p := jobPlacement{streamWorkers: map[uint][]string{}, hostStreams: map[string]map[uint]bool{}}
+	for _, job := range active {
+		for _, w := range workers {
+			if w.WorkerID == job.WorkerID {
+				p.add(job, w)
+			}
+		}
+	}
+	return p
+}
+
+// add records that job is placed on worker.
+func (p jobPlacement) add(job model.Job, worker model.Worker) {
+	if job.Type != model.JobTypeStream && job.Type != model.JobTypePremiere {
+		return
+	}
+	p.streamWorkers[job.StreamID] = append(p.streamWorkers[job.StreamID], worker.WorkerID)
+	if p.hostStreams[worker.Host] == nil {
+		p.hostStreams[worker.Host] = map[uint]bool{}
+	}
+	p.hostStreams[worker.Host][job.StreamID] = true
+}
+
+// selectWorkerForJob returns the worker that should run the job and the reason for it.
+// Jobs with a target worker run on it as long as it's alive.
+func selectWorkerForJob(daoWrapper dao.DaoWrapper, job model.Job, workers []model.Worker, placement jobPlacement) (*model.Worker, string) {
+	if job.TargetWorkerID != "" {
+		for i := range workers {
+			if workers[i].WorkerID == job.TargetWorkerID {
+				return &workers[i], "target worker of the job"
+			}
+		}
+		return nil, "target worker is not alive"
+	}
+	req := schedulingRequest{
+		cost:         jobCost(job.Type),
+		requiredTags: requiredTags(string(job.Type)),
+		streamID:     job.StreamID,
+		affinity:     placement.streamWorkers[job.StreamID],
+		hostStreams:  placement.hostStreams,
+	}
+	if job.Attempts != 0 {
+		for _, w := range workers {
+			if w.WorkerID == job.WorkerID {
+				req.avoidHost = w.Host
+			}
+		}
+	}
+	if job.Type == model.JobTypeStream {
+		stream, err := daoWrapper.StreamsDao.GetStreamByID(context.Background(), fmt.Sprintf("%d", job.StreamID))
+		if err != nil {
+			return nil, fmt.Sprintf("can't get stream: %v", err)
+		}
+		lectureHall, err := daoWrapper.LectureHallsDao.GetLectureHallByID(stream.LectureHallID)
+		if err != nil {
+			return nil, fmt.Sprintf("can't get lecture hall: %v", err)
+		}
+		req.requiredTags = append(lectureHall.GetWorkerTags(), req.requiredTags...)
+	}
+	return scheduleWorker(workers, req)
+}
+
+// jobCost returns the workload a job adds to a worker, see model.Worker.
+func jobCost(t model.JobType) uint {
+	switch t {
+	case model.JobTypeStream, model.JobTypePremiere:
+		return 3
+	case model.JobTypeThumbnails, model.JobTypeSectionImages, model.JobTypeStitchRecording, model.JobTypeCutClip, model.JobTypeRenderEdit:
+		return 1
+	default:
+		return 0
+	}
+}
+
+// runJob sends a claimed job to the worker and stores the result. Only the columns of the result are
+// written, and only if the job is still assigned, so lease renewals and cancellations in the meantime persist.
+func runJob(daoWrapper dao.DaoWrapper, job model.Job, worker model.Worker) {
+	from := job.State
+	running, err := sendJob(daoWrapper, &job, worker)
+	switch {
+	case err != nil:
+		logger.Warn("Job failed", "err", err, "job", job.ID, "type", job.Type, "worker", worker.WorkerID, "attempt", job.Attempts)
+		job.Fail(err, time.Now())
+	case running:
+		job.Acknowledge()
+	default:
+		job.Succeed()
+	}
+	saved, err := daoWrapper.JobDao.SaveResult(context.Background(), &job, from)
+	if err != nil {
+		logger.Error("Can't save job", "err", err, "job", job.ID)
+	} else if !saved {
+		logger.Info("Job changed while it was sent to the worker, discarding the result", "job", job.ID, "worker", worker.WorkerID)
+	}
+}
+
+// sendJob invokes the RPC of a job at the worker. running is true if the worker acknowledged a job
+// that completes later with a notification of the worker.
+func sendJob(daoWrapper dao.DaoWrapper, job *model.Job, worker model.Worker) (running bool, err error) {
+	conn, err := dialIn(worker)
+	if err != nil {
+		return false, err
+	}
+	defer endConnection(conn)
+	client := pb.NewToWorkerClient(conn)
+
+	switch job.Type {
+	case model.JobTypeStream:
+		return true, sendStreamJob(daoWrapper, job, worker, client)
+	case model.JobTypePremiere:
+		return true, sendPremiereJob(daoWrapper, job, worker, client)
+	case model.JobTypeStreamEnd:
+		return false, sendStreamEndJob(job, worker, client)
+	case model.JobTypeThumbnails:
+		return false, sendThumbnailsJob(daoWrapper, job, worker, client)
+	case model.JobTypeCombineThumbnails:
+		return false, sendCombineThumbnailsJob(daoWrapper, job, client)
+	case model.JobTypeSectionImages:
+		return false, sendSectionImagesJob(daoWrapper, job, client)
+	case model.JobTypeDeleteSectionImage:
+		return false, sendDeleteSectionImageJob(job, client)
+	case model.JobTypeStitchRecording:
+		return false, sendStitchRecordingJob(daoWrapper, job, worker, client)
+	case model.JobTypeCutClip:
+		return false, sendCutClipJob(daoWrapper, job, worker, client)
+	case model.JobTypeRenderEdit:
+		return false, sendRenderEditJob(daoWrapper, job, worker, client)
+	default:
+		return false, fmt.Errorf("unknown job type %s", job.Type)
+	}
+}
+
+// completeJobs marks the running jobs of a type for a stream on a worker as succeeded.
+func completeJobs(daoWrapper dao.DaoWrapper, jobType model.JobType, streamID uint, workerID string) {
+	ctx := context.Background()
+	jobs, err := daoWrapper.JobDao.GetUnfinished(ctx, jobType, streamID)
+	if err != nil {
+		logger.Error("Can't get jobs of stream", "err", err, "stream", streamID)
+		return
+	}
+	for i := range jobs {
+		if jobs[i].WorkerID != workerID || jobs[i].State == model.JobQueued {
+			continue
+		}
+		jobs[i].Succeed()
+		if err := daoWrapper.JobDao.Save(ctx, &jobs[i]); err != nil {
+			logger.Error("Can't save job", "err", err, "job", jobs[i].ID)
+		}
+	}
+}
+
+func sendStreamJob(daoWrapper dao.DaoWrapper, job *model.Job, worker model.Worker, client pb.ToWorkerClient) error {
+	var payload streamJobPayload
+	if err := job.DecodePayload(&payload); err != nil {
+		return err
+	}
+	stream, err := daoWrapper.StreamsDao.GetStreamByID(context.Background(), fmt.Sprintf("%d", job.StreamID))
+	if err != nil {
+		return err
+	}
+	course, err := daoWrapper.CoursesDao.GetCourseById(context.Background(), stream.CourseID)
+	if err != nil {
+		return err
+	}
+	server, slot, err := getStreamJobSlot(daoWrapper, stream, &payload)
+	if err != nil {
+		return err
+	}
+	if err = job.SetPayload(payload); err != nil {
+		return err
+	}
+	output := getLiveOutput(server, course)
+	req := pb.StreamRequest{
+		WorkerId:     worker.WorkerID,
+		SourceType:   payload.SourceType,
+		SourceUrl:    payload.Source,
+		CourseSlug:   course.Slug,
+		Start:        timestamppb.New(stream.Start),
+		End:          timestamppb.New(stream.End),
+		PublishVoD:   course.VODEnabled,
+		StreamID:     uint32(stream.ID),
+		CourseTerm:   course.TeachingTerm,
+		CourseYear:   uint32(course.Year),
+		StreamName:   slot.StreamName,
+		IngestServer: output.ingestServer,
+		OutUrl:       output.outUrl,
+		Renditions:   output.renditions,
+		Part:         uint32(payload.Part),
+		LowLatency:   output.lowLatency,
+		Dvr:          output.dvr,
+		DvrWindow:    output.dvrWindow,
+		Profile:      getEncodingProfile(course, payload.SourceType),
+		SlideText:    tools.Cfg.OcrService != nil,
+	}
+	if err = daoWrapper.StreamsDao.SaveWorkerForStream(stream, worker); err != nil {
+		return fmt.Errorf("could not save worker for stream: %w", err)
+	}
+	if payload.Part != 0 {
+		err = saveRecordingPart(daoWrapper, model.RecordingPart{StreamID: stream.ID, Version: job.Version, Part: payload.Part, WorkerID: worker.WorkerID})
+		if err != nil {
+			return fmt.Errorf("could not save recording part: %w", err)
+		}
+	}
+	resp, err := client.RequestStream(context.Background(), &req)
+	if err != nil {
+		return err
+	}
+	if !resp.Ok {
+		return errors.New("worker rejected stream request")
+	}
+	return nil
+}
+
+// getStreamJobSlot returns the ingest server and stream slot a stream job pushes to. The slot of the first attempt
+// is reused, so the playlist url of viewers keeps working when the stream fails over to another worker.
+func getStreamJobSlot(daoWrapper dao.DaoWrapper, stream model.Stream, payload *streamJobPayload) (model.IngestServer, model.StreamName, error) {
+	if payload.SlotID != 0 {
+		slot, err := daoWrapper.IngestServerDao.GetStreamSlotByID(payload.SlotID)
+		if err == nil && slot.StreamID == stream.ID {
+			server, err := daoWrapper.IngestServerDao.GetIngestServerByID(slot.IngestServerID)
+			if err == nil {
+				return server, slot, nil
+			}
+		}
+		logger.Warn("Can't reuse stream slot, using a new one", "err", err, "stream", stream.ID, "slot", payload.SlotID)
+	}
+	server, err := daoWrapper.IngestServerDao.GetBestIngestServer()
+	if err != nil {
+		return server, model.StreamName{}, fmt.Errorf("can't find ingest server: %w", err)
+	}
+	var slot model.StreamName
+	if payload.SourceType == "COMB" { // try to find a transcoding slot for comb view:
+		slot, err = daoWrapper.IngestServerDao.GetTranscodedStreamSlot(server.ID)
+	}
+	if payload.SourceType != "COMB" || err != nil {
+		slot, err = daoWrapper.IngestServerDao.GetStreamSlot(server.ID)
+		if err != nil {
+			return server, slot, fmt.Errorf("no free stream slot: %w", err)
+		}
+	}
+	slot.StreamID = stream.ID
+	daoWrapper.IngestServerDao.SaveSlot(slot)
+	payload.SlotID = slot.ID
+	return server, slot, nil
+}
+
+func sendPremiereJob(daoWrapper dao.DaoWrapper, job *model.Job, worker model.Worker, client pb.ToWorkerClient) error {
+	stream, err := daoWrapper.StreamsDao.GetStreamByID(context.Background(), fmt.Sprintf("%d", job.StreamID))
+	if err != nil {
+		return err
+	}
+	if len(stream.Files) == 0 {
+		return errors.New("premiere without file")
+	}
+	course, err := daoWrapper.CoursesDao.GetCourseById(context.Background(), stream.CourseID)
+	if err != nil {
+		return err
+	}
+	ingestServer, err := daoWrapper.IngestServerDao.GetBestIngestServer()
+	if err != nil {
+		return fmt.Errorf("can't find ingest server: %w", err)
+	}
+	resp, err := client.RequestPremiere(context.Background(), &pb.PremiereRequest{
+		StreamID:     uint32(stream.ID),
+		FilePath:     stream.Files[0].Path,
+		WorkerID:     worker.WorkerID,
+		IngestServer: ingestServer.Url,
+		OutUrl:       ingestServer.OutUrl,
+		Profile:      getEncodingProfile(course, "COMB"),
+	})
+	if err != nil {
+		return err
+	}
+	if !resp.Ok {
+		return errors.New("worker rejected premiere request")
+	}
+	return nil
+}
+
+func sendStreamEndJob(job *model.Job, worker model.Worker, client pb.ToWorkerClient) error {
+	var payload streamEndJobPayload
+	if err := job.DecodePayload(&payload); err != nil {
+		return err
+	}
+	resp, err := client.RequestStreamEnd(context.Background(), &pb.EndStreamRequest{
+		StreamID:   uint32(job.StreamID),
+		WorkerID:   worker.WorkerID,
+		DiscardVoD: payload.DiscardVoD,
+		FailedOver: payload.FailedOver,
+	})
+	if err != nil {
+		return err
+	}
+	if !resp.Ok {
+		return errors.New("worker rejected stream end request")
+	}
+	return nil
+}
+
+func sendThumbnailsJob(daoWrapper dao.DaoWrapper, job *model.Job, worker model.Worker, client pb.ToWorkerClient) error {
+	var payload thumbnailsJobPayload
+	if err := job.DecodePayload(&payload); err != nil {
+		return err
+	}
+	stream, err := daoWrapper.StreamsDao.GetStreamByID(context.Background(), fmt.Sprintf("%d", job.StreamID))
+	if err != nil {
+		return err
+	}
+	course, err := daoWrapper.CoursesDao.GetCourseById(context.Background(), stream.CourseID)
+	if err != nil {
+		return err
+	}
+	res, err := client.GenerateThumbnails(context.Background(), &pb.GenerateThumbnailRequest{
+		Path:          payload.Path,
+		WorkerID:      worker.WorkerID,
+		StreamID:      uint32(stream.ID),
+		StreamVersion: job.Version,
+		CourseSlug:    course.Slug,
+		CourseYear:    uint32(course.Year),
+		TeachingTerm:  course.TeachingTerm,
+		Start:         timestamppb.New(stream.Start),
+	})
+	if err != nil {
+		return err
+	}
+	if !res.Ok {
+		return errors.New("worker did not generate thumbnails")
+	}
+	return nil
+}
+
+// sendCombineThumbnailsJob generates a combined thumbnail from the two source thumbnails CAM and PRES if both exist
+func sendCombineThumbnailsJob(daoWrapper dao.DaoWrapper, job *model.Job, client pb.ToWorkerClient) error {
+	stream, err := daoWrapper.StreamsDao.GetStreamByID(context.Background(), fmt.Sprintf("%d", job.StreamID))
+	if err != nil {
+		return err
+	}
+	var thumbCam, thumbPres string
+	for _, file := range stream.Files {
+		if file.Type == model.FILETYPE_THUMB_LG_CAM {
+			thumbCam = file.Path
+		}
+		if file.Type == model.FILETYPE_THUMB_LG_PRES {
+			thumbPres = file.Path
+		}
+	}
+	if thumbCam == "" || thumbPres == "" {
+		return nil // nothing to do
+	}
+	thumbnails, err := client.CombineThumbnails(context.Background(), &pb.CombineThumbnailsRequest{
+		PrimaryThumbnail:   thumbPres,
+		SecondaryThumbnail: thumbCam,
+		Path:               strings.ReplaceAll(thumbPres, "PRES", "CAM_PRES"),
+	})
+	if err != nil {
+		return err
+	}
+	return daoWrapper.FileDao.SetThumbnail(stream.ID, model.File{StreamID: stream.ID, Path: thumbnails.FilePath, Type: model.FILETYPE_THUMB_LG_CAM_PRES})
+}
+
+// sendSectionImagesJob (re-)generates the images of all video sections of a stream.
+func sendSectionImagesJob(daoWrapper dao.DaoWrapper, job *model.Job, client pb.ToWorkerClient) error {
+	stream, err := daoWrapper.StreamsDao.GetStreamByID(context.Background(), fmt.Sprintf("%d", job.StreamID))
+	if err != nil {
+		return err
+	}
+	course, err := daoWrapper.CoursesDao.GetCourseById(context.Background(), stream.CourseID)
+	if err != nil {
+		return err
+	}
+	sections, err := daoWrapper.VideoSectionDao.GetByStreamId(stream.ID)
+	if err != nil {
+		return err
+	}
+	if len(sections) == 0 {
+		return nil
+	}
+	// sign the playlist for this attempt, tokens of earlier attempts might have expired already
+	if err = tools.SetSignedOriginalPlaylists(&stream, nil, false); err != nil {
+		return err
+	}
+
+	// collect timestamps
+	sectionTimestamps := make([]*pb.Section, len(sections))
+	for i, section := range sections {
+		sectionTimestamps[i] = &pb.Section{
+			Hours:   uint32(section.StartHours),
+			Minutes: uint32(section.StartMinutes),
+			Seconds: uint32(section.StartSeconds),
+		}
+	}
+
+	// make request
+	res, err := client.GenerateSectionImages(context.Background(), &pb.GenerateSectionImageRequest{
+		PlaylistURL:        stream.PlaylistUrl,
+		CourseName:         course.Name,
+		CourseYear:         uint32(course.Year),
+		CourseTeachingTerm: course.TeachingTerm,
+		Sections:           sectionTimestamps,
+	})
+	if err != nil {
+		return err
+	}
+	if len(res.Paths) != len(sections) {
+		return fmt.Errorf("expected %d section images, got %d", len(sections), len(res.Paths))
+	}
+
+	// update database
+	for i, section := range sections {
+		imageFile := model.File{StreamID: section.StreamID, Path: res.Paths[i], Type: model.FILETYPE_IMAGE_JPG}
+		if err := daoWrapper.FileDao.NewFile(&imageFile); err != nil {
+			return err
+		}
+
+		update := model.VideoSection{Model: gorm.Model{ID: section.ID}, FileID: imageFile.ID}
+		if err := daoWrapper.VideoSectionDao.Update(&update); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func sendDeleteSectionImageJob(job *model.Job, client pb.ToWorkerClient) error {
+	var payload deleteSectionImageJobPayload
+	if err := job.DecodePayload(&payload); err != nil {
+		return err
+	}
+	_, err := client.DeleteSectionImage(context.Background(), &pb.DeleteSectionImageRequest{Path: payload.Path})
+	return err
+}
+
+// removeStoredFile queues the removal of a file on the shared storage of the workers.
+// Any worker can remove it, like the images of video sections.
+func removeStoredFile(daoWrapper dao.DaoWrapper, streamID uint, path string) error {
+	job, err := model.NewJob(model.JobTypeDeleteSectionImage, streamID, deleteSectionImageJobPayload{Path: path})
+	if err != nil {
+		return err
+	}
+	return daoWrapper.JobDao.Create(context.Background(), &job)
+}
+
+func sendStitchRecordingJob(daoWrapper dao.DaoWrapper, job *model.Job, worker model.Worker, client pb.ToWorkerClient) error {
+	var payload stitchRecordingJobPayload
+	if err := job.DecodePayload(&payload); err != nil {
+		return err
+	}
+	stream, err := daoWrapper.StreamsDao.GetStreamByID(context.Background(), fmt.Sprintf("%d", job.StreamID))
+	if err != nil {
+		return err
+	}
+	course, err := daoWrapper.CoursesDao.GetCourseById(context.Background(), stream.CourseID)
+	if err != nil {
+		return err
+	}
+	resp, err := client.RequestStitch(context.Background(), &pb.StitchRequest{
+		WorkerID:   worker.WorkerID,
+		StreamID:   uint32(stream.ID),
+		SourceType: job.Version,
+		Parts:      payload.Parts,
+		CourseSlug: course.Slug,
+		CourseTerm: course.TeachingTerm,
+		CourseYear: uint32(course.Year),
+		Start:      timestamppb.New(stream.Start),
+		End:        timestamppb.New(stream.End),
+		PublishVoD: course.VODEnabled,
+		Profile:    getEncodingProfile(course, job.Version),
+		SlideText:  tools.Cfg.OcrService != nil,
+	})
+	if err != nil {
+		return err
+	}
+	if !resp.Ok {
+		return errors.New("worker rejected stitch request")
+	}
+	return nil
+}
+
+// cutSourceFile returns the VoD file that parts of a stream are cut from, the combined view if the stream has one.
+func cutSourceFile(stream model.Stream) (vod model.File, ok bool) {
+	for _, file := range stream.Files {
+		if file.Type != model.FILETYPE_VOD {
+			continue
+		}
+		if !ok || file.GetVodTypeByName() == "COMB" {
+			vod, ok = file, true
+		}
+	}
+	return vod, ok
+}
@@ -1,6 +1,7 @@
 package api
 
 import (
+	"context"
 	"errors"
 	"fmt"
 	"net/http"
@@ -8,8 +9,10 @@ import (
 
 	"github.com/TUM-Dev/gocast/dao"
 	"github.com/TUM-Dev/gocast/mock_dao"
+	"github.com/TUM-Dev/gocast/model"
 	"github.com/TUM-Dev/gocast/tools"
 	"github.com/TUM-Dev/gocast/tools/testutils"
+	"github.com/TUM-Dev/gocast/worker/pb"
 	"github.com/gin-gonic/gin"
 	"github.com/golang/mock/gomock"
 	"github.com/matthiasreumann/gomino"
@@ -18,9 +21,30 @@ import (
 func TestWorker(t *testing.T) {
 	gin.SetMode(gin.TestMode)
 
+	certificateDao := func(revokeErr error) dao.WorkerCertificateDao {
+		certificateDaoMock := mock_dao.NewMockWorkerCertificateDao(gomock.NewController(t))
+		certificateDaoMock.
+			EXPECT().
+			RevokeForWorker(gomock.Any(), testutils.Worker1.WorkerID).
+			Return(revokeErr).
+			AnyTimes()
+		return certificateDaoMock
+	}
+
 	t.Run("DELETE/api/workers/:workerID", func(t *testing.T) {
 		url := fmt.Sprintf("/api/workers/%s", testutils.Worker1.WorkerID)
 		gomino.TestCases{
+			"can not revoke certificates": {
+				Router: func(r *gin.Engine) {
+					wrapper := dao.DaoWrapper{
+						WorkerDao:            mock_dao.NewMockWorkerDao(gomock.NewController(t)),
+						WorkerCertificateDao: certificateDao(errors.New("")),
+					}
+					configWorkerRouter(r, wrapper)
+				},
+				Middlewares:  testutils.GetMiddlewares(tools.ErrorHandler, testutils.TUMLiveContext(testutils.TUMLiveContextAdmin)),
+				ExpectedCode: http.StatusInternalServerError,
+			},
 			"can not delete worker": {
 				Router: func(r *gin.Engine) {
 					wrapper := dao.DaoWrapper{
@@ -33,6 +57,7 @@ func TestWorker(t *testing.T) {
 								AnyTimes()
 							return workerDaoMock
 						}(),
+						WorkerCertificateDao: certificateDao(nil),
 					}
 					configWorkerRouter(r, wrapper)
 				},
@@ -51,6 +76,7 @@ func TestWorker(t *testing.T) {
 								AnyTimes()
 							return workerDaoMock
 						}(),
+						WorkerCertificateDao: certificateDao(nil),
 					}
 					configWorkerRouter(r, wrapper)
 				},
@@ -62,4 +88,249 @@ func TestWorker(t *testing.T) {
 			Url(url).
 			Run(t, testutils.Equal)
 	})
+
+	t.Run("GET/api/workers/:workerID/certificates", func(t *testing.T) {
+		url := fmt.Sprintf("/api/workers/%s/certificates", testutils.Worker1.WorkerID)
+		certificates := []model.WorkerCertificate{{WorkerID: testutils.Worker1.WorkerID, Serial: "2a"}}
+		getCertificates := func(res []model.WorkerCertificate, err error) func(r *gin.Engine) {
+			return func(r *gin.Engine) {
+				certificateDaoMock := mock_dao.NewMockWorkerCertificateDao(gomock.NewController(t))
+				certificateDaoMock.
+					EXPECT().
+					GetForWorker(gomock.Any(), testutils.Worker1.WorkerID).
+					Return(res, err).
+					AnyTimes()
+				configWorkerRouter(r, dao.DaoWrapper{WorkerCertificateDao: certificateDaoMock})
+			}
+		}
+		gomino.TestCases{
+			"can not get certificates": {
+				Router:       getCertificates(nil, errors.New("")),
+				Middlewares:  testutils.GetMiddlewares(tools.ErrorHandler, testutils.TUMLiveContext(testutils.TUMLiveContextAdmin)),
+				ExpectedCode: http.StatusInternalServerError,
+			},
+			"success": {
+				Router:           getCertificates(certificates, nil),
+				Middlewares:      testutils.GetMiddlewares(tools.ErrorHandler, testutils.TUMLiveContext(testutils.TUMLiveContextAdmin)),
+				ExpectedCode:     http.StatusOK,
+				ExpectedResponse: certificates,
+			},
+		}.
+			Method(http.MethodGet).
+			Url(url).
+			Run(t, testutils.Equal)
+	})
+
+	t.Run("POST/api/workers/:workerID/certificates/revoke", func(t *testing.T) {
+		url := fmt.Sprintf("/api/workers/%s/certificates/revoke", testutils.Worker1.WorkerID)
+		gomino.TestCases{
+			"can not revoke certificates": {
+				Router: func(r *gin.Engine) {
+					configWorkerRouter(r, dao.DaoWrapper{WorkerCertificateDao: certificateDao(errors.New(""))})
+				},
+				Middlewares:  testutils.GetMiddlewares(tools.ErrorHandler, testutils.TUMLiveContext(testutils.TUMLiveContextAdmin)),
+				ExpectedCode: http.StatusInternalServerError,
+			},
+			"success": {
+				Router: func(r *gin.Engine) {
+					configWorkerRouter(r, dao.DaoWrapper{WorkerCertificateDao: certificateDao(nil)})
+				},
+				Middlewares:  testutils.GetMiddlewares(tools.ErrorHandler, testutils.TUMLiveContext(testutils.TUMLiveContextAdmin)),
+				ExpectedCode: http.StatusOK,
+			},
+		}.
+			Method(http.MethodPost).
+			Url(url).
+			Run(t, testutils.Equal)
+	})
+}
+
+func TestGetLiveRenditions(t *testing.T) {
+	tools.Cfg.LiveLadder = []tools.LiveRendition{
+		{Name: "720p", Height: 720, VideoBitrate: 2500, AudioBitrate: 128},
+		{Name: "audio", AudioBitrate: 64},
+	}
+	defer func() { tools.Cfg.LiveLadder = nil }()
+
+	t.Run("server without master playlist", func(t *testing.T) {
+		server := model.IngestServer{OutUrl: "https://out.example.com/%s/playlist.m3u8"}
+		if renditions := getLiveRenditions(server); len(renditions) != 0 {
+			t.Errorf("expected no renditions, got %v", renditions)
+		}
+		if out := server.GetOutUrl(false); out != server.OutUrl {
+			t.Errorf("expected %s, got %s", server.OutUrl, out)
+		}
+	})
+	t.Run("server with master playlist", func(t *testing.T) {
+		server := model.IngestServer{
+			OutUrl:       "https://out.example.com/%s/playlist.m3u8",
+			MasterOutUrl: "https://out.example.com/%s.m3u8",
+		}
+		renditions := getLiveRenditions(server)
+		if len(renditions) != 2 || renditions[0].Name != "720p" || renditions[1].Height != 0 {
+			t.Errorf("unexpected renditions %v", renditions)
+		}
+		if out := server.GetOutUrl(true); out != server.MasterOutUrl {
+			t.Errorf("expected %s, got %s", server.MasterOutUrl, out)
+		}
+	})
+}
+
+func TestGetLiveOutput(t *testing.T) {
+	server := model.IngestServer{
+		Url:              "rtmp://ingest.example.com/live",
+		OutUrl:           "https://out.example.com/%s/playlist.m3u8",
+		LowLatencyUrl:    "rtmp://ingest.example.com/ll",
+		LowLatencyOutUrl: "https://out.example.com/ll/%s/index.m3u8",
+	}
+
+	t.Run("low latency course", func(t *testing.T) {
+		output := getLiveOutput(server, model.Course{LowLatency: true})
+		if !output.lowLatency || output.ingestServer != server.LowLatencyUrl || output.outUrl != server.LowLatencyOutUrl {
+			t.Errorf("expected low latency output, got %+v", output)
+		}
+	})
+	t.Run("regular course", func(t *testing.T) {
+		output := getLiveOutput(server, model.Course{})
+		if output.lowLatency || output.ingestServer != server.Url || output.outUrl != server.OutUrl {
+			t.Errorf("expected regular output, got %+v", output)
+		}
+	})
+	t.Run("server without low latency", func(t *testing.T) {
+		output := getLiveOutput(model.IngestServer{Url: server.Url, OutUrl: server.OutUrl}, model.Course{LowLatency: true})
+		if output.lowLatency || output.ingestServer != server.Url {
+			t.Errorf("expected regular output, got %+v", output)
+		}
+	})
+}
+
+func TestGetEncodingProfile(t *testing.T) {
+	profiles, defaults := tools.Cfg.EncodingProfiles, tools.Cfg.DefaultEncodingProfiles
+	defer func() { tools.Cfg.EncodingProfiles, tools.Cfg.DefaultEncodingProfiles = profiles, defaults }()
+	tools.Cfg.EncodingProfiles = map[string]tools.EncodingProfile{
+		"slides": {Crf: 20, Tune: "stillimage"},
+		"hevc":   {VideoCodec: "libx265", Crf: 28, AudioBitrate: 96},
+	}
+	tools.Cfg.DefaultEncodingProfiles = map[string]string{"pres": "slides"}
+
+	t.Run("profile of course", func(t *testing.T) {
+		profile := getEncodingProfile(model.Course{EncodingProfile: "hevc"}, "PRES")
+		if profile.GetName() != "hevc" || profile.GetVideoCodec() != "libx265" || profile.GetCrf() != 28 || profile.GetAudioBitrate() != 96 {
+			t.Errorf("expected profile of course, got %v", profile)
+		}
+	})
+	t.Run("default of source type", func(t *testing.T) {
+		profile := getEncodingProfile(model.Course{}, "PRES")
+		if profile.GetName() != "slides" || profile.GetTune() != "stillimage" {
+			t.Errorf("expected default profile of PRES, got %v", profile)
+		}
+	})
+	t.Run("unknown profile of course", func(t *testing.T) {
+		if profile := getEncodingProfile(model.Course{EncodingProfile: "removed"}, "PRES"); profile.GetName() != "slides" {
+			t.Errorf("expected default profile of PRES, got %v", profile)
+		}
+	})
+	t.Run("no profile", func(t *testing.T) {
+		if profile := getEncodingProfile(model.Course{}, "CAM"); profile != nil {
+			t.Errorf("expected no profile, got %v", profile)
+		}
+	})
+}
+
+func TestNotifyTranscodingFinishedCodec(t *testing.T) {
+	newServer := func(t *testing.T, stream model.Stream, saved func(*model.Stream)) server {
+		workerMock := mock_dao.NewMockWorkerDao(gomock.NewController(t))
+		workerMock.EXPECT().GetWorkerByID(gomock.Any(), "w1").Return(model.Worker{WorkerID: "w1"}, nil)
+		streamsMock := mock_dao.NewMockStreamsDao(gomock.NewController(t))
+		streamsMock.EXPECT().GetStreamByID(gomock.Any(), "1").Return(stream, nil)
+		if saved != nil {
+			streamsMock.EXPECT().SaveStream(gomock.Any()).DoAndReturn(func(s *model.Stream) error {
+				saved(s)
+				return nil
+			})
+		}
+		return server{DaoWrapper: dao.DaoWrapper{WorkerDao: workerMock, StreamsDao: streamsMock}}
+	}
+	stream := model.Stream{Files: []model.File{{StreamID: 1, Path: "/vod/a_COMB.mp4", Type: model.FILETYPE_VOD}}}
+	stream.ID = 1
+
+	t.Run("adds rendition", func(t *testing.T) {
+		s := newServer(t, stream, func(s *model.Stream) {
+			if len(s.Files) != 2 || s.Files[1].Type != model.FILETYPE_VOD_HEVC || s.Files[1].Path != "/vod/a_COMB_hevc.mp4" {
+				t.Errorf("expected hevc file, got %+v", s.Files)
+			}
+		})
+		_, err := s.NotifyTranscodingFinished(context.Background(), &pb.TranscodingFinished{WorkerID: "w1", StreamID: 1, FilePath: "/vod/a_COMB_hevc.mp4", SourceType: "COMB", Codec: "hevc"})
+		if err != nil {
+			t.Fatal(err)
+		}
+	})
+	t.Run("adds audio rendition", func(t *testing.T) {
+		s := newServer(t, stream, func(s *model.Stream) {
+			if len(s.Files) != 2 || s.Files[1].Type != model.FILETYPE_AUDIO_M4A || s.Files[1].Path != "/vod/1.m4a" {
+				t.Errorf("expected audio file, got %+v", s.Files)
+			}
+		})
+		_, err := s.NotifyTranscodingFinished(context.Background(), &pb.TranscodingFinished{WorkerID: "w1", StreamID: 1, FilePath: "/vod/1.m4a", SourceType: "COMB", Codec: "aac"})
+		if err != nil {
+			t.Fatal(err)
+		}
+	})
+	t.Run("known rendition", func(t *testing.T) {
+		known := stream
+		known.Files = append([]model.File{{StreamID: 1, Path: "/vod/a_COMB_av1.mp4", Type: model.FILETYPE_VOD_AV1}}, stream.Files...)
+		s := newServer(t, known, nil)
+		_, err := s.NotifyTranscodingFinished(context.Background(), &pb.TranscodingFinished{WorkerID: "w1", StreamID: 1, FilePath: "/vod/a_COMB_av1.mp4", SourceType: "COMB", Codec: "av1"})
+		if err != nil {
+			t.Fatal(err)
+		}
+	})
+	t.Run("unknown codec", func(t *testing.T) {
+		s := newServer(t, stream, nil)
+		_, err := s.NotifyTranscodingFinished(context.Background(), &pb.TranscodingFinished{WorkerID: "w1", StreamID: 1, FilePath: "/vod/a_COMB_vp9.mp4", SourceType: "COMB", Codec: "vp9"})
+		if err == nil {
+			t.Error("expected error for unknown codec")
+		}
+	})
+}
+
+func TestNotifyTranscodingFinishedLoudness(t *testing.T) {
+	workerMock := mock_dao.NewMockWorkerDao(gomock.NewController(t))
+	workerMock.EXPECT().GetWorkerByID(gomock.Any(), "w1").Return(model.Worker{WorkerID: "w1"}, nil)
+	stream := model.Stream{}
+	stream.ID = 1
+	streamsMock := mock_dao.NewMockStreamsDao(gomock.NewController(t))
+	streamsMock.EXPECT().GetStreamByID(gomock.Any(), "1").Return(stream, nil)
+	streamsMock.EXPECT().RemoveTranscodingProgress(model.COMB, uint(1)).Return(nil)
+	streamsMock.EXPECT().SaveStream(gomock.Any()).Return(nil)
+	streamsMock.EXPECT().SaveLoudness(uint(1), -31.4, -6.0).Return(nil)
+	partsMock := mock_dao.NewMockRecordingPartDao(gomock.NewController(t))
+	partsMock.EXPECT().GetForStream(gomock.Any(), uint(1), "COMB").Return(nil, nil)
+	s := server{DaoWrapper: dao.DaoWrapper{WorkerDao: workerMock, StreamsDao: streamsMock, RecordingPartDao: partsMock}}
+
+	_, err := s.NotifyTranscodingFinished(context.Background(), &pb.TranscodingFinished{
+		WorkerID:   "w1",
+		StreamID:   1,
+		FilePath:   "/vod/a_COMB.mp4",
+		SourceType: "COMB",
+		Loudness:   &pb.Loudness{Integrated: -31.4, TruePeak: -6},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestSendHeartBeatLeaseFailure(t *testing.T) {
+	workerMock := mock_dao.NewMockWorkerDao(gomock.NewController(t))
+	workerMock.EXPECT().GetWorkerByID(gomock.Any(), "w1").Return(model.Worker{WorkerID: "w1"}, nil)
+	workerMock.EXPECT().SaveWorker(gomock.Any()).Return(nil)
+	jobsMock := mock_dao.NewMockJobDao(gomock.NewController(t))
+	jobsMock.EXPECT().RenewLeases(gomock.Any(), "w1", gomock.Any()).Return(errors.New("deadlock"))
+	s := server{DaoWrapper: dao.DaoWrapper{WorkerDao: workerMock, JobDao: jobsMock}}
+
+	// the worker stays alive, its live streams must not fail over
+	status, err := s.SendHeartBeat(context.Background(), &pb.HeartBeat{WorkerID: "w1"})
+	if err != nil || !status.GetOk() {
+		t.Errorf("expected heartbeat to succeed, got %v (%v)", status, err)
+	}
 }
@@ -37,7 +37,10 @@ var logger = slog.New(slog.NewJSONHandler(os.Stdout, &slog.HandlerOptions{
 
 var initializers = []initializer{
 	tools.LoadConfig,
+	tools.InitWorkerCA,
 	api.ServeWorkerGRPC,
+	api.ServeVoiceReceiverGRPC,
+	api.ServeOcrReceiverGRPC,
 	tools.InitBranding,
 }
 
@@ -189,6 +192,17 @@ func main() {
 		&model.Subtitles{},
 		&model.TranscodingFailure{},
 		&model.Email{},
+		&model.Job{},
+		&model.RecordingPart{},
+		&model.SubtitleJob{},
+		&model.SearchIndexUpdate{},
+		&model.WorkerCertificate{},
+		&model.StreamHealth{},
+		&model.StreamIncident{},
+		&model.SectionSuggestion{},
+		&model.SlideText{},
+		&model.Clip{},
+		&model.StreamEdit{},
 	)
 	if err != nil {
 		sentry.CaptureException(err)
@@ -216,6 +230,8 @@ func main() {
 	}
 	dao.Cache = *cache
 
+	// the DAOs queue changes for the search index only if there is one
+	dao.SearchIndexEnabled = tools.Cfg.Meili != nil
 	// init meili search index settings
 	go tools.NewMeiliExporter(dao.NewDaoWrapper()).SetIndexSettings()
 
@@ -245,12 +261,20 @@ func initCron() {
 	_ = tools.Cron.AddFunc("sentryFlush", func() { sentry.Flush(time.Minute * 2) }, "0-59/5 * * * *")
 	// Look for due streams and notify workers about them
 	_ = tools.Cron.AddFunc("triggerDueStreams", api.NotifyWorkers(daoWrapper), "0-59 * * * *")
+	// Retry jobs of workers that stopped sending heartbeats and dispatch queued jobs
+	_ = tools.Cron.AddFunc("dispatchJobs", api.DispatchJobs(daoWrapper), "0-59 * * * *")
+	// Stitch recordings of streams that failed over between workers
+	_ = tools.Cron.AddFunc("stitchRecordings", api.StitchRecordings(daoWrapper), "0-59 * * * *")
 	// update courses available
 	_ = tools.Cron.AddFunc("prefetchCourses", tum.PrefetchCourses(daoWrapper), "30 3 * * *")
-	// export data to meili search
-	_ = tools.Cron.AddFunc("exportToMeili", tools.NewMeiliExporter(daoWrapper).Export, "30 4 * * *")
+	// apply changes queued by the DAOs to meili search, a full export is only run from the maintenance page
+	_ = tools.Cron.AddFunc("applySearchIndexUpdates", tools.NewMeiliExporter(daoWrapper).ApplyUpdates, "0-59 * * * *")
 	// fetch live stream previews
 	_ = tools.Cron.AddFunc("fetchLivePreviews", api.FetchLivePreviews(daoWrapper), "*/1 * * * *")
+	// stream health is only interesting while the stream is running and shortly after
+	_ = tools.Cron.AddFunc("deleteStreamHealth", api.DeleteStreamHealth(daoWrapper), "15 4 * * *")
+	// renew the certificates of the voice and OCR service before they expire
+	_ = tools.Cron.AddFunc("renewServiceCerts", tools.RenewServiceCerts, "45 4 * * *")
 	tools.Cron.Run()
 }
 
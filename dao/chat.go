@@ -163,17 +163,29 @@ func (d chatDao) GetPolls(streamID uint) (polls []model.Poll, err error) {
 
 // ApproveChat sets the attribute 'visible' to true
 func (d chatDao) ApproveChat(id uint) error {
-	return DB.Model(&model.Chat{}).Where("id = ?", id).Updates(map[string]interface{}{"visible": true}).Error
+	err := DB.Model(&model.Chat{}).Where("id = ?", id).Updates(map[string]interface{}{"visible": true}).Error
+	if err == nil {
+		enqueueStreamsWhere("id = (?)", DB.Unscoped().Model(&model.Chat{}).Select("stream_id").Where("id = ?", id))
+	}
+	return err
 }
 
 // RetractChat sets the attribute 'visible' to false
 func (d chatDao) RetractChat(id uint) error {
-	return DB.Model(&model.Chat{}).Where("id = ?", id).Updates(map[string]interface{}{"visible": false}).Error
+	err := DB.Model(&model.Chat{}).Where("id = ?", id).Updates(map[string]interface{}{"visible": false}).Error
+	if err == nil {
+		enqueueStreamsWhere("id = (?)", DB.Unscoped().Model(&model.Chat{}).Select("stream_id").Where("id = ?", id))
+	}
+	return err
 }
 
 // DeleteChat removes a chat with the given id from the database.
 func (d chatDao) DeleteChat(id uint) error {
-	return DB.Where("id = ? OR reply_to = ?", id, id).Delete(&model.Chat{}).Error
+	err := DB.Where("id = ? OR reply_to = ?", id, id).Delete(&model.Chat{}).Error
+	if err == nil {
+		enqueueStreamsWhere("id = (?)", DB.Unscoped().Model(&model.Chat{}).Select("stream_id").Where("id = ?", id))
+	}
+	return err
 }
 
 // ResolveChat sets the attribute resolved of chat with the given id to true
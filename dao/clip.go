@@ -0,0 +1,64 @@
+package dao
+
+import (
+	"context"
+
+	"github.com/TUM-Dev/gocast/model"
+	"gorm.io/gorm"
+)
+
+//go:generate mockgen -source=clip.go -destination ../mock_dao/clip.go
+
+type ClipDao interface {
+	// Create a new Clip.
+	Create(ctx context.Context, clip *model.Clip) error
+
+	// GetBySlug returns the Clip with the slug of its short url.
+	GetBySlug(ctx context.Context, slug string) (model.Clip, error)
+
+	// GetForCourse returns the clips of all streams of a course with their streams, newest first.
+	GetForCourse(ctx context.Context, courseID uint) ([]model.Clip, error)
+
+	// SetFilePath saves the file a worker cut for a Clip.
+	SetFilePath(ctx context.Context, id uint, path string) error
+
+	// Delete a Clip.
+	Delete(ctx context.Context, id uint) error
+}
+
+type clipDao struct {
+	db *gorm.DB
+}
+
+func NewClipDao() ClipDao {
+	return clipDao{db: DB}
+}
+
+// Create a new Clip.
+func (d clipDao) Create(c context.Context, clip *model.Clip) error {
+	return DB.WithContext(c).Create(clip).Error
+}
+
+// GetBySlug returns the Clip with the slug of its short url.
+func (d clipDao) GetBySlug(c context.Context, slug string) (res model.Clip, err error) {
+	return res, DB.WithContext(c).Where("slug = ?", slug).First(&res).Error
+}
+
+// GetForCourse returns the clips of all streams of a course with their streams, newest first.
+func (d clipDao) GetForCourse(c context.Context, courseID uint) (res []model.Clip, err error) {
+	return res, DB.WithContext(c).Preload("Stream").
+		Joins("JOIN streams ON streams.id = clips.stream_id AND streams.deleted_at IS NULL").
+		Where("streams.course_id = ?", courseID).
+		Order("clips.created_at DESC").
+		Find(&res).Error
+}
+
+// SetFilePath saves the file a worker cut for a Clip.
+func (d clipDao) SetFilePath(c context.Context, id uint, path string) error {
+	return DB.WithContext(c).Model(&model.Clip{}).Where("id = ?", id).Update("file_path", path).Error
+}
+
+// Delete a Clip.
+func (d clipDao) Delete(c context.Context, id uint) error {
+	return DB.WithContext(c).Delete(&model.Clip{}, id).Error
+}
@@ -64,6 +64,7 @@ func (d coursesDao) CreateCourse(ctx context.Context, course *model.Course, keep
 	if !keep {
 		return DB.Delete(&course).Error
 	}
+	enqueueSearchIndexUpdate(model.SearchIndexCourse, course.ID)
 	return nil
 }
 
@@ -202,6 +203,7 @@ func (d coursesDao) GetCourseById(ctx context.Context, id uint) (course model.Co
 	dbErr := DB.Preload("Streams.TranscodingProgresses").
 		Preload("Streams.VideoSections").
 		Preload("Streams.Files").
+		Preload("Streams.Edits").
 		Preload("Streams", func(db *gorm.DB) *gorm.DB {
 			return db.Order("streams.start desc")
 		}).Find(&foundCourse, "id = ?", id).Error
@@ -218,7 +220,7 @@ func (d coursesDao) GetCourseBySlugYearAndTerm(ctx context.Context, slug string,
 		return cachedCourses.(model.Course), nil
 	}
 	var course model.Course
-	err := DB.Preload("Streams.VideoSections").Preload("Streams.Units", func(db *gorm.DB) *gorm.DB {
+	err := DB.Preload("Streams.VideoSections").Preload("Streams.Files").Preload("Streams.Edits").Preload("Streams.Units", func(db *gorm.DB) *gorm.DB {
 		return db.Order("unit_start desc")
 	}).Preload("Streams", func(db *gorm.DB) *gorm.DB {
 		return db.Order("start desc")
@@ -283,16 +285,25 @@ func (d coursesDao) GetCourseAdmins(courseID uint) ([]model.User, error) {
 
 func (d coursesDao) UpdateCourse(ctx context.Context, course model.Course) error {
 	defer Cache.Clear()
-	return DB.Session(&gorm.Session{FullSaveAssociations: true}).Updates(&course).Error
+	err := DB.Session(&gorm.Session{FullSaveAssociations: true}).Updates(&course).Error
+	if err == nil {
+		enqueueSearchIndexUpdate(model.SearchIndexCourse, course.ID)
+	}
+	return err
 }
 
 func (d coursesDao) UpdateCourseMetadata(ctx context.Context, course model.Course) {
 	defer Cache.Clear()
 	DB.Save(&course)
+	enqueueSearchIndexUpdate(model.SearchIndexCourse, course.ID)
 }
 
 func (d coursesDao) UnDeleteCourse(ctx context.Context, course model.Course) error {
-	return DB.Exec("UPDATE courses SET deleted_at = NULL WHERE id = ?", course.ID).Error
+	err := DB.Exec("UPDATE courses SET deleted_at = NULL WHERE id = ?", course.ID).Error
+	if err == nil {
+		enqueueSearchIndexUpdate(model.SearchIndexCourse, course.ID)
+	}
+	return err
 }
 
 func (d coursesDao) RemoveAdminFromCourse(userID uint, courseID uint) error {
@@ -315,6 +326,7 @@ func (d coursesDao) DeleteCourse(course model.Course) {
 	if err != nil {
 		logger.Error("Can't delete course", "err", err)
 	}
+	enqueueSearchIndexUpdate(model.SearchIndexCourse, course.ID)
 }
 
 type Semester struct {
@@ -36,6 +36,16 @@ type DaoWrapper struct {
 	SubtitlesDao
 	TranscodingFailureDao
 	EmailDao
+	JobDao
+	RecordingPartDao
+	SubtitleJobDao
+	SearchIndexDao
+	WorkerCertificateDao
+	StreamHealthDao
+	StreamIncidentDao
+	SectionSuggestionDao
+	SlideTextDao
+	ClipDao
 }
 
 func NewDaoWrapper() DaoWrapper {
@@ -63,5 +73,15 @@ func NewDaoWrapper() DaoWrapper {
 		SubtitlesDao:          NewSubtitlesDao(),
 		TranscodingFailureDao: NewTranscodingFailureDao(),
 		EmailDao:              NewEmailDao(),
+		JobDao:                NewJobDao(),
+		RecordingPartDao:      NewRecordingPartDao(),
+		SubtitleJobDao:        NewSubtitleJobDao(),
+		SearchIndexDao:        NewSearchIndexDao(),
+		WorkerCertificateDao:  NewWorkerCertificateDao(),
+		StreamHealthDao:       NewStreamHealthDao(),
+		StreamIncidentDao:     NewStreamIncidentDao(),
+		SectionSuggestionDao:  NewSectionSuggestionDao(),
+		SlideTextDao:          NewSlideTextDao(),
+		ClipDao:               NewClipDao(),
 	}
 }
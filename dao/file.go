@@ -15,6 +15,7 @@ type FileDao interface {
 	CountVoDFiles() (int64, error)
 	SetThumbnail(streamId uint, thumb model.File) error
 	GetThumbnail(streamId uint, fileType model.FileType) (f model.File, err error)
+	GetForStreams(streamIds []uint, fileType model.FileType) ([]model.File, error)
 }
 
 type fileDao struct {
@@ -62,3 +63,12 @@ func (d fileDao) GetThumbnail(streamId uint, fileType model.FileType) (f model.F
 	err = DB.Where("stream_id = ? AND type = ?", streamId, fileType).First(&f).Error
 	return
 }
+
+// GetForStreams returns the files of the given type of all streams.
+func (d fileDao) GetForStreams(streamIds []uint, fileType model.FileType) (files []model.File, err error) {
+	if len(streamIds) == 0 {
+		return nil, nil
+	}
+	err = DB.Where("stream_id IN ? AND type = ?", streamIds, fileType).Find(&files).Error
+	return
+}
@@ -14,8 +14,10 @@ type IngestServerDao interface {
 	SaveIngestServer(server model.IngestServer)
 
 	GetBestIngestServer() (server model.IngestServer, err error)
+	GetIngestServerByID(id uint) (server model.IngestServer, err error)
 	GetTranscodedStreamSlot(ingestServerID uint) (sn model.StreamName, err error)
 	GetStreamSlot(ingestServerID uint) (sn model.StreamName, err error)
+	GetStreamSlotByID(id uint) (sn model.StreamName, err error)
 
 	RemoveStreamFromSlot(streamID uint) error
 }
@@ -51,6 +53,12 @@ func (d ingestServerDao) GetBestIngestServer() (server model.IngestServer, err e
 	return
 }
 
+// GetIngestServerByID returns the IngestServer with the given id
+func (d ingestServerDao) GetIngestServerByID(id uint) (server model.IngestServer, err error) {
+	err = DB.First(&server, id).Error
+	return
+}
+
 func (d ingestServerDao) GetTranscodedStreamSlot(ingestServerID uint) (sn model.StreamName, err error) {
 	err = DB.Order("freed_at asc").First(&sn, "is_transcoding AND ingest_server_id = ? AND stream_id IS null", ingestServerID).Error
 	return
@@ -61,6 +69,12 @@ func (d ingestServerDao) GetStreamSlot(ingestServerID uint) (sn model.StreamName
 	return
 }
 
+// GetStreamSlotByID returns the slot with the given id, used or not
+func (d ingestServerDao) GetStreamSlotByID(id uint) (sn model.StreamName, err error) {
+	err = DB.First(&sn, id).Error
+	return
+}
+
 func (d ingestServerDao) RemoveStreamFromSlot(streamID uint) error {
 	return DB.
 		Model(&model.StreamName{}).
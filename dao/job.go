@@ -0,0 +1,170 @@
+package dao
+
+import (
+	"context"
+	"time"
+
+	"github.com/TUM-Dev/gocast/model"
+	"gorm.io/gorm"
+)
+
+//go:generate mockgen -source=job.go -destination ../mock_dao/job.go
+
+type JobDao interface {
+	// Create a new Job.
+	Create(context.Context, *model.Job) error
+
+	// Get a Job by id.
+	Get(context.Context, uint) (model.Job, error)
+
+	// Save a Job.
+	Save(context.Context, *model.Job) error
+
+	// Find returns jobs with the given state and type, newest first. Empty filters match all jobs.
+	Find(ctx context.Context, state model.JobState, jobType model.JobType, limit int, offset int) ([]model.Job, error)
+
+	// GetDue returns queued jobs whose next attempt is due, oldest first.
+	GetDue(ctx context.Context, limit int) ([]model.Job, error)
+
+	// GetUnfinished returns the queued, assigned and running jobs of a type for a stream.
+	GetUnfinished(ctx context.Context, jobType model.JobType, streamID uint) ([]model.Job, error)
+
+	// GetActive returns all assigned and running jobs.
+	GetActive(ctx context.Context) ([]model.Job, error)
+
+	// GetExpiredLeases returns assigned and running jobs whose lease expired.
+	GetExpiredLeases(ctx context.Context) ([]model.Job, error)
+
+	// Claim stores the assignment of a queued job. It returns false if the job was claimed by someone else.
+	Claim(ctx context.Context, job *model.Job) (bool, error)
+
+	// SaveResult stores the outcome of an attempt of a job that is still in state from. It returns false
+	// if the job changed in the meantime, e.g. because it was canceled.
+	SaveResult(ctx context.Context, job *model.Job, from model.JobState) (bool, error)
+
+	// RenewLeases extends the leases of all assigned and running jobs of a worker until the given time.
+	RenewLeases(ctx context.Context, workerID string, until time.Time) error
+}
+
+type jobDao struct {
+	db *gorm.DB
+}
+
+func NewJobDao() JobDao {
+	return jobDao{db: DB}
+}
+
+// Create a new Job.
+func (d jobDao) Create(c context.Context, job *model.Job) error {
+	return DB.WithContext(c).Create(job).Error
+}
+
+// Get a Job by id.
+func (d jobDao) Get(c context.Context, id uint) (res model.Job, err error) {
+	return res, DB.WithContext(c).First(&res, id).Error
+}
+
+// Save a Job.
+func (d jobDao) Save(c context.Context, job *model.Job) error {
+	return DB.WithContext(c).Save(job).Error
+}
+
+// Find returns jobs with the given state and type, newest first. Empty filters match all jobs.
+func (d jobDao) Find(c context.Context, state model.JobState, jobType model.JobType, limit int, offset int) (res []model.Job, err error) {
+	query := DB.WithContext(c).Order("id DESC").Limit(limit).Offset(offset)
+	if state != "" {
+		query = query.Where("state = ?", state)
+	}
+	if jobType != "" {
+		query = query.Where("type = ?", jobType)
+	}
+	return res, query.Find(&res).Error
+}
+
+// GetDue returns queued jobs whose next attempt is due, oldest first.
+func (d jobDao) GetDue(c context.Context, limit int) (res []model.Job, err error) {
+	return res,
+		DB.
+			WithContext(c).
+			Where("state = ? AND run_after <= ?", model.JobQueued, time.Now()).
+			Order("run_after ASC").
+			Limit(limit).
+			Find(&res).
+			Error
+}
+
+// GetUnfinished returns the queued, assigned and running jobs of a type for a stream.
+func (d jobDao) GetUnfinished(c context.Context, jobType model.JobType, streamID uint) (res []model.Job, err error) {
+	return res,
+		DB.
+			WithContext(c).
+			Where("type = ? AND stream_id = ? AND state IN ?", jobType, streamID,
+				[]model.JobState{model.JobQueued, model.JobAssigned, model.JobRunning}).
+			Find(&res).
+			Error
+}
+
+// GetActive returns all assigned and running jobs.
+func (d jobDao) GetActive(c context.Context) (res []model.Job, err error) {
+	return res,
+		DB.
+			WithContext(c).
+			Where("state IN ?", []model.JobState{model.JobAssigned, model.JobRunning}).
+			Find(&res).
+			Error
+}
+
+// GetExpiredLeases returns assigned and running jobs whose lease expired.
+func (d jobDao) GetExpiredLeases(c context.Context) (res []model.Job, err error) {
+	return res,
+		DB.
+			WithContext(c).
+			Where("state IN ? AND lease_expires_at < ?", []model.JobState{model.JobAssigned, model.JobRunning}, time.Now()).
+			Find(&res).
+			Error
+}
+
+// Claim stores the assignment of a queued job. It returns false if the job was claimed by someone else.
+func (d jobDao) Claim(c context.Context, job *model.Job) (bool, error) {
+	res := DB.
+		WithContext(c).
+		Model(&model.Job{}).
+		Where("id = ? AND state = ?", job.ID, model.JobQueued).
+		Updates(map[string]interface{}{
+			"state":            job.State,
+			"worker_id":        job.WorkerID,
+			"attempts":         job.Attempts,
+			"lease_expires_at": job.LeaseExpiresAt,
+		})
+	return res.RowsAffected == 1, res.Error
+}
+
+// SaveResult stores the outcome of an attempt of a job that is still in state from. It returns false
+// if the job changed in the meantime, e.g. because it was canceled.
+func (d jobDao) SaveResult(c context.Context, job *model.Job, from model.JobState) (bool, error) {
+	updates := map[string]interface{}{
+		"state":      job.State,
+		"last_error": job.LastError,
+		"run_after":  job.RunAfter,
+	}
+	if job.LeaseExpiresAt == nil {
+		// the attempt is over. Leases of running jobs are renewed concurrently, so they are left alone.
+		updates["lease_expires_at"] = nil
+	}
+	res := DB.
+		WithContext(c).
+		Model(&model.Job{}).
+		Where("id = ? AND state = ?", job.ID, from).
+		Updates(updates)
+	return res.RowsAffected == 1, res.Error
+}
+
+// RenewLeases extends the leases of all assigned and running jobs of a worker until the given time.
+func (d jobDao) RenewLeases(c context.Context, workerID string, until time.Time) error {
+	return DB.
+		WithContext(c).
+		Model(&model.Job{}).
+		Where("worker_id = ? AND state IN ?", workerID, []model.JobState{model.JobAssigned, model.JobRunning}).
+		Update("lease_expires_at", until).
+		Error
+}
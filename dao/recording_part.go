@@ -0,0 +1,52 @@
+package dao
+
+import (
+	"context"
+
+	"github.com/TUM-Dev/gocast/model"
+	"gorm.io/gorm"
+)
+
+//go:generate mockgen -source=recording_part.go -destination ../mock_dao/recording_part.go
+
+type RecordingPartDao interface {
+	// Create a new RecordingPart.
+	Create(context.Context, *model.RecordingPart) error
+
+	// Save a RecordingPart.
+	Save(context.Context, *model.RecordingPart) error
+
+	// GetForStream returns the recording parts of a stream version ordered by part.
+	GetForStream(ctx context.Context, streamID uint, version string) ([]model.RecordingPart, error)
+
+	// GetUnstitched returns all recording parts that are not stitched into a VoD yet.
+	GetUnstitched(ctx context.Context) ([]model.RecordingPart, error)
+}
+
+type recordingPartDao struct {
+	db *gorm.DB
+}
+
+func NewRecordingPartDao() RecordingPartDao {
+	return recordingPartDao{db: DB}
+}
+
+// Create a new RecordingPart.
+func (d recordingPartDao) Create(c context.Context, part *model.RecordingPart) error {
+	return DB.WithContext(c).Create(part).Error
+}
+
+// Save a RecordingPart.
+func (d recordingPartDao) Save(c context.Context, part *model.RecordingPart) error {
+	return DB.WithContext(c).Save(part).Error
+}
+
+// GetForStream returns the recording parts of a stream version ordered by part.
+func (d recordingPartDao) GetForStream(c context.Context, streamID uint, version string) (res []model.RecordingPart, err error) {
+	return res, DB.WithContext(c).Where("stream_id = ? AND version = ?", streamID, version).Order("part").Find(&res).Error
+}
+
+// GetUnstitched returns all recording parts that are not stitched into a VoD yet.
+func (d recordingPartDao) GetUnstitched(c context.Context) (res []model.RecordingPart, err error) {
+	return res, DB.WithContext(c).Where("stitched = ?", false).Order("stream_id, version, part").Find(&res).Error
+}
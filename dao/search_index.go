@@ -0,0 +1,82 @@
+package dao
+
+import (
+	"context"
+
+	"github.com/TUM-Dev/gocast/model"
+	"gorm.io/gorm"
+)
+
+//go:generate mockgen -source=search_index.go -destination ../mock_dao/search_index.go
+
+type SearchIndexDao interface {
+	// Enqueue queues streams or courses for reindexing.
+	Enqueue(ctx context.Context, kind string, ids ...uint) error
+
+	// GetPending returns the oldest queued updates.
+	GetPending(ctx context.Context, limit int) ([]model.SearchIndexUpdate, error)
+
+	// Delete removes applied updates from the queue.
+	Delete(ctx context.Context, ids []uint) error
+}
+
+type searchIndexDao struct {
+	db *gorm.DB
+}
+
+func NewSearchIndexDao() SearchIndexDao {
+	return searchIndexDao{db: DB}
+}
+
+// Enqueue queues streams or courses for reindexing.
+func (d searchIndexDao) Enqueue(c context.Context, kind string, ids ...uint) error {
+	if len(ids) == 0 {
+		return nil
+	}
+	updates := make([]model.SearchIndexUpdate, len(ids))
+	for i, id := range ids {
+		updates[i] = model.SearchIndexUpdate{Kind: kind, ObjectID: id}
+	}
+	return DB.WithContext(c).Create(&updates).Error
+}
+
+// GetPending returns the oldest queued updates.
+func (d searchIndexDao) GetPending(c context.Context, limit int) (res []model.SearchIndexUpdate, err error) {
+	return res, DB.WithContext(c).Order("id").Limit(limit).Find(&res).Error
+}
+
+// Delete removes applied updates from the queue.
+func (d searchIndexDao) Delete(c context.Context, ids []uint) error {
+	if len(ids) == 0 {
+		return nil
+	}
+	return DB.WithContext(c).Unscoped().Delete(&model.SearchIndexUpdate{}, ids).Error
+}
+
+// SearchIndexEnabled is set if a search index is configured. Without one, nobody applies the queued updates,
+// so the write paths of the DAOs don't queue them.
+var SearchIndexEnabled = false
+
+// enqueueSearchIndexUpdate is called by the write paths of other DAOs. A failure doesn't fail the write,
+// the search index can be repaired with a full rebuild.
+func enqueueSearchIndexUpdate(kind string, ids ...uint) {
+	if !SearchIndexEnabled {
+		return
+	}
+	if err := NewSearchIndexDao().Enqueue(context.Background(), kind, ids...); err != nil {
+		logger.Error("can't queue search index update", "err", err, "kind", kind, "ids", ids)
+	}
+}
+
+// enqueueStreamsWhere queues the streams matching a condition, e.g. all streams of a lecture series.
+func enqueueStreamsWhere(query interface{}, args ...interface{}) {
+	if !SearchIndexEnabled {
+		return
+	}
+	var ids []uint
+	if err := DB.Unscoped().Model(&model.Stream{}).Where(query, args...).Pluck("id", &ids).Error; err != nil {
+		logger.Error("can't find streams for search index update", "err", err)
+		return
+	}
+	enqueueSearchIndexUpdate(model.SearchIndexStream, ids...)
+}
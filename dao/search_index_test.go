@@ -0,0 +1,24 @@
+package dao
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/TUM-Dev/gocast/model"
+)
+
+func TestEnqueueSearchIndexUpdate(t *testing.T) {
+	statements := dryRunDB(t)
+	defer func() { SearchIndexEnabled = false }()
+
+	enqueueSearchIndexUpdate(model.SearchIndexStream, 1)
+	if len(*statements) != 0 {
+		t.Errorf("expected no updates to be queued without search index, got %v", *statements)
+	}
+
+	SearchIndexEnabled = true
+	enqueueSearchIndexUpdate(model.SearchIndexStream, 1, 2)
+	if len(*statements) != 1 || !strings.Contains((*statements)[0], "INSERT INTO `search_index_updates`") {
+		t.Errorf("expected updates to be queued, got %v", *statements)
+	}
+}
@@ -0,0 +1,76 @@
+package dao
+
+import (
+	"context"
+
+	"github.com/TUM-Dev/gocast/model"
+	"gorm.io/gorm"
+)
+
+//go:generate mockgen -source=section-suggestion.go -destination ../mock_dao/section-suggestion.go
+
+type SectionSuggestionDao interface {
+	// Replace the suggestions of a stream and their thumbnails, thumbnails[i] is the thumbnail of suggestions[i].
+	Replace(ctx context.Context, streamID uint, suggestions []model.SectionSuggestion, thumbnails []model.File) error
+
+	// Get a SectionSuggestion by id.
+	Get(ctx context.Context, id uint) (model.SectionSuggestion, error)
+
+	// GetForStream returns the suggestions of a stream in the order of the VoD.
+	GetForStream(ctx context.Context, streamID uint) ([]model.SectionSuggestion, error)
+
+	// Delete a SectionSuggestion, e.g. when it is accepted or rejected.
+	Delete(ctx context.Context, id uint) error
+}
+
+type sectionSuggestionDao struct {
+	db *gorm.DB
+}
+
+func NewSectionSuggestionDao() SectionSuggestionDao {
+	return sectionSuggestionDao{db: DB}
+}
+
+// Replace the suggestions of a stream and their thumbnails, thumbnails[i] is the thumbnail of suggestions[i].
+func (d sectionSuggestionDao) Replace(c context.Context, streamID uint, suggestions []model.SectionSuggestion, thumbnails []model.File) error {
+	return DB.WithContext(c).Transaction(func(tx *gorm.DB) error {
+		var old []model.SectionSuggestion
+		if err := tx.Where("stream_id = ?", streamID).Find(&old).Error; err != nil {
+			return err
+		}
+		if err := tx.Unscoped().Where("stream_id = ?", streamID).Delete(&model.SectionSuggestion{}).Error; err != nil {
+			return err
+		}
+		// the worker overwrote the thumbnails of the replaced suggestions already
+		for _, suggestion := range old {
+			if err := tx.Delete(&model.File{}, suggestion.FileID).Error; err != nil {
+				return err
+			}
+		}
+		for i := range suggestions {
+			if err := tx.Create(&thumbnails[i]).Error; err != nil {
+				return err
+			}
+			suggestions[i].FileID = thumbnails[i].ID
+		}
+		if len(suggestions) == 0 {
+			return nil
+		}
+		return tx.Create(&suggestions).Error
+	})
+}
+
+// Get a SectionSuggestion by id.
+func (d sectionSuggestionDao) Get(c context.Context, id uint) (res model.SectionSuggestion, err error) {
+	return res, DB.WithContext(c).First(&res, id).Error
+}
+
+// GetForStream returns the suggestions of a stream in the order of the VoD.
+func (d sectionSuggestionDao) GetForStream(c context.Context, streamID uint) (res []model.SectionSuggestion, err error) {
+	return res, DB.WithContext(c).Where("stream_id = ?", streamID).Order("start ASC").Find(&res).Error
+}
+
+// Delete a SectionSuggestion, e.g. when it is accepted or rejected.
+func (d sectionSuggestionDao) Delete(c context.Context, id uint) error {
+	return DB.WithContext(c).Unscoped().Delete(&model.SectionSuggestion{}, id).Error
+}
@@ -0,0 +1,48 @@
+package dao
+
+import (
+	"context"
+
+	"github.com/TUM-Dev/gocast/model"
+	"gorm.io/gorm"
+)
+
+//go:generate mockgen -source=slide-text.go -destination ../mock_dao/slide-text.go
+
+type SlideTextDao interface {
+	// Replace the slide texts of a stream.
+	Replace(ctx context.Context, streamID uint, texts []model.SlideText) error
+
+	// GetForStream returns the slide texts of a stream in the order of the VoD.
+	GetForStream(ctx context.Context, streamID uint) ([]model.SlideText, error)
+}
+
+type slideTextDao struct {
+	db *gorm.DB
+}
+
+func NewSlideTextDao() SlideTextDao {
+	return slideTextDao{db: DB}
+}
+
+// Replace the slide texts of a stream.
+func (d slideTextDao) Replace(c context.Context, streamID uint, texts []model.SlideText) error {
+	err := DB.WithContext(c).Transaction(func(tx *gorm.DB) error {
+		if err := tx.Unscoped().Where("stream_id = ?", streamID).Delete(&model.SlideText{}).Error; err != nil {
+			return err
+		}
+		if len(texts) == 0 {
+			return nil
+		}
+		return tx.Create(&texts).Error
+	})
+	if err == nil {
+		enqueueSearchIndexUpdate(model.SearchIndexStream, streamID)
+	}
+	return err
+}
+
+// GetForStream returns the slide texts of a stream in the order of the VoD.
+func (d slideTextDao) GetForStream(c context.Context, streamID uint) (res []model.SlideText, err error) {
+	return res, DB.WithContext(c).Where("stream_id = ?", streamID).Order("start ASC").Find(&res).Error
+}
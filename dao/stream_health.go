@@ -0,0 +1,45 @@
+package dao
+
+import (
+	"context"
+	"time"
+
+	"github.com/TUM-Dev/gocast/model"
+	"gorm.io/gorm"
+)
+
+//go:generate mockgen -source=stream_health.go -destination ../mock_dao/stream_health.go
+
+type StreamHealthDao interface {
+	// Create a new StreamHealth sample.
+	Create(context.Context, *model.StreamHealth) error
+
+	// GetForStream returns the samples of a stream, oldest first.
+	GetForStream(ctx context.Context, streamID uint) ([]model.StreamHealth, error)
+
+	// DeleteOlderThan deletes all samples taken before t.
+	DeleteOlderThan(ctx context.Context, t time.Time) error
+}
+
+type streamHealthDao struct {
+	db *gorm.DB
+}
+
+func NewStreamHealthDao() StreamHealthDao {
+	return streamHealthDao{db: DB}
+}
+
+// Create a new StreamHealth sample.
+func (d streamHealthDao) Create(c context.Context, sample *model.StreamHealth) error {
+	return DB.WithContext(c).Create(sample).Error
+}
+
+// GetForStream returns the samples of a stream, oldest first.
+func (d streamHealthDao) GetForStream(c context.Context, streamID uint) (res []model.StreamHealth, err error) {
+	return res, DB.WithContext(c).Where("stream_id = ?", streamID).Order("time").Find(&res).Error
+}
+
+// DeleteOlderThan deletes all samples taken before t.
+func (d streamHealthDao) DeleteOlderThan(c context.Context, t time.Time) error {
+	return DB.WithContext(c).Where("time < ?", t).Delete(&model.StreamHealth{}).Error
+}
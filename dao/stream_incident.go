@@ -0,0 +1,55 @@
+package dao
+
+import (
+	"context"
+
+	"github.com/TUM-Dev/gocast/model"
+	"gorm.io/gorm"
+)
+
+//go:generate mockgen -source=stream_incident.go -destination ../mock_dao/stream_incident.go
+
+type StreamIncidentDao interface {
+	// Create a new StreamIncident.
+	Create(context.Context, *model.StreamIncident) error
+
+	// Save a StreamIncident.
+	Save(context.Context, *model.StreamIncident) error
+
+	// GetOpen returns the latest incident of a type that isn't over for a version of a stream.
+	GetOpen(ctx context.Context, streamID uint, version model.StreamVersion, incidentType string) (model.StreamIncident, error)
+
+	// GetForStream returns the incidents of a stream, newest first.
+	GetForStream(ctx context.Context, streamID uint) ([]model.StreamIncident, error)
+}
+
+type streamIncidentDao struct {
+	db *gorm.DB
+}
+
+func NewStreamIncidentDao() StreamIncidentDao {
+	return streamIncidentDao{db: DB}
+}
+
+// Create a new StreamIncident.
+func (d streamIncidentDao) Create(c context.Context, incident *model.StreamIncident) error {
+	return DB.WithContext(c).Create(incident).Error
+}
+
+// Save a StreamIncident.
+func (d streamIncidentDao) Save(c context.Context, incident *model.StreamIncident) error {
+	return DB.WithContext(c).Save(incident).Error
+}
+
+// GetOpen returns the latest incident of a type that isn't over for a version of a stream.
+func (d streamIncidentDao) GetOpen(c context.Context, streamID uint, version model.StreamVersion, incidentType string) (res model.StreamIncident, err error) {
+	return res, DB.WithContext(c).
+		Where("stream_id = ? AND version = ? AND type = ? AND ended_at IS NULL", streamID, version, incidentType).
+		Order("id DESC").
+		First(&res).Error
+}
+
+// GetForStream returns the incidents of a stream, newest first.
+func (d streamIncidentDao) GetForStream(c context.Context, streamID uint) (res []model.StreamIncident, err error) {
+	return res, DB.WithContext(c).Where("stream_id = ?", streamID).Order("id DESC").Find(&res).Error
+}
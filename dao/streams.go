@@ -31,6 +31,7 @@ type StreamsDao interface {
 	GetWorkersForStream(stream model.Stream) ([]model.Worker, error)
 	GetAllStreams() ([]model.Stream, error)
 	ExecAllStreamsWithCoursesAndSubtitles(f func([]StreamWithCourseAndSubtitles))
+	GetStreamWithCourseAndSubtitles(ctx context.Context, id uint) (StreamWithCourseAndSubtitles, error)
 	GetCurrentLive(ctx context.Context) (currentLive []model.Stream, err error)
 	GetCurrentLiveNonHidden(ctx context.Context) (currentLive []model.Stream, err error)
 	GetLiveStreamsInLectureHall(lectureHallId uint) ([]model.Stream, error)
@@ -44,17 +45,20 @@ type StreamsDao interface {
 	ClearWorkersForStream(stream model.Stream) error
 	UpdateSilences(silences []model.Silence, streamID string) error
 	DeleteSilences(streamID string) error
+	UpdateEdits(streamID uint, edits []model.StreamEdit) error
 	UpdateStreamFullAssoc(vod *model.Stream) error
 	SetStreamNotLiveById(streamID uint) error
 	SetStreamLiveNowTimestampById(streamID uint, liveNowTimestamp time.Time) error
 	SaveEndedState(streamID uint, hasEnded bool) error
 	SaveCOMBURL(stream *model.Stream, url string)
+	SaveDvrURL(stream *model.Stream, version string, url string) error
 	SaveCAMURL(stream *model.Stream, url string)
 	SavePRESURL(stream *model.Stream, url string)
 	SaveTranscodingProgress(progress model.TranscodingProgress) error
 	RemoveTranscodingProgress(streamVersion model.StreamVersion, streamId uint) error
 	GetTranscodingProgressByVersion(streamVersion model.StreamVersion, streamId uint) (model.TranscodingProgress, error)
 	SaveStream(vod *model.Stream) error
+	SaveLoudness(streamID uint, integrated float64, truePeak float64) error
 	ToggleVisibility(streamId uint, private bool) error
 
 	DeleteStream(streamID string)
@@ -78,7 +82,11 @@ func NewStreamsDao() StreamsDao {
 }
 
 func (d streamsDao) CreateStream(stream *model.Stream) error {
-	return DB.Create(stream).Error
+	err := DB.Create(stream).Error
+	if err == nil {
+		enqueueSearchIndexUpdate(model.SearchIndexStream, stream.ID)
+	}
+	return err
 }
 
 func (d streamsDao) SaveTranscodingProgress(progress model.TranscodingProgress) error {
@@ -172,6 +180,9 @@ func (d streamsDao) GetStreamByID(ctx context.Context, id string) (stream model.
 		}).
 		Preload("Files").
 		Preload("Silences").
+		Preload("Edits", func(db *gorm.DB) *gorm.DB {
+			return db.Order("start asc")
+		}).
 		Preload("Units", func(db *gorm.DB) *gorm.DB {
 			return db.Order("unit_start asc")
 		}).First(&res, "id = ?", id).Error
@@ -192,12 +203,18 @@ func (d streamsDao) UpdateLectureSeries(stream model.Stream) error {
 		"name":        stream.Name,
 		"description": stream.Description,
 	}).Error
+	if err == nil {
+		enqueueStreamsWhere("series_identifier = ?", stream.SeriesIdentifier)
+	}
 	return err
 }
 
 func (d streamsDao) DeleteLectureSeries(seriesIdentifier string) error {
 	defer Cache.Clear()
 	err := DB.Delete(&model.Stream{}, "`series_identifier` = ?", seriesIdentifier).Error
+	if err == nil {
+		enqueueStreamsWhere("series_identifier = ?", seriesIdentifier)
+	}
 	return err
 }
 
@@ -217,8 +234,37 @@ func (d streamsDao) GetAllStreams() ([]model.Stream, error) {
 
 type StreamWithCourseAndSubtitles struct {
 	Name, Description, TeachingTerm, CourseName, Subtitles string
+	Slug, Visibility                                       string
 	ID, CourseID                                           uint
 	Year                                                   int
+	Private                                                bool
+	Start                                                  time.Time
+}
+
+// GetStreamWithCourseAndSubtitles returns a recorded stream with its course and active subtitles
+// as ExecAllStreamsWithCoursesAndSubtitles does, gorm.ErrRecordNotFound if the stream is deleted or not recorded.
+func (d streamsDao) GetStreamWithCourseAndSubtitles(ctx context.Context, id uint) (res StreamWithCourseAndSubtitles, err error) {
+	err = DB.WithContext(ctx).Raw(`SELECT streams.id,
+                    streams.name,
+                    streams.description,
+                    streams.private,
+                    streams.start,
+                    c.id as course_id,
+                    c.name as course_name,
+                    c.slug,
+                    c.visibility,
+                    c.teaching_term,
+                    c.year,
+                    GROUP_CONCAT(s.content SEPARATOR '\n') AS subtitles
+             	FROM streams
+                      JOIN courses c ON c.id = streams.course_id AND c.deleted_at IS NULL
+                      LEFT JOIN subtitles s ON streams.id = s.stream_id AND s.active AND s.deleted_at IS NULL
+             	WHERE streams.id = ? AND streams.recording AND streams.deleted_at IS NULL
+             	GROUP BY streams.id`, id).Scan(&res).Error
+	if err == nil && res.ID == 0 {
+		err = gorm.ErrRecordNotFound
+	}
+	return res, err
 }
 
 // ExecAllStreamsWithCoursesAndSubtitles executes f on all streams with their courses and subtitles preloaded.
@@ -233,15 +279,19 @@ func (d streamsDao) ExecAllStreamsWithCoursesAndSubtitles(f func([]StreamWithCou
 				SELECT streams.id,
                     streams.name,
                     streams.description,
+                    streams.private,
+                    streams.start,
                     c.id as course_id,
                     c.name as course_name,
+                    c.slug,
+                    c.visibility,
                     c.teaching_term,
                     c.year,
                     s.content as subtitles,
                     IFNULL(s.stream_id, streams.id) as sid
              	FROM streams
                       JOIN courses c ON c.id = streams.course_id
-                      LEFT JOIN subtitles s ON streams.id = s.stream_id
+                      LEFT JOIN subtitles s ON streams.id = s.stream_id AND s.active
              	WHERE streams.recording AND streams.deleted_at IS NULL
 				LIMIT ? OFFSET ?
              	)
@@ -306,6 +356,25 @@ func (d streamsDao) GetStreamsWithWatchState(courseID uint, userID uint) (stream
 	for i := range streams {
 		streams[i].Watched = watchedStates[i].Watched
 	}
+	if err != nil || len(streams) == 0 {
+		return
+	}
+	// the edits are signed with the playlists of the streams
+	ids := make([]uint, len(streams))
+	for i := range streams {
+		ids[i] = streams[i].ID
+	}
+	var edits []model.StreamEdit
+	if err = DB.Where("stream_id IN ?", ids).Order("start asc").Find(&edits).Error; err != nil {
+		return
+	}
+	for _, edit := range edits {
+		for i := range streams {
+			if streams[i].ID == edit.StreamID {
+				streams[i].Edits = append(streams[i].Edits, edit)
+			}
+		}
+	}
 	return
 }
 
@@ -429,6 +498,9 @@ func (d streamsDao) UpdateStream(stream model.Stream) error {
 		"end":          stream.End,
 		"chat_enabled": stream.ChatEnabled,
 	}).Error
+	if err == nil {
+		enqueueSearchIndexUpdate(model.SearchIndexStream, stream.ID)
+	}
 	return err
 }
 
@@ -456,15 +528,41 @@ func (d streamsDao) UpdateSilences(silences []model.Silence, streamID string) er
 	return DB.Save(&silences).Error
 }
 
+// UpdateEdits replaces the edit decision list of a stream.
+func (d streamsDao) UpdateEdits(streamID uint, edits []model.StreamEdit) error {
+	defer Cache.Clear()
+	return DB.Transaction(func(tx *gorm.DB) error {
+		if err := tx.Where("stream_id = ?", streamID).Delete(&model.StreamEdit{}).Error; err != nil {
+			return err
+		}
+		if len(edits) == 0 {
+			return nil
+		}
+		for i := range edits {
+			edits[i].StreamID = streamID
+		}
+		return tx.Create(&edits).Error
+	})
+}
+
 func (d streamsDao) UpdateStreamFullAssoc(vod *model.Stream) error {
 	defer Cache.Clear()
 	err := DB.Session(&gorm.Session{FullSaveAssociations: true}).Updates(&vod).Error
+	if err == nil {
+		enqueueSearchIndexUpdate(model.SearchIndexStream, vod.ID)
+	}
 	return err
 }
 
 func (d streamsDao) SetStreamNotLiveById(streamID uint) error {
 	defer Cache.Clear()
-	return DB.Debug().Exec("UPDATE `streams` SET `live_now`='0' WHERE id = ?", streamID).Error
+	// DVR playlists are removed by the workers once the stream ends
+	err := DB.Debug().Exec("UPDATE `streams` SET `live_now`='0', `dvr_playlist_url`='', `dvr_playlist_url_pres`='', `dvr_playlist_url_cam`='' WHERE id = ?", streamID).Error
+	if err == nil {
+		// index the chat of the lecture
+		enqueueSearchIndexUpdate(model.SearchIndexStream, streamID)
+	}
+	return err
 }
 
 // SetStreamLiveNowTimestampById stores timestamp when stream is going live.
@@ -497,8 +595,34 @@ func (d streamsDao) SavePRESURL(stream *model.Stream, url string) {
 	Cache.Clear()
 }
 
+// SaveDvrURL sets the DVR playlist of a version (COMB, PRES or CAM) of a live stream.
+func (d streamsDao) SaveDvrURL(stream *model.Stream, version string, url string) error {
+	defer Cache.Clear()
+	column := "dvr_playlist_url"
+	switch version {
+	case "PRES":
+		column = "dvr_playlist_url_pres"
+	case "CAM":
+		column = "dvr_playlist_url_cam"
+	}
+	return DB.Model(stream).Update(column, url).Error
+}
+
 func (d streamsDao) ToggleVisibility(streamId uint, private bool) error {
-	return DB.Model(&model.Stream{}).Where("id = ?", streamId).Updates(map[string]interface{}{"private": private}).Error
+	err := DB.Model(&model.Stream{}).Where("id = ?", streamId).Updates(map[string]interface{}{"private": private}).Error
+	if err == nil {
+		enqueueSearchIndexUpdate(model.SearchIndexStream, streamId)
+	}
+	return err
+}
+
+// SaveLoudness stores the loudness a worker measured in the recording of a stream before normalising it.
+func (d streamsDao) SaveLoudness(streamID uint, integrated float64, truePeak float64) error {
+	defer Cache.Clear()
+	return DB.Model(&model.Stream{}).Where("id = ?", streamID).Updates(map[string]interface{}{
+		"loudness_integrated": integrated,
+		"loudness_true_peak":  truePeak,
+	}).Error
 }
 
 func (d streamsDao) SaveStream(vod *model.Stream) error {
@@ -531,6 +655,9 @@ func (d streamsDao) SaveStream(vod *model.Stream) error {
 		ThumbInterval:    vod.ThumbInterval,
 		Private:          vod.Private,
 	}).Error
+	if err == nil {
+		enqueueSearchIndexUpdate(model.SearchIndexStream, vod.ID)
+	}
 	return err
 }
 
@@ -541,6 +668,7 @@ func (d streamsDao) RemoveTranscodingProgress(streamVersion model.StreamVersion,
 func (d streamsDao) DeleteStream(streamID string) {
 	DB.Where("id = ?", streamID).Delete(&model.Stream{})
 	Cache.Clear()
+	enqueueStreamsWhere("id = ?", streamID)
 }
 
 func (d streamsDao) DeleteUnit(id uint) {
@@ -556,4 +684,5 @@ func (d streamsDao) DeleteStreamsWithTumID(ids []uint) {
 		}
 		return nil
 	})
+	enqueueStreamsWhere("tum_online_event_id IN ?", ids)
 }
@@ -0,0 +1,48 @@
+package dao
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/dgraph-io/ristretto"
+	"gorm.io/driver/mysql"
+	"gorm.io/gorm"
+)
+
+// dryRunDB replaces DB with a connection that only builds statements and returns the statements built.
+func dryRunDB(t *testing.T) *[]string {
+	db, err := gorm.Open(mysql.New(mysql.Config{DSN: "test@tcp(localhost:3306)/test", SkipInitializeWithVersion: true}),
+		&gorm.Config{DryRun: true, DisableAutomaticPing: true, SkipDefaultTransaction: true})
+	if err != nil {
+		t.Fatal(err)
+	}
+	var statements []string
+	record := func(tx *gorm.DB) {
+		statements = append(statements, tx.Dialector.Explain(tx.Statement.SQL.String(), tx.Statement.Vars...))
+	}
+	if err = db.Callback().Update().After("gorm:update").Register("test:statements", record); err != nil {
+		t.Fatal(err)
+	}
+	if err = db.Callback().Create().After("gorm:create").Register("test:statements", record); err != nil {
+		t.Fatal(err)
+	}
+	cache, _ := ristretto.NewCache(&ristretto.Config{NumCounters: 1e3, MaxCost: 1 << 20, BufferItems: 64})
+	DB, Cache = db, *cache
+	return &statements
+}
+
+func TestSaveLoudness(t *testing.T) {
+	statements := dryRunDB(t)
+	if err := NewStreamsDao().SaveLoudness(1, -31.4, -6); err != nil {
+		t.Fatal(err)
+	}
+	if len(*statements) != 1 {
+		t.Fatalf("expected one update, got %v", *statements)
+	}
+	stmt := (*statements)[0]
+	for _, want := range []string{"`loudness_integrated`=-31.4", "`loudness_true_peak`=-6", "WHERE id = 1"} {
+		if !strings.Contains(stmt, want) {
+			t.Errorf("expected %s in %s", want, stmt)
+		}
+	}
+}
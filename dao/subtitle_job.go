@@ -0,0 +1,55 @@
+package dao
+
+import (
+	"context"
+
+	"github.com/TUM-Dev/gocast/model"
+	"gorm.io/gorm"
+)
+
+//go:generate mockgen -source=subtitle_job.go -destination ../mock_dao/subtitle_job.go
+
+type SubtitleJobDao interface {
+	// Create a new SubtitleJob.
+	Create(context.Context, *model.SubtitleJob) error
+
+	// Save a SubtitleJob.
+	Save(context.Context, *model.SubtitleJob) error
+
+	// GetUnfinished returns the latest queued or running job of a stream and language.
+	GetUnfinished(ctx context.Context, streamID uint, lang string) (model.SubtitleJob, error)
+
+	// GetForStream returns the jobs of a stream, newest first.
+	GetForStream(ctx context.Context, streamID uint) ([]model.SubtitleJob, error)
+}
+
+type subtitleJobDao struct {
+	db *gorm.DB
+}
+
+func NewSubtitleJobDao() SubtitleJobDao {
+	return subtitleJobDao{db: DB}
+}
+
+// Create a new SubtitleJob.
+func (d subtitleJobDao) Create(c context.Context, job *model.SubtitleJob) error {
+	return DB.WithContext(c).Create(job).Error
+}
+
+// Save a SubtitleJob.
+func (d subtitleJobDao) Save(c context.Context, job *model.SubtitleJob) error {
+	return DB.WithContext(c).Save(job).Error
+}
+
+// GetUnfinished returns the latest queued or running job of a stream and language.
+func (d subtitleJobDao) GetUnfinished(c context.Context, streamID uint, lang string) (res model.SubtitleJob, err error) {
+	return res, DB.WithContext(c).
+		Where("stream_id = ? AND language = ? AND state IN ?", streamID, lang, []string{model.SubtitleJobQueued, model.SubtitleJobRunning}).
+		Order("id DESC").
+		First(&res).Error
+}
+
+// GetForStream returns the jobs of a stream, newest first.
+func (d subtitleJobDao) GetForStream(c context.Context, streamID uint) (res []model.SubtitleJob, err error) {
+	return res, DB.WithContext(c).Where("stream_id = ?", streamID).Order("id DESC").Find(&res).Error
+}
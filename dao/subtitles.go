@@ -14,15 +14,27 @@ type SubtitlesDao interface {
 	// Get Subtitles by ID
 	Get(context.Context, uint) (model.Subtitles, error)
 
-	// GetByStreamIDandLang returns the subtitles for a given query
+	// GetByStreamIDandLang returns the active subtitles for a given query
 	GetByStreamIDandLang(context.Context, uint, string) (model.Subtitles, error)
 
-	// CreateOrUpsert creates or updates subtitles for the database
+	// GetVersion returns a version of the subtitles of a stream in a language
+	GetVersion(ctx context.Context, streamID uint, lang string, version uint) (model.Subtitles, error)
+
+	// GetForStream returns all versions of the subtitles of a stream ordered by language and version
+	GetForStream(ctx context.Context, streamID uint) ([]model.Subtitles, error)
+
+	// CreateOrUpsert creates or updates the machine made version of subtitles
 	CreateOrUpsert(context.Context, *model.Subtitles) error
 
-	// Create saves subtitles
+	// Create saves subtitles as the next version of their stream and language
 	Create(c context.Context, it *model.Subtitles) error
 
+	// Save updates subtitles
+	Save(context.Context, *model.Subtitles) error
+
+	// Activate makes a version the only active version of its stream and language
+	Activate(context.Context, *model.Subtitles) error
+
 	// Delete a Subtitles by id.
 	Delete(context.Context, uint) error
 }
@@ -41,37 +53,116 @@ func (d subtitlesDao) Get(c context.Context, id uint) (res model.Subtitles, err
 }
 
 func (d subtitlesDao) GetByStreamIDandLang(c context.Context, id uint, lang string) (res model.Subtitles, err error) {
-	return res, DB.WithContext(c).First(&res, &model.Subtitles{StreamID: id, Language: lang}).Error
+	return res, DB.WithContext(c).
+		Where("stream_id = ? AND language = ? AND active", id, lang).
+		Order("version DESC").
+		First(&res).Error
+}
+
+// GetVersion returns a version of the subtitles of a stream in a language.
+func (d subtitlesDao) GetVersion(c context.Context, streamID uint, lang string, version uint) (res model.Subtitles, err error) {
+	return res, DB.WithContext(c).
+		Where("stream_id = ? AND language = ? AND version = ?", streamID, lang, version).
+		First(&res).Error
 }
 
-// CreateOrUpsert creates or upserts subtitles.
+// GetForStream returns all versions of the subtitles of a stream ordered by language and version.
+func (d subtitlesDao) GetForStream(c context.Context, streamID uint) (res []model.Subtitles, err error) {
+	return res, DB.WithContext(c).Where("stream_id = ?", streamID).Order("language, version").Find(&res).Error
+}
+
+// CreateOrUpsert updates the latest version of machine made subtitles from the same source (generated or translated)
+// or creates one. A new version only becomes active if there is no active version yet, so corrected subtitles stay active.
 func (d subtitlesDao) CreateOrUpsert(c context.Context, it *model.Subtitles) error {
-	update := DB.
-		WithContext(c).
-		Model(&model.Subtitles{}).Where("stream_id = ? AND language = ?", it.StreamID, it.Language).
-		Update("content", it.Content)
-
-	if update.Error != nil {
-		if errors.Is(update.Error, gorm.ErrRecordNotFound) {
-			return d.Create(c, it)
+	if it.Source == "" {
+		it.Source = model.SubtitlesSourceGenerated
+	}
+	var latest model.Subtitles
+	err := DB.WithContext(c).
+		Where("stream_id = ? AND language = ? AND source = ?", it.StreamID, it.Language, it.Source).
+		Order("version DESC").
+		First(&latest).Error
+	if err == nil {
+		err = DB.WithContext(c).Model(&latest).Update("content", it.Content).Error
+		if err == nil && latest.Active {
+			enqueueSearchIndexUpdate(model.SearchIndexStream, it.StreamID)
 		}
+		return err
+	}
+	if !errors.Is(err, gorm.ErrRecordNotFound) {
+		return err
+	}
 
-		return update.Error
+	var active int64
+	err = DB.WithContext(c).
+		Model(&model.Subtitles{}).
+		Where("stream_id = ? AND language = ? AND active", it.StreamID, it.Language).
+		Count(&active).Error
+	if err != nil {
+		return err
 	}
+	it.Active = active == 0
+	return d.Create(c, it)
+}
 
-	if update.RowsAffected == 0 {
-		return d.Create(c, it)
+// Create subtitles as the next version of their stream and language.
+func (d subtitlesDao) Create(c context.Context, it *model.Subtitles) error {
+	err := DB.WithContext(c).Transaction(func(tx *gorm.DB) error {
+		var latest uint
+		err := tx.Model(&model.Subtitles{}).
+			Where("stream_id = ? AND language = ?", it.StreamID, it.Language).
+			Select("IFNULL(MAX(version), 0)").
+			Scan(&latest).Error
+		if err != nil {
+			return err
+		}
+		it.Version = latest + 1
+		active := it.Active
+		if err = tx.Create(it).Error; err != nil {
+			return err
+		}
+		// active defaults to true in the database, gorm skips the zero value on create
+		it.Active = active
+		return tx.Model(it).Update("active", active).Error
+	})
+	if err == nil && it.Active {
+		enqueueSearchIndexUpdate(model.SearchIndexStream, it.StreamID)
 	}
+	return err
+}
 
-	return nil
+// Save updates subtitles.
+func (d subtitlesDao) Save(c context.Context, it *model.Subtitles) error {
+	err := DB.WithContext(c).Save(it).Error
+	if err == nil && it.Active {
+		enqueueSearchIndexUpdate(model.SearchIndexStream, it.StreamID)
+	}
+	return err
 }
 
-// Create subtitles.
-func (d subtitlesDao) Create(c context.Context, it *model.Subtitles) error {
-	return DB.WithContext(c).Create(it).Error
+// Activate makes a version the only active version of its stream and language.
+func (d subtitlesDao) Activate(c context.Context, it *model.Subtitles) error {
+	err := DB.WithContext(c).Transaction(func(tx *gorm.DB) error {
+		err := tx.Model(&model.Subtitles{}).
+			Where("stream_id = ? AND language = ? AND id != ?", it.StreamID, it.Language, it.ID).
+			Update("active", false).Error
+		if err != nil {
+			return err
+		}
+		it.Active = true
+		return tx.Model(it).Update("active", true).Error
+	})
+	if err == nil {
+		enqueueSearchIndexUpdate(model.SearchIndexStream, it.StreamID)
+	}
+	return err
 }
 
 // Delete a Subtitles by id.
 func (d subtitlesDao) Delete(c context.Context, id uint) error {
-	return DB.WithContext(c).Delete(&model.Subtitles{}, id).Error
+	err := DB.WithContext(c).Delete(&model.Subtitles{}, id).Error
+	if err == nil {
+		enqueueStreamsWhere("id = (?)", DB.Unscoped().Model(&model.Subtitles{}).Select("stream_id").Where("id = ?", id))
+	}
+	return err
 }
@@ -16,9 +16,14 @@ type TokenDao interface {
 	GetTokenByID(id string) (model.Token, error)
 	GetAllTokens(user *model.User) ([]AllTokensDto, error)
 
+	// GetUserToken returns the newest non-expired token of the user with the given scope.
+	GetUserToken(userID uint, scope string) (model.Token, error)
+
 	TokenUsed(token model.Token) error
 
 	DeleteToken(id string) error
+	// DeleteUserTokens revokes all tokens of the user with the given scope.
+	DeleteUserTokens(userID uint, scope string) error
 }
 
 type tokenDao struct {
@@ -47,6 +52,13 @@ func (d tokenDao) GetTokenByID(id string) (model.Token, error) {
 	return t, err
 }
 
+// GetUserToken returns the newest non-expired token of the user with the given scope.
+func (d tokenDao) GetUserToken(userID uint, scope string) (model.Token, error) {
+	var t model.Token
+	err := DB.Model(&t).Where("user_id = ? AND scope = ? AND (expires IS null OR expires > NOW())", userID, scope).Order("id desc").First(&t).Error
+	return t, err
+}
+
 // GetAllTokens returns all tokens and the corresponding users name, email and lrz id
 func (d tokenDao) GetAllTokens(user *model.User) ([]AllTokensDto, error) {
 	var tokens []AllTokensDto
@@ -77,6 +89,11 @@ func (d tokenDao) DeleteToken(id string) error {
 	return DB.Delete(&model.Token{}, id).Error
 }
 
+// DeleteUserTokens revokes all tokens of the user with the given scope.
+func (d tokenDao) DeleteUserTokens(userID uint, scope string) error {
+	return DB.Where("user_id = ? AND scope = ?", userID, scope).Delete(&model.Token{}).Error
+}
+
 type AllTokensDto struct {
 	model.Token
 	UserName  string
@@ -24,15 +24,29 @@ func NewVideoSectionDao() VideoSectionDao {
 }
 
 func (d videoSectionDao) Create(sections []model.VideoSection) error {
-	return d.db.Create(&sections).Error
+	err := d.db.Create(&sections).Error
+	if err == nil {
+		for _, section := range sections {
+			enqueueSearchIndexUpdate(model.SearchIndexStream, section.StreamID)
+		}
+	}
+	return err
 }
 
 func (d videoSectionDao) Update(section *model.VideoSection) error {
-	return d.db.Session(&gorm.Session{FullSaveAssociations: true}).Updates(&section).Error
+	err := d.db.Session(&gorm.Session{FullSaveAssociations: true}).Updates(&section).Error
+	if err == nil {
+		enqueueStreamsWhere("id = (?)", DB.Unscoped().Model(&model.VideoSection{}).Select("stream_id").Where("id = ?", section.ID))
+	}
+	return err
 }
 
 func (d videoSectionDao) Delete(videoSectionID uint) error {
-	return d.db.Delete(&model.VideoSection{}, "id = ?", videoSectionID).Error
+	err := d.db.Delete(&model.VideoSection{}, "id = ?", videoSectionID).Error
+	if err == nil {
+		enqueueStreamsWhere("id = (?)", DB.Unscoped().Model(&model.VideoSection{}).Select("stream_id").Where("id = ?", videoSectionID))
+	}
+	return err
 }
 
 func (d videoSectionDao) Get(videoSectionID uint) (section model.VideoSection, err error) {
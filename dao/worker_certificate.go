@@ -0,0 +1,55 @@
+package dao
+
+import (
+	"context"
+	"time"
+
+	"github.com/TUM-Dev/gocast/model"
+	"gorm.io/gorm"
+)
+
+//go:generate mockgen -source=worker_certificate.go -destination ../mock_dao/worker_certificate.go
+
+type WorkerCertificateDao interface {
+	// Create a new WorkerCertificate.
+	Create(context.Context, *model.WorkerCertificate) error
+
+	// GetBySerial returns the certificate with the given hex encoded serial number.
+	GetBySerial(ctx context.Context, serial string) (model.WorkerCertificate, error)
+
+	// GetForWorker returns all certificates issued to a worker, newest first.
+	GetForWorker(ctx context.Context, workerID string) ([]model.WorkerCertificate, error)
+
+	// RevokeForWorker revokes all certificates of a worker.
+	RevokeForWorker(ctx context.Context, workerID string) error
+}
+
+type workerCertificateDao struct {
+	db *gorm.DB
+}
+
+func NewWorkerCertificateDao() WorkerCertificateDao {
+	return workerCertificateDao{db: DB}
+}
+
+// Create a new WorkerCertificate.
+func (d workerCertificateDao) Create(c context.Context, cert *model.WorkerCertificate) error {
+	return DB.WithContext(c).Create(cert).Error
+}
+
+// GetBySerial returns the certificate with the given hex encoded serial number.
+func (d workerCertificateDao) GetBySerial(c context.Context, serial string) (res model.WorkerCertificate, err error) {
+	return res, DB.WithContext(c).Where("serial = ?", serial).First(&res).Error
+}
+
+// GetForWorker returns all certificates issued to a worker, newest first.
+func (d workerCertificateDao) GetForWorker(c context.Context, workerID string) (res []model.WorkerCertificate, err error) {
+	return res, DB.WithContext(c).Where("worker_id = ?", workerID).Order("id DESC").Find(&res).Error
+}
+
+// RevokeForWorker revokes all certificates of a worker.
+func (d workerCertificateDao) RevokeForWorker(c context.Context, workerID string) error {
+	return DB.WithContext(c).Model(&model.WorkerCertificate{}).
+		Where("worker_id = ? AND revoked_at IS NULL", workerID).
+		Update("revoked_at", time.Now()).Error
+}
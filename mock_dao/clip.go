@@ -0,0 +1,108 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: clip.go
+
+// Package mock_dao is a generated GoMock package.
+package mock_dao
+
+import (
+	context "context"
+	reflect "reflect"
+
+	model "github.com/TUM-Dev/gocast/model"
+	gomock "github.com/golang/mock/gomock"
+)
+
+// MockClipDao is a mock of ClipDao interface.
+type MockClipDao struct {
+	ctrl     *gomock.Controller
+	recorder *MockClipDaoMockRecorder
+}
+
+// MockClipDaoMockRecorder is the mock recorder for MockClipDao.
+type MockClipDaoMockRecorder struct {
+	mock *MockClipDao
+}
+
+// NewMockClipDao creates a new mock instance.
+func NewMockClipDao(ctrl *gomock.Controller) *MockClipDao {
+	mock := &MockClipDao{ctrl: ctrl}
+	mock.recorder = &MockClipDaoMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockClipDao) EXPECT() *MockClipDaoMockRecorder {
+	return m.recorder
+}
+
+// Create mocks base method.
+func (m *MockClipDao) Create(ctx context.Context, clip *model.Clip) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Create", ctx, clip)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Create indicates an expected call of Create.
+func (mr *MockClipDaoMockRecorder) Create(ctx, clip interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockClipDao)(nil).Create), ctx, clip)
+}
+
+// Delete mocks base method.
+func (m *MockClipDao) Delete(ctx context.Context, id uint) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Delete", ctx, id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Delete indicates an expected call of Delete.
+func (mr *MockClipDaoMockRecorder) Delete(ctx, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockClipDao)(nil).Delete), ctx, id)
+}
+
+// GetBySlug mocks base method.
+func (m *MockClipDao) GetBySlug(ctx context.Context, slug string) (model.Clip, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetBySlug", ctx, slug)
+	ret0, _ := ret[0].(model.Clip)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetBySlug indicates an expected call of GetBySlug.
+func (mr *MockClipDaoMockRecorder) GetBySlug(ctx, slug interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetBySlug", reflect.TypeOf((*MockClipDao)(nil).GetBySlug), ctx, slug)
+}
+
+// GetForCourse mocks base method.
+func (m *MockClipDao) GetForCourse(ctx context.Context, courseID uint) ([]model.Clip, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetForCourse", ctx, courseID)
+	ret0, _ := ret[0].([]model.Clip)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetForCourse indicates an expected call of GetForCourse.
+func (mr *MockClipDaoMockRecorder) GetForCourse(ctx, courseID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetForCourse", reflect.TypeOf((*MockClipDao)(nil).GetForCourse), ctx, courseID)
+}
+
+// SetFilePath mocks base method.
+func (m *MockClipDao) SetFilePath(ctx context.Context, id uint, path string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetFilePath", ctx, id, path)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SetFilePath indicates an expected call of SetFilePath.
+func (mr *MockClipDaoMockRecorder) SetFilePath(ctx, id, path interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetFilePath", reflect.TypeOf((*MockClipDao)(nil).SetFilePath), ctx, id, path)
+}
@@ -49,6 +49,21 @@ func (mr *MockIngestServerDaoMockRecorder) GetBestIngestServer() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetBestIngestServer", reflect.TypeOf((*MockIngestServerDao)(nil).GetBestIngestServer))
 }
 
+// GetIngestServerByID mocks base method.
+func (m *MockIngestServerDao) GetIngestServerByID(id uint) (model.IngestServer, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetIngestServerByID", id)
+	ret0, _ := ret[0].(model.IngestServer)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetIngestServerByID indicates an expected call of GetIngestServerByID.
+func (mr *MockIngestServerDaoMockRecorder) GetIngestServerByID(id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetIngestServerByID", reflect.TypeOf((*MockIngestServerDao)(nil).GetIngestServerByID), id)
+}
+
 // GetStreamSlot mocks base method.
 func (m *MockIngestServerDao) GetStreamSlot(ingestServerID uint) (model.StreamName, error) {
 	m.ctrl.T.Helper()
@@ -64,6 +79,21 @@ func (mr *MockIngestServerDaoMockRecorder) GetStreamSlot(ingestServerID interfac
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetStreamSlot", reflect.TypeOf((*MockIngestServerDao)(nil).GetStreamSlot), ingestServerID)
 }
 
+// GetStreamSlotByID mocks base method.
+func (m *MockIngestServerDao) GetStreamSlotByID(id uint) (model.StreamName, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetStreamSlotByID", id)
+	ret0, _ := ret[0].(model.StreamName)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetStreamSlotByID indicates an expected call of GetStreamSlotByID.
+func (mr *MockIngestServerDaoMockRecorder) GetStreamSlotByID(id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetStreamSlotByID", reflect.TypeOf((*MockIngestServerDao)(nil).GetStreamSlotByID), id)
+}
+
 // GetTranscodedStreamSlot mocks base method.
 func (m *MockIngestServerDao) GetTranscodedStreamSlot(ingestServerID uint) (model.StreamName, error) {
 	m.ctrl.T.Helper()
@@ -0,0 +1,199 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: job.go
+
+// Package mock_dao is a generated GoMock package.
+package mock_dao
+
+import (
+	context "context"
+	reflect "reflect"
+	time "time"
+
+	model "github.com/TUM-Dev/gocast/model"
+	gomock "github.com/golang/mock/gomock"
+)
+
+// MockJobDao is a mock of JobDao interface.
+type MockJobDao struct {
+	ctrl     *gomock.Controller
+	recorder *MockJobDaoMockRecorder
+}
+
+// MockJobDaoMockRecorder is the mock recorder for MockJobDao.
+type MockJobDaoMockRecorder struct {
+	mock *MockJobDao
+}
+
+// NewMockJobDao creates a new mock instance.
+func NewMockJobDao(ctrl *gomock.Controller) *MockJobDao {
+	mock := &MockJobDao{ctrl: ctrl}
+	mock.recorder = &MockJobDaoMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockJobDao) EXPECT() *MockJobDaoMockRecorder {
+	return m.recorder
+}
+
+// Claim mocks base method.
+func (m *MockJobDao) Claim(ctx context.Context, job *model.Job) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Claim", ctx, job)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Claim indicates an expected call of Claim.
+func (mr *MockJobDaoMockRecorder) Claim(ctx, job interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Claim", reflect.TypeOf((*MockJobDao)(nil).Claim), ctx, job)
+}
+
+// Create mocks base method.
+func (m *MockJobDao) Create(arg0 context.Context, arg1 *model.Job) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Create", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Create indicates an expected call of Create.
+func (mr *MockJobDaoMockRecorder) Create(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockJobDao)(nil).Create), arg0, arg1)
+}
+
+// Find mocks base method.
+func (m *MockJobDao) Find(ctx context.Context, state model.JobState, jobType model.JobType, limit, offset int) ([]model.Job, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Find", ctx, state, jobType, limit, offset)
+	ret0, _ := ret[0].([]model.Job)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Find indicates an expected call of Find.
+func (mr *MockJobDaoMockRecorder) Find(ctx, state, jobType, limit, offset interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Find", reflect.TypeOf((*MockJobDao)(nil).Find), ctx, state, jobType, limit, offset)
+}
+
+// Get mocks base method.
+func (m *MockJobDao) Get(arg0 context.Context, arg1 uint) (model.Job, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Get", arg0, arg1)
+	ret0, _ := ret[0].(model.Job)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Get indicates an expected call of Get.
+func (mr *MockJobDaoMockRecorder) Get(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Get", reflect.TypeOf((*MockJobDao)(nil).Get), arg0, arg1)
+}
+
+// GetActive mocks base method.
+func (m *MockJobDao) GetActive(ctx context.Context) ([]model.Job, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetActive", ctx)
+	ret0, _ := ret[0].([]model.Job)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetActive indicates an expected call of GetActive.
+func (mr *MockJobDaoMockRecorder) GetActive(ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetActive", reflect.TypeOf((*MockJobDao)(nil).GetActive), ctx)
+}
+
+// GetDue mocks base method.
+func (m *MockJobDao) GetDue(ctx context.Context, limit int) ([]model.Job, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetDue", ctx, limit)
+	ret0, _ := ret[0].([]model.Job)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetDue indicates an expected call of GetDue.
+func (mr *MockJobDaoMockRecorder) GetDue(ctx, limit interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetDue", reflect.TypeOf((*MockJobDao)(nil).GetDue), ctx, limit)
+}
+
+// GetExpiredLeases mocks base method.
+func (m *MockJobDao) GetExpiredLeases(ctx context.Context) ([]model.Job, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetExpiredLeases", ctx)
+	ret0, _ := ret[0].([]model.Job)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetExpiredLeases indicates an expected call of GetExpiredLeases.
+func (mr *MockJobDaoMockRecorder) GetExpiredLeases(ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetExpiredLeases", reflect.TypeOf((*MockJobDao)(nil).GetExpiredLeases), ctx)
+}
+
+// GetUnfinished mocks base method.
+func (m *MockJobDao) GetUnfinished(ctx context.Context, jobType model.JobType, streamID uint) ([]model.Job, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetUnfinished", ctx, jobType, streamID)
+	ret0, _ := ret[0].([]model.Job)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetUnfinished indicates an expected call of GetUnfinished.
+func (mr *MockJobDaoMockRecorder) GetUnfinished(ctx, jobType, streamID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUnfinished", reflect.TypeOf((*MockJobDao)(nil).GetUnfinished), ctx, jobType, streamID)
+}
+
+// RenewLeases mocks base method.
+func (m *MockJobDao) RenewLeases(ctx context.Context, workerID string, until time.Time) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RenewLeases", ctx, workerID, until)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RenewLeases indicates an expected call of RenewLeases.
+func (mr *MockJobDaoMockRecorder) RenewLeases(ctx, workerID, until interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RenewLeases", reflect.TypeOf((*MockJobDao)(nil).RenewLeases), ctx, workerID, until)
+}
+
+// Save mocks base method.
+func (m *MockJobDao) Save(arg0 context.Context, arg1 *model.Job) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Save", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Save indicates an expected call of Save.
+func (mr *MockJobDaoMockRecorder) Save(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Save", reflect.TypeOf((*MockJobDao)(nil).Save), arg0, arg1)
+}
+
+// SaveResult mocks base method.
+func (m *MockJobDao) SaveResult(ctx context.Context, job *model.Job, from model.JobState) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SaveResult", ctx, job, from)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SaveResult indicates an expected call of SaveResult.
+func (mr *MockJobDaoMockRecorder) SaveResult(ctx, job, from interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SaveResult", reflect.TypeOf((*MockJobDao)(nil).SaveResult), ctx, job, from)
+}
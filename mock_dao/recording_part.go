@@ -0,0 +1,94 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: recording_part.go
+
+// Package mock_dao is a generated GoMock package.
+package mock_dao
+
+import (
+	context "context"
+	reflect "reflect"
+
+	model "github.com/TUM-Dev/gocast/model"
+	gomock "github.com/golang/mock/gomock"
+)
+
+// MockRecordingPartDao is a mock of RecordingPartDao interface.
+type MockRecordingPartDao struct {
+	ctrl     *gomock.Controller
+	recorder *MockRecordingPartDaoMockRecorder
+}
+
+// MockRecordingPartDaoMockRecorder is the mock recorder for MockRecordingPartDao.
+type MockRecordingPartDaoMockRecorder struct {
+	mock *MockRecordingPartDao
+}
+
+// NewMockRecordingPartDao creates a new mock instance.
+func NewMockRecordingPartDao(ctrl *gomock.Controller) *MockRecordingPartDao {
+	mock := &MockRecordingPartDao{ctrl: ctrl}
+	mock.recorder = &MockRecordingPartDaoMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockRecordingPartDao) EXPECT() *MockRecordingPartDaoMockRecorder {
+	return m.recorder
+}
+
+// Create mocks base method.
+func (m *MockRecordingPartDao) Create(arg0 context.Context, arg1 *model.RecordingPart) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Create", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Create indicates an expected call of Create.
+func (mr *MockRecordingPartDaoMockRecorder) Create(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockRecordingPartDao)(nil).Create), arg0, arg1)
+}
+
+// GetForStream mocks base method.
+func (m *MockRecordingPartDao) GetForStream(ctx context.Context, streamID uint, version string) ([]model.RecordingPart, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetForStream", ctx, streamID, version)
+	ret0, _ := ret[0].([]model.RecordingPart)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetForStream indicates an expected call of GetForStream.
+func (mr *MockRecordingPartDaoMockRecorder) GetForStream(ctx, streamID, version interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetForStream", reflect.TypeOf((*MockRecordingPartDao)(nil).GetForStream), ctx, streamID, version)
+}
+
+// GetUnstitched mocks base method.
+func (m *MockRecordingPartDao) GetUnstitched(ctx context.Context) ([]model.RecordingPart, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetUnstitched", ctx)
+	ret0, _ := ret[0].([]model.RecordingPart)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetUnstitched indicates an expected call of GetUnstitched.
+func (mr *MockRecordingPartDaoMockRecorder) GetUnstitched(ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUnstitched", reflect.TypeOf((*MockRecordingPartDao)(nil).GetUnstitched), ctx)
+}
+
+// Save mocks base method.
+func (m *MockRecordingPartDao) Save(arg0 context.Context, arg1 *model.RecordingPart) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Save", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Save indicates an expected call of Save.
+func (mr *MockRecordingPartDaoMockRecorder) Save(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Save", reflect.TypeOf((*MockRecordingPartDao)(nil).Save), arg0, arg1)
+}
@@ -0,0 +1,84 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: search_index.go
+
+// Package mock_dao is a generated GoMock package.
+package mock_dao
+
+import (
+	context "context"
+	reflect "reflect"
+
+	model "github.com/TUM-Dev/gocast/model"
+	gomock "github.com/golang/mock/gomock"
+)
+
+// MockSearchIndexDao is a mock of SearchIndexDao interface.
+type MockSearchIndexDao struct {
+	ctrl     *gomock.Controller
+	recorder *MockSearchIndexDaoMockRecorder
+}
+
+// MockSearchIndexDaoMockRecorder is the mock recorder for MockSearchIndexDao.
+type MockSearchIndexDaoMockRecorder struct {
+	mock *MockSearchIndexDao
+}
+
+// NewMockSearchIndexDao creates a new mock instance.
+func NewMockSearchIndexDao(ctrl *gomock.Controller) *MockSearchIndexDao {
+	mock := &MockSearchIndexDao{ctrl: ctrl}
+	mock.recorder = &MockSearchIndexDaoMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockSearchIndexDao) EXPECT() *MockSearchIndexDaoMockRecorder {
+	return m.recorder
+}
+
+// Delete mocks base method.
+func (m *MockSearchIndexDao) Delete(ctx context.Context, ids []uint) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Delete", ctx, ids)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Delete indicates an expected call of Delete.
+func (mr *MockSearchIndexDaoMockRecorder) Delete(ctx, ids interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockSearchIndexDao)(nil).Delete), ctx, ids)
+}
+
+// Enqueue mocks base method.
+func (m *MockSearchIndexDao) Enqueue(ctx context.Context, kind string, ids ...uint) error {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, kind}
+	for _, a := range ids {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "Enqueue", varargs...)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Enqueue indicates an expected call of Enqueue.
+func (mr *MockSearchIndexDaoMockRecorder) Enqueue(ctx, kind interface{}, ids ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, kind}, ids...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Enqueue", reflect.TypeOf((*MockSearchIndexDao)(nil).Enqueue), varargs...)
+}
+
+// GetPending mocks base method.
+func (m *MockSearchIndexDao) GetPending(ctx context.Context, limit int) ([]model.SearchIndexUpdate, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetPending", ctx, limit)
+	ret0, _ := ret[0].([]model.SearchIndexUpdate)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetPending indicates an expected call of GetPending.
+func (mr *MockSearchIndexDaoMockRecorder) GetPending(ctx, limit interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPending", reflect.TypeOf((*MockSearchIndexDao)(nil).GetPending), ctx, limit)
+}
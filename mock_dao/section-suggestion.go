@@ -0,0 +1,94 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: section-suggestion.go
+
+// Package mock_dao is a generated GoMock package.
+package mock_dao
+
+import (
+	context "context"
+	reflect "reflect"
+
+	model "github.com/TUM-Dev/gocast/model"
+	gomock "github.com/golang/mock/gomock"
+)
+
+// MockSectionSuggestionDao is a mock of SectionSuggestionDao interface.
+type MockSectionSuggestionDao struct {
+	ctrl     *gomock.Controller
+	recorder *MockSectionSuggestionDaoMockRecorder
+}
+
+// MockSectionSuggestionDaoMockRecorder is the mock recorder for MockSectionSuggestionDao.
+type MockSectionSuggestionDaoMockRecorder struct {
+	mock *MockSectionSuggestionDao
+}
+
+// NewMockSectionSuggestionDao creates a new mock instance.
+func NewMockSectionSuggestionDao(ctrl *gomock.Controller) *MockSectionSuggestionDao {
+	mock := &MockSectionSuggestionDao{ctrl: ctrl}
+	mock.recorder = &MockSectionSuggestionDaoMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockSectionSuggestionDao) EXPECT() *MockSectionSuggestionDaoMockRecorder {
+	return m.recorder
+}
+
+// Delete mocks base method.
+func (m *MockSectionSuggestionDao) Delete(ctx context.Context, id uint) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Delete", ctx, id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Delete indicates an expected call of Delete.
+func (mr *MockSectionSuggestionDaoMockRecorder) Delete(ctx, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockSectionSuggestionDao)(nil).Delete), ctx, id)
+}
+
+// Get mocks base method.
+func (m *MockSectionSuggestionDao) Get(ctx context.Context, id uint) (model.SectionSuggestion, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Get", ctx, id)
+	ret0, _ := ret[0].(model.SectionSuggestion)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Get indicates an expected call of Get.
+func (mr *MockSectionSuggestionDaoMockRecorder) Get(ctx, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Get", reflect.TypeOf((*MockSectionSuggestionDao)(nil).Get), ctx, id)
+}
+
+// GetForStream mocks base method.
+func (m *MockSectionSuggestionDao) GetForStream(ctx context.Context, streamID uint) ([]model.SectionSuggestion, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetForStream", ctx, streamID)
+	ret0, _ := ret[0].([]model.SectionSuggestion)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetForStream indicates an expected call of GetForStream.
+func (mr *MockSectionSuggestionDaoMockRecorder) GetForStream(ctx, streamID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetForStream", reflect.TypeOf((*MockSectionSuggestionDao)(nil).GetForStream), ctx, streamID)
+}
+
+// Replace mocks base method.
+func (m *MockSectionSuggestionDao) Replace(ctx context.Context, streamID uint, suggestions []model.SectionSuggestion, thumbnails []model.File) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Replace", ctx, streamID, suggestions, thumbnails)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Replace indicates an expected call of Replace.
+func (mr *MockSectionSuggestionDaoMockRecorder) Replace(ctx, streamID, suggestions, thumbnails interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Replace", reflect.TypeOf((*MockSectionSuggestionDao)(nil).Replace), ctx, streamID, suggestions, thumbnails)
+}
@@ -0,0 +1,65 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: slide-text.go
+
+// Package mock_dao is a generated GoMock package.
+package mock_dao
+
+import (
+	context "context"
+	reflect "reflect"
+
+	model "github.com/TUM-Dev/gocast/model"
+	gomock "github.com/golang/mock/gomock"
+)
+
+// MockSlideTextDao is a mock of SlideTextDao interface.
+type MockSlideTextDao struct {
+	ctrl     *gomock.Controller
+	recorder *MockSlideTextDaoMockRecorder
+}
+
+// MockSlideTextDaoMockRecorder is the mock recorder for MockSlideTextDao.
+type MockSlideTextDaoMockRecorder struct {
+	mock *MockSlideTextDao
+}
+
+// NewMockSlideTextDao creates a new mock instance.
+func NewMockSlideTextDao(ctrl *gomock.Controller) *MockSlideTextDao {
+	mock := &MockSlideTextDao{ctrl: ctrl}
+	mock.recorder = &MockSlideTextDaoMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockSlideTextDao) EXPECT() *MockSlideTextDaoMockRecorder {
+	return m.recorder
+}
+
+// GetForStream mocks base method.
+func (m *MockSlideTextDao) GetForStream(ctx context.Context, streamID uint) ([]model.SlideText, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetForStream", ctx, streamID)
+	ret0, _ := ret[0].([]model.SlideText)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetForStream indicates an expected call of GetForStream.
+func (mr *MockSlideTextDaoMockRecorder) GetForStream(ctx, streamID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetForStream", reflect.TypeOf((*MockSlideTextDao)(nil).GetForStream), ctx, streamID)
+}
+
+// Replace mocks base method.
+func (m *MockSlideTextDao) Replace(ctx context.Context, streamID uint, texts []model.SlideText) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Replace", ctx, streamID, texts)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Replace indicates an expected call of Replace.
+func (mr *MockSlideTextDaoMockRecorder) Replace(ctx, streamID, texts interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Replace", reflect.TypeOf((*MockSlideTextDao)(nil).Replace), ctx, streamID, texts)
+}
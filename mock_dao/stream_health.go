@@ -0,0 +1,80 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: stream_health.go
+
+// Package mock_dao is a generated GoMock package.
+package mock_dao
+
+import (
+	context "context"
+	reflect "reflect"
+	time "time"
+
+	model "github.com/TUM-Dev/gocast/model"
+	gomock "github.com/golang/mock/gomock"
+)
+
+// MockStreamHealthDao is a mock of StreamHealthDao interface.
+type MockStreamHealthDao struct {
+	ctrl     *gomock.Controller
+	recorder *MockStreamHealthDaoMockRecorder
+}
+
+// MockStreamHealthDaoMockRecorder is the mock recorder for MockStreamHealthDao.
+type MockStreamHealthDaoMockRecorder struct {
+	mock *MockStreamHealthDao
+}
+
+// NewMockStreamHealthDao creates a new mock instance.
+func NewMockStreamHealthDao(ctrl *gomock.Controller) *MockStreamHealthDao {
+	mock := &MockStreamHealthDao{ctrl: ctrl}
+	mock.recorder = &MockStreamHealthDaoMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockStreamHealthDao) EXPECT() *MockStreamHealthDaoMockRecorder {
+	return m.recorder
+}
+
+// Create mocks base method.
+func (m *MockStreamHealthDao) Create(arg0 context.Context, arg1 *model.StreamHealth) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Create", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Create indicates an expected call of Create.
+func (mr *MockStreamHealthDaoMockRecorder) Create(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockStreamHealthDao)(nil).Create), arg0, arg1)
+}
+
+// DeleteOlderThan mocks base method.
+func (m *MockStreamHealthDao) DeleteOlderThan(ctx context.Context, t time.Time) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteOlderThan", ctx, t)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteOlderThan indicates an expected call of DeleteOlderThan.
+func (mr *MockStreamHealthDaoMockRecorder) DeleteOlderThan(ctx, t interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteOlderThan", reflect.TypeOf((*MockStreamHealthDao)(nil).DeleteOlderThan), ctx, t)
+}
+
+// GetForStream mocks base method.
+func (m *MockStreamHealthDao) GetForStream(ctx context.Context, streamID uint) ([]model.StreamHealth, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetForStream", ctx, streamID)
+	ret0, _ := ret[0].([]model.StreamHealth)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetForStream indicates an expected call of GetForStream.
+func (mr *MockStreamHealthDaoMockRecorder) GetForStream(ctx, streamID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetForStream", reflect.TypeOf((*MockStreamHealthDao)(nil).GetForStream), ctx, streamID)
+}
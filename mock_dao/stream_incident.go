@@ -0,0 +1,94 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: stream_incident.go
+
+// Package mock_dao is a generated GoMock package.
+package mock_dao
+
+import (
+	context "context"
+	reflect "reflect"
+
+	model "github.com/TUM-Dev/gocast/model"
+	gomock "github.com/golang/mock/gomock"
+)
+
+// MockStreamIncidentDao is a mock of StreamIncidentDao interface.
+type MockStreamIncidentDao struct {
+	ctrl     *gomock.Controller
+	recorder *MockStreamIncidentDaoMockRecorder
+}
+
+// MockStreamIncidentDaoMockRecorder is the mock recorder for MockStreamIncidentDao.
+type MockStreamIncidentDaoMockRecorder struct {
+	mock *MockStreamIncidentDao
+}
+
+// NewMockStreamIncidentDao creates a new mock instance.
+func NewMockStreamIncidentDao(ctrl *gomock.Controller) *MockStreamIncidentDao {
+	mock := &MockStreamIncidentDao{ctrl: ctrl}
+	mock.recorder = &MockStreamIncidentDaoMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockStreamIncidentDao) EXPECT() *MockStreamIncidentDaoMockRecorder {
+	return m.recorder
+}
+
+// Create mocks base method.
+func (m *MockStreamIncidentDao) Create(arg0 context.Context, arg1 *model.StreamIncident) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Create", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Create indicates an expected call of Create.
+func (mr *MockStreamIncidentDaoMockRecorder) Create(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockStreamIncidentDao)(nil).Create), arg0, arg1)
+}
+
+// GetForStream mocks base method.
+func (m *MockStreamIncidentDao) GetForStream(ctx context.Context, streamID uint) ([]model.StreamIncident, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetForStream", ctx, streamID)
+	ret0, _ := ret[0].([]model.StreamIncident)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetForStream indicates an expected call of GetForStream.
+func (mr *MockStreamIncidentDaoMockRecorder) GetForStream(ctx, streamID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetForStream", reflect.TypeOf((*MockStreamIncidentDao)(nil).GetForStream), ctx, streamID)
+}
+
+// GetOpen mocks base method.
+func (m *MockStreamIncidentDao) GetOpen(ctx context.Context, streamID uint, version model.StreamVersion, incidentType string) (model.StreamIncident, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetOpen", ctx, streamID, version, incidentType)
+	ret0, _ := ret[0].(model.StreamIncident)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetOpen indicates an expected call of GetOpen.
+func (mr *MockStreamIncidentDaoMockRecorder) GetOpen(ctx, streamID, version, incidentType interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetOpen", reflect.TypeOf((*MockStreamIncidentDao)(nil).GetOpen), ctx, streamID, version, incidentType)
+}
+
+// Save mocks base method.
+func (m *MockStreamIncidentDao) Save(arg0 context.Context, arg1 *model.StreamIncident) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Save", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Save indicates an expected call of Save.
+func (mr *MockStreamIncidentDaoMockRecorder) Save(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Save", reflect.TypeOf((*MockStreamIncidentDao)(nil).Save), arg0, arg1)
+}
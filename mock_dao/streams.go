@@ -304,6 +304,21 @@ func (mr *MockStreamsDaoMockRecorder) GetStreamByTumOnlineID(ctx, id interface{}
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetStreamByTumOnlineID", reflect.TypeOf((*MockStreamsDao)(nil).GetStreamByTumOnlineID), ctx, id)
 }
 
+// GetStreamWithCourseAndSubtitles mocks base method.
+func (m *MockStreamsDao) GetStreamWithCourseAndSubtitles(ctx context.Context, id uint) (dao.StreamWithCourseAndSubtitles, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetStreamWithCourseAndSubtitles", ctx, id)
+	ret0, _ := ret[0].(dao.StreamWithCourseAndSubtitles)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetStreamWithCourseAndSubtitles indicates an expected call of GetStreamWithCourseAndSubtitles.
+func (mr *MockStreamsDaoMockRecorder) GetStreamWithCourseAndSubtitles(ctx, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetStreamWithCourseAndSubtitles", reflect.TypeOf((*MockStreamsDao)(nil).GetStreamWithCourseAndSubtitles), ctx, id)
+}
+
 // GetStreamsByIds mocks base method.
 func (m *MockStreamsDao) GetStreamsByIds(ids []uint) ([]model.Stream, error) {
 	m.ctrl.T.Helper()
@@ -417,6 +432,20 @@ func (mr *MockStreamsDaoMockRecorder) SaveCOMBURL(stream, url interface{}) *gomo
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SaveCOMBURL", reflect.TypeOf((*MockStreamsDao)(nil).SaveCOMBURL), stream, url)
 }
 
+// SaveDvrURL mocks base method.
+func (m *MockStreamsDao) SaveDvrURL(stream *model.Stream, version, url string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SaveDvrURL", stream, version, url)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SaveDvrURL indicates an expected call of SaveDvrURL.
+func (mr *MockStreamsDaoMockRecorder) SaveDvrURL(stream, version, url interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SaveDvrURL", reflect.TypeOf((*MockStreamsDao)(nil).SaveDvrURL), stream, version, url)
+}
+
 // SaveEndedState mocks base method.
 func (m *MockStreamsDao) SaveEndedState(streamID uint, hasEnded bool) error {
 	m.ctrl.T.Helper()
@@ -431,6 +460,20 @@ func (mr *MockStreamsDaoMockRecorder) SaveEndedState(streamID, hasEnded interfac
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SaveEndedState", reflect.TypeOf((*MockStreamsDao)(nil).SaveEndedState), streamID, hasEnded)
 }
 
+// SaveLoudness mocks base method.
+func (m *MockStreamsDao) SaveLoudness(streamID uint, integrated, truePeak float64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SaveLoudness", streamID, integrated, truePeak)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SaveLoudness indicates an expected call of SaveLoudness.
+func (mr *MockStreamsDaoMockRecorder) SaveLoudness(streamID, integrated, truePeak interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SaveLoudness", reflect.TypeOf((*MockStreamsDao)(nil).SaveLoudness), streamID, integrated, truePeak)
+}
+
 // SavePRESURL mocks base method.
 func (m *MockStreamsDao) SavePRESURL(stream *model.Stream, url string) {
 	m.ctrl.T.Helper()
@@ -555,6 +598,20 @@ func (mr *MockStreamsDaoMockRecorder) UnsetLectureHall(streamIDs interface{}) *g
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UnsetLectureHall", reflect.TypeOf((*MockStreamsDao)(nil).UnsetLectureHall), streamIDs)
 }
 
+// UpdateEdits mocks base method.
+func (m *MockStreamsDao) UpdateEdits(streamID uint, edits []model.StreamEdit) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateEdits", streamID, edits)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateEdits indicates an expected call of UpdateEdits.
+func (mr *MockStreamsDaoMockRecorder) UpdateEdits(streamID, edits interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateEdits", reflect.TypeOf((*MockStreamsDao)(nil).UpdateEdits), streamID, edits)
+}
+
 // UpdateLectureSeries mocks base method.
 func (m *MockStreamsDao) UpdateLectureSeries(arg0 model.Stream) error {
 	m.ctrl.T.Helper()
@@ -0,0 +1,94 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: subtitle_job.go
+
+// Package mock_dao is a generated GoMock package.
+package mock_dao
+
+import (
+	context "context"
+	reflect "reflect"
+
+	model "github.com/TUM-Dev/gocast/model"
+	gomock "github.com/golang/mock/gomock"
+)
+
+// MockSubtitleJobDao is a mock of SubtitleJobDao interface.
+type MockSubtitleJobDao struct {
+	ctrl     *gomock.Controller
+	recorder *MockSubtitleJobDaoMockRecorder
+}
+
+// MockSubtitleJobDaoMockRecorder is the mock recorder for MockSubtitleJobDao.
+type MockSubtitleJobDaoMockRecorder struct {
+	mock *MockSubtitleJobDao
+}
+
+// NewMockSubtitleJobDao creates a new mock instance.
+func NewMockSubtitleJobDao(ctrl *gomock.Controller) *MockSubtitleJobDao {
+	mock := &MockSubtitleJobDao{ctrl: ctrl}
+	mock.recorder = &MockSubtitleJobDaoMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockSubtitleJobDao) EXPECT() *MockSubtitleJobDaoMockRecorder {
+	return m.recorder
+}
+
+// Create mocks base method.
+func (m *MockSubtitleJobDao) Create(arg0 context.Context, arg1 *model.SubtitleJob) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Create", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Create indicates an expected call of Create.
+func (mr *MockSubtitleJobDaoMockRecorder) Create(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockSubtitleJobDao)(nil).Create), arg0, arg1)
+}
+
+// GetForStream mocks base method.
+func (m *MockSubtitleJobDao) GetForStream(ctx context.Context, streamID uint) ([]model.SubtitleJob, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetForStream", ctx, streamID)
+	ret0, _ := ret[0].([]model.SubtitleJob)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetForStream indicates an expected call of GetForStream.
+func (mr *MockSubtitleJobDaoMockRecorder) GetForStream(ctx, streamID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetForStream", reflect.TypeOf((*MockSubtitleJobDao)(nil).GetForStream), ctx, streamID)
+}
+
+// GetUnfinished mocks base method.
+func (m *MockSubtitleJobDao) GetUnfinished(ctx context.Context, streamID uint, lang string) (model.SubtitleJob, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetUnfinished", ctx, streamID, lang)
+	ret0, _ := ret[0].(model.SubtitleJob)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetUnfinished indicates an expected call of GetUnfinished.
+func (mr *MockSubtitleJobDaoMockRecorder) GetUnfinished(ctx, streamID, lang interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUnfinished", reflect.TypeOf((*MockSubtitleJobDao)(nil).GetUnfinished), ctx, streamID, lang)
+}
+
+// Save mocks base method.
+func (m *MockSubtitleJobDao) Save(arg0 context.Context, arg1 *model.SubtitleJob) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Save", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Save indicates an expected call of Save.
+func (mr *MockSubtitleJobDaoMockRecorder) Save(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Save", reflect.TypeOf((*MockSubtitleJobDao)(nil).Save), arg0, arg1)
+}
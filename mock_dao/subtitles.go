@@ -35,6 +35,20 @@ func (m *MockSubtitlesDao) EXPECT() *MockSubtitlesDaoMockRecorder {
 	return m.recorder
 }
 
+// Activate mocks base method.
+func (m *MockSubtitlesDao) Activate(arg0 context.Context, arg1 *model.Subtitles) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Activate", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Activate indicates an expected call of Activate.
+func (mr *MockSubtitlesDaoMockRecorder) Activate(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Activate", reflect.TypeOf((*MockSubtitlesDao)(nil).Activate), arg0, arg1)
+}
+
 // Create mocks base method.
 func (m *MockSubtitlesDao) Create(c context.Context, it *model.Subtitles) error {
 	m.ctrl.T.Helper()
@@ -106,3 +120,47 @@ func (mr *MockSubtitlesDaoMockRecorder) GetByStreamIDandLang(arg0, arg1, arg2 in
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByStreamIDandLang", reflect.TypeOf((*MockSubtitlesDao)(nil).GetByStreamIDandLang), arg0, arg1, arg2)
 }
+
+// GetForStream mocks base method.
+func (m *MockSubtitlesDao) GetForStream(ctx context.Context, streamID uint) ([]model.Subtitles, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetForStream", ctx, streamID)
+	ret0, _ := ret[0].([]model.Subtitles)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetForStream indicates an expected call of GetForStream.
+func (mr *MockSubtitlesDaoMockRecorder) GetForStream(ctx, streamID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetForStream", reflect.TypeOf((*MockSubtitlesDao)(nil).GetForStream), ctx, streamID)
+}
+
+// GetVersion mocks base method.
+func (m *MockSubtitlesDao) GetVersion(ctx context.Context, streamID uint, lang string, version uint) (model.Subtitles, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetVersion", ctx, streamID, lang, version)
+	ret0, _ := ret[0].(model.Subtitles)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetVersion indicates an expected call of GetVersion.
+func (mr *MockSubtitlesDaoMockRecorder) GetVersion(ctx, streamID, lang, version interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetVersion", reflect.TypeOf((*MockSubtitlesDao)(nil).GetVersion), ctx, streamID, lang, version)
+}
+
+// Save mocks base method.
+func (m *MockSubtitlesDao) Save(arg0 context.Context, arg1 *model.Subtitles) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Save", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Save indicates an expected call of Save.
+func (mr *MockSubtitlesDaoMockRecorder) Save(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Save", reflect.TypeOf((*MockSubtitlesDao)(nil).Save), arg0, arg1)
+}
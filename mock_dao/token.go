@@ -63,6 +63,20 @@ func (mr *MockTokenDaoMockRecorder) DeleteToken(id interface{}) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteToken", reflect.TypeOf((*MockTokenDao)(nil).DeleteToken), id)
 }
 
+// DeleteUserTokens mocks base method.
+func (m *MockTokenDao) DeleteUserTokens(userID uint, scope string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteUserTokens", userID, scope)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteUserTokens indicates an expected call of DeleteUserTokens.
+func (mr *MockTokenDaoMockRecorder) DeleteUserTokens(userID, scope interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteUserTokens", reflect.TypeOf((*MockTokenDao)(nil).DeleteUserTokens), userID, scope)
+}
+
 // GetAllTokens mocks base method.
 func (m *MockTokenDao) GetAllTokens(user *model.User) ([]dao.AllTokensDto, error) {
 	m.ctrl.T.Helper()
@@ -108,6 +122,21 @@ func (mr *MockTokenDaoMockRecorder) GetTokenByID(id interface{}) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTokenByID", reflect.TypeOf((*MockTokenDao)(nil).GetTokenByID), id)
 }
 
+// GetUserToken mocks base method.
+func (m *MockTokenDao) GetUserToken(userID uint, scope string) (model.Token, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetUserToken", userID, scope)
+	ret0, _ := ret[0].(model.Token)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetUserToken indicates an expected call of GetUserToken.
+func (mr *MockTokenDaoMockRecorder) GetUserToken(userID, scope interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUserToken", reflect.TypeOf((*MockTokenDao)(nil).GetUserToken), userID, scope)
+}
+
 // TokenUsed mocks base method.
 func (m *MockTokenDao) TokenUsed(token model.Token) error {
 	m.ctrl.T.Helper()
@@ -0,0 +1,94 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: worker_certificate.go
+
+// Package mock_dao is a generated GoMock package.
+package mock_dao
+
+import (
+	context "context"
+	reflect "reflect"
+
+	model "github.com/TUM-Dev/gocast/model"
+	gomock "github.com/golang/mock/gomock"
+)
+
+// MockWorkerCertificateDao is a mock of WorkerCertificateDao interface.
+type MockWorkerCertificateDao struct {
+	ctrl     *gomock.Controller
+	recorder *MockWorkerCertificateDaoMockRecorder
+}
+
+// MockWorkerCertificateDaoMockRecorder is the mock recorder for MockWorkerCertificateDao.
+type MockWorkerCertificateDaoMockRecorder struct {
+	mock *MockWorkerCertificateDao
+}
+
+// NewMockWorkerCertificateDao creates a new mock instance.
+func NewMockWorkerCertificateDao(ctrl *gomock.Controller) *MockWorkerCertificateDao {
+	mock := &MockWorkerCertificateDao{ctrl: ctrl}
+	mock.recorder = &MockWorkerCertificateDaoMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockWorkerCertificateDao) EXPECT() *MockWorkerCertificateDaoMockRecorder {
+	return m.recorder
+}
+
+// Create mocks base method.
+func (m *MockWorkerCertificateDao) Create(arg0 context.Context, arg1 *model.WorkerCertificate) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Create", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Create indicates an expected call of Create.
+func (mr *MockWorkerCertificateDaoMockRecorder) Create(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockWorkerCertificateDao)(nil).Create), arg0, arg1)
+}
+
+// GetBySerial mocks base method.
+func (m *MockWorkerCertificateDao) GetBySerial(ctx context.Context, serial string) (model.WorkerCertificate, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetBySerial", ctx, serial)
+	ret0, _ := ret[0].(model.WorkerCertificate)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetBySerial indicates an expected call of GetBySerial.
+func (mr *MockWorkerCertificateDaoMockRecorder) GetBySerial(ctx, serial interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetBySerial", reflect.TypeOf((*MockWorkerCertificateDao)(nil).GetBySerial), ctx, serial)
+}
+
+// GetForWorker mocks base method.
+func (m *MockWorkerCertificateDao) GetForWorker(ctx context.Context, workerID string) ([]model.WorkerCertificate, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetForWorker", ctx, workerID)
+	ret0, _ := ret[0].([]model.WorkerCertificate)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetForWorker indicates an expected call of GetForWorker.
+func (mr *MockWorkerCertificateDaoMockRecorder) GetForWorker(ctx, workerID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetForWorker", reflect.TypeOf((*MockWorkerCertificateDao)(nil).GetForWorker), ctx, workerID)
+}
+
+// RevokeForWorker mocks base method.
+func (m *MockWorkerCertificateDao) RevokeForWorker(ctx context.Context, workerID string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RevokeForWorker", ctx, workerID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RevokeForWorker indicates an expected call of RevokeForWorker.
+func (mr *MockWorkerCertificateDaoMockRecorder) RevokeForWorker(ctx, workerID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RevokeForWorker", reflect.TypeOf((*MockWorkerCertificateDao)(nil).RevokeForWorker), ctx, workerID)
+}
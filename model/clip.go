@@ -0,0 +1,42 @@
+package model
+
+import (
+	"fmt"
+
+	"gorm.io/gorm"
+)
+
+// Clip is a named part of the VoD of a stream that is shared with its own short url, /c/<slug>.
+// It plays from a sub-playlist of the VoD or, if downloads are allowed, from a file a worker cut.
+type Clip struct {
+	gorm.Model
+
+	Slug     string `gorm:"not null;uniqueIndex;size:16" json:"slug"`
+	StreamID uint   `gorm:"not null;index" json:"streamID"`
+	Stream   Stream `gorm:"constraint:OnDelete:CASCADE" json:"-"`
+	UserID   uint   `gorm:"not null" json:"userID"` // creator of the clip
+	Title    string `json:"title"`
+	Start    uint   `gorm:"not null" json:"start"` // seconds into the VoD
+	End      uint   `gorm:"not null" json:"end"`
+	FilePath string `json:"-"` // cut by a worker, empty until it's done or if the course doesn't allow downloads
+}
+
+// PlaylistQuery returns the query that turns the playlist of the VoD into the sub-playlist of the clip.
+func (c Clip) PlaylistQuery() string {
+	return fmt.Sprintf("wowzaplaystart=%d&wowzaplayduration=%d", c.Start*1000, (c.End-c.Start)*1000)
+}
+
+// GetName returns the title of the clip or a name derived from the stream if it has none.
+func (c Clip) GetName(stream Stream) string {
+	if c.Title != "" {
+		return c.Title
+	}
+	return fmt.Sprintf("%s (%s - %s)", stream.GetName(), formatClipTime(c.Start), formatClipTime(c.End))
+}
+
+func formatClipTime(seconds uint) string {
+	if seconds >= 3600 {
+		return fmt.Sprintf("%d:%02d:%02d", seconds/3600, seconds/60%60, seconds%60)
+	}
+	return fmt.Sprintf("%d:%02d", seconds/60, seconds%60)
+}
@@ -35,10 +35,14 @@ type Course struct {
 	UserCreatedByToken      bool   `gorm:"default:false"`
 	CameraPresetPreferences string // json encoded. e.g. [{lectureHallID:1, presetID:4}, ...]
 	SourcePreferences       string // json encoded. e.g. [{lectureHallID:1, sourceMode:0}, ...]
+	SubtitleLanguages       string // json encoded. e.g. ["de", "en"], subtitles are translated into these languages
 	Pinned                  bool   `gorm:"-"` // Used to determine if the course is pinned when loaded for a specific user.
 
 	LivePrivate bool `gorm:"not null; default:false"` // whether Livestreams are private
 	VodPrivate  bool `gorm:"not null; default:false"` // Whether VODs are made private after livestreams
+	LowLatency  bool `gorm:"not null; default:false"` // whether Livestreams are served as LL-HLS if the ingest server supports it
+
+	EncodingProfile string `gorm:"not null; default:''"` // name of the encoding profile of streams and VoDs, the default of their source type if empty
 }
 
 type CourseDTO struct {
@@ -119,6 +123,25 @@ func (c Course) GetSourcePreference() []SourcePreference {
 	return res
 }
 
+// GetSubtitleLanguages returns the languages subtitles of the course are translated into
+func (c Course) GetSubtitleLanguages() []string {
+	var res []string
+	err := json.Unmarshal([]byte(c.SubtitleLanguages), &res)
+	if err != nil {
+		return []string{}
+	}
+	return res
+}
+
+// SetSubtitleLanguages updates the languages subtitles of the course are translated into
+func (c *Course) SetSubtitleLanguages(languages []string) {
+	lBytes, err := json.Marshal(languages)
+	if err != nil {
+		log.Println(err)
+	}
+	c.SubtitleLanguages = string(lBytes)
+}
+
 // GetSourceModeForLectureHall retrieves the source preference for the given lecture hall, returns default SourcePreference if non-existing
 func (c Course) GetSourceModeForLectureHall(id uint) SourceMode {
 	for _, preference := range c.GetSourcePreference() {
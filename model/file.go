@@ -23,8 +23,19 @@ const (
 	FILETYPE_THUMB_LG_PRES
 	FILETYPE_THUMB_LG_CAM_PRES // generated from CAM and PRES, preferred over the others
 	FILETYPE_THUMB_CUSTOM
+	FILETYPE_VOD_HEVC // additional rendition of the VoD, offered to players that support it
+	FILETYPE_VOD_AV1
+	FILETYPE_AUDIO_M4A  // audio-only rendition of the VoD, e.g. for podcasts
+	FILETYPE_VOD_EDITED // VoD with the edit decision list of the stream rendered into it
 )
 
+// VodCodecFileTypes maps the codecs of additional VoD renditions to their file type.
+var VodCodecFileTypes = map[string]FileType{
+	"hevc": FILETYPE_VOD_HEVC,
+	"av1":  FILETYPE_VOD_AV1,
+	"aac":  FILETYPE_AUDIO_M4A,
+}
+
 type File struct {
 	gorm.Model
 
@@ -0,0 +1,209 @@
+package model
+
+import (
+	"encoding/json"
+	"errors"
+	"time"
+
+	"gorm.io/gorm"
+)
+
+// JobState is the state of a Job in the job queue.
+//
+// queued -> assigned -> running -> succeeded
+//
+// Jobs that fail in any state are queued again until their retry policy is exhausted and end up failed.
+type JobState string
+
+const (
+	JobQueued    JobState = "queued"    // waiting for a worker
+	JobAssigned  JobState = "assigned"  // sent to a worker that has not acknowledged it yet
+	JobRunning   JobState = "running"   // acknowledged by the worker, completes with a notification of the worker
+	JobSucceeded JobState = "succeeded" // done
+	JobFailed    JobState = "failed"    // dead-lettered: all attempts failed, the job expired or was canceled
+)
+
+// JobType identifies the worker RPC a Job drives.
+type JobType string
+
+const (
+	JobTypeStream             JobType = "stream"
+	JobTypePremiere           JobType = "premiere"
+	JobTypeStreamEnd          JobType = "streamEnd"
+	JobTypeThumbnails         JobType = "thumbnails"
+	JobTypeCombineThumbnails  JobType = "combineThumbnails"
+	JobTypeSectionImages      JobType = "sectionImages"
+	JobTypeDeleteSectionImage JobType = "deleteSectionImage"
+	JobTypeStitchRecording    JobType = "stitchRecording"
+	JobTypeCutClip            JobType = "cutClip"
+	JobTypeRenderEdit         JobType = "renderEdit"
+)
+
+// JobLease is how long a worker holds a job. Leases are renewed with every heartbeat of the worker,
+// jobs of workers that stop sending heartbeats are retried once their lease expired.
+const JobLease = time.Minute * 5
+
+var (
+	ErrJobNotFailed = errors.New("only failed jobs can be retried")
+	ErrJobDone      = errors.New("job is already done")
+)
+
+// RetryPolicy defines how often a failed job is attempted again and how long to wait between the attempts.
+type RetryPolicy struct {
+	MaxAttempts uint
+	Backoff     time.Duration // delay after the first failed attempt, doubled for every further attempt
+	MaxBackoff  time.Duration
+}
+
+// Delay returns how long to wait for the next attempt after the given number of failed attempts.
+func (p RetryPolicy) Delay(attempts uint) time.Duration {
+	delay := p.Backoff
+	for i := uint(1); i < attempts && delay < p.MaxBackoff; i++ {
+		delay *= 2
+	}
+	if delay > p.MaxBackoff {
+		return p.MaxBackoff
+	}
+	return delay
+}
+
+var defaultRetryPolicy = RetryPolicy{MaxAttempts: 5, Backoff: time.Minute, MaxBackoff: time.Minute * 30}
+
+// retryPolicies are the policies of job types that differ from the default.
+var retryPolicies = map[JobType]RetryPolicy{
+	// lectures are running already, retry fast. Jobs for streams expire with the end of the stream anyway.
+	JobTypeStream:    {MaxAttempts: 10, Backoff: time.Second * 10, MaxBackoff: time.Minute},
+	JobTypePremiere:  {MaxAttempts: 5, Backoff: time.Second * 10, MaxBackoff: time.Minute},
+	JobTypeStreamEnd: {MaxAttempts: 5, Backoff: time.Second * 5, MaxBackoff: time.Minute},
+}
+
+// RetryPolicy returns the retry policy of jobs of type t.
+func (t JobType) RetryPolicy() RetryPolicy {
+	if p, ok := retryPolicies[t]; ok {
+		return p
+	}
+	return defaultRetryPolicy
+}
+
+// Job is a unit of work for a worker. Jobs are persisted so they survive restarts and are retried if a worker fails.
+type Job struct {
+	gorm.Model
+
+	Type     JobType  `gorm:"not null;index" json:"type"`
+	State    JobState `gorm:"not null;index;default:queued" json:"state"`
+	Payload  string   `gorm:"type:text" json:"payload"`        // json encoded arguments of the job
+	StreamID uint     `gorm:"index" json:"streamID,omitempty"` // stream the job belongs to, 0 if none
+	Version  string   `json:"version,omitempty"`               // stream version (CAM, PRES, COMB) the job belongs to
+
+	TargetWorkerID string `json:"targetWorkerID,omitempty"` // if set, the job is only dispatched to this worker
+	WorkerID       string `gorm:"index" json:"workerID"`    // worker of the current or the last failed attempt
+
+	Attempts       uint       `gorm:"not null;default:0" json:"attempts"`
+	MaxAttempts    uint       `gorm:"not null" json:"maxAttempts"`
+	RunAfter       time.Time  `gorm:"not null;index" json:"runAfter"` // earliest time of the next attempt
+	LeaseExpiresAt *time.Time `json:"leaseExpiresAt"`
+	ExpiresAt      *time.Time `json:"expiresAt"` // the job fails without further attempts if it isn't done until then
+	LastError      string     `gorm:"type:text" json:"lastError"`
+}
+
+// NewJob creates a queued job of type t. payload is json encoded and may be nil.
+func NewJob(t JobType, streamID uint, payload interface{}) (Job, error) {
+	job := Job{
+		Type:        t,
+		State:       JobQueued,
+		StreamID:    streamID,
+		MaxAttempts: t.RetryPolicy().MaxAttempts,
+		RunAfter:    time.Now(),
+	}
+	if payload != nil {
+		if err := job.SetPayload(payload); err != nil {
+			return job, err
+		}
+	}
+	return job, nil
+}
+
+// SetPayload json encodes v as the payload of the job.
+func (j *Job) SetPayload(v interface{}) error {
+	p, err := json.Marshal(v)
+	if err != nil {
+		return err
+	}
+	j.Payload = string(p)
+	return nil
+}
+
+// DecodePayload decodes the payload of the job into v.
+func (j *Job) DecodePayload(v interface{}) error {
+	if j.Payload == "" {
+		return nil
+	}
+	return json.Unmarshal([]byte(j.Payload), v)
+}
+
+// Done returns whether the job has reached a final state.
+func (j *Job) Done() bool {
+	return j.State == JobSucceeded || j.State == JobFailed
+}
+
+// Expired returns whether the job can't be completed in time anymore.
+func (j *Job) Expired(now time.Time) bool {
+	return j.ExpiresAt != nil && now.After(*j.ExpiresAt)
+}
+
+// Assign starts a new attempt of the job on the worker with the id workerID.
+func (j *Job) Assign(workerID string, now time.Time) {
+	lease := now.Add(JobLease)
+	j.State = JobAssigned
+	j.WorkerID = workerID
+	j.Attempts++
+	j.LeaseExpiresAt = &lease
+}
+
+// Acknowledge marks a job as accepted by its worker, it completes later with a notification from the worker.
+func (j *Job) Acknowledge() {
+	j.State = JobRunning
+}
+
+// Succeed marks the job as done.
+func (j *Job) Succeed() {
+	j.State = JobSucceeded
+	j.LeaseExpiresAt = nil
+}
+
+// Fail records a failed attempt. The job is queued again with a backoff
+// or fails for good if all attempts are used up or the job expired.
+func (j *Job) Fail(err error, now time.Time) {
+	j.LastError = err.Error()
+	j.LeaseExpiresAt = nil
+	if j.Attempts >= j.MaxAttempts || j.Expired(now) {
+		j.State = JobFailed
+		return
+	}
+	j.State = JobQueued
+	j.RunAfter = now.Add(j.Type.RetryPolicy().Delay(j.Attempts))
+}
+
+// Cancel fails the job without further attempts.
+func (j *Job) Cancel(reason string) error {
+	if j.Done() {
+		return ErrJobDone
+	}
+	j.State = JobFailed
+	j.LastError = reason
+	j.LeaseExpiresAt = nil
+	return nil
+}
+
+// Retry queues a failed job again with a fresh set of attempts.
+func (j *Job) Retry(now time.Time) error {
+	if j.State != JobFailed {
+		return ErrJobNotFailed
+	}
+	j.State = JobQueued
+	j.WorkerID = ""
+	j.Attempts = 0
+	j.RunAfter = now
+	j.ExpiresAt = nil
+	return nil
+}
@@ -1,6 +1,10 @@
 package model
 
-import "gorm.io/gorm"
+import (
+	"strings"
+
+	"gorm.io/gorm"
+)
 
 type LectureHall struct {
 	gorm.Model
@@ -18,6 +22,7 @@ type LectureHall struct {
 	PwrCtrlIp      string // power control api for red live light
 	LiveLightIndex int    // id of power outlet for live light
 	ExternalURL    string
+	WorkerTags     string // comma separated tags a worker needs to stream from this lecture hall, e.g. can-reach-HS1
 }
 
 type CameraType uint
@@ -57,3 +62,14 @@ func (l *LectureHall) ToDTO() *LectureHallDTO {
 		ExternalURL: l.ExternalURL,
 	}
 }
+
+// GetWorkerTags returns the tags a worker needs to stream from this lecture hall.
+func (l LectureHall) GetWorkerTags() []string {
+	var tags []string
+	for _, tag := range strings.Split(l.WorkerTags, ",") {
+		if tag = strings.TrimSpace(tag); tag != "" {
+			tags = append(tags, tag)
+		}
+	}
+	return tags
+}
@@ -0,0 +1,17 @@
+package model
+
+import "gorm.io/gorm"
+
+// RecordingPart is a part of the recording of a stream version that failed over between workers.
+// Every worker records its own part, the parts are stitched into one VoD once they are transcoded
+// or their workers are gone.
+type RecordingPart struct {
+	gorm.Model
+
+	StreamID uint   `gorm:"not null;index"`
+	Version  string `gorm:"not null"` // CAM, PRES or COMB
+	Part     uint   `gorm:"not null"` // parts are stitched in ascending order
+	WorkerID string `gorm:"not null"` // worker that records the part
+	FilePath string // transcoded part, empty until the worker reports it
+	Stitched bool   `gorm:"not null;default:false"`
+}
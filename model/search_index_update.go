@@ -0,0 +1,18 @@
+package model
+
+import "gorm.io/gorm"
+
+// Kinds of search index updates
+const (
+	SearchIndexStream = "stream" // reindex a stream with its sections, subtitles and chat
+	SearchIndexCourse = "course" // reindex a course and all its streams
+)
+
+// SearchIndexUpdate is a change of a stream or course that is not in the search index yet.
+// DAOs queue updates on writes, the search exporter applies and deletes them.
+type SearchIndexUpdate struct {
+	gorm.Model
+
+	Kind     string `gorm:"not null;index:idx_search_index_update"`
+	ObjectID uint   `gorm:"not null;index:idx_search_index_update"` // id of the stream or course
+}
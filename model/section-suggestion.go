@@ -0,0 +1,30 @@
+package model
+
+import (
+	"gorm.io/gorm"
+)
+
+// SectionSuggestion is a chapter of a VoD the worker suggests from the slide changes of the presentation.
+// Lecturers accept it as VideoSection or reject it.
+type SectionSuggestion struct {
+	gorm.Model
+
+	StreamID uint `gorm:"not null;index" json:"streamID"`
+	Start    uint `gorm:"not null" json:"start"`  // seconds into the VoD
+	FileID   uint `gorm:"not null" json:"fileID"` // thumbnail of the slide
+}
+
+// StartHours returns the hours of the start of the suggestion, as VideoSection splits it.
+func (s SectionSuggestion) StartHours() uint {
+	return s.Start / 3600
+}
+
+// StartMinutes returns the minutes of the start of the suggestion, as VideoSection splits it.
+func (s SectionSuggestion) StartMinutes() uint {
+	return s.Start / 60 % 60
+}
+
+// StartSeconds returns the seconds of the start of the suggestion, as VideoSection splits it.
+func (s SectionSuggestion) StartSeconds() uint {
+	return s.Start % 60
+}
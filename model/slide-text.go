@@ -0,0 +1,15 @@
+package model
+
+import (
+	"gorm.io/gorm"
+)
+
+// SlideText is the text the OCR service extracted from a slide of the presentation of a VoD.
+// It's only used for the search.
+type SlideText struct {
+	gorm.Model
+
+	StreamID uint   `gorm:"not null;index"`
+	Start    uint   `gorm:"not null"` // seconds since the start of the video
+	Text     string `gorm:"not null;type:text"`
+}
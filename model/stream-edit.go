@@ -0,0 +1,99 @@
+package model
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"gorm.io/gorm"
+)
+
+// StreamEdit is a range of the edit decision list of a stream, in milliseconds into its VoD.
+// Like the segments of cut requests, a list with ranges to keep plays only those, otherwise everything but the
+// discarded ranges. Edits are non-destructive: the edge server applies them when the VoD is played and
+// workers can render them into a new file, the original is kept.
+type StreamEdit struct {
+	gorm.Model
+
+	StreamID uint `gorm:"not null;index" json:"-"`
+	Start    uint `gorm:"not null" json:"start"`
+	End      uint `gorm:"not null" json:"end"`
+	Discard  bool `gorm:"not null;default:false" json:"discard"`
+}
+
+// EditRange is a part of a VoD in milliseconds. An End of 0 lasts until the end of the VoD.
+type EditRange struct {
+	Start uint `json:"start"`
+	End   uint `json:"end"`
+}
+
+// KeepRanges returns the parts of the VoD an edit decision list keeps, ordered by their start.
+func KeepRanges(edits []StreamEdit) []EditRange {
+	var keep, discard []EditRange
+	for _, e := range edits {
+		if e.End <= e.Start {
+			continue
+		}
+		if e.Discard {
+			discard = append(discard, EditRange{Start: e.Start, End: e.End})
+		} else {
+			keep = append(keep, EditRange{Start: e.Start, End: e.End})
+		}
+	}
+	if len(keep) != 0 {
+		sort.Slice(keep, func(i, j int) bool { return keep[i].Start < keep[j].Start })
+		return keep
+	}
+	if len(discard) == 0 {
+		return nil
+	}
+	sort.Slice(discard, func(i, j int) bool { return discard[i].Start < discard[j].Start })
+	var start uint
+	for _, r := range discard {
+		if r.Start > start {
+			keep = append(keep, EditRange{Start: start, End: r.Start})
+		}
+		if r.End > start {
+			start = r.End
+		}
+	}
+	return append(keep, EditRange{Start: start})
+}
+
+// KeepQuery returns the value of the keep parameter that applies an edit decision list to the playlists of a VoD,
+// e.g. 0-2700000,3300000- to remove a break. It's empty if the list doesn't edit the VoD.
+func KeepQuery(edits []StreamEdit) string {
+	ranges := KeepRanges(edits)
+	if len(ranges) == 0 {
+		return ""
+	}
+	parts := make([]string, len(ranges))
+	for i, r := range ranges {
+		if r.End == 0 {
+			parts[i] = fmt.Sprintf("%d-", r.Start)
+		} else {
+			parts[i] = fmt.Sprintf("%d-%d", r.Start, r.End)
+		}
+	}
+	return strings.Join(parts, ",")
+}
+
+// KeptWithin returns the parts between start and end, in milliseconds into the VoD, that an edit decision list keeps,
+// e.g. of a clip. It's empty if the edits remove all of it.
+func KeptWithin(edits []StreamEdit, start, end uint) []EditRange {
+	keep := KeepRanges(edits)
+	if len(keep) == 0 {
+		return []EditRange{{Start: start, End: end}}
+	}
+	var res []EditRange
+	for _, r := range keep {
+		from, to := max(r.Start, start), end
+		if r.End != 0 {
+			to = min(r.End, end)
+		}
+		if from < to {
+			res = append(res, EditRange{Start: from, End: to})
+		}
+	}
+	return res
+}
@@ -34,6 +34,9 @@ type Stream struct {
 	PlaylistUrl            string
 	PlaylistUrlPRES        string
 	PlaylistUrlCAM         string
+	DvrPlaylistUrl         string // DVR playlists are only set while the stream is live
+	DvrPlaylistUrlPRES     string
+	DvrPlaylistUrlCAM      string
 	LiveNow                bool      `gorm:"not null"`
 	LiveNowTimestamp       time.Time `gorm:"default:null;column:live_now_timestamp"`
 	Recording              bool
@@ -51,9 +54,12 @@ type Stream struct {
 	ThumbInterval          uint32 `gorm:"default:null"`
 	StreamName             string
 	Duration               sql.NullInt32    `gorm:"default:null"`
+	LoudnessIntegrated     sql.NullFloat64  `gorm:"default:null"` // LUFS of the recording before the loudness was normalised
+	LoudnessTruePeak       sql.NullFloat64  `gorm:"default:null"` // dBTP of the recording before the loudness was normalised
 	StreamWorkers          []Worker         `gorm:"many2many:stream_workers;"`
 	StreamProgresses       []StreamProgress `gorm:"foreignKey:StreamID"`
 	VideoSections          []VideoSection
+	Edits                  []StreamEdit          // edit decision list, applied when the VoD is played
 	TranscodingProgresses  []TranscodingProgress `gorm:"foreignKey:StreamID"`
 	Private                bool                  `gorm:"not null;default:false"`
 
@@ -65,8 +71,28 @@ type DownloadableVod struct {
 }
 
 // GetVodFiles returns all downloadable files that user can see when using the download dropdown for a stream.
-func (s Stream) GetVodFiles() []DownloadableVod {
+// The playlists of edited streams only play the kept parts, so they are downloaded as the file the edits were
+// rendered into, nothing until it exists. Their original recordings are only offered if original is set,
+// e.g. to admins of the course.
+func (s Stream) GetVodFiles(original bool) []DownloadableVod {
 	dFiles := make([]DownloadableVod, 0)
+	if len(s.Edits) != 0 {
+		for _, file := range s.Files {
+			switch {
+			case file.Type == FILETYPE_VOD_EDITED:
+				dFiles = append(dFiles, DownloadableVod{
+					FriendlyName: "Edited",
+					DownloadURL:  fmt.Sprintf("/api/download/%d?type=download", file.ID),
+				})
+			case file.Type == FILETYPE_VOD && original:
+				dFiles = append(dFiles, DownloadableVod{
+					FriendlyName: fmt.Sprintf("Original (%s)", file.GetVodTypeByName()),
+					DownloadURL:  fmt.Sprintf("/api/download/%d?type=download", file.ID),
+				})
+			}
+		}
+		return dFiles
+	}
 
 	if s.PlaylistUrl != "" {
 		dFiles = append(dFiles, DownloadableVod{
@@ -221,7 +247,8 @@ func (s Stream) IsPlanned() bool {
 
 func (s Stream) HLSUrl() string {
 	hls := s.PlaylistUrl
-	if s.StartOffset > 0 {
+	// edits replace the trim of the stream, signing applies them to the playlist
+	if len(s.Edits) == 0 && s.StartOffset > 0 {
 		hls = fmt.Sprintf("%s?wowzaplaystart=%d&wowzaplayduration=%d", s.PlaylistUrl, s.StartOffset, s.EndOffset)
 	}
 
@@ -360,7 +387,7 @@ func (s Stream) GetJson(lhs []LectureHall, course Course) gin.H {
 		"isCustomThumbnailEnabled": s.CustomThumbnailEnabled,
 		"courseSlug":               course.Slug,
 		"private":                  s.Private,
-		"downloadableVods":         s.GetVodFiles(),
+		"downloadableVods":         s.GetVodFiles(true),
 		"videoSections":            videoSections,
 	}
 }
@@ -393,7 +420,7 @@ type StreamDTO struct {
 func (s Stream) ToDTO() StreamDTO {
 	downloads := []DownloadableVod{}
 	if s.IsDownloadable() {
-		downloads = s.GetVodFiles()
+		downloads = s.GetVodFiles(false)
 	}
 	duration := int32(s.End.Sub(s.Start).Seconds())
 	if s.Duration.Valid {
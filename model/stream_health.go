@@ -0,0 +1,19 @@
+package model
+
+import "time"
+
+// StreamHealth is a sample of the ffmpeg progress a worker reported for a version of a running stream.
+type StreamHealth struct {
+	ID       uint          `gorm:"primarykey" json:"-"`
+	StreamID uint          `gorm:"not null;index:idx_stream_health" json:"streamID"`
+	Version  StreamVersion `gorm:"not null;type:varchar(8)" json:"version"`
+	WorkerID string        `json:"-"`
+	Time     time.Time     `gorm:"not null;index:idx_stream_health" json:"time"`
+
+	Bitrate          float64 `json:"bitrate"` // kbit/s
+	Fps              float64 `json:"fps"`
+	DroppedFrames    uint64  `json:"droppedFrames"`
+	DuplicatedFrames uint64  `json:"duplicatedFrames"`
+	Speed            float64 `json:"speed"`
+	Stalled          bool    `json:"stalled"`
+}
@@ -0,0 +1,38 @@
+package model
+
+import (
+	"time"
+
+	"gorm.io/gorm"
+)
+
+// Types of stream incidents
+const (
+	StreamIncidentBlack   = "black"   // the video is black
+	StreamIncidentFreeze  = "freeze"  // the video shows the same image
+	StreamIncidentSilence = "silence" // the audio is silent
+)
+
+// StreamIncident is a problem the live analysis of a worker detected in a version of a running stream.
+type StreamIncident struct {
+	gorm.Model
+
+	StreamID  uint          `gorm:"not null;index"`
+	Version   StreamVersion `gorm:"not null;type:varchar(8)"`
+	Type      string        `gorm:"not null;type:varchar(16)"`
+	StartedAt time.Time     `gorm:"not null"`
+	EndedAt   *time.Time    // nil while the incident lasts
+}
+
+// Description returns a human-readable description of the incident, e.g. "Black screen on PRES".
+func (i StreamIncident) Description() string {
+	switch i.Type {
+	case StreamIncidentBlack:
+		return "Black screen on " + string(i.Version)
+	case StreamIncidentFreeze:
+		return "Frozen image on " + string(i.Version)
+	case StreamIncidentSilence:
+		return "No audio on " + string(i.Version)
+	}
+	return "Unknown incident on " + string(i.Version)
+}
@@ -0,0 +1,28 @@
+package model
+
+import "gorm.io/gorm"
+
+// States of subtitle jobs
+const (
+	SubtitleJobQueued  = "queued"  // requested from the voice-service
+	SubtitleJobRunning = "running" // the voice-service reported progress
+	SubtitleJobFailed  = "failed"  // the voice-service reported a failure
+	SubtitleJobDone    = "done"    // the subtitles were received
+)
+
+// SubtitleJob tracks a request to the voice-service to generate or translate the subtitles of a stream in a language.
+type SubtitleJob struct {
+	gorm.Model
+
+	StreamID       uint   `gorm:"not null;index"`
+	Language       string `gorm:"not null"`
+	SourceLanguage string // language the subtitles are translated from, empty if generated
+	State          string `gorm:"not null;default:queued"`
+	Progress       int    `gorm:"not null;default:0"` // percent
+	Error          string
+}
+
+// Finished returns whether the voice-service is done with the job.
+func (j SubtitleJob) Finished() bool {
+	return j.State == SubtitleJobDone || j.State == SubtitleJobFailed
+}
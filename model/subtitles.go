@@ -4,13 +4,25 @@ import (
 	"gorm.io/gorm"
 )
 
-// Subtitles represents subtitles for a particular stream in a particular language
+// Sources of subtitle versions
+const (
+	SubtitlesSourceGenerated  = "generated"  // by the voice-service
+	SubtitlesSourceUploaded   = "uploaded"   // human made file uploaded by an admin
+	SubtitlesSourceEdited     = "edited"     // cues edited by an admin
+	SubtitlesSourceTranslated = "translated" // by the voice-service from another language
+)
+
+// Subtitles represents a version of the subtitles for a particular stream in a particular language.
+// Only the active version of a language is served to viewers, the other versions are kept for admins.
 type Subtitles struct {
 	gorm.Model
 
 	StreamID uint   `gorm:"not null"`
-	Content  string `gorm:"not null"` // the .srt content provided by the voice-service
+	Content  string `gorm:"not null"` // WebVTT, older generated versions may contain the .srt of the voice-service
 	Language string `gorm:"not null"`
+	Version  uint   `gorm:"not null;default:1"` // counts up per stream and language
+	Source   string `gorm:"not null;default:generated"`
+	Active   bool   `gorm:"not null;default:true"`
 }
 
 // TableName returns the name of the table for the Subtitles model in the database.
@@ -9,6 +9,7 @@ import (
 const (
 	TokenScopeAdmin    = "admin"
 	TokenScopeLecturer = "lecturer"
+	TokenScopeFeed     = "feed" // grants podcast apps access to the feeds of the courses the user can watch
 )
 
 // Token can be used to authenticate instead of a user account
@@ -18,6 +19,6 @@ type Token struct {
 	User    User         `gorm:"foreignKey:user_id;not null;constraint:OnUpdate:CASCADE,OnDelete:CASCADE;"` // creator of the token
 	Token   string       `json:"token" gorm:"not null"`                                                     // secret token
 	Expires sql.NullTime `json:"expires"`                                                                   // expiration date (null if none)
-	Scope   string       `json:"scope" gorm:"not null"`                                                     // scope of the token, currently only admin
+	Scope   string       `json:"scope" gorm:"not null"`                                                     // scope of the token: admin, lecturer or feed
 	LastUse sql.NullTime `json:"last_use"`                                                                  // last time the token was used
 }
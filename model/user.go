@@ -271,6 +271,18 @@ func (u *User) IsEligibleToWatchCourse(course Course) bool {
 	return u.IsAdminOfCourse(course)
 }
 
+// IsEligibleToWatchStream returns whether the user may watch the stream of the course, like tools.InitStream checks it.
+// u is nil for users that aren't logged in.
+func (u *User) IsEligibleToWatchStream(course Course, stream Stream) bool {
+	if stream.Private && !u.IsAdminOfCourse(course) {
+		return false
+	}
+	if course.Visibility == "public" || course.Visibility == "hidden" {
+		return true
+	}
+	return u != nil && u.IsEligibleToWatchCourse(course)
+}
+
 func (u *User) CoursesForSemester(year int, term string, context context.Context) []Course {
 	cMap := make(map[uint]Course)
 	for _, c := range u.Courses {
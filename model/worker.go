@@ -1,6 +1,13 @@
 package model
 
-import "time"
+import (
+	"strings"
+	"time"
+)
+
+// WorkerHeartbeatTimeout is how long a worker may stay silent before its streams fail over to other workers.
+// Workers send a heartbeat every minute.
+const WorkerHeartbeatTimeout = time.Minute * 2
 
 type Worker struct {
 	WorkerID string `gorm:"primaryKey"`
@@ -15,9 +22,55 @@ type Worker struct {
 	Disk   string
 	Uptime string
 
+	// Resources reported with the last heartbeat, used for scheduling. Zero if the worker doesn't report them.
+	CpuPercent   float64
+	MemAvailable uint64 // bytes
+	MemTotal     uint64
+	DiskUsed     uint64
+	DiskTotal    uint64
+
+	Tags string // comma separated capabilities, e.g. has-gpu-encoder,campus-network
+
 	Version string
 }
 
 func (w *Worker) IsAlive() bool {
 	return w.LastSeen.After(time.Now().Add(time.Minute * -6))
 }
+
+// HeartbeatMissing returns whether the worker missed its heartbeats for longer than WorkerHeartbeatTimeout.
+func (w *Worker) HeartbeatMissing(now time.Time) bool {
+	return now.Sub(w.LastSeen) > WorkerHeartbeatTimeout
+}
+
+// GetTags returns the capabilities of the worker.
+func (w *Worker) GetTags() []string {
+	if w.Tags == "" {
+		return nil
+	}
+	return strings.Split(w.Tags, ",")
+}
+
+// MissingTags returns the tags of required that the worker doesn't have.
+func (w *Worker) MissingTags(required []string) []string {
+	var missing []string
+	tags := w.GetTags()
+	for _, r := range required {
+		found := false
+		for _, t := range tags {
+			if t == r {
+				found = true
+				break
+			}
+		}
+		if !found {
+			missing = append(missing, r)
+		}
+	}
+	return missing
+}
+
+// ReportsResources returns whether the worker reports its resources with its heartbeats.
+func (w *Worker) ReportsResources() bool {
+	return w.MemTotal != 0 && w.DiskTotal != 0
+}
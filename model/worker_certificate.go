@@ -0,0 +1,23 @@
+package model
+
+import (
+	"time"
+
+	"gorm.io/gorm"
+)
+
+// WorkerCertificate is a client certificate issued to a worker. Workers authenticate every gRPC call with
+// the certificate, calls with unknown or revoked certificates are rejected.
+type WorkerCertificate struct {
+	gorm.Model
+
+	WorkerID  string     `gorm:"not null;index" json:"workerID"`
+	Serial    string     `gorm:"not null;uniqueIndex;type:varchar(64)" json:"serial"` // hex encoded serial number
+	NotAfter  time.Time  `gorm:"not null" json:"notAfter"`
+	RevokedAt *time.Time `json:"revokedAt"` // nil unless revoked
+}
+
+// Valid returns whether the certificate may be used at the given time.
+func (c WorkerCertificate) Valid(now time.Time) bool {
+	return c.RevokedAt == nil && now.Before(c.NotAfter)
+}
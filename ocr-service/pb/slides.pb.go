@@ -0,0 +1,470 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.28.0
+// 	protoc        v3.19.4
+// source: slides.proto
+
+package pb
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	emptypb "google.golang.org/protobuf/types/known/emptypb"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type Slide struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Start     int32  `protobuf:"varint,1,opt,name=start,proto3" json:"start,omitempty"`                         // seconds since the start of the video
+	ImageFile string `protobuf:"bytes,2,opt,name=image_file,json=imageFile,proto3" json:"image_file,omitempty"` // on the shared storage
+}
+
+func (x *Slide) Reset() {
+	*x = Slide{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_slides_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Slide) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Slide) ProtoMessage() {}
+
+func (x *Slide) ProtoReflect() protoreflect.Message {
+	mi := &file_slides_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Slide.ProtoReflect.Descriptor instead.
+func (*Slide) Descriptor() ([]byte, []int) {
+	return file_slides_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *Slide) GetStart() int32 {
+	if x != nil {
+		return x.Start
+	}
+	return 0
+}
+
+func (x *Slide) GetImageFile() string {
+	if x != nil {
+		return x.ImageFile
+	}
+	return ""
+}
+
+type RecognizeRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	StreamId int32    `protobuf:"varint,1,opt,name=stream_id,json=streamId,proto3" json:"stream_id,omitempty"`
+	Slides   []*Slide `protobuf:"bytes,2,rep,name=slides,proto3" json:"slides,omitempty"`
+}
+
+func (x *RecognizeRequest) Reset() {
+	*x = RecognizeRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_slides_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RecognizeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RecognizeRequest) ProtoMessage() {}
+
+func (x *RecognizeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_slides_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RecognizeRequest.ProtoReflect.Descriptor instead.
+func (*RecognizeRequest) Descriptor() ([]byte, []int) {
+	return file_slides_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *RecognizeRequest) GetStreamId() int32 {
+	if x != nil {
+		return x.StreamId
+	}
+	return 0
+}
+
+func (x *RecognizeRequest) GetSlides() []*Slide {
+	if x != nil {
+		return x.Slides
+	}
+	return nil
+}
+
+type SlideText struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Start int32  `protobuf:"varint,1,opt,name=start,proto3" json:"start,omitempty"` // seconds since the start of the video
+	Text  string `protobuf:"bytes,2,opt,name=text,proto3" json:"text,omitempty"`
+}
+
+func (x *SlideText) Reset() {
+	*x = SlideText{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_slides_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SlideText) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SlideText) ProtoMessage() {}
+
+func (x *SlideText) ProtoReflect() protoreflect.Message {
+	mi := &file_slides_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SlideText.ProtoReflect.Descriptor instead.
+func (*SlideText) Descriptor() ([]byte, []int) {
+	return file_slides_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *SlideText) GetStart() int32 {
+	if x != nil {
+		return x.Start
+	}
+	return 0
+}
+
+func (x *SlideText) GetText() string {
+	if x != nil {
+		return x.Text
+	}
+	return ""
+}
+
+type ReceiveRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	StreamId int32        `protobuf:"varint,1,opt,name=stream_id,json=streamId,proto3" json:"stream_id,omitempty"`
+	Slides   []*SlideText `protobuf:"bytes,2,rep,name=slides,proto3" json:"slides,omitempty"` // slides without text are omitted
+}
+
+func (x *ReceiveRequest) Reset() {
+	*x = ReceiveRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_slides_proto_msgTypes[3]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ReceiveRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReceiveRequest) ProtoMessage() {}
+
+func (x *ReceiveRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_slides_proto_msgTypes[3]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReceiveRequest.ProtoReflect.Descriptor instead.
+func (*ReceiveRequest) Descriptor() ([]byte, []int) {
+	return file_slides_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *ReceiveRequest) GetStreamId() int32 {
+	if x != nil {
+		return x.StreamId
+	}
+	return 0
+}
+
+func (x *ReceiveRequest) GetSlides() []*SlideText {
+	if x != nil {
+		return x.Slides
+	}
+	return nil
+}
+
+type FailureReport struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	StreamId int32  `protobuf:"varint,1,opt,name=stream_id,json=streamId,proto3" json:"stream_id,omitempty"`
+	Error    string `protobuf:"bytes,2,opt,name=error,proto3" json:"error,omitempty"`
+}
+
+func (x *FailureReport) Reset() {
+	*x = FailureReport{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_slides_proto_msgTypes[4]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *FailureReport) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FailureReport) ProtoMessage() {}
+
+func (x *FailureReport) ProtoReflect() protoreflect.Message {
+	mi := &file_slides_proto_msgTypes[4]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FailureReport.ProtoReflect.Descriptor instead.
+func (*FailureReport) Descriptor() ([]byte, []int) {
+	return file_slides_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *FailureReport) GetStreamId() int32 {
+	if x != nil {
+		return x.StreamId
+	}
+	return 0
+}
+
+func (x *FailureReport) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+var File_slides_proto protoreflect.FileDescriptor
+
+var file_slides_proto_rawDesc = []byte{
+	0x0a, 0x0c, 0x73, 0x6c, 0x69, 0x64, 0x65, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x0b,
+	0x6c, 0x69, 0x76, 0x65, 0x2e, 0x6f, 0x63, 0x72, 0x2e, 0x76, 0x31, 0x1a, 0x1b, 0x67, 0x6f, 0x6f,
+	0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x65, 0x6d, 0x70,
+	0x74, 0x79, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x3c, 0x0a, 0x05, 0x53, 0x6c, 0x69, 0x64,
+	0x65, 0x12, 0x14, 0x0a, 0x05, 0x73, 0x74, 0x61, 0x72, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05,
+	0x52, 0x05, 0x73, 0x74, 0x61, 0x72, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x69, 0x6d, 0x61, 0x67, 0x65,
+	0x5f, 0x66, 0x69, 0x6c, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x69, 0x6d, 0x61,
+	0x67, 0x65, 0x46, 0x69, 0x6c, 0x65, 0x22, 0x5b, 0x0a, 0x10, 0x52, 0x65, 0x63, 0x6f, 0x67, 0x6e,
+	0x69, 0x7a, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1b, 0x0a, 0x09, 0x73, 0x74,
+	0x72, 0x65, 0x61, 0x6d, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x73,
+	0x74, 0x72, 0x65, 0x61, 0x6d, 0x49, 0x64, 0x12, 0x2a, 0x0a, 0x06, 0x73, 0x6c, 0x69, 0x64, 0x65,
+	0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x6c, 0x69, 0x76, 0x65, 0x2e, 0x6f,
+	0x63, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x6c, 0x69, 0x64, 0x65, 0x52, 0x06, 0x73, 0x6c, 0x69,
+	0x64, 0x65, 0x73, 0x22, 0x35, 0x0a, 0x09, 0x53, 0x6c, 0x69, 0x64, 0x65, 0x54, 0x65, 0x78, 0x74,
+	0x12, 0x14, 0x0a, 0x05, 0x73, 0x74, 0x61, 0x72, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52,
+	0x05, 0x73, 0x74, 0x61, 0x72, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x65, 0x78, 0x74, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x74, 0x65, 0x78, 0x74, 0x22, 0x5d, 0x0a, 0x0e, 0x52, 0x65,
+	0x63, 0x65, 0x69, 0x76, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1b, 0x0a, 0x09,
+	0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52,
+	0x08, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x49, 0x64, 0x12, 0x2e, 0x0a, 0x06, 0x73, 0x6c, 0x69,
+	0x64, 0x65, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x6c, 0x69, 0x76, 0x65,
+	0x2e, 0x6f, 0x63, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x6c, 0x69, 0x64, 0x65, 0x54, 0x65, 0x78,
+	0x74, 0x52, 0x06, 0x73, 0x6c, 0x69, 0x64, 0x65, 0x73, 0x22, 0x42, 0x0a, 0x0d, 0x46, 0x61, 0x69,
+	0x6c, 0x75, 0x72, 0x65, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x12, 0x1b, 0x0a, 0x09, 0x73, 0x74,
+	0x72, 0x65, 0x61, 0x6d, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x73,
+	0x74, 0x72, 0x65, 0x61, 0x6d, 0x49, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x32, 0x57, 0x0a,
+	0x0f, 0x53, 0x6c, 0x69, 0x64, 0x65, 0x52, 0x65, 0x63, 0x6f, 0x67, 0x6e, 0x69, 0x7a, 0x65, 0x72,
+	0x12, 0x44, 0x0a, 0x09, 0x52, 0x65, 0x63, 0x6f, 0x67, 0x6e, 0x69, 0x7a, 0x65, 0x12, 0x1d, 0x2e,
+	0x6c, 0x69, 0x76, 0x65, 0x2e, 0x6f, 0x63, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x63, 0x6f,
+	0x67, 0x6e, 0x69, 0x7a, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x67,
+	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45,
+	0x6d, 0x70, 0x74, 0x79, 0x22, 0x00, 0x32, 0x9c, 0x01, 0x0a, 0x11, 0x53, 0x6c, 0x69, 0x64, 0x65,
+	0x54, 0x65, 0x78, 0x74, 0x52, 0x65, 0x63, 0x65, 0x69, 0x76, 0x65, 0x72, 0x12, 0x40, 0x0a, 0x07,
+	0x52, 0x65, 0x63, 0x65, 0x69, 0x76, 0x65, 0x12, 0x1b, 0x2e, 0x6c, 0x69, 0x76, 0x65, 0x2e, 0x6f,
+	0x63, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x63, 0x65, 0x69, 0x76, 0x65, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x00, 0x12, 0x45,
+	0x0a, 0x0d, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x46, 0x61, 0x69, 0x6c, 0x75, 0x72, 0x65, 0x12,
+	0x1a, 0x2e, 0x6c, 0x69, 0x76, 0x65, 0x2e, 0x6f, 0x63, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x46, 0x61,
+	0x69, 0x6c, 0x75, 0x72, 0x65, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x1a, 0x16, 0x2e, 0x67, 0x6f,
+	0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d,
+	0x70, 0x74, 0x79, 0x22, 0x00, 0x42, 0x10, 0x5a, 0x0e, 0x6f, 0x63, 0x72, 0x2d, 0x73, 0x65, 0x72,
+	0x76, 0x69, 0x63, 0x65, 0x2f, 0x70, 0x62, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_slides_proto_rawDescOnce sync.Once
+	file_slides_proto_rawDescData = file_slides_proto_rawDesc
+)
+
+func file_slides_proto_rawDescGZIP() []byte {
+	file_slides_proto_rawDescOnce.Do(func() {
+		file_slides_proto_rawDescData = protoimpl.X.CompressGZIP(file_slides_proto_rawDescData)
+	})
+	return file_slides_proto_rawDescData
+}
+
+var file_slides_proto_msgTypes = make([]protoimpl.MessageInfo, 5)
+var file_slides_proto_goTypes = []interface{}{
+	(*Slide)(nil),            // 0: live.ocr.v1.Slide
+	(*RecognizeRequest)(nil), // 1: live.ocr.v1.RecognizeRequest
+	(*SlideText)(nil),        // 2: live.ocr.v1.SlideText
+	(*ReceiveRequest)(nil),   // 3: live.ocr.v1.ReceiveRequest
+	(*FailureReport)(nil),    // 4: live.ocr.v1.FailureReport
+	(*emptypb.Empty)(nil),    // 5: google.protobuf.Empty
+}
+var file_slides_proto_depIdxs = []int32{
+	0, // 0: live.ocr.v1.RecognizeRequest.slides:type_name -> live.ocr.v1.Slide
+	2, // 1: live.ocr.v1.ReceiveRequest.slides:type_name -> live.ocr.v1.SlideText
+	1, // 2: live.ocr.v1.SlideRecognizer.Recognize:input_type -> live.ocr.v1.RecognizeRequest
+	3, // 3: live.ocr.v1.SlideTextReceiver.Receive:input_type -> live.ocr.v1.ReceiveRequest
+	4, // 4: live.ocr.v1.SlideTextReceiver.ReportFailure:input_type -> live.ocr.v1.FailureReport
+	5, // 5: live.ocr.v1.SlideRecognizer.Recognize:output_type -> google.protobuf.Empty
+	5, // 6: live.ocr.v1.SlideTextReceiver.Receive:output_type -> google.protobuf.Empty
+	5, // 7: live.ocr.v1.SlideTextReceiver.ReportFailure:output_type -> google.protobuf.Empty
+	5, // [5:8] is the sub-list for method output_type
+	2, // [2:5] is the sub-list for method input_type
+	2, // [2:2] is the sub-list for extension type_name
+	2, // [2:2] is the sub-list for extension extendee
+	0, // [0:2] is the sub-list for field type_name
+}
+
+func init() { file_slides_proto_init() }
+func file_slides_proto_init() {
+	if File_slides_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_slides_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Slide); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_slides_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RecognizeRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_slides_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SlideText); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_slides_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ReceiveRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_slides_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*FailureReport); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_slides_proto_rawDesc,
+			NumEnums:      0,
+			NumMessages:   5,
+			NumExtensions: 0,
+			NumServices:   2,
+		},
+		GoTypes:           file_slides_proto_goTypes,
+		DependencyIndexes: file_slides_proto_depIdxs,
+		MessageInfos:      file_slides_proto_msgTypes,
+	}.Build()
+	File_slides_proto = out.File
+	file_slides_proto_rawDesc = nil
+	file_slides_proto_goTypes = nil
+	file_slides_proto_depIdxs = nil
+}
@@ -0,0 +1,232 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.2.0
+// - protoc             v3.19.4
+// source: slides.proto
+
+package pb
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+	emptypb "google.golang.org/protobuf/types/known/emptypb"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.32.0 or later.
+const _ = grpc.SupportPackageIsVersion7
+
+// SlideRecognizerClient is the client API for SlideRecognizer service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type SlideRecognizerClient interface {
+	// Recognize extracts the text of the slides and sends it to the SlideTextReceiver.
+	Recognize(ctx context.Context, in *RecognizeRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+}
+
+type slideRecognizerClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewSlideRecognizerClient(cc grpc.ClientConnInterface) SlideRecognizerClient {
+	return &slideRecognizerClient{cc}
+}
+
+func (c *slideRecognizerClient) Recognize(ctx context.Context, in *RecognizeRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, "/live.ocr.v1.SlideRecognizer/Recognize", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// SlideRecognizerServer is the server API for SlideRecognizer service.
+// All implementations must embed UnimplementedSlideRecognizerServer
+// for forward compatibility
+type SlideRecognizerServer interface {
+	// Recognize extracts the text of the slides and sends it to the SlideTextReceiver.
+	Recognize(context.Context, *RecognizeRequest) (*emptypb.Empty, error)
+	mustEmbedUnimplementedSlideRecognizerServer()
+}
+
+// UnimplementedSlideRecognizerServer must be embedded to have forward compatible implementations.
+type UnimplementedSlideRecognizerServer struct {
+}
+
+func (UnimplementedSlideRecognizerServer) Recognize(context.Context, *RecognizeRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Recognize not implemented")
+}
+func (UnimplementedSlideRecognizerServer) mustEmbedUnimplementedSlideRecognizerServer() {}
+
+// UnsafeSlideRecognizerServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to SlideRecognizerServer will
+// result in compilation errors.
+type UnsafeSlideRecognizerServer interface {
+	mustEmbedUnimplementedSlideRecognizerServer()
+}
+
+func RegisterSlideRecognizerServer(s grpc.ServiceRegistrar, srv SlideRecognizerServer) {
+	s.RegisterService(&SlideRecognizer_ServiceDesc, srv)
+}
+
+func _SlideRecognizer_Recognize_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RecognizeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SlideRecognizerServer).Recognize(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/live.ocr.v1.SlideRecognizer/Recognize",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SlideRecognizerServer).Recognize(ctx, req.(*RecognizeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// SlideRecognizer_ServiceDesc is the grpc.ServiceDesc for SlideRecognizer service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var SlideRecognizer_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "live.ocr.v1.SlideRecognizer",
+	HandlerType: (*SlideRecognizerServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Recognize",
+			Handler:    _SlideRecognizer_Recognize_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "slides.proto",
+}
+
+// SlideTextReceiverClient is the client API for SlideTextReceiver service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type SlideTextReceiverClient interface {
+	Receive(ctx context.Context, in *ReceiveRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	// ReportFailure is called instead of Receive if the text of the slides can't be extracted.
+	ReportFailure(ctx context.Context, in *FailureReport, opts ...grpc.CallOption) (*emptypb.Empty, error)
+}
+
+type slideTextReceiverClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewSlideTextReceiverClient(cc grpc.ClientConnInterface) SlideTextReceiverClient {
+	return &slideTextReceiverClient{cc}
+}
+
+func (c *slideTextReceiverClient) Receive(ctx context.Context, in *ReceiveRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, "/live.ocr.v1.SlideTextReceiver/Receive", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *slideTextReceiverClient) ReportFailure(ctx context.Context, in *FailureReport, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, "/live.ocr.v1.SlideTextReceiver/ReportFailure", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// SlideTextReceiverServer is the server API for SlideTextReceiver service.
+// All implementations must embed UnimplementedSlideTextReceiverServer
+// for forward compatibility
+type SlideTextReceiverServer interface {
+	Receive(context.Context, *ReceiveRequest) (*emptypb.Empty, error)
+	// ReportFailure is called instead of Receive if the text of the slides can't be extracted.
+	ReportFailure(context.Context, *FailureReport) (*emptypb.Empty, error)
+	mustEmbedUnimplementedSlideTextReceiverServer()
+}
+
+// UnimplementedSlideTextReceiverServer must be embedded to have forward compatible implementations.
+type UnimplementedSlideTextReceiverServer struct {
+}
+
+func (UnimplementedSlideTextReceiverServer) Receive(context.Context, *ReceiveRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Receive not implemented")
+}
+func (UnimplementedSlideTextReceiverServer) ReportFailure(context.Context, *FailureReport) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReportFailure not implemented")
+}
+func (UnimplementedSlideTextReceiverServer) mustEmbedUnimplementedSlideTextReceiverServer() {}
+
+// UnsafeSlideTextReceiverServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to SlideTextReceiverServer will
+// result in compilation errors.
+type UnsafeSlideTextReceiverServer interface {
+	mustEmbedUnimplementedSlideTextReceiverServer()
+}
+
+func RegisterSlideTextReceiverServer(s grpc.ServiceRegistrar, srv SlideTextReceiverServer) {
+	s.RegisterService(&SlideTextReceiver_ServiceDesc, srv)
+}
+
+func _SlideTextReceiver_Receive_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReceiveRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SlideTextReceiverServer).Receive(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/live.ocr.v1.SlideTextReceiver/Receive",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SlideTextReceiverServer).Receive(ctx, req.(*ReceiveRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SlideTextReceiver_ReportFailure_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(FailureReport)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SlideTextReceiverServer).ReportFailure(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/live.ocr.v1.SlideTextReceiver/ReportFailure",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SlideTextReceiverServer).ReportFailure(ctx, req.(*FailureReport))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// SlideTextReceiver_ServiceDesc is the grpc.ServiceDesc for SlideTextReceiver service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var SlideTextReceiver_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "live.ocr.v1.SlideTextReceiver",
+	HandlerType: (*SlideTextReceiverServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Receive",
+			Handler:    _SlideTextReceiver_Receive_Handler,
+		},
+		{
+			MethodName: "ReportFailure",
+			Handler:    _SlideTextReceiver_ReportFailure_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "slides.proto",
+}
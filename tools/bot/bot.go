@@ -39,6 +39,7 @@ type AlertMessage struct {
 	CombIP      string
 	CameraIP    string
 	IsLecturer  bool
+	Incident    bool // raised by a worker instead of a viewer, there is no one to contact
 	Stream      model.Stream
 	User        model.User
 }
@@ -67,6 +68,10 @@ func (b *Bot) SendMessage(message Message) error {
 
 // SendAlert sends an alert message to the bot e.g. via Matrix.
 func (b *Bot) SendAlert(alert AlertMessage, statsDao dao.StatisticsDao) error {
+	if alert.Incident {
+		// incidents aren't reports of viewers and always need attention
+		return b.SendMessage(Message{Text: getFormattedMessageText(GenerateInfoText(alert)), Prio: true})
+	}
 	issuesPerStream[alert.Stream.ID] = append(issuesPerStream[alert.Stream.ID], issueInfo{Time: time.Now(), UserID: alert.User.ID})
 	message := Message{
 		Text: getFormattedMessageText(GenerateInfoText(alert)),
@@ -98,6 +103,9 @@ func GenerateInfoText(botInfo AlertMessage) string {
 			infoText += "<tr><th>Camera IP</th><td>" + botInfo.CameraIP + "</td></tr>"
 		}
 	}
+	if botInfo.Incident {
+		return infoText + "</table>🤖 <b>Detected by the worker</b>"
+	}
 	infoText += "</table>📢 <b>Contact information</b>\n\n<table>"
 	// Has the person that reported the issue entered custom contact data?
 	if botInfo.Name != "" {
@@ -8,6 +8,7 @@ import (
 	"errors"
 	"fmt"
 	"os"
+	"sort"
 	"time"
 
 	"github.com/meilisearch/meilisearch-go"
@@ -163,11 +164,17 @@ type Config struct {
 		Host string `yaml:"host"`
 		Port string `yaml:"port"`
 	}
-	IngestBase  string  `yaml:"ingestBase"`
-	WebUrl      string  `yaml:"webUrl"`
-	WorkerToken string  `yaml:"workerToken"` // used for workers to join the worker pool
-	JWTKey      *string `yaml:"jwtKey"`
-	Meili       *struct {
+	// OcrService extracts the text of slides for the search, it's not used if unset.
+	OcrService *struct {
+		Host string `yaml:"host"`
+		Port string `yaml:"port"`
+	} `yaml:"ocrService"`
+	IngestBase    string  `yaml:"ingestBase"`
+	SrtIngestBase string  `yaml:"srtIngestBase"` // e.g. srt://ingest.tum.live:8890, SRT ingest URLs are omitted if empty
+	WebUrl        string  `yaml:"webUrl"`
+	WorkerToken   string  `yaml:"workerToken"` // used for workers to join the worker pool
+	JWTKey        *string `yaml:"jwtKey"`
+	Meili         *struct {
 		Host   string `yaml:"host"`
 		ApiKey string `yaml:"apiKey"`
 	} `yaml:"meili"`
@@ -175,6 +182,89 @@ type Config struct {
 	CanonicalURL   string `yaml:"canonicalURL"`
 	WikiURL        string `yaml:"wikiURL"`
 	RtmpProxyURL   string `yaml:"rtmpProxyURL"`
+	WhipURL        string `yaml:"whipURL"` // WHIP endpoint of the workers for browser based self-streams, e.g. https://ingest.tum.live:8070/whip/
+	// Dvr lets viewers rewind running live streams, it is disabled if unset.
+	Dvr *DvrConfig `yaml:"dvr"`
+	// LiveLadder are the renditions workers push for every live stream. Players switch between them
+	// using the master playlist of the ingest server. A single 2500k rendition is pushed if empty.
+	LiveLadder []LiveRendition `yaml:"liveLadder"`
+	// EncodingProfiles are the named encoder settings courses can select for their streams and VoDs.
+	EncodingProfiles map[string]EncodingProfile `yaml:"encodingProfiles"`
+	// DefaultEncodingProfiles maps source types (COMB, PRES, CAM) to the profile used if a course doesn't select one.
+	// Workers use their built-in settings if neither is set.
+	DefaultEncodingProfiles map[string]string `yaml:"defaultEncodingProfiles"`
+	// Scheduler configures which workers may run which jobs.
+	Scheduler SchedulerConfig `yaml:"scheduler"`
+	// MTLS secures the gRPC channels to workers, the voice service and the OCR service.
+	MTLS MTLSConfig `yaml:"mtls"`
+}
+
+type MTLSConfig struct {
+	Enabled bool `yaml:"enabled"`
+	// CACert and CAKey are the pem files of the CA that issues worker certificates. Created if missing.
+	CACert string `yaml:"caCert"`
+	CAKey  string `yaml:"caKey"`
+	// ServerNames are the host names workers and the voice service use to dial TUM-Live.
+	ServerNames []string `yaml:"serverNames"`
+	// WorkerCertValidity defaults to 30 days. Workers renew their certificate after two thirds of it.
+	WorkerCertValidity time.Duration `yaml:"workerCertValidity"`
+	// VoiceServiceCert and VoiceServiceKey are written for the voice service with the validity of worker certificates
+	// and renewed after two thirds of it.
+	VoiceServiceCert string `yaml:"voiceServiceCert"`
+	VoiceServiceKey  string `yaml:"voiceServiceKey"`
+	// OcrServiceCert and OcrServiceKey are written and renewed for the OCR service like the voice service ones.
+	OcrServiceCert string `yaml:"ocrServiceCert"`
+	OcrServiceKey  string `yaml:"ocrServiceKey"`
+}
+
+type SchedulerConfig struct {
+	// RequiredTags maps job types (e.g. stream, thumbnails) to the tags a worker needs to run them.
+	// The kinds upload and livePreview cover uploads of VoDs and live previews, which are not jobs.
+	// Streams additionally require the worker tags of their lecture hall.
+	RequiredTags map[string][]string `yaml:"requiredTags"`
+}
+
+type DvrConfig struct {
+	// Window is how many minutes of a live stream workers keep for rewinding, 0 keeps the whole stream.
+	Window uint32 `yaml:"window"`
+	// EdgeURL is where the edges serve the DVR playlists of the workers under /<worker host>/dvr/.
+	EdgeURL string `yaml:"edgeURL"`
+}
+
+// LiveRendition is one quality of the adaptive bitrate ladder of live streams.
+type LiveRendition struct {
+	Name         string `yaml:"name"`         // appended to the stream name, e.g. 720p
+	Height       uint32 `yaml:"height"`       // 0 for audio only
+	VideoBitrate uint32 `yaml:"videoBitrate"` // kbit/s
+	AudioBitrate uint32 `yaml:"audioBitrate"` // kbit/s
+}
+
+// EncodingProfile are the encoder settings of a stream, unset values keep the defaults of the workers.
+// Live streams are always pushed as H.264 and AAC, the codecs only apply to the VoD.
+type EncodingProfile struct {
+	VideoCodec      string `yaml:"videoCodec"`      // ffmpeg encoder of the VoD, libx264 if unset. Offer HEVC and AV1 with VodCodecs
+	Crf             uint32 `yaml:"crf"`             // quality of the VoD
+	VideoBitrate    uint32 `yaml:"videoBitrate"`    // kbit/s of live streams without ladder and premieres
+	Preset          string `yaml:"preset"`          // e.g. veryfast
+	Tune            string `yaml:"tune"`            // e.g. stillimage, ignored for live streams
+	Height          uint32 `yaml:"height"`          // 0 keeps the resolution of the source
+	AudioCodec      string `yaml:"audioCodec"`      // ffmpeg encoder of the VoD, e.g. aac
+	AudioBitrate    uint32 `yaml:"audioBitrate"`    // kbit/s
+	AudioChannels   uint32 `yaml:"audioChannels"`   // 0 keeps the channels of the source
+	AudioSampleRate uint32 `yaml:"audioSampleRate"` // Hz
+	// VodCodecs are encoded as additional renditions of the VoD after the H.264 VoD (hevc and/or av1).
+	// They are offered to players that support them and take several times the duration of the VoD to encode.
+	VodCodecs []string `yaml:"vodCodecs"`
+}
+
+// EncodingProfileNames returns the names of the configured encoding profiles in alphabetical order.
+func (c Config) EncodingProfileNames() []string {
+	names := make([]string, 0, len(c.EncodingProfiles))
+	for name := range c.EncodingProfiles {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
 }
 
 type MailConfig struct {
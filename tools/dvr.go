@@ -0,0 +1,35 @@
+package tools
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/TUM-Dev/gocast/model"
+)
+
+// DvrWindow returns how far viewers can rewind running live streams, 0 if they can rewind to the start.
+func DvrWindow() time.Duration {
+	if Cfg.Dvr == nil {
+		return 0
+	}
+	return time.Duration(Cfg.Dvr.Window) * time.Minute
+}
+
+// DvrPlaylistURL returns the url of the DVR playlist a worker keeps for a version (COMB, PRES or CAM) of a stream.
+// Returns "" if DVR is disabled.
+func DvrPlaylistURL(workerHost string, streamID uint, version string) string {
+	if Cfg.Dvr == nil {
+		return ""
+	}
+	return fmt.Sprintf("%s/%s/dvr/%d/%s/playlist.m3u8", strings.TrimSuffix(Cfg.Dvr.EdgeURL, "/"), workerHost, streamID, version)
+}
+
+// DvrSeekableRange returns the part of a running live stream viewers can rewind to at now.
+func DvrSeekableRange(s model.Stream, now time.Time) (start time.Time, end time.Time) {
+	start = s.LiveNowTimestamp
+	if window := DvrWindow(); window > 0 && now.Add(-window).After(start) {
+		start = now.Add(-window)
+	}
+	return start, now
+}
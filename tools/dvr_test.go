@@ -0,0 +1,36 @@
+package tools
+
+import (
+	"testing"
+	"time"
+
+	"github.com/TUM-Dev/gocast/model"
+)
+
+func TestDvr(t *testing.T) {
+	defer func() { Cfg.Dvr = nil }()
+	live := time.Date(2023, 4, 1, 10, 0, 0, 0, time.UTC)
+	now := live.Add(90 * time.Minute)
+	s := model.Stream{LiveNowTimestamp: live}
+
+	Cfg.Dvr = nil
+	if url := DvrPlaylistURL("worker1", 3, "COMB"); url != "" {
+		t.Errorf("expected no dvr playlist if dvr is disabled, got %s", url)
+	}
+
+	Cfg.Dvr = &DvrConfig{EdgeURL: "https://edge.example.com/"}
+	if url := DvrPlaylistURL("worker1", 3, "COMB"); url != "https://edge.example.com/worker1/dvr/3/COMB/playlist.m3u8" {
+		t.Errorf("unexpected dvr playlist %s", url)
+	}
+	if start, end := DvrSeekableRange(s, now); !start.Equal(live) || !end.Equal(now) {
+		t.Errorf("expected whole stream to be seekable without window, got %v - %v", start, end)
+	}
+
+	Cfg.Dvr.Window = 30
+	if start, _ := DvrSeekableRange(s, now); !start.Equal(now.Add(-30 * time.Minute)) {
+		t.Errorf("expected seekable range to start 30 minutes ago, got %v", start)
+	}
+	if start, _ := DvrSeekableRange(s, live.Add(10*time.Minute)); !start.Equal(live) {
+		t.Errorf("expected seekable range to start with the stream, got %v", start)
+	}
+}
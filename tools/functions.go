@@ -2,6 +2,7 @@ package tools
 
 import (
 	"errors"
+	"fmt"
 	"log"
 	"os/exec"
 	"regexp"
@@ -22,6 +23,15 @@ func CourseListContains(courses []model.Course, courseId uint) bool {
 	return false
 }
 
+// SrtIngestURL returns the SRT URL lecturers publish a self-stream to, or "" if SRT ingest isn't configured.
+// mediamtx parses the streamid as action:path:query, the worker authenticates the query like the one of RTMP publishes.
+func SrtIngestURL(courseSlug string, streamID uint, streamKey string) string {
+	if Cfg.SrtIngestBase == "" {
+		return ""
+	}
+	return fmt.Sprintf("%s?streamid=publish:%s-%d:secret=%s", strings.TrimSuffix(Cfg.SrtIngestBase, "/"), courseSlug, streamID, streamKey)
+}
+
 func UploadLRZ(file string) error {
 	cmd := exec.Command("curl", "-F",
 		"filename=@"+file,
@@ -32,3 +32,16 @@ func TestMaskLogin(t *testing.T) {
 		t.Errorf("MaskLogin(ge12tum) = %s, want %s", masked, "ge**tum")
 	}
 }
+
+func TestSrtIngestURL(t *testing.T) {
+	Cfg.SrtIngestBase = ""
+	if url := SrtIngestURL("eidi", 1, "key"); url != "" {
+		t.Errorf("SrtIngestURL without base = %s, want empty", url)
+	}
+	Cfg.SrtIngestBase = "srt://ingest.tum.live:8890/"
+	defer func() { Cfg.SrtIngestBase = "" }()
+	exp := "srt://ingest.tum.live:8890?streamid=publish:eidi-1:secret=key"
+	if url := SrtIngestURL("eidi", 1, "key"); url != exp {
+		t.Errorf("SrtIngestURL(eidi, 1, key) = %s, want %s", url, exp)
+	}
+}
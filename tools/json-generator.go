@@ -14,7 +14,9 @@ func AdminCourseJson(c *model.Course, lhs []model.LectureHall, u *model.User) []
 		if err != nil {
 			logger.Error("Could not sign playlist for admin", "err", err)
 		}
-		res = append(res, s.GetJson(lhs, *c))
+		j := s.GetJson(lhs, *c)
+		j["srtIngest"] = SrtIngestURL(c.Slug, s.ID, s.StreamKey)
+		res = append(res, j)
 	}
 	return res
 }
@@ -1,23 +1,64 @@
 package tools
 
 import (
+	"context"
 	"errors"
 	"fmt"
 	"strings"
 
 	"github.com/TUM-Dev/gocast/dao"
+	"github.com/TUM-Dev/gocast/model"
 	"github.com/asticode/go-astisub"
 	"github.com/meilisearch/meilisearch-go"
+	"gorm.io/gorm"
 )
 
-type MeiliStream struct {
-	ID           uint   `json:"ID"`
-	Name         string `json:"name"`
-	Description  string `json:"description"`
-	CourseName   string `json:"courseName"`
+// Names of the meilisearch indexes
+const (
+	MeiliIndexStreams   = "STREAMS"
+	MeiliIndexCourses   = "COURSES"
+	MeiliIndexSections  = "SECTIONS"
+	MeiliIndexSubtitles = "SUBTITLES"
+	MeiliIndexChat      = "CHAT"
+	MeiliIndexSlides    = "SLIDES"
+)
+
+var meiliIndexes = []string{MeiliIndexCourses, MeiliIndexStreams, MeiliIndexSections, MeiliIndexSubtitles, MeiliIndexChat, MeiliIndexSlides}
+
+// maxSearchIndexUpdates is the number of queued updates ApplyUpdates handles per run.
+const maxSearchIndexUpdates = 500
+
+// MeiliPermissions are stored with every document, so searches can be filtered by what a user may see.
+type MeiliPermissions struct {
+	CourseID     uint   `json:"courseID"`
+	CourseSlug   string `json:"courseSlug"`
+	Visibility   string `json:"visibility"` // of the course
+	Private      bool   `json:"private"`    // of the stream
 	Year         int    `json:"year"`
 	TeachingTerm string `json:"semester"`
-	CourseID     uint   `json:"courseID"`
+}
+
+type MeiliStream struct {
+	ID          uint   `json:"ID"`
+	Name        string `json:"name"`
+	Description string `json:"description"`
+	CourseName  string `json:"courseName"`
+	MeiliPermissions
+}
+
+type MeiliCourse struct {
+	ID   uint   `json:"ID"`
+	Name string `json:"name"`
+	MeiliPermissions
+}
+
+type MeiliSection struct {
+	ID          uint   `json:"ID"`
+	StreamID    uint   `json:"streamID"`
+	StreamName  string `json:"streamName"`
+	Description string `json:"description"`
+	Timestamp   int64  `json:"timestamp"` // seconds since the start of the video
+	MeiliPermissions
 }
 
 type MeiliSubtitles struct {
@@ -27,6 +68,23 @@ type MeiliSubtitles struct {
 	TextPrev  string `json:"textPrev"` // the previous subtitle line
 	Text      string `json:"text"`
 	TextNext  string `json:"textNext"` // the next subtitle line
+	MeiliPermissions
+}
+
+type MeiliChat struct {
+	ID        uint   `json:"ID"`
+	StreamID  uint   `json:"streamID"`
+	Message   string `json:"message"`
+	Timestamp int64  `json:"timestamp"` // seconds since the start of the video, 0 if sent before
+	MeiliPermissions
+}
+
+type MeiliSlide struct {
+	ID        uint   `json:"ID"`
+	StreamID  uint   `json:"streamID"`
+	Text      string `json:"text"`
+	Timestamp int64  `json:"timestamp"` // seconds since the start of the video
+	MeiliPermissions
 }
 
 type MeiliExporter struct {
@@ -46,73 +104,319 @@ func NewMeiliExporter(d dao.DaoWrapper) *MeiliExporter {
 	return &MeiliExporter{c, d}
 }
 
+// Export rebuilds all indexes from the database. The DAOs queue their changes for ApplyUpdates,
+// so a rebuild is only needed to repair the indexes.
 func (m *MeiliExporter) Export() {
 	if m == nil {
 		return
 	}
-	index := m.c.Index("STREAMS")
-	_, err := m.c.Index("SUBTITLES").DeleteAllDocuments()
-	if err != nil {
-		logger.Warn("could not delete all old subtitles", "err", err)
+	for _, index := range meiliIndexes {
+		if _, err := m.c.Index(index).DeleteAllDocuments(); err != nil {
+			logger.Warn("could not delete all old documents", "index", index, "err", err)
+		}
 	}
+	m.exportCourses()
 
 	m.d.StreamsDao.ExecAllStreamsWithCoursesAndSubtitles(func(streams []dao.StreamWithCourseAndSubtitles) {
 		meilistreams := make([]MeiliStream, len(streams))
-		streamIDs := make([]uint, len(streams))
 		for i, stream := range streams {
-			streamIDs[i] = stream.ID
-			meilistreams[i] = MeiliStream{
-				ID:           stream.ID,
-				CourseID:     stream.CourseID,
-				Name:         stream.Name,
-				Description:  stream.Description,
-				CourseName:   stream.CourseName,
-				Year:         stream.Year,
-				TeachingTerm: stream.TeachingTerm,
-			}
-			if stream.Subtitles != "" {
-				meiliSubtitles := make([]MeiliSubtitles, 0)
-
-				vtt, err := astisub.ReadFromWebVTT(strings.NewReader(stream.Subtitles))
-				if err != nil {
-					logger.Warn("could not parse subtitles", "err", err)
-					continue
-				}
-				for i := range vtt.Items {
-					sub := MeiliSubtitles{
-						ID:        fmt.Sprintf("%d-%d", stream.ID, vtt.Items[i].StartAt.Milliseconds()),
-						StreamID:  stream.ID,
-						Timestamp: vtt.Items[i].StartAt.Milliseconds(),
-						Text:      vtt.Items[i].String(),
-					}
-					if i > 0 {
-						sub.TextPrev = meiliSubtitles[i-1].Text
-						meiliSubtitles[i-1].TextNext = sub.Text
-					}
-
-					meiliSubtitles = append(meiliSubtitles, sub)
-				}
-
-				if len(meiliSubtitles) > 0 {
-					_, err := m.c.Index("SUBTITLES").AddDocuments(&meiliSubtitles, "ID")
-					if err != nil {
-						logger.Error("issue adding subtitles to meili", "err", err)
-					}
-				}
-			}
+			meilistreams[i] = newMeiliStream(stream)
+			m.exportStreamContent(stream, meilistreams[i].MeiliPermissions)
 		}
-		_, err := index.AddDocuments(&meilistreams, "ID")
+		_, err := m.c.Index(MeiliIndexStreams).AddDocuments(&meilistreams, "ID")
 		if err != nil {
 			logger.Error("issue adding documents to meili", "err", err)
 		}
 	})
 }
 
+// ApplyUpdates reindexes the streams and courses the DAOs queued since the last run.
+func (m *MeiliExporter) ApplyUpdates() {
+	if m == nil {
+		return
+	}
+	ctx := context.Background()
+	updates, err := m.d.SearchIndexDao.GetPending(ctx, maxSearchIndexUpdates)
+	if err != nil {
+		logger.Error("could not get search index updates", "err", err)
+		return
+	}
+	if len(updates) == 0 {
+		return
+	}
+	// ids of the queued updates per course and stream. Streams of reindexed courses inherit the updates of
+	// their course, so course updates are only removed once all of their streams are reindexed.
+	courses := map[uint][]uint{}
+	streams := map[uint][]uint{}
+	for _, update := range updates {
+		switch update.Kind {
+		case model.SearchIndexCourse:
+			courses[update.ObjectID] = append(courses[update.ObjectID], update.ID)
+		case model.SearchIndexStream:
+			streams[update.ObjectID] = append(streams[update.ObjectID], update.ID)
+		}
+	}
+	failed := map[uint]bool{}
+	for courseID, ids := range courses {
+		streamIDs, err := m.reindexCourse(ctx, courseID)
+		if err != nil {
+			logger.Error("could not reindex course", "err", err, "course", courseID)
+			for _, id := range ids {
+				failed[id] = true
+			}
+			continue
+		}
+		for _, streamID := range streamIDs {
+			streams[streamID] = append(streams[streamID], ids...)
+		}
+	}
+	for streamID, ids := range streams {
+		if err = m.reindexStream(ctx, streamID); err != nil {
+			logger.Error("could not reindex stream", "err", err, "stream", streamID)
+			for _, id := range ids {
+				failed[id] = true
+			}
+		}
+	}
+	// failed updates stay queued and are retried with the next run
+	applied := make([]uint, 0, len(updates))
+	for _, update := range updates {
+		if !failed[update.ID] {
+			applied = append(applied, update.ID)
+		}
+	}
+	if err = m.d.SearchIndexDao.Delete(ctx, applied); err != nil {
+		logger.Error("could not delete applied search index updates", "err", err)
+	}
+}
+
+// reindexCourse updates or deletes the document of a course and returns the streams that need to be reindexed,
+// as they carry the course's permissions.
+func (m *MeiliExporter) reindexCourse(ctx context.Context, courseID uint) ([]uint, error) {
+	course, err := m.d.CoursesDao.GetCourseById(ctx, courseID)
+	if err != nil {
+		return nil, err
+	}
+	if course.ID == 0 {
+		// the course is deleted, so are its streams
+		if _, err = m.c.Index(MeiliIndexCourses).DeleteDocument(fmt.Sprint(courseID)); err != nil {
+			return nil, err
+		}
+		return nil, m.deleteDocuments(fmt.Sprintf("courseID = %d", courseID), meiliIndexes...)
+	}
+	if _, err = m.c.Index(MeiliIndexCourses).AddDocuments([]MeiliCourse{newMeiliCourse(course)}, "ID"); err != nil {
+		return nil, err
+	}
+	streamIDs := make([]uint, len(course.Streams))
+	for i, stream := range course.Streams {
+		streamIDs[i] = stream.ID
+	}
+	return streamIDs, nil
+}
+
+// reindexStream replaces the documents of a stream or deletes them if the stream is gone.
+func (m *MeiliExporter) reindexStream(ctx context.Context, streamID uint) error {
+	stream, err := m.d.StreamsDao.GetStreamWithCourseAndSubtitles(ctx, streamID)
+	if err != nil && !errors.Is(err, gorm.ErrRecordNotFound) {
+		return err
+	}
+	indexed := err == nil // deleted and unrecorded streams aren't indexed
+	if _, err = m.c.Index(MeiliIndexStreams).DeleteDocument(fmt.Sprint(streamID)); err != nil {
+		return err
+	}
+	if err = m.deleteDocuments(fmt.Sprintf("streamID = %d", streamID), MeiliIndexSections, MeiliIndexSubtitles, MeiliIndexChat, MeiliIndexSlides); err != nil {
+		return err
+	}
+	if !indexed {
+		return nil
+	}
+	doc := newMeiliStream(stream)
+	if _, err = m.c.Index(MeiliIndexStreams).AddDocuments([]MeiliStream{doc}, "ID"); err != nil {
+		return err
+	}
+	m.exportStreamContent(stream, doc.MeiliPermissions)
+	return nil
+}
+
+func (m *MeiliExporter) deleteDocuments(filter string, indexes ...string) error {
+	for _, index := range indexes {
+		if index == MeiliIndexCourses {
+			continue
+		}
+		if _, err := m.c.Index(index).DeleteDocumentsByFilter(filter); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func newMeiliStream(stream dao.StreamWithCourseAndSubtitles) MeiliStream {
+	return MeiliStream{
+		ID:          stream.ID,
+		Name:        stream.Name,
+		Description: stream.Description,
+		CourseName:  stream.CourseName,
+		MeiliPermissions: MeiliPermissions{
+			CourseID:     stream.CourseID,
+			CourseSlug:   stream.Slug,
+			Visibility:   stream.Visibility,
+			Private:      stream.Private,
+			Year:         stream.Year,
+			TeachingTerm: stream.TeachingTerm,
+		},
+	}
+}
+
+func newMeiliCourse(course model.Course) MeiliCourse {
+	return MeiliCourse{
+		ID:   course.ID,
+		Name: course.Name,
+		MeiliPermissions: MeiliPermissions{
+			CourseID:     course.ID,
+			CourseSlug:   course.Slug,
+			Visibility:   course.Visibility,
+			Year:         course.Year,
+			TeachingTerm: course.TeachingTerm,
+		},
+	}
+}
+
+// exportStreamContent adds the sections, subtitles, chat and slide texts of a stream.
+func (m *MeiliExporter) exportStreamContent(stream dao.StreamWithCourseAndSubtitles, permissions MeiliPermissions) {
+	m.exportSections(stream, permissions)
+	m.exportChat(stream, permissions)
+	m.exportSlides(stream, permissions)
+	if stream.Subtitles == "" {
+		return
+	}
+	meiliSubtitles := make([]MeiliSubtitles, 0)
+
+	vtt, err := astisub.ReadFromWebVTT(strings.NewReader(stream.Subtitles))
+	if err != nil {
+		logger.Warn("could not parse subtitles", "err", err)
+		return
+	}
+	for i := range vtt.Items {
+		sub := MeiliSubtitles{
+			ID:               fmt.Sprintf("%d-%d", stream.ID, vtt.Items[i].StartAt.Milliseconds()),
+			StreamID:         stream.ID,
+			Timestamp:        vtt.Items[i].StartAt.Milliseconds(),
+			Text:             vtt.Items[i].String(),
+			MeiliPermissions: permissions,
+		}
+		if i > 0 {
+			sub.TextPrev = meiliSubtitles[i-1].Text
+			meiliSubtitles[i-1].TextNext = sub.Text
+		}
+
+		meiliSubtitles = append(meiliSubtitles, sub)
+	}
+
+	if len(meiliSubtitles) > 0 {
+		_, err := m.c.Index(MeiliIndexSubtitles).AddDocuments(&meiliSubtitles, "ID")
+		if err != nil {
+			logger.Error("issue adding subtitles to meili", "err", err)
+		}
+	}
+}
+
+func (m *MeiliExporter) exportCourses() {
+	courses, err := m.d.CoursesDao.GetAllCourses()
+	if err != nil {
+		logger.Error("could not get courses for meili", "err", err)
+		return
+	}
+	meiliCourses := make([]MeiliCourse, len(courses))
+	for i, course := range courses {
+		meiliCourses[i] = newMeiliCourse(course)
+	}
+	if len(meiliCourses) > 0 {
+		if _, err = m.c.Index(MeiliIndexCourses).AddDocuments(&meiliCourses, "ID"); err != nil {
+			logger.Error("issue adding courses to meili", "err", err)
+		}
+	}
+}
+
+func (m *MeiliExporter) exportSections(stream dao.StreamWithCourseAndSubtitles, permissions MeiliPermissions) {
+	sections, err := m.d.VideoSectionDao.GetByStreamId(stream.ID)
+	if err != nil {
+		logger.Warn("could not get sections for meili", "err", err, "stream", stream.ID)
+		return
+	}
+	meiliSections := make([]MeiliSection, len(sections))
+	for i, section := range sections {
+		meiliSections[i] = MeiliSection{
+			ID:               section.ID,
+			StreamID:         stream.ID,
+			StreamName:       stream.Name,
+			Description:      section.Description,
+			Timestamp:        int64(section.StartHours*3600 + section.StartMinutes*60 + section.StartSeconds),
+			MeiliPermissions: permissions,
+		}
+	}
+	if len(meiliSections) > 0 {
+		if _, err = m.c.Index(MeiliIndexSections).AddDocuments(&meiliSections, "ID"); err != nil {
+			logger.Error("issue adding sections to meili", "err", err)
+		}
+	}
+}
+
+func (m *MeiliExporter) exportChat(stream dao.StreamWithCourseAndSubtitles, permissions MeiliPermissions) {
+	chats, err := m.d.ChatDao.GetVisibleChats(0, stream.ID)
+	if err != nil {
+		logger.Warn("could not get chat for meili", "err", err, "stream", stream.ID)
+		return
+	}
+	meiliChats := make([]MeiliChat, 0, len(chats))
+	for _, chat := range chats {
+		for _, msg := range append([]model.Chat{chat}, chat.Replies...) {
+			if !msg.Visible.Bool {
+				continue
+			}
+			meiliChats = append(meiliChats, MeiliChat{
+				ID:               msg.ID,
+				StreamID:         stream.ID,
+				Message:          msg.Message,
+				Timestamp:        max(int64(msg.CreatedAt.Sub(stream.Start).Seconds()), 0),
+				MeiliPermissions: permissions,
+			})
+		}
+	}
+	if len(meiliChats) > 0 {
+		if _, err = m.c.Index(MeiliIndexChat).AddDocuments(&meiliChats, "ID"); err != nil {
+			logger.Error("issue adding chat to meili", "err", err)
+		}
+	}
+}
+
+func (m *MeiliExporter) exportSlides(stream dao.StreamWithCourseAndSubtitles, permissions MeiliPermissions) {
+	texts, err := m.d.SlideTextDao.GetForStream(context.Background(), stream.ID)
+	if err != nil {
+		logger.Warn("could not get slide texts for meili", "err", err, "stream", stream.ID)
+		return
+	}
+	meiliSlides := make([]MeiliSlide, len(texts))
+	for i, text := range texts {
+		meiliSlides[i] = MeiliSlide{
+			ID:               text.ID,
+			StreamID:         stream.ID,
+			Text:             text.Text,
+			Timestamp:        int64(text.Start),
+			MeiliPermissions: permissions,
+		}
+	}
+	if len(meiliSlides) > 0 {
+		if _, err = m.c.Index(MeiliIndexSlides).AddDocuments(&meiliSlides, "ID"); err != nil {
+			logger.Error("issue adding slides to meili", "err", err)
+		}
+	}
+}
+
 func (m *MeiliExporter) SetIndexSettings() {
 	if m == nil {
 		return
 	}
-	index := m.c.Index("STREAMS")
+	index := m.c.Index(MeiliIndexStreams)
 	synonyms := map[string][]string{
 		"W": {"Wintersemester", "Winter", "WS", "WiSe"},
 		"S": {"Sommersemester", "Sommer", "SS", "SoSe", "Summer"},
@@ -122,12 +426,31 @@ func (m *MeiliExporter) SetIndexSettings() {
 		logger.Error("could not set synonyms for meili index STREAMS", "err", err)
 	}
 
-	_, err = m.c.Index("SUBTITLES").UpdateSettings(&meilisearch.Settings{
-		FilterableAttributes: []string{"streamID", "courseID"},
+	_, err = m.c.Index(MeiliIndexSubtitles).UpdateSettings(&meilisearch.Settings{
+		FilterableAttributes: append([]string{"streamID"}, meiliPermissionAttributes...),
 		SearchableAttributes: []string{"text"},
 		SortableAttributes:   []string{"timestamp"},
 	})
 	if err != nil {
 		logger.Warn("could not set settings for meili index SUBTITLES", "err", err)
 	}
+
+	for name, searchable := range map[string][]string{
+		MeiliIndexStreams:  {"name", "description", "courseName", "semester"},
+		MeiliIndexCourses:  {"name"},
+		MeiliIndexSections: {"description"},
+		MeiliIndexChat:     {"message"},
+		MeiliIndexSlides:   {"text"},
+	} {
+		_, err = m.c.Index(name).UpdateSettings(&meilisearch.Settings{
+			FilterableAttributes: append([]string{"streamID"}, meiliPermissionAttributes...),
+			SearchableAttributes: searchable,
+		})
+		if err != nil {
+			logger.Warn("could not set settings for meili index", "index", name, "err", err)
+		}
+	}
 }
+
+// meiliPermissionAttributes are the attributes of MeiliPermissions searches filter by.
+var meiliPermissionAttributes = []string{"courseID", "visibility", "private", "year", "semester"}
@@ -0,0 +1,63 @@
+package tools
+
+import (
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/TUM-Dev/gocast/dao"
+	"github.com/TUM-Dev/gocast/mock_dao"
+	"github.com/TUM-Dev/gocast/model"
+	"github.com/golang/mock/gomock"
+	"github.com/meilisearch/meilisearch-go"
+	"gorm.io/gorm"
+)
+
+func TestApplyUpdates(t *testing.T) {
+	// meili accepts all tasks except for the documents of stream 2
+	meili := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		if r.URL.Path == "/indexes/STREAMS/documents/2" {
+			w.WriteHeader(http.StatusInternalServerError)
+			_, _ = w.Write([]byte(`{"message":"unavailable","code":"internal","type":"internal","link":""}`))
+			return
+		}
+		w.WriteHeader(http.StatusAccepted)
+		_, _ = w.Write([]byte(`{"taskUid":1,"indexUid":"STREAMS","status":"enqueued","type":"documentDeletion","enqueuedAt":"2024-01-01T00:00:00Z"}`))
+	}))
+	defer meili.Close()
+
+	ctrl := gomock.NewController(t)
+	searchIndex := mock_dao.NewMockSearchIndexDao(ctrl)
+	searchIndex.EXPECT().GetPending(gomock.Any(), maxSearchIndexUpdates).Return([]model.SearchIndexUpdate{
+		{Model: gorm.Model{ID: 1}, Kind: model.SearchIndexStream, ObjectID: 1},
+		{Model: gorm.Model{ID: 2}, Kind: model.SearchIndexStream, ObjectID: 2},
+		{Model: gorm.Model{ID: 3}, Kind: model.SearchIndexCourse, ObjectID: 5},
+		{Model: gorm.Model{ID: 4}, Kind: model.SearchIndexCourse, ObjectID: 6},
+		{Model: gorm.Model{ID: 5}, Kind: model.SearchIndexStream, ObjectID: 1},
+		{Model: gorm.Model{ID: 6}, Kind: model.SearchIndexCourse, ObjectID: 7},
+	}, nil)
+	// only the updates that were reindexed are removed, the others are retried
+	searchIndex.EXPECT().Delete(gomock.Any(), []uint{1, 4, 5}).Return(nil)
+	courses := mock_dao.NewMockCoursesDao(ctrl)
+	courses.EXPECT().GetCourseById(gomock.Any(), uint(5)).Return(model.Course{}, errors.New("database unavailable"))
+	courses.EXPECT().GetCourseById(gomock.Any(), uint(6)).Return(model.Course{}, nil) // deleted
+	// the course is reindexed, but its stream 2 isn't
+	course := model.Course{Model: gorm.Model{ID: 7}, Streams: []model.Stream{{Model: gorm.Model{ID: 2}}}}
+	courses.EXPECT().GetCourseById(gomock.Any(), uint(7)).Return(course, nil)
+	streams := mock_dao.NewMockStreamsDao(ctrl)
+	streams.EXPECT().GetStreamWithCourseAndSubtitles(gomock.Any(), gomock.Any()).Return(dao.StreamWithCourseAndSubtitles{}, gorm.ErrRecordNotFound).Times(2)
+
+	m := &MeiliExporter{
+		c: meilisearch.NewClient(meilisearch.ClientConfig{Host: meili.URL}),
+		d: dao.DaoWrapper{SearchIndexDao: searchIndex, CoursesDao: courses, StreamsDao: streams},
+	}
+	m.ApplyUpdates()
+}
+
+func TestApplyUpdatesWithoutMeili(t *testing.T) {
+	// NewMeiliExporter returns nil if meili isn't configured
+	var m *MeiliExporter
+	m.ApplyUpdates()
+}
@@ -1,8 +1,11 @@
 package tools
 
 import (
+	"encoding/json"
 	"fmt"
+	"strings"
 
+	"github.com/TUM-Dev/gocast/model"
 	"github.com/meilisearch/meilisearch-go"
 )
 
@@ -21,3 +24,95 @@ func SearchSubtitles(q string, streamID uint) *meilisearch.SearchResponse {
 	}
 	return response
 }
+
+// MeiliSearchResults contains the hits of a search across all indexes.
+type MeiliSearchResults struct {
+	Courses   []MeiliCourse
+	Streams   []MeiliStream
+	Sections  []MeiliSection
+	Subtitles []MeiliSubtitles
+	Chat      []MeiliChat
+	Slides    []MeiliSlide
+}
+
+// SearchAll searches courses, streams, sections, subtitles, chat and slide texts at once.
+// filter restricts the documents of all indexes, see SearchPermissionFilter.
+func SearchAll(q string, filter string, limit int64) (*MeiliSearchResults, error) {
+	c, err := Cfg.GetMeiliClient()
+	if err != nil {
+		return nil, err
+	}
+	var f interface{}
+	if filter != "" {
+		f = filter
+	}
+	queries := make([]meilisearch.SearchRequest, len(meiliIndexes))
+	for i, index := range meiliIndexes {
+		queries[i] = meilisearch.SearchRequest{IndexUID: index, Query: q, Filter: f, Limit: limit}
+	}
+	response, err := c.MultiSearch(&meilisearch.MultiSearchRequest{Queries: queries})
+	if err != nil {
+		return nil, err
+	}
+	res := &MeiliSearchResults{}
+	targets := map[string]interface{}{
+		MeiliIndexCourses:   &res.Courses,
+		MeiliIndexStreams:   &res.Streams,
+		MeiliIndexSections:  &res.Sections,
+		MeiliIndexSubtitles: &res.Subtitles,
+		MeiliIndexChat:      &res.Chat,
+		MeiliIndexSlides:    &res.Slides,
+	}
+	for _, result := range response.Results {
+		target, ok := targets[result.IndexUID]
+		if !ok {
+			continue
+		}
+		// hits are generic maps, decode them into the documents we exported
+		hits, err := json.Marshal(result.Hits)
+		if err != nil {
+			return nil, err
+		}
+		if err = json.Unmarshal(hits, target); err != nil {
+			return nil, err
+		}
+	}
+	return res, nil
+}
+
+// SearchPermissionFilter returns a filter for the documents user may find, user is nil for visitors that aren't logged in.
+// Courses must be public, loggedin or have the user enrolled and streams must not be private, unless the user administers the course.
+func SearchPermissionFilter(user *model.User) string {
+	if user == nil {
+		return `visibility = "public" AND private = false`
+	}
+	if user.Role == model.AdminType {
+		return ""
+	}
+	filter := `visibility IN ["public", "loggedin"]`
+	if len(user.Courses) > 0 {
+		filter = fmt.Sprintf("(%s OR courseID IN %s)", filter, meiliCourseIDs(user.Courses))
+	}
+	filter += " AND private = false"
+	if len(user.AdministeredCourses) > 0 {
+		filter = fmt.Sprintf("(%s) OR courseID IN %s", filter, meiliCourseIDs(user.AdministeredCourses))
+	}
+	return filter
+}
+
+// SearchSemesterFilter restricts a filter to the courses of a semester.
+func SearchSemesterFilter(filter string, year int, term string) string {
+	semester := fmt.Sprintf(`year = %d AND semester = %q`, year, term)
+	if filter == "" {
+		return semester
+	}
+	return fmt.Sprintf("(%s) AND %s", filter, semester)
+}
+
+func meiliCourseIDs(courses []model.Course) string {
+	ids := make([]string, len(courses))
+	for i, course := range courses {
+		ids[i] = fmt.Sprint(course.ID)
+	}
+	return "[" + strings.Join(ids, ", ") + "]"
+}
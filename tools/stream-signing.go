@@ -16,12 +16,27 @@ type JWTPlaylistClaims struct {
 	Download bool
 	StreamID string
 	CourseID string
+	Keep     string // parts of the VoD the edge server plays, e.g. 0-2700000,3300000-. Empty if the VoD isn't edited
 }
 
 // SetSignedPlaylists adds a signed jwt to all available playlist urls that indicates that the
-// user is allowed to consume the playlist. The method assumes that the user has been pre-authorized and doesn't
-// check for permissions.
+// user is allowed to consume the playlist. For adaptive streams, the playlist urls are master playlists and
+// the jwt covers all renditions referenced by them. The method assumes that the user has been pre-authorized and doesn't
+// check for permissions. The DVR playlists of running live streams are signed as well.
+// The edit decision list of the stream is applied to its VoD playlists. The jwt claims the kept parts, so the
+// edge server rejects requests for the rest of the VoD. Edited VoDs aren't downloaded from their playlists but as the
+// file the edits were rendered into, see model.Stream.GetVodFiles.
 func SetSignedPlaylists(s *model.Stream, user *model.User, allowDownloading bool) error {
+	return signPlaylists(s, user, allowDownloading, model.KeepQuery(s.Edits))
+}
+
+// SetSignedOriginalPlaylists is SetSignedPlaylists without the edit decision list, e.g. for editing the VoD.
+// Only admins of the course and workers may get the original playlists.
+func SetSignedOriginalPlaylists(s *model.Stream, user *model.User, allowDownloading bool) error {
+	return signPlaylists(s, user, allowDownloading, "")
+}
+
+func signPlaylists(s *model.Stream, user *model.User, allowDownloading bool, keep string) error {
 	var playlists []struct{ Type, Playlist string }
 	if s.PlaylistUrl != "" {
 		playlists = append(playlists, struct{ Type, Playlist string }{Type: "COMB", Playlist: s.PlaylistUrl})
@@ -32,6 +47,15 @@ func SetSignedPlaylists(s *model.Stream, user *model.User, allowDownloading bool
 	if s.PlaylistUrlPRES != "" {
 		playlists = append(playlists, struct{ Type, Playlist string }{Type: "PRES", Playlist: s.PlaylistUrlPRES})
 	}
+	if s.DvrPlaylistUrl != "" {
+		playlists = append(playlists, struct{ Type, Playlist string }{Type: "DVRCOMB", Playlist: s.DvrPlaylistUrl})
+	}
+	if s.DvrPlaylistUrlCAM != "" {
+		playlists = append(playlists, struct{ Type, Playlist string }{Type: "DVRCAM", Playlist: s.DvrPlaylistUrlCAM})
+	}
+	if s.DvrPlaylistUrlPRES != "" {
+		playlists = append(playlists, struct{ Type, Playlist string }{Type: "DVRPRES", Playlist: s.DvrPlaylistUrlPRES})
+	}
 
 	for _, playlist := range playlists {
 		if strings.Contains(playlist.Playlist, "lrz.de") { // todo: remove after migration from lrz services
@@ -39,6 +63,11 @@ func SetSignedPlaylists(s *model.Stream, user *model.User, allowDownloading bool
 		}
 
 		t := jwt.New(jwt.GetSigningMethod("RS256"))
+		// DVR playlists are live, edits only apply to the VoD
+		playlistKeep := keep
+		if strings.HasPrefix(playlist.Type, "DVR") {
+			playlistKeep = ""
+		}
 
 		var userid uint
 		userid = 0
@@ -51,23 +80,43 @@ func SetSignedPlaylists(s *model.Stream, user *model.User, allowDownloading bool
 			},
 			UserID:   userid,
 			Playlist: playlist.Playlist,
-			Download: allowDownloading,
+			Download: allowDownloading && playlistKeep == "",
 			StreamID: fmt.Sprintf("%d", s.ID),
 			CourseID: fmt.Sprintf("%d", s.CourseID),
+			Keep:     playlistKeep,
 		}
 		str, err := t.SignedString(Cfg.GetJWTKey())
 		if err != nil {
 			return err
 		}
+		signed := withJWT(playlist.Playlist, str)
+		if playlistKeep != "" {
+			signed += "&keep=" + playlistKeep
+		}
 
 		switch playlist.Type {
 		case "CAM":
-			s.PlaylistUrlCAM += "?jwt=" + str
+			s.PlaylistUrlCAM = signed
 		case "PRES":
-			s.PlaylistUrlPRES += "?jwt=" + str
+			s.PlaylistUrlPRES = signed
 		case "COMB":
-			s.PlaylistUrl += "?jwt=" + str
+			s.PlaylistUrl = signed
+		case "DVRCAM":
+			s.DvrPlaylistUrlCAM = signed
+		case "DVRPRES":
+			s.DvrPlaylistUrlPRES = signed
+		case "DVRCOMB":
+			s.DvrPlaylistUrl = signed
 		}
 	}
 	return nil
 }
+
+// withJWT appends the jwt to the query of playlist. Master playlists of adaptive live streams
+// may already carry a query (e.g. ?dvr), so the separator depends on the url.
+func withJWT(playlist string, jwt string) string {
+	if strings.Contains(playlist, "?") {
+		return playlist + "&jwt=" + jwt
+	}
+	return playlist + "?jwt=" + jwt
+}
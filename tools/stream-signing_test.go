@@ -0,0 +1,69 @@
+package tools
+
+import (
+	"crypto/rand"
+	"crypto/rsa"
+	"net/url"
+	"testing"
+
+	"github.com/TUM-Dev/gocast/model"
+	"github.com/golang-jwt/jwt/v4"
+)
+
+func TestSetSignedPlaylistsClaimsEdits(t *testing.T) {
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer func(k *rsa.PrivateKey) { jwtKey = k }(jwtKey)
+	jwtKey = key
+
+	stream := model.Stream{
+		PlaylistUrl:    "https://edge.example.com/vod/eidi.mp4/playlist.m3u8",
+		DvrPlaylistUrl: "https://edge.example.com/vm1/dvr/3/COMB/playlist.m3u8",
+		Edits:          []model.StreamEdit{{Start: 2700000, End: 3300000, Discard: true}},
+	}
+	original := stream
+	if err = SetSignedPlaylists(&stream, nil, true); err != nil {
+		t.Fatal(err)
+	}
+	claims := playlistClaims(t, stream.PlaylistUrl, &key.PublicKey)
+	if claims.Keep != "0-2700000,3300000-" {
+		t.Errorf("expected kept parts in jwt, got %q", claims.Keep)
+	}
+	if claims.Download {
+		t.Error("expected no download of edited playlist")
+	}
+	if q, _ := url.Parse(stream.PlaylistUrl); q.Query().Get("keep") != "0-2700000,3300000-" {
+		t.Errorf("expected kept parts in query, got %s", stream.PlaylistUrl)
+	}
+	if claims := playlistClaims(t, stream.DvrPlaylistUrl, &key.PublicKey); claims.Keep != "" {
+		t.Errorf("expected no edits of dvr playlist, got %q", claims.Keep)
+	}
+
+	if err = SetSignedOriginalPlaylists(&original, nil, true); err != nil {
+		t.Fatal(err)
+	}
+	claims = playlistClaims(t, original.PlaylistUrl, &key.PublicKey)
+	if claims.Keep != "" {
+		t.Errorf("expected original playlist without edits, got %q", claims.Keep)
+	}
+	if !claims.Download {
+		t.Error("expected download of original playlist")
+	}
+}
+
+// playlistClaims returns the verified claims of the jwt of a signed playlist url.
+func playlistClaims(t *testing.T, playlist string, key *rsa.PublicKey) *JWTPlaylistClaims {
+	u, err := url.Parse(playlist)
+	if err != nil {
+		t.Fatal(err)
+	}
+	token, err := jwt.ParseWithClaims(u.Query().Get("jwt"), &JWTPlaylistClaims{}, func(*jwt.Token) (interface{}, error) {
+		return key, nil
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	return token.Claims.(*JWTPlaylistClaims)
+}
@@ -0,0 +1,180 @@
+// Package subtitles parses subtitle files into cues and writes them as WebVTT or SRT
+package subtitles
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/asticode/go-astisub"
+)
+
+// Formats of subtitle files
+const (
+	FormatWebVTT = "vtt"
+	FormatSRT    = "srt"
+	FormatTTML   = "ttml"
+)
+
+var ErrUnknownFormat = errors.New("unknown subtitle format")
+
+// Cue is a piece of text shown between Start and End.
+type Cue struct {
+	Start time.Duration
+	End   time.Duration
+	Text  string // lines are separated by \n
+}
+
+// cueJSON is the representation of cues in the API, times are in milliseconds.
+type cueJSON struct {
+	Start int64  `json:"start"`
+	End   int64  `json:"end"`
+	Text  string `json:"text"`
+}
+
+func (c Cue) MarshalJSON() ([]byte, error) {
+	return json.Marshal(cueJSON{Start: c.Start.Milliseconds(), End: c.End.Milliseconds(), Text: c.Text})
+}
+
+func (c *Cue) UnmarshalJSON(data []byte) error {
+	var j cueJSON
+	if err := json.Unmarshal(data, &j); err != nil {
+		return err
+	}
+	*c = Cue{Start: time.Duration(j.Start) * time.Millisecond, End: time.Duration(j.End) * time.Millisecond, Text: j.Text}
+	return nil
+}
+
+// Validate returns an error if the cue can't be shown.
+func (c Cue) Validate() error {
+	if c.Start < 0 || c.End <= c.Start {
+		return fmt.Errorf("cue must end after it starts (%s - %s)", c.Start, c.End)
+	}
+	if strings.TrimSpace(c.Text) == "" {
+		return errors.New("cue has no text")
+	}
+	return nil
+}
+
+// DetectFormat guesses the format of a subtitle file from its content.
+func DetectFormat(data []byte) string {
+	trimmed := bytes.TrimSpace(bytes.TrimPrefix(data, []byte("\xef\xbb\xbf")))
+	switch {
+	case bytes.HasPrefix(trimmed, []byte("WEBVTT")):
+		return FormatWebVTT
+	case bytes.HasPrefix(trimmed, []byte("<")):
+		return FormatTTML
+	default:
+		return FormatSRT
+	}
+}
+
+// Parse reads the cues of a subtitle file. The format is detected if empty.
+// Styling is dropped, cues are ordered by their start.
+func Parse(data []byte, format string) ([]Cue, error) {
+	if format == "" {
+		format = DetectFormat(data)
+	}
+	var s *astisub.Subtitles
+	var err error
+	switch format {
+	case FormatWebVTT:
+		s, err = astisub.ReadFromWebVTT(bytes.NewReader(data))
+	case FormatSRT:
+		s, err = astisub.ReadFromSRT(bytes.NewReader(data))
+	case FormatTTML:
+		s, err = astisub.ReadFromTTML(bytes.NewReader(data))
+	default:
+		return nil, ErrUnknownFormat
+	}
+	if err != nil {
+		return nil, err
+	}
+	cues := make([]Cue, 0, len(s.Items))
+	for _, item := range s.Items {
+		lines := make([]string, 0, len(item.Lines))
+		for _, line := range item.Lines {
+			lines = append(lines, strings.TrimSpace(line.String()))
+		}
+		cues = append(cues, Cue{Start: item.StartAt, End: item.EndAt, Text: strings.Join(lines, "\n")})
+	}
+	Sort(cues)
+	return cues, nil
+}
+
+// Sort orders cues by their start.
+func Sort(cues []Cue) {
+	sort.SliceStable(cues, func(i, j int) bool {
+		return cues[i].Start < cues[j].Start
+	})
+}
+
+// Shift moves all cues by offset. Cues that would start before the video are cut or dropped.
+func Shift(cues []Cue, offset time.Duration) []Cue {
+	shifted := make([]Cue, 0, len(cues))
+	for _, c := range cues {
+		c.Start += offset
+		c.End += offset
+		if c.End <= 0 {
+			continue
+		}
+		if c.Start < 0 {
+			c.Start = 0
+		}
+		shifted = append(shifted, c)
+	}
+	return shifted
+}
+
+// Write encodes cues as WebVTT or SRT.
+func Write(cues []Cue, format string) (string, error) {
+	var b strings.Builder
+	switch format {
+	case FormatWebVTT:
+		b.WriteString("WEBVTT\n")
+		for _, c := range cues {
+			fmt.Fprintf(&b, "\n%s --> %s\n%s\n", formatTimestamp(c.Start, "."), formatTimestamp(c.End, "."), escapeWebVTT(cueText(c.Text)))
+		}
+	case FormatSRT:
+		for i, c := range cues {
+			fmt.Fprintf(&b, "%d\n%s --> %s\n%s\n\n", i+1, formatTimestamp(c.Start, ","), formatTimestamp(c.End, ","), cueText(c.Text))
+		}
+	default:
+		return "", ErrUnknownFormat
+	}
+	return b.String(), nil
+}
+
+// ContentType returns the mime type of a format.
+func ContentType(format string) string {
+	if format == FormatSRT {
+		return "application/x-subrip"
+	}
+	return "text/vtt"
+}
+
+func formatTimestamp(d time.Duration, millisecondSep string) string {
+	ms := d.Milliseconds()
+	return fmt.Sprintf("%02d:%02d:%02d%s%03d", ms/3600000, ms/60000%60, ms/1000%60, millisecondSep, ms%1000)
+}
+
+// cueText removes empty lines, which would end the cue.
+func cueText(text string) string {
+	lines := strings.Split(text, "\n")
+	nonEmpty := lines[:0]
+	for _, line := range lines {
+		if line = strings.TrimSpace(line); line != "" {
+			nonEmpty = append(nonEmpty, line)
+		}
+	}
+	return strings.Join(nonEmpty, "\n")
+}
+
+// escapeWebVTT escapes the characters WebVTT reserves for markup.
+func escapeWebVTT(text string) string {
+	return strings.NewReplacer("&", "&amp;", "<", "&lt;").Replace(text)
+}
@@ -0,0 +1,62 @@
+package subtitles
+
+import (
+	"testing"
+	"time"
+)
+
+const srt = `1
+00:00:01,000 --> 00:00:02,500
+Hello <world>
+
+2
+00:00:03,000 --> 00:00:04,000
+second
+line
+`
+
+func TestParseAndWrite(t *testing.T) {
+	cues, err := Parse([]byte(srt), "")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(cues) != 2 || cues[0].Start != time.Second || cues[0].End != 2500*time.Millisecond || cues[1].Text != "second\nline" {
+		t.Fatalf("unexpected cues %+v", cues)
+	}
+
+	vtt, err := Write(cues, FormatWebVTT)
+	if err != nil {
+		t.Fatal(err)
+	}
+	expected := "WEBVTT\n\n00:00:01.000 --> 00:00:02.500\nHello &lt;world>\n\n00:00:03.000 --> 00:00:04.000\nsecond\nline\n"
+	if vtt != expected {
+		t.Errorf("unexpected WebVTT:\n%s", vtt)
+	}
+	reparsed, err := Parse([]byte(vtt), "")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(reparsed) != 2 || reparsed[0].Text != "Hello <world>" || reparsed[1].End != 4*time.Second {
+		t.Errorf("WebVTT should parse back to the same cues, got %+v", reparsed)
+	}
+
+	back, err := Write(cues, FormatSRT)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if back != srt+"\n" {
+		t.Errorf("unexpected SRT:\n%s", back)
+	}
+}
+
+func TestShift(t *testing.T) {
+	cues := []Cue{
+		{Start: 0, End: time.Second, Text: "dropped"},
+		{Start: time.Second, End: 3 * time.Second, Text: "cut"},
+		{Start: 4 * time.Second, End: 5 * time.Second, Text: "moved"},
+	}
+	shifted := Shift(cues, -2*time.Second)
+	if len(shifted) != 2 || shifted[0].Start != 0 || shifted[0].End != time.Second || shifted[1].Start != 2*time.Second {
+		t.Errorf("unexpected shifted cues %+v", shifted)
+	}
+}
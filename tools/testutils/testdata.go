@@ -284,8 +284,11 @@ var (
 	}
 	SubtitlesFPVLive = model.Subtitles{
 		StreamID: StreamFPVLive.ID,
-		Content:  "wonderful",
+		Content:  "WEBVTT\n\n00:00:01.000 --> 00:00:02.500\nwonderful\n",
 		Language: "en",
+		Version:  1,
+		Source:   model.SubtitlesSourceGenerated,
+		Active:   true,
 	}
 )
 
@@ -0,0 +1,289 @@
+package tools
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"math/big"
+	"net"
+	"os"
+	"time"
+)
+
+// ServerCommonName is the common name of the certificate TUM-Live authenticates with at workers and the voice service.
+const ServerCommonName = "tumlive"
+
+// VoiceServiceCommonName is the common name of the certificate the voice service authenticates with.
+const VoiceServiceCommonName = "voice-service"
+
+// OcrServiceCommonName is the common name of the certificate the OCR service authenticates with.
+const OcrServiceCommonName = "ocr-service"
+
+const (
+	caValidity                = time.Hour * 24 * 365 * 10
+	serverCertValidity        = time.Hour * 24 * 365
+	defaultWorkerCertValidity = time.Hour * 24 * 30
+)
+
+// WorkerCA issues the certificates of workers and TUM-Live for the mutually authenticated gRPC channels.
+// It's nil if mutual TLS is disabled.
+var WorkerCA *CA
+
+// CA is a small certificate authority. Its key never leaves TUM-Live, workers send certificate requests.
+type CA struct {
+	cert       *x509.Certificate
+	certPEM    []byte
+	key        *ecdsa.PrivateKey
+	pool       *x509.CertPool
+	serverCert tls.Certificate
+}
+
+// InitWorkerCA loads or creates the worker CA if mutual TLS is enabled.
+func InitWorkerCA() {
+	if !Cfg.MTLS.Enabled {
+		logger.Warn("Mutual TLS is disabled, gRPC channels to workers and services are not encrypted")
+		return
+	}
+	ca, err := LoadOrCreateCA(Cfg.MTLS.CACert, Cfg.MTLS.CAKey, Cfg.MTLS.ServerNames)
+	if err != nil {
+		panic(fmt.Errorf("can't load worker CA: %v", err))
+	}
+	WorkerCA = ca
+	RenewServiceCerts()
+}
+
+// RenewServiceCerts issues the certificates of the voice and OCR service if they don't exist or expire soon.
+// Like workers, they are renewed after two thirds of their validity, the services pick up the new files on restart.
+func RenewServiceCerts() {
+	if WorkerCA == nil {
+		return
+	}
+	if Cfg.MTLS.VoiceServiceCert != "" && Cfg.VoiceService != nil {
+		if err := WorkerCA.renewServiceCert(Cfg.MTLS.VoiceServiceCert, Cfg.MTLS.VoiceServiceKey, VoiceServiceCommonName, Cfg.VoiceService.Host, WorkerCertValidity()); err != nil {
+			logger.Error("Can't issue certificate of the voice service", "err", err)
+		}
+	}
+	if Cfg.MTLS.OcrServiceCert != "" && Cfg.OcrService != nil {
+		if err := WorkerCA.renewServiceCert(Cfg.MTLS.OcrServiceCert, Cfg.MTLS.OcrServiceKey, OcrServiceCommonName, Cfg.OcrService.Host, WorkerCertValidity()); err != nil {
+			logger.Error("Can't issue certificate of the OCR service", "err", err)
+		}
+	}
+}
+
+// LoadOrCreateCA loads the CA from its pem files and creates them if they don't exist.
+// TUM-Live gets a fresh certificate for serverNames, the names workers and the voice service dial it with.
+func LoadOrCreateCA(certFile, keyFile string, serverNames []string) (*CA, error) {
+	ca := &CA{}
+	certPEM, err := os.ReadFile(certFile)
+	switch {
+	case errors.Is(err, os.ErrNotExist):
+		if err = ca.create(certFile, keyFile); err != nil {
+			return nil, err
+		}
+	case err != nil:
+		return nil, err
+	default:
+		if err = ca.load(certPEM, keyFile); err != nil {
+			return nil, err
+		}
+	}
+	ca.pool = x509.NewCertPool()
+	ca.pool.AddCert(ca.cert)
+
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		return nil, err
+	}
+	der, _, err := ca.sign(&key.PublicKey, ServerCommonName, serverNames, serverCertValidity)
+	if err != nil {
+		return nil, fmt.Errorf("issue server certificate: %w", err)
+	}
+	ca.serverCert = tls.Certificate{Certificate: [][]byte{der}, PrivateKey: key}
+	return ca, nil
+}
+
+func (ca *CA) create(certFile, keyFile string) error {
+	logger.Info("Creating worker CA", "cert", certFile)
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		return err
+	}
+	serial, err := newSerial()
+	if err != nil {
+		return err
+	}
+	template := &x509.Certificate{
+		SerialNumber:          serial,
+		Subject:               pkix.Name{CommonName: "TUM-Live worker CA"},
+		NotBefore:             time.Now().Add(-time.Hour),
+		NotAfter:              time.Now().Add(caValidity),
+		KeyUsage:              x509.KeyUsageCertSign | x509.KeyUsageCRLSign,
+		BasicConstraintsValid: true,
+		IsCA:                  true,
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+	if err != nil {
+		return err
+	}
+	keyDER, err := x509.MarshalECPrivateKey(key)
+	if err != nil {
+		return err
+	}
+	if err = os.WriteFile(keyFile, pem.EncodeToMemory(&pem.Block{Type: "EC PRIVATE KEY", Bytes: keyDER}), 0o600); err != nil {
+		return err
+	}
+	certPEM := pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der})
+	if err = os.WriteFile(certFile, certPEM, 0o644); err != nil {
+		return err
+	}
+	return ca.load(certPEM, keyFile)
+}
+
+func (ca *CA) load(certPEM []byte, keyFile string) error {
+	block, _ := pem.Decode(certPEM)
+	if block == nil {
+		return errors.New("no certificate in CA cert file")
+	}
+	cert, err := x509.ParseCertificate(block.Bytes)
+	if err != nil {
+		return err
+	}
+	keyPEM, err := os.ReadFile(keyFile)
+	if err != nil {
+		return err
+	}
+	block, _ = pem.Decode(keyPEM)
+	if block == nil {
+		return errors.New("no key in CA key file")
+	}
+	key, err := x509.ParseECPrivateKey(block.Bytes)
+	if err != nil {
+		return err
+	}
+	ca.cert, ca.certPEM, ca.key = cert, certPEM, key
+	return nil
+}
+
+// CertPEM returns the pem encoded certificate of the CA that workers need to verify TUM-Live.
+func (ca *CA) CertPEM() []byte {
+	return ca.certPEM
+}
+
+// IssueCertificate signs a pem encoded certificate request for commonName, e.g. a worker id.
+// The subject of the request is ignored, hosts are the names the owner of the certificate is dialed with.
+func (ca *CA) IssueCertificate(csrPEM []byte, commonName string, hosts []string, validity time.Duration) (certPEM []byte, cert *x509.Certificate, err error) {
+	block, _ := pem.Decode(csrPEM)
+	if block == nil || block.Type != "CERTIFICATE REQUEST" {
+		return nil, nil, errors.New("no certificate request found")
+	}
+	csr, err := x509.ParseCertificateRequest(block.Bytes)
+	if err != nil {
+		return nil, nil, err
+	}
+	if err = csr.CheckSignature(); err != nil {
+		return nil, nil, fmt.Errorf("invalid certificate request: %w", err)
+	}
+	der, cert, err := ca.sign(csr.PublicKey, commonName, hosts, validity)
+	if err != nil {
+		return nil, nil, err
+	}
+	return pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der}), cert, nil
+}
+
+// sign issues a certificate that authenticates both as server and as client.
+func (ca *CA) sign(pub interface{}, commonName string, hosts []string, validity time.Duration) ([]byte, *x509.Certificate, error) {
+	serial, err := newSerial()
+	if err != nil {
+		return nil, nil, err
+	}
+	template := &x509.Certificate{
+		SerialNumber: serial,
+		Subject:      pkix.Name{CommonName: commonName},
+		NotBefore:    time.Now().Add(-time.Hour), // tolerate clock skew
+		NotAfter:     time.Now().Add(validity),
+		KeyUsage:     x509.KeyUsageDigitalSignature,
+		ExtKeyUsage:  []x509.ExtKeyUsage{x509.ExtKeyUsageServerAuth, x509.ExtKeyUsageClientAuth},
+	}
+	// hosts dialed by their address are only verified against IP SANs
+	for _, host := range hosts {
+		if ip := net.ParseIP(host); ip != nil {
+			template.IPAddresses = append(template.IPAddresses, ip)
+		} else {
+			template.DNSNames = append(template.DNSNames, host)
+		}
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, ca.cert, pub, ca.key)
+	if err != nil {
+		return nil, nil, err
+	}
+	cert, err := x509.ParseCertificate(der)
+	return der, cert, err
+}
+
+// renewServiceCert issues a certificate for a service like the voice service unless its files hold a certificate
+// for host that is valid for more than a third of validity.
+func (ca *CA) renewServiceCert(certFile, keyFile, commonName, host string, validity time.Duration) error {
+	if certPEM, err := os.ReadFile(certFile); err == nil {
+		if block, _ := pem.Decode(certPEM); block != nil {
+			cert, err := x509.ParseCertificate(block.Bytes)
+			if err == nil && cert.CheckSignatureFrom(ca.cert) == nil && cert.VerifyHostname(host) == nil &&
+				time.Until(cert.NotAfter) > validity/3 {
+				return nil
+			}
+		}
+	}
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		return err
+	}
+	der, _, err := ca.sign(&key.PublicKey, commonName, []string{host}, validity)
+	if err != nil {
+		return err
+	}
+	keyDER, err := x509.MarshalECPrivateKey(key)
+	if err != nil {
+		return err
+	}
+	if err = os.WriteFile(keyFile, pem.EncodeToMemory(&pem.Block{Type: "EC PRIVATE KEY", Bytes: keyDER}), 0o600); err != nil {
+		return err
+	}
+	return os.WriteFile(certFile, pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der}), 0o644)
+}
+
+// ServerTLSConfig returns the config of gRPC servers that authenticate their clients with certificates of the CA.
+func (ca *CA) ServerTLSConfig(clientAuth tls.ClientAuthType) *tls.Config {
+	return &tls.Config{
+		Certificates: []tls.Certificate{ca.serverCert},
+		ClientCAs:    ca.pool,
+		ClientAuth:   clientAuth,
+		MinVersion:   tls.VersionTLS12,
+	}
+}
+
+// ClientTLSConfig returns the config to dial serverName, e.g. a worker, with the certificate of TUM-Live.
+func (ca *CA) ClientTLSConfig(serverName string) *tls.Config {
+	return &tls.Config{
+		Certificates: []tls.Certificate{ca.serverCert},
+		RootCAs:      ca.pool,
+		ServerName:   serverName,
+		MinVersion:   tls.VersionTLS12,
+	}
+}
+
+// WorkerCertValidity returns how long certificates issued to workers are valid. Workers renew them in time.
+func WorkerCertValidity() time.Duration {
+	if Cfg.MTLS.WorkerCertValidity > 0 {
+		return Cfg.MTLS.WorkerCertValidity
+	}
+	return defaultWorkerCertValidity
+}
+
+func newSerial() (*big.Int, error) {
+	return rand.Int(rand.Reader, new(big.Int).Lsh(big.NewInt(1), 128))
+}
@@ -0,0 +1,126 @@
+package tools
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+)
+
+func TestWorkerCA(t *testing.T) {
+	dir := t.TempDir()
+	certFile, keyFile := filepath.Join(dir, "ca.pem"), filepath.Join(dir, "ca-key.pem")
+	ca, err := LoadOrCreateCA(certFile, keyFile, []string{"tum.live"})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	csr, err := x509.CreateCertificateRequest(rand.Reader, &x509.CertificateRequest{Subject: pkix.Name{CommonName: "someone-else"}}, key)
+	if err != nil {
+		t.Fatal(err)
+	}
+	csrPEM := pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE REQUEST", Bytes: csr})
+
+	// reloading the CA must keep its key, certificates issued before stay valid
+	reloaded, err := LoadOrCreateCA(certFile, keyFile, []string{"tum.live"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	_, cert, err := reloaded.IssueCertificate(csrPEM, "worker-1", []string{"worker1.example.com"}, time.Hour)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if cert.Subject.CommonName != "worker-1" {
+		t.Errorf("expected common name worker-1, got %s", cert.Subject.CommonName)
+	}
+	_, err = cert.Verify(x509.VerifyOptions{
+		DNSName:   "worker1.example.com",
+		Roots:     ca.pool,
+		KeyUsages: []x509.ExtKeyUsage{x509.ExtKeyUsageClientAuth},
+	})
+	if err != nil {
+		t.Errorf("certificate should verify against the CA: %v", err)
+	}
+
+	if _, _, err = ca.IssueCertificate([]byte("no csr"), "worker-1", nil, time.Hour); err == nil {
+		t.Error("expected error for invalid certificate request")
+	}
+}
+
+func TestWorkerCAIPHosts(t *testing.T) {
+	dir := t.TempDir()
+	ca, err := LoadOrCreateCA(filepath.Join(dir, "ca.pem"), filepath.Join(dir, "ca-key.pem"), []string{"tum.live"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	_, cert, err := ca.sign(&key.PublicKey, "worker-1", []string{"10.0.0.7", "worker1.example.com"}, time.Hour)
+	if err != nil {
+		t.Fatal(err)
+	}
+	// workers registered with their address are dialed with it
+	for _, host := range []string{"10.0.0.7", "worker1.example.com"} {
+		if _, err = cert.Verify(x509.VerifyOptions{DNSName: host, Roots: ca.pool}); err != nil {
+			t.Errorf("certificate should verify for %s: %v", host, err)
+		}
+	}
+	if len(cert.DNSNames) != 1 || len(cert.IPAddresses) != 1 {
+		t.Errorf("expected one DNS and one IP SAN, got %v and %v", cert.DNSNames, cert.IPAddresses)
+	}
+}
+
+func TestRenewServiceCert(t *testing.T) {
+	dir := t.TempDir()
+	ca, err := LoadOrCreateCA(filepath.Join(dir, "ca.pem"), filepath.Join(dir, "ca-key.pem"), []string{"tum.live"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	certFile, keyFile := filepath.Join(dir, "voice.pem"), filepath.Join(dir, "voice-key.pem")
+	readCert := func() *x509.Certificate {
+		certPEM, err := os.ReadFile(certFile)
+		if err != nil {
+			t.Fatal(err)
+		}
+		block, _ := pem.Decode(certPEM)
+		cert, err := x509.ParseCertificate(block.Bytes)
+		if err != nil {
+			t.Fatal(err)
+		}
+		return cert
+	}
+
+	if err = ca.renewServiceCert(certFile, keyFile, VoiceServiceCommonName, "voice.example.com", 30*24*time.Hour); err != nil {
+		t.Fatal(err)
+	}
+	issued := readCert()
+	if until := time.Until(issued.NotAfter); until > 31*24*time.Hour {
+		t.Errorf("expected the validity of worker certificates, got %v", until)
+	}
+	// still fresh, kept
+	if err = ca.renewServiceCert(certFile, keyFile, VoiceServiceCommonName, "voice.example.com", 30*24*time.Hour); err != nil {
+		t.Fatal(err)
+	}
+	if kept := readCert(); kept.SerialNumber.Cmp(issued.SerialNumber) != 0 {
+		t.Error("expected fresh certificate to be kept")
+	}
+	// less than a third of the validity left, renewed
+	if err = ca.renewServiceCert(certFile, keyFile, VoiceServiceCommonName, "voice.example.com", 100*24*time.Hour); err != nil {
+		t.Fatal(err)
+	}
+	if renewed := readCert(); renewed.SerialNumber.Cmp(issued.SerialNumber) == 0 {
+		t.Error("expected expiring certificate to be renewed")
+	}
+}
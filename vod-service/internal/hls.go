@@ -0,0 +1,327 @@
+package internal
+
+import (
+	"bufio"
+	"encoding/json"
+	"fmt"
+	"math"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strconv"
+	"strings"
+)
+
+// variant describes a packaged rendition as it is listed in the master playlist and the manifest.
+type variant struct {
+	Name             string  `json:"name"`
+	Playlist         string  `json:"playlist"`
+	IFramePlaylist   string  `json:"iFramePlaylist,omitempty"`
+	Width            int     `json:"width,omitempty"`
+	Height           int     `json:"height,omitempty"`
+	Codecs           string  `json:"codecs,omitempty"`
+	Bandwidth        int     `json:"bandwidth"`                 // peak segment bitrate in bit/s
+	AverageBandwidth int     `json:"averageBandwidth"`          // bit/s
+	Duration         float64 `json:"duration"`                  // seconds
+	IFrameBandwidth  int     `json:"iFrameBandwidth,omitempty"` // peak bitrate of the i-frame playlist in bit/s
+}
+
+// manifest is written next to the master playlist and describes all renditions of a package.
+type manifest struct {
+	Name       string    `json:"name"`
+	Master     string    `json:"master"`
+	Renditions []variant `json:"renditions"`
+}
+
+type probeResult struct {
+	Streams []struct {
+		CodecType string `json:"codec_type"`
+		CodecName string `json:"codec_name"`
+		Profile   string `json:"profile"`
+		Level     int    `json:"level"`
+		Width     int    `json:"width"`
+		Height    int    `json:"height"`
+		PixFmt    string `json:"pix_fmt"`
+	} `json:"streams"`
+}
+
+func probe(file string) (probeResult, error) {
+	var res probeResult
+	out, err := exec.Command("ffprobe", "-v", "quiet", "-print_format", "json", "-show_streams", file).Output()
+	if err != nil {
+		return res, fmt.Errorf("probe %s: %w", file, err)
+	}
+	err = json.Unmarshal(out, &res)
+	return res, err
+}
+
+// height returns the height of the first video stream or 0 if there is none.
+func (p probeResult) height() int {
+	for _, s := range p.Streams {
+		if s.CodecType == "video" {
+			return s.Height
+		}
+	}
+	return 0
+}
+
+// codecs returns the RFC 6381 codecs of the probed file as used in the CODECS attribute of the master playlist.
+// An empty string is returned if any of the codecs is unknown, the attribute is omitted in that case.
+func (p probeResult) codecs() string {
+	var codecs []string
+	for _, s := range p.Streams {
+		switch {
+		case s.CodecName == "h264":
+			profiles := map[string]string{"Constrained Baseline": "42e0", "Baseline": "4200", "Main": "4d00", "High": "6400"}
+			profile, ok := profiles[s.Profile]
+			if !ok {
+				return ""
+			}
+			codecs = append(codecs, fmt.Sprintf("avc1.%s%02x", profile, s.Level))
+		case s.CodecName == "hevc":
+			// hvc1.<profile>.<compatibility>.L<level>.<constraints>, the level of ffprobe is general_level_idc
+			profiles := map[string]string{"Main": "1.6", "Main 10": "2.4"}
+			profile, ok := profiles[s.Profile]
+			if !ok {
+				return ""
+			}
+			codecs = append(codecs, fmt.Sprintf("hvc1.%s.L%d.B0", profile, s.Level))
+		case s.CodecName == "av1":
+			// av01.<profile>.<level><tier>.<bit depth>
+			if s.Profile != "Main" {
+				return ""
+			}
+			depth := "08"
+			if strings.Contains(s.PixFmt, "10") {
+				depth = "10"
+			}
+			codecs = append(codecs, fmt.Sprintf("av01.0.%02dM.%s", s.Level, depth))
+		case s.CodecName == "aac" && s.Profile == "HE-AAC":
+			codecs = append(codecs, "mp4a.40.5")
+		case s.CodecName == "aac":
+			codecs = append(codecs, "mp4a.40.2")
+		case s.CodecType == "video" || s.CodecType == "audio":
+			return ""
+		}
+	}
+	return strings.Join(codecs, ",")
+}
+
+// mediaSegment is an entry of a media playlist.
+type mediaSegment struct {
+	uri      string
+	duration float64
+}
+
+// readMediaPlaylist returns the segments of the media playlist at path.
+func readMediaPlaylist(path string) ([]mediaSegment, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+	var segments []mediaSegment
+	duration := 0.0
+	scanner := bufio.NewScanner(f)
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+		switch {
+		case strings.HasPrefix(line, "#EXTINF:"):
+			duration, err = strconv.ParseFloat(strings.TrimSuffix(strings.SplitN(strings.TrimPrefix(line, "#EXTINF:"), ",", 2)[0], ","), 64)
+			if err != nil {
+				return nil, fmt.Errorf("parse segment duration %q: %w", line, err)
+			}
+		case line != "" && !strings.HasPrefix(line, "#"):
+			segments = append(segments, mediaSegment{uri: line, duration: duration})
+		}
+	}
+	return segments, scanner.Err()
+}
+
+// describeVariant computes the bandwidth, resolution and codecs of the rendition packaged in dir.
+func describeVariant(dir, name string) (variant, error) {
+	v := variant{Name: name, Playlist: name + "/playlist.m3u8"}
+	segments, err := readMediaPlaylist(filepath.Join(dir, "playlist.m3u8"))
+	if err != nil {
+		return v, err
+	}
+	if len(segments) == 0 {
+		return v, fmt.Errorf("rendition %s has no segments", name)
+	}
+	var bits float64
+	for _, s := range segments {
+		info, err := os.Stat(filepath.Join(dir, s.uri))
+		if err != nil {
+			return v, err
+		}
+		segmentBits := float64(info.Size() * 8)
+		bits += segmentBits
+		v.Duration += s.duration
+		if s.duration > 0 && int(math.Ceil(segmentBits/s.duration)) > v.Bandwidth {
+			v.Bandwidth = int(math.Ceil(segmentBits / s.duration))
+		}
+	}
+	if v.Duration > 0 {
+		v.AverageBandwidth = int(math.Ceil(bits / v.Duration))
+	}
+	// fragmented mp4 segments can only be probed with their init segment
+	probeFile := filepath.Join(dir, segments[0].uri)
+	if _, err = os.Stat(filepath.Join(dir, "init.mp4")); err == nil {
+		probeFile = filepath.Join(dir, "init.mp4")
+	}
+	p, err := probe(probeFile)
+	if err != nil {
+		return v, err
+	}
+	for _, s := range p.Streams {
+		if s.CodecType == "video" {
+			v.Width, v.Height = s.Width, s.Height
+		}
+	}
+	v.Codecs = p.codecs()
+	return v, nil
+}
+
+type packetProbe struct {
+	Packets []struct {
+		PtsTime string `json:"pts_time"`
+		Pos     string `json:"pos"`
+		Flags   string `json:"flags"`
+	} `json:"packets"`
+}
+
+// iFrame is an entry of an i-frame playlist.
+type iFrame struct {
+	uri            string
+	offset, length int64
+	pts, duration  float64
+}
+
+// writeIFramePlaylist writes an EXT-X-I-FRAMES-ONLY playlist for the rendition in dir that references the keyframes
+// of its segments by byte range. This allows players to show previews while scrubbing without loading whole segments.
+func writeIFramePlaylist(dir string, v *variant) error {
+	segments, err := readMediaPlaylist(filepath.Join(dir, "playlist.m3u8"))
+	if err != nil {
+		return err
+	}
+	var frames []iFrame
+	end := 0.0
+	for _, s := range segments {
+		segment := filepath.Join(dir, s.uri)
+		out, err := exec.Command("ffprobe", "-v", "quiet", "-print_format", "json",
+			"-select_streams", "v:0", "-show_entries", "packet=pts_time,pos,flags", segment).Output()
+		if err != nil {
+			return fmt.Errorf("probe packets of %s: %w", segment, err)
+		}
+		var packets packetProbe
+		if err = json.Unmarshal(out, &packets); err != nil {
+			return err
+		}
+		info, err := os.Stat(segment)
+		if err != nil {
+			return err
+		}
+		segmentFrames, segmentEnd := segmentIFrames(s.uri, packets, info.Size())
+		frames = append(frames, segmentFrames...)
+		end = math.Max(end, segmentEnd)
+	}
+	if len(frames) == 0 {
+		return fmt.Errorf("rendition %s has no keyframes", v.Name)
+	}
+	playlist, bandwidth := iFramePlaylist(frames, end)
+	if err = writeFileAtomic(filepath.Join(dir, "iframes.m3u8"), []byte(playlist)); err != nil {
+		return err
+	}
+	v.IFramePlaylist, v.IFrameBandwidth = v.Name+"/iframes.m3u8", bandwidth
+	return nil
+}
+
+// segmentIFrames returns the keyframes of the video packets of a segment of size bytes and the pts of its last packet.
+// A keyframe ends where the next video packet starts, the last one at the end of the segment.
+func segmentIFrames(uri string, packets packetProbe, size int64) (frames []iFrame, end float64) {
+	current := -1 // index of the last keyframe in frames
+	for _, p := range packets.Packets {
+		pts, errPts := strconv.ParseFloat(p.PtsTime, 64)
+		pos, errPos := strconv.ParseInt(p.Pos, 10, 64)
+		if errPts != nil || errPos != nil {
+			continue
+		}
+		end = math.Max(end, pts)
+		if current != -1 && frames[current].length == 0 {
+			frames[current].length = pos - frames[current].offset
+		}
+		if strings.HasPrefix(p.Flags, "K") {
+			frames = append(frames, iFrame{uri: uri, offset: pos, pts: pts})
+			current = len(frames) - 1
+		}
+	}
+	if current != -1 && frames[current].length == 0 {
+		frames[current].length = size - frames[current].offset
+	}
+	return frames, end
+}
+
+// iFramePlaylist returns the i-frame playlist of frames and its peak bitrate in bit/s. Every keyframe lasts until
+// the next one, the last one until end.
+func iFramePlaylist(frames []iFrame, end float64) (string, int) {
+	targetDuration := 1.0
+	bandwidth := 0
+	for i := range frames {
+		next := end
+		if i+1 < len(frames) {
+			next = frames[i+1].pts
+		}
+		frames[i].duration = math.Max(next-frames[i].pts, 0.001)
+		targetDuration = math.Max(targetDuration, frames[i].duration)
+		if peak := int(math.Ceil(float64(frames[i].length*8) / frames[i].duration)); peak > bandwidth {
+			bandwidth = peak
+		}
+	}
+
+	var b strings.Builder
+	b.WriteString("#EXTM3U\n#EXT-X-VERSION:4\n")
+	b.WriteString(fmt.Sprintf("#EXT-X-TARGETDURATION:%d\n", int(math.Ceil(targetDuration))))
+	b.WriteString("#EXT-X-MEDIA-SEQUENCE:0\n#EXT-X-PLAYLIST-TYPE:VOD\n#EXT-X-I-FRAMES-ONLY\n")
+	for _, f := range frames {
+		b.WriteString(fmt.Sprintf("#EXTINF:%.6f,\n#EXT-X-BYTERANGE:%d@%d\n%s\n", f.duration, f.length, f.offset, f.uri))
+	}
+	b.WriteString("#EXT-X-ENDLIST\n")
+	return b.String(), bandwidth
+}
+
+// masterPlaylist returns the master playlist referencing all variants and their i-frame playlists.
+func masterPlaylist(variants []variant) string {
+	var b strings.Builder
+	b.WriteString("#EXTM3U\n#EXT-X-VERSION:4\n#EXT-X-INDEPENDENT-SEGMENTS\n")
+	for _, v := range variants {
+		b.WriteString(fmt.Sprintf("#EXT-X-STREAM-INF:BANDWIDTH=%d,AVERAGE-BANDWIDTH=%d", v.Bandwidth, v.AverageBandwidth))
+		if v.Height != 0 {
+			b.WriteString(fmt.Sprintf(",RESOLUTION=%dx%d", v.Width, v.Height))
+		}
+		if v.Codecs != "" {
+			b.WriteString(fmt.Sprintf(",CODECS=\"%s\"", v.Codecs))
+		}
+		b.WriteString("\n" + v.Playlist + "\n")
+	}
+	for _, v := range variants {
+		if v.IFramePlaylist == "" {
+			continue
+		}
+		b.WriteString(fmt.Sprintf("#EXT-X-I-FRAME-STREAM-INF:BANDWIDTH=%d,RESOLUTION=%dx%d", v.IFrameBandwidth, v.Width, v.Height))
+		if videoCodec := strings.Split(v.Codecs, ",")[0]; strings.HasPrefix(videoCodec, "avc1") {
+			b.WriteString(fmt.Sprintf(",CODECS=\"%s\"", videoCodec))
+		}
+		b.WriteString(fmt.Sprintf(",URI=\"%s\"\n", v.IFramePlaylist))
+	}
+	return b.String()
+}
+
+// writeFileAtomic writes data to a temporary file and renames it to path,
+// so the edge never serves partially written playlists.
+func writeFileAtomic(path string, data []byte) error {
+	tmp := path + ".tmp"
+	if err := os.WriteFile(tmp, data, 0o644); err != nil {
+		return err
+	}
+	return os.Rename(tmp, path)
+}
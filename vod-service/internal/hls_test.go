@@ -0,0 +1,137 @@
+package internal
+
+import (
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func TestReadMediaPlaylist(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "playlist.m3u8")
+	playlist := "#EXTM3U\n#EXT-X-TARGETDURATION:4\n#EXTINF:4.000000,\nsegment0.ts\n#EXTINF:2.5,\nsegment1.ts\n#EXT-X-ENDLIST\n"
+	if err := os.WriteFile(path, []byte(playlist), 0o644); err != nil {
+		t.Fatal(err)
+	}
+	segments, err := readMediaPlaylist(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := []mediaSegment{{uri: "segment0.ts", duration: 4}, {uri: "segment1.ts", duration: 2.5}}
+	if len(segments) != len(want) || segments[0] != want[0] || segments[1] != want[1] {
+		t.Errorf("expected %v, got %v", want, segments)
+	}
+}
+
+func TestCodecs(t *testing.T) {
+	tests := []struct {
+		name, probe, want string
+	}{
+		{"h264 and aac", `{"streams": [{"codec_type": "video", "codec_name": "h264", "profile": "High", "level": 31},
+			{"codec_type": "audio", "codec_name": "aac", "profile": "LC"}]}`, "avc1.64001f,mp4a.40.2"},
+		{"hevc", `{"streams": [{"codec_type": "video", "codec_name": "hevc", "profile": "Main", "level": 120}]}`, "hvc1.1.6.L120.B0"},
+		{"10 bit av1", `{"streams": [{"codec_type": "video", "codec_name": "av1", "profile": "Main", "level": 8, "pix_fmt": "yuv420p10le"}]}`, "av01.0.08M.10"},
+		{"audio only", `{"streams": [{"codec_type": "audio", "codec_name": "aac", "profile": "HE-AAC"}]}`, "mp4a.40.5"},
+		{"unknown profile", `{"streams": [{"codec_type": "video", "codec_name": "h264", "profile": "High 4:4:4"}]}`, ""},
+		{"unknown codec", `{"streams": [{"codec_type": "audio", "codec_name": "opus"}]}`, ""},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			var p probeResult
+			if err := json.Unmarshal([]byte(test.probe), &p); err != nil {
+				t.Fatal(err)
+			}
+			if codecs := p.codecs(); codecs != test.want {
+				t.Errorf("expected %q, got %q", test.want, codecs)
+			}
+		})
+	}
+}
+
+// packets of two segments as printed by ffprobe -show_entries packet=pts_time,pos,flags
+const (
+	segment0Packets = `{"packets": [
+		{"pts_time": "10.000000", "pos": "564", "flags": "K__"},
+		{"pts_time": "10.040000", "pos": "40000", "flags": "___"},
+		{"pts_time": "11.000000", "pos": "N/A", "flags": "___"},
+		{"pts_time": "11.960000", "pos": "80000", "flags": "___"}
+	]}`
+	segment1Packets = `{"packets": [
+		{"pts_time": "12.000000", "pos": "376", "flags": "K_"},
+		{"pts_time": "12.040000", "pos": "30376", "flags": "__"},
+		{"pts_time": "13.000000", "pos": "60000", "flags": "__"}
+	]}`
+)
+
+func TestSegmentIFrames(t *testing.T) {
+	var packets packetProbe
+	if err := json.Unmarshal([]byte(segment0Packets), &packets); err != nil {
+		t.Fatal(err)
+	}
+	frames, end := segmentIFrames("segment0.ts", packets, 100000)
+	// the keyframe ends where the next packet starts, packets without position are skipped
+	want := iFrame{uri: "segment0.ts", offset: 564, length: 39436, pts: 10}
+	if len(frames) != 1 || frames[0] != want {
+		t.Errorf("expected %v, got %v", want, frames)
+	}
+	if end != 11.96 {
+		t.Errorf("expected end at last packet, got %f", end)
+	}
+
+	// a keyframe without following packet lasts until the end of the segment
+	packets.Packets = packets.Packets[:1]
+	if frames, _ = segmentIFrames("segment0.ts", packets, 100000); frames[0].length != 100000-564 {
+		t.Errorf("expected keyframe until the end of the segment, got %d", frames[0].length)
+	}
+}
+
+func TestIFramePlaylist(t *testing.T) {
+	var frames []iFrame
+	end := 0.0
+	for i, probe := range []string{segment0Packets, segment1Packets} {
+		var packets packetProbe
+		if err := json.Unmarshal([]byte(probe), &packets); err != nil {
+			t.Fatal(err)
+		}
+		segmentFrames, segmentEnd := segmentIFrames([]string{"segment0.ts", "segment1.ts"}[i], packets, 100000)
+		frames = append(frames, segmentFrames...)
+		if segmentEnd > end {
+			end = segmentEnd
+		}
+	}
+	playlist, bandwidth := iFramePlaylist(frames, end)
+	want := "#EXTM3U\n#EXT-X-VERSION:4\n#EXT-X-TARGETDURATION:2\n#EXT-X-MEDIA-SEQUENCE:0\n#EXT-X-PLAYLIST-TYPE:VOD\n#EXT-X-I-FRAMES-ONLY\n" +
+		"#EXTINF:2.000000,\n#EXT-X-BYTERANGE:39436@564\nsegment0.ts\n" +
+		"#EXTINF:1.000000,\n#EXT-X-BYTERANGE:30000@376\nsegment1.ts\n" +
+		"#EXT-X-ENDLIST\n"
+	if playlist != want {
+		t.Errorf("expected\n%s\ngot\n%s", want, playlist)
+	}
+	// 30000 bytes in one second peak over 39436 bytes in two
+	if bandwidth != 240000 {
+		t.Errorf("expected peak bandwidth of 240000, got %d", bandwidth)
+	}
+}
+
+func TestMasterPlaylist(t *testing.T) {
+	variants := []variant{
+		{Name: "source", Playlist: "source/playlist.m3u8", IFramePlaylist: "source/iframes.m3u8", Width: 1920, Height: 1080,
+			Codecs: "avc1.64002a,mp4a.40.2", Bandwidth: 6000000, AverageBandwidth: 4500000, IFrameBandwidth: 900000},
+		{Name: "hevc", Playlist: "hevc/playlist.m3u8", IFramePlaylist: "hevc/iframes.m3u8", Width: 1920, Height: 1080,
+			Codecs: "hvc1.1.6.L120.B0,mp4a.40.2", Bandwidth: 3000000, AverageBandwidth: 2200000, IFrameBandwidth: 500000},
+		{Name: "audio", Playlist: "audio/playlist.m3u8", Bandwidth: 70000, AverageBandwidth: 64000},
+	}
+	want := "#EXTM3U\n#EXT-X-VERSION:4\n#EXT-X-INDEPENDENT-SEGMENTS\n" +
+		"#EXT-X-STREAM-INF:BANDWIDTH=6000000,AVERAGE-BANDWIDTH=4500000,RESOLUTION=1920x1080,CODECS=\"avc1.64002a,mp4a.40.2\"\nsource/playlist.m3u8\n" +
+		"#EXT-X-STREAM-INF:BANDWIDTH=3000000,AVERAGE-BANDWIDTH=2200000,RESOLUTION=1920x1080,CODECS=\"hvc1.1.6.L120.B0,mp4a.40.2\"\nhevc/playlist.m3u8\n" +
+		"#EXT-X-STREAM-INF:BANDWIDTH=70000,AVERAGE-BANDWIDTH=64000\naudio/playlist.m3u8\n" +
+		"#EXT-X-I-FRAME-STREAM-INF:BANDWIDTH=900000,RESOLUTION=1920x1080,CODECS=\"avc1.64002a\",URI=\"source/iframes.m3u8\"\n" +
+		"#EXT-X-I-FRAME-STREAM-INF:BANDWIDTH=500000,RESOLUTION=1920x1080,URI=\"hevc/iframes.m3u8\"\n"
+	if playlist := masterPlaylist(variants); playlist != want {
+		t.Errorf("expected\n%s\ngot\n%s", want, playlist)
+	}
+	if playlist := masterPlaylist(variants[2:]); strings.Contains(playlist, "RESOLUTION") || strings.Contains(playlist, "I-FRAME") {
+		t.Errorf("expected audio only playlist without resolution and i-frames, got\n%s", playlist)
+	}
+}
@@ -0,0 +1,83 @@
+package internal
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// rendition is one quality of the adaptive bitrate ladder a VoD is packaged in.
+type rendition struct {
+	Name         string // directory of the rendition inside the package, e.g. 720p
+	Height       int    // 0 for audio only renditions
+	VideoBitrate int    // kbit/s
+	AudioBitrate int    // kbit/s
+}
+
+// sourceRendition is the name of the rendition that contains the uploaded file without re-encoding.
+const sourceRendition = "source"
+
+// defaultLadder is used if no LADDER is configured. Renditions with a height of at least the
+// height of the upload are skipped, the source rendition covers them.
+var defaultLadder = []rendition{
+	{Name: "720p", Height: 720, VideoBitrate: 2500, AudioBitrate: 128},
+	{Name: "480p", Height: 480, VideoBitrate: 1000, AudioBitrate: 96},
+	{Name: "360p", Height: 360, VideoBitrate: 600, AudioBitrate: 96},
+	{Name: "audio", AudioBitrate: 64},
+}
+
+// parseLadder parses a ladder in the format name:height:videoBitrate:audioBitrate,...
+// e.g. "720p:720:2500:128,audio:0:0:64".
+func parseLadder(s string) ([]rendition, error) {
+	var ladder []rendition
+	for _, r := range strings.Split(s, ",") {
+		pts := strings.Split(strings.TrimSpace(r), ":")
+		if len(pts) != 4 {
+			return nil, fmt.Errorf("invalid rendition %q, expected name:height:videoBitrate:audioBitrate", r)
+		}
+		if pts[0] == "" || pts[0] == sourceRendition || fileNameIllegal.MatchString(pts[0]) {
+			return nil, fmt.Errorf("invalid rendition name %q", pts[0])
+		}
+		values := make([]int, 3)
+		for i, v := range pts[1:] {
+			parsed, err := strconv.Atoi(v)
+			if err != nil || parsed < 0 {
+				return nil, fmt.Errorf("invalid value %q in rendition %q", v, r)
+			}
+			values[i] = parsed
+		}
+		ladder = append(ladder, rendition{Name: pts[0], Height: values[0], VideoBitrate: values[1], AudioBitrate: values[2]})
+	}
+	return ladder, nil
+}
+
+// selectRenditions returns the renditions of the ladder that are encoded for an upload of sourceHeight.
+// Video renditions with at least the height of the upload are skipped, the source rendition covers them.
+func selectRenditions(ladder []rendition, sourceHeight int) []rendition {
+	var selected []rendition
+	for _, r := range ladder {
+		if r.Height == 0 || r.Height < sourceHeight {
+			selected = append(selected, r)
+		}
+	}
+	return selected
+}
+
+// args returns the ffmpeg options that encode a rendition of the input.
+// Keyframes are forced every 2 seconds, so all video renditions share segment boundaries
+// and the i-frame playlists allow seeking with this granularity.
+func (r rendition) args() []string {
+	if r.Height == 0 {
+		return []string{"-map", "0:a:0", "-vn", "-c:a", "aac", "-b:a", fmt.Sprintf("%dk", r.AudioBitrate), "-ac", "2"}
+	}
+	return []string{
+		"-map", "0:v:0", "-map", "0:a:0?",
+		"-c:v", "libx264", "-preset", "veryfast", "-profile:v", "main", "-level", "4.0",
+		"-vf", fmt.Sprintf("scale=-2:%d", r.Height),
+		"-b:v", fmt.Sprintf("%dk", r.VideoBitrate),
+		"-maxrate", fmt.Sprintf("%dk", r.VideoBitrate),
+		"-bufsize", fmt.Sprintf("%dk", r.VideoBitrate*2),
+		"-force_key_frames", "expr:gte(t,n_forced*2)", "-sc_threshold", "0",
+		"-c:a", "aac", "-b:a", fmt.Sprintf("%dk", r.AudioBitrate), "-ac", "2",
+	}
+}
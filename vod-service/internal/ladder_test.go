@@ -0,0 +1,45 @@
+package internal
+
+import "testing"
+
+func TestParseLadder(t *testing.T) {
+	ladder, err := parseLadder("720p:720:2500:128, audio:0:0:64")
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := []rendition{{Name: "720p", Height: 720, VideoBitrate: 2500, AudioBitrate: 128}, {Name: "audio", AudioBitrate: 64}}
+	if len(ladder) != len(want) || ladder[0] != want[0] || ladder[1] != want[1] {
+		t.Errorf("expected %v, got %v", want, ladder)
+	}
+	for _, invalid := range []string{"720p:720:2500", "source:720:2500:128", "../720p:720:2500:128", "720p:-720:2500:128", "720p:720:fast:128"} {
+		if _, err = parseLadder(invalid); err == nil {
+			t.Errorf("expected error for %q", invalid)
+		}
+	}
+}
+
+func TestSelectRenditions(t *testing.T) {
+	tests := []struct {
+		name         string
+		sourceHeight int
+		want         []string
+	}{
+		{"full hd upload", 1080, []string{"720p", "480p", "360p", "audio"}},
+		{"720p upload", 720, []string{"480p", "360p", "audio"}},
+		{"tiny upload", 240, []string{"audio"}},
+		{"audio upload", 0, []string{"audio"}},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			selected := selectRenditions(defaultLadder, test.sourceHeight)
+			if len(selected) != len(test.want) {
+				t.Fatalf("expected %v, got %v", test.want, selected)
+			}
+			for i, name := range test.want {
+				if selected[i].Name != name {
+					t.Errorf("expected %v, got %v", test.want, selected)
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,60 @@
+package internal
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math"
+	"os/exec"
+	"strconv"
+)
+
+// loudnorm targets of EBU R128, the same the workers normalise VoDs to (see worker/loudnorm).
+// The vod-service is built without the worker module, so it keeps its own parser.
+const loudnormTargets = "loudnorm=I=-23:TP=-1:LRA=11"
+
+// loudness is the measurement of the first pass of ffmpeg's loudnorm filter.
+type loudness struct {
+	InputI       string `json:"input_i"`
+	InputTP      string `json:"input_tp"`
+	InputLRA     string `json:"input_lra"`
+	InputThresh  string `json:"input_thresh"`
+	TargetOffset string `json:"target_offset"`
+}
+
+// measureLoudness runs the first pass of the loudness normalisation on the first audio stream of file.
+func measureLoudness(file string) (loudness, error) {
+	output, err := exec.Command("ffmpeg", "-hide_banner", "-nostats", "-i", file,
+		"-map", "0:a:0", "-af", loudnormTargets+":print_format=json", "-f", "null", "-").CombinedOutput()
+	if err != nil {
+		return loudness{}, fmt.Errorf("measure loudness of %s: %w", file, err)
+	}
+	return parseLoudness(output)
+}
+
+// parseLoudness parses the measurement loudnorm prints as last JSON object of the log of ffmpeg.
+func parseLoudness(output []byte) (loudness, error) {
+	var l loudness
+	start, end := bytes.LastIndexByte(output, '{'), bytes.LastIndexByte(output, '}')
+	if start == -1 || end < start {
+		return l, errors.New("no loudness measurement in output of ffmpeg")
+	}
+	if err := json.Unmarshal(output[start:end+1], &l); err != nil {
+		return l, err
+	}
+	// silent files measure -inf, they can't be normalised
+	if i, err := strconv.ParseFloat(l.InputI, 64); err != nil || math.IsInf(i, 0) {
+		return l, fmt.Errorf("can't normalise loudness of %s LUFS", l.InputI)
+	}
+	return l, nil
+}
+
+// args returns the ffmpeg options that normalise the audio of a rendition with the second pass of loudnorm.
+func (l loudness) args() []string {
+	return []string{
+		"-af", fmt.Sprintf("%s:measured_I=%s:measured_TP=%s:measured_LRA=%s:measured_thresh=%s:offset=%s:linear=true",
+			loudnormTargets, l.InputI, l.InputTP, l.InputLRA, l.InputThresh, l.TargetOffset),
+		"-ar", "48000", // loudnorm resamples to 192kHz
+	}
+}
@@ -1,21 +1,29 @@
 package internal
 
 import (
+	"encoding/json"
+	"errors"
 	"fmt"
 	"io"
+	"io/fs"
 	"net/http"
 	"os"
 	"os/exec"
+	"path/filepath"
 	"regexp"
+	"sort"
 	"strings"
+	"sync"
 )
 
 type config struct {
 	outputDir string
+	ladder    []rendition
 }
 
 type App struct {
 	config config
+	locks  sync.Map // *sync.Mutex by package name
 }
 
 func NewApp() *App {
@@ -26,7 +34,16 @@ func NewApp() *App {
 	if !strings.HasSuffix(outputDir, "/") {
 		outputDir += "/"
 	}
-	return &App{config: config{outputDir: outputDir}}
+	ladder := defaultLadder
+	if os.Getenv("LADDER") != "" {
+		var err error
+		ladder, err = parseLadder(os.Getenv("LADDER"))
+		if err != nil {
+			logger.Error("Invalid LADDER, using default ladder.", "err", err)
+			ladder = defaultLadder
+		}
+	}
+	return &App{config: config{outputDir: outputDir, ladder: ladder}}
 }
 
 func (a *App) Run() {
@@ -72,12 +89,30 @@ func (a *App) uploadHandler(w http.ResponseWriter, r *http.Request) {
 	// write this byte array to our temporary file
 	// return that we have successfully uploaded our file!
 	fmt.Fprintf(w, "Successfully Uploaded File to %s\n", tempFile.Name())
-	go a.packageFile(tempFile.Name(), handler.Filename)
+	if codec := r.FormValue("codec"); codec != "" {
+		go a.packageCodec(tempFile.Name(), r.FormValue("package"), codec)
+		return
+	}
+	// workers normalise the loudness of their VoDs already
+	go a.packageFile(tempFile.Name(), handler.Filename, r.FormValue("normalized") == "true")
 }
 
 var fileNameIllegal = regexp.MustCompile(`[^a-zA-Z0-9_\\.]+`)
 
-func (a *App) packageFile(file, name string) {
+// codecRenditions are the codecs of the additional renditions workers add to packages. They are packaged
+// as fragmented mp4, HLS doesn't allow them in MPEG-TS.
+var codecRenditions = map[string]bool{"hevc": true, "av1": true}
+
+// lockPackage serializes the packaging of a VoD and its additional renditions and returns the function that unlocks it.
+func (a *App) lockPackage(name string) func() {
+	l, _ := a.locks.LoadOrStore(name, &sync.Mutex{})
+	l.(*sync.Mutex).Lock()
+	return l.(*sync.Mutex).Unlock
+}
+
+// packageFile packages the upload file as HLS with all renditions of the ladder.
+// The loudness is normalised unless the upload is normalised already.
+func (a *App) packageFile(file, name string, normalized bool) {
 	defer func() {
 		err := os.Remove(file)
 		if err != nil {
@@ -85,32 +120,205 @@ func (a *App) packageFile(file, name string) {
 		}
 	}()
 	name = fileNameIllegal.ReplaceAllString(name, "_")
-	// override eventually existing files
-	err := os.RemoveAll(a.config.outputDir + name)
+	defer a.lockPackage(name)()
+	dir := filepath.Join(a.config.outputDir, name)
+	// override eventually existing files, the renditions of other codecs are kept until workers replace them
+	codecVariants, err := removeH264Renditions(dir)
 	if err != nil {
 		logger.Error("Error on removing files", "err", err)
 		// try to continue anyway
 	}
-	err = os.MkdirAll(a.config.outputDir+name, os.ModePerm)
+	err = os.MkdirAll(dir, os.ModePerm)
 	if err != nil {
 		logger.Error("Error on creating directories", "err", err)
 		return
 	}
-	c := exec.Command("ffmpeg",
-		strings.Split(
-			"-i "+file+
-				" -c copy "+
-				"-f hls "+
-				"-hls_time 8 "+
-				"-hls_playlist_type vod "+
-				"-hls_flags independent_segments "+
-				"-hls_segment_type mpegts "+
-				"-hls_segment_filename "+a.config.outputDir+name+"/"+"segment%04d.ts "+
-				a.config.outputDir+name+"/"+"playlist.m3u8", " ")...)
-	c.Stdout = os.Stdout
-	c.Stderr = os.Stderr
-	err = c.Run()
+	source, err := probe(file)
 	if err != nil {
-		fmt.Println(err)
+		logger.Error("Error probing upload", "err", err)
+		return
+	}
+
+	var audioArgs []string
+	if !normalized {
+		if l, err := measureLoudness(file); err != nil {
+			logger.Warn("Error measuring loudness, not normalising it", "err", err, "name", name)
+		} else {
+			audioArgs = l.args()
+		}
+	}
+
+	// the source rendition keeps the video of the upload as is, all others are encoded from it.
+	sourceArgs := []string{"-c", "copy"}
+	if audioArgs != nil {
+		sourceArgs = append([]string{"-map", "0:v:0?", "-map", "0:a:0", "-c:v", "copy", "-c:a", "aac", "-b:a", "128k"}, audioArgs...)
+	}
+	if err = a.packageRendition(file, dir, sourceRendition, sourceArgs, "mpegts"); err != nil {
+		logger.Error("Error packaging source rendition", "err", err, "name", name)
+		return
+	}
+	renditions := []string{sourceRendition}
+	for _, r := range selectRenditions(a.config.ladder, source.height()) {
+		if err = a.packageRendition(file, dir, r.Name, append(r.args(), audioArgs...), "mpegts"); err != nil {
+			logger.Error("Error packaging rendition, skipping it", "err", err, "name", name, "rendition", r.Name)
+			continue
+		}
+		renditions = append(renditions, r.Name)
+	}
+
+	m := manifest{Name: name, Master: "playlist.m3u8"}
+	for _, r := range renditions {
+		v, err := describeVariant(filepath.Join(dir, r), r)
+		if err != nil {
+			logger.Error("Error describing rendition, skipping it", "err", err, "name", name, "rendition", r)
+			continue
+		}
+		if v.Height != 0 {
+			if err = writeIFramePlaylist(filepath.Join(dir, r), &v); err != nil {
+				logger.Warn("Error writing i-frame playlist", "err", err, "name", name, "rendition", r)
+			}
+		}
+		m.Renditions = append(m.Renditions, v)
+	}
+	m.Renditions = append(m.Renditions, codecVariants...)
+	sortVariants(m.Renditions)
+	if err = writePackage(dir, m); err != nil {
+		logger.Error("Error writing package", "err", err, "name", name)
+	}
+}
+
+// removeH264Renditions removes everything but the renditions of codecRenditions from the package in dir and
+// returns the variants of the kept renditions, so they stay in the master playlist.
+func removeH264Renditions(dir string) ([]variant, error) {
+	var kept []variant
+	if manifestJSON, err := os.ReadFile(filepath.Join(dir, "manifest.json")); err == nil {
+		var m manifest
+		if err = json.Unmarshal(manifestJSON, &m); err != nil {
+			logger.Warn("Error decoding manifest, dropping the renditions of other codecs", "err", err, "dir", dir)
+		}
+		for _, v := range m.Renditions {
+			if codecRenditions[v.Name] {
+				kept = append(kept, v)
+			}
+		}
+	}
+	entries, err := os.ReadDir(dir)
+	if errors.Is(err, fs.ErrNotExist) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	keep := map[string]bool{}
+	for _, v := range kept {
+		keep[v.Name] = true
+	}
+	for _, e := range entries {
+		if e.IsDir() && keep[e.Name()] {
+			continue
+		}
+		if err = os.RemoveAll(filepath.Join(dir, e.Name())); err != nil {
+			return kept, err
+		}
+	}
+	return kept, nil
+}
+
+// packageCodec adds the additional rendition in file, which the worker already encoded in codec, to the package name.
+// Only clients supporting the codec play it, so it is left out if its codecs can't be described in the master playlist.
+func (a *App) packageCodec(file, name, codec string) {
+	defer func() {
+		err := os.Remove(file)
+		if err != nil {
+			logger.Error("Error cleaning up file", "err", err)
+		}
+	}()
+	if !codecRenditions[codec] {
+		logger.Error("Unsupported codec of rendition", "codec", codec, "name", name)
+		return
+	}
+	name = fileNameIllegal.ReplaceAllString(name, "_")
+	defer a.lockPackage(name)()
+	dir := filepath.Join(a.config.outputDir, name)
+	manifestJSON, err := os.ReadFile(filepath.Join(dir, "manifest.json"))
+	if err != nil {
+		logger.Error("Error reading manifest, the VoD isn't packaged", "err", err, "name", name)
+		return
+	}
+	var m manifest
+	if err = json.Unmarshal(manifestJSON, &m); err != nil {
+		logger.Error("Error decoding manifest", "err", err, "name", name)
+		return
+	}
+	if err = a.packageRendition(file, dir, codec, []string{"-map", "0", "-c", "copy"}, "fmp4"); err != nil {
+		logger.Error("Error packaging rendition", "err", err, "name", name, "rendition", codec)
+		return
+	}
+	v, err := describeVariant(filepath.Join(dir, codec), codec)
+	if err != nil {
+		logger.Error("Error describing rendition", "err", err, "name", name, "rendition", codec)
+		return
 	}
+	if v.Codecs == "" {
+		logger.Error("Unknown codecs of rendition, not offering it", "name", name, "rendition", codec)
+		return
+	}
+	renditions := []variant{v}
+	for _, r := range m.Renditions {
+		if r.Name != codec {
+			renditions = append(renditions, r)
+		}
+	}
+	m.Renditions = renditions
+	sortVariants(m.Renditions)
+	if err = writePackage(dir, m); err != nil {
+		logger.Error("Error writing package", "err", err, "name", name)
+	}
+}
+
+// sortVariants sorts variants by quality, players start with the first variant. Audio only renditions go last.
+func sortVariants(variants []variant) {
+	sort.SliceStable(variants, func(i, j int) bool {
+		if (variants[i].Height == 0) != (variants[j].Height == 0) {
+			return variants[j].Height == 0
+		}
+		return variants[i].Bandwidth > variants[j].Bandwidth
+	})
+}
+
+// writePackage writes the manifest and master playlist of the package in dir.
+func writePackage(dir string, m manifest) error {
+	manifestJSON, err := json.MarshalIndent(m, "", "  ")
+	if err != nil {
+		return fmt.Errorf("encode manifest: %w", err)
+	}
+	if err = writeFileAtomic(filepath.Join(dir, "manifest.json"), manifestJSON); err != nil {
+		return fmt.Errorf("write manifest: %w", err)
+	}
+	// the master playlist is written last, so it never references renditions that aren't ready yet.
+	if err = writeFileAtomic(filepath.Join(dir, m.Master), []byte(masterPlaylist(m.Renditions))); err != nil {
+		return fmt.Errorf("write master playlist: %w", err)
+	}
+	return nil
+}
+
+// packageRendition encodes file with the ffmpeg options in args and packages it as HLS into dir/rendition.
+// segmentType is mpegts or fmp4.
+func (a *App) packageRendition(file, dir, rendition string, args []string, segmentType string) error {
+	out := filepath.Join(dir, rendition)
+	if err := os.MkdirAll(out, os.ModePerm); err != nil {
+		return err
+	}
+	args = append([]string{"-i", file}, args...)
+	args = append(args, "-f", "hls", "-hls_time", "8", "-hls_playlist_type", "vod", "-hls_flags", "independent_segments")
+	if segmentType == "fmp4" {
+		args = append(args, "-hls_segment_type", "fmp4", "-hls_fmp4_init_filename", "init.mp4",
+			"-hls_segment_filename", filepath.Join(out, "segment%04d.m4s"))
+	} else {
+		args = append(args, "-hls_segment_type", "mpegts", "-hls_segment_filename", filepath.Join(out, "segment%04d.ts"))
+	}
+	c := exec.Command("ffmpeg", append(args, filepath.Join(out, "playlist.m3u8"))...)
+	c.Stdout = os.Stdout
+	c.Stderr = os.Stderr
+	return c.Run()
 }
@@ -0,0 +1,40 @@
+package internal
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestRemoveH264Renditions(t *testing.T) {
+	dir := filepath.Join(t.TempDir(), "eidi")
+	hevc := variant{Name: "hevc", Playlist: "hevc/playlist.m3u8", Height: 1080, Codecs: "hvc1.1.6.L120.B0", Bandwidth: 3000000}
+	for _, d := range []string{"source", "720p", "hevc"} {
+		if err := os.MkdirAll(filepath.Join(dir, d), os.ModePerm); err != nil {
+			t.Fatal(err)
+		}
+	}
+	m := manifest{Name: "eidi", Master: "playlist.m3u8", Renditions: []variant{{Name: "source", Height: 1080}, {Name: "720p", Height: 720}, hevc}}
+	if err := writePackage(dir, m); err != nil {
+		t.Fatal(err)
+	}
+
+	kept, err := removeH264Renditions(dir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(kept) != 1 || kept[0] != hevc {
+		t.Errorf("expected the hevc rendition to be kept, got %v", kept)
+	}
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(entries) != 1 || entries[0].Name() != "hevc" {
+		t.Errorf("expected only the hevc rendition to be left, got %v", entries)
+	}
+
+	if kept, err = removeH264Renditions(filepath.Join(dir, "missing")); kept != nil || err != nil {
+		t.Errorf("expected nothing for a new package, got %v (%v)", kept, err)
+	}
+}
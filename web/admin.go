@@ -100,7 +100,7 @@ func (r mainRoutes) AdminPage(c *gin.Context) {
 			Semesters:           semesters,
 			CurY:                y,
 			CurT:                t,
-			Tokens:              TokensData{Tokens: tokens, RtmpProxyURL: tools.Cfg.RtmpProxyURL, User: tumLiveContext.User},
+			Tokens:              TokensData{Tokens: tokens, RtmpProxyURL: tools.Cfg.RtmpProxyURL, WhipURL: tools.Cfg.WhipURL, User: tumLiveContext.User},
 			InfoPages:           infopages,
 			ServerNotifications: serverNotifications,
 			Notifications:       notifications,
@@ -153,6 +153,7 @@ type WorkersData struct {
 type TokensData struct {
 	Tokens       []dao.AllTokensDto
 	RtmpProxyURL string
+	WhipURL      string
 	User         *model.User
 }
 
@@ -164,11 +165,34 @@ func (r mainRoutes) LectureCutPage(c *gin.Context) {
 		return
 	}
 	tumLiveContext := foundContext.(tools.TUMLiveContext)
+	// the reference video is the original, edits are made relative to it
+	if err := tools.SetSignedOriginalPlaylists(tumLiveContext.Stream, tumLiveContext.User, false); err != nil {
+		logger.Warn("Can't sign playlists", "err", err)
+	}
 	if err := templateExecutor.ExecuteTemplate(c.Writer, "lecture-cut.gohtml", tumLiveContext); err != nil {
 		logger.Error("Error executing template lecture-cut.gohtml", "err", err)
 	}
 }
 
+// BrowserPublishPage lets lecturers self-stream from their browser through the WHIP endpoint of the workers.
+func (r mainRoutes) BrowserPublishPage(c *gin.Context) {
+	tumLiveContext := c.MustGet("TUMLiveContext").(tools.TUMLiveContext)
+	indexData := NewIndexData()
+	indexData.TUMLiveContext = tumLiveContext
+	err := templateExecutor.ExecuteTemplate(c.Writer, "browser-publish.gohtml", BrowserPublishPageData{
+		IndexData: indexData,
+		WhipURL:   tools.Cfg.WhipURL,
+	})
+	if err != nil {
+		logger.Error("Error executing template browser-publish.gohtml", "err", err)
+	}
+}
+
+type BrowserPublishPageData struct {
+	IndexData IndexData
+	WhipURL   string
+}
+
 func (r mainRoutes) LectureUnitsPage(c *gin.Context) {
 	foundContext, exists := c.Get("TUMLiveContext")
 	if !exists {
@@ -270,9 +294,10 @@ func (r mainRoutes) EditCoursePage(c *gin.Context) {
 		CurY:      tumLiveContext.Course.Year,
 		CurT:      tumLiveContext.Course.TeachingTerm,
 		EditCourseData: EditCourseData{
-			IndexData:    indexData,
-			IngestBase:   tools.Cfg.IngestBase,
-			LectureHalls: lectureHalls,
+			IndexData:        indexData,
+			IngestBase:       tools.Cfg.IngestBase,
+			LectureHalls:     lectureHalls,
+			EncodingProfiles: tools.Cfg.EncodingProfileNames(),
 		},
 	})
 	if err != nil {
@@ -309,6 +334,12 @@ func (r mainRoutes) UpdateCourse(c *gin.Context) {
 	enChatMod := c.PostForm("enChatMod") == "on"
 	livePrivate := c.PostForm("livePrivate") == "on"
 	vodPrivate := c.PostForm("vodPrivate") == "on"
+	lowLatency := c.PostForm("lowLatency") == "on"
+	encodingProfile := c.PostForm("encodingProfile")
+	if _, ok := tools.Cfg.EncodingProfiles[encodingProfile]; encodingProfile != "" && !ok {
+		c.AbortWithStatusJSON(http.StatusBadRequest, gin.H{"msg": "unknown encoding profile"})
+		return
+	}
 	tumLiveContext.Course.Visibility = access
 	tumLiveContext.Course.VODEnabled = enVOD
 	tumLiveContext.Course.DownloadsEnabled = enDL
@@ -317,6 +348,8 @@ func (r mainRoutes) UpdateCourse(c *gin.Context) {
 	tumLiveContext.Course.ModeratedChatEnabled = enChatMod
 	tumLiveContext.Course.LivePrivate = livePrivate
 	tumLiveContext.Course.VodPrivate = vodPrivate
+	tumLiveContext.Course.LowLatency = lowLatency
+	tumLiveContext.Course.EncodingProfile = encodingProfile
 	r.CoursesDao.UpdateCourseMetadata(context.Background(), *tumLiveContext.Course)
 	c.Redirect(http.StatusFound, fmt.Sprintf("/admin/course/%v", tumLiveContext.Course.ID))
 }
@@ -360,9 +393,10 @@ func (apd AdminPageData) UsersAsJson() string {
 }
 
 type EditCourseData struct {
-	IndexData    IndexData
-	IngestBase   string
-	LectureHalls []model.LectureHall
+	IndexData        IndexData
+	IngestBase       string
+	LectureHalls     []model.LectureHall
+	EncodingProfiles []string // names of the profiles the course can select
 }
 
 type LectureUnitsPageData struct {
@@ -0,0 +1,76 @@
+package web
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+
+	"github.com/TUM-Dev/gocast/api"
+	"github.com/TUM-Dev/gocast/model"
+	"github.com/TUM-Dev/gocast/tools"
+	"github.com/gin-gonic/gin"
+	"gorm.io/gorm"
+)
+
+// ClipPage plays a clip from the cut file if the user may download it, otherwise from the sub-playlist of the VoD.
+func (r mainRoutes) ClipPage(c *gin.Context) {
+	indexData := NewIndexDataWithContext(c)
+	user := indexData.TUMLiveContext.User
+
+	clip, err := r.ClipDao.GetBySlug(c, c.Param("slug"))
+	if err != nil {
+		if !errors.Is(err, gorm.ErrRecordNotFound) {
+			logger.Error("Can't get clip", "err", err)
+		}
+		tools.RenderErrorPage(c, http.StatusNotFound, tools.PageNotFoundErrMsg)
+		return
+	}
+	stream, err := r.StreamsDao.GetStreamByID(c, fmt.Sprintf("%d", clip.StreamID))
+	if err != nil {
+		tools.RenderErrorPage(c, http.StatusNotFound, tools.StreamNotFoundErrMsg)
+		return
+	}
+	course, err := r.CoursesDao.GetCourseById(c, stream.CourseID)
+	if err != nil {
+		tools.RenderErrorPage(c, http.StatusNotFound, tools.CourseNotFoundErrMsg)
+		return
+	}
+	if !user.IsEligibleToWatchStream(course, stream) {
+		if user == nil {
+			c.Redirect(http.StatusFound, "/login?return="+url.QueryEscape(c.Request.RequestURI))
+			return
+		}
+		tools.RenderErrorPage(c, http.StatusForbidden, tools.ForbiddenStreamAccess)
+		return
+	}
+
+	data := ClipPageData{
+		IndexData: indexData,
+		Clip:      clip,
+		Name:      clip.GetName(stream),
+		WatchUrl:  fmt.Sprintf("%s?t=%d", course.GetStreamUrl(stream), clip.Start),
+		Source:    api.ClipFile(clip, course, user),
+		MimeType:  "video/mp4",
+	}
+	if data.Source == "" {
+		data.MimeType = "application/x-mpegURL"
+		if data.Source, err = api.ClipPlaylist(clip, stream, user); err != nil {
+			logger.Warn("Can't sign clip playlist", "err", err)
+		}
+	}
+	data.IndexData.TUMLiveContext.Course = &course
+	data.IndexData.TUMLiveContext.Stream = &stream
+	if err = templateExecutor.ExecuteTemplate(c.Writer, "clip.gohtml", data); err != nil {
+		logger.Error("Can't execute template clip.gohtml", "err", err)
+	}
+}
+
+type ClipPageData struct {
+	IndexData IndexData
+	Clip      model.Clip
+	Name      string
+	WatchUrl  string // the full lecture at the start of the clip
+	Source    string
+	MimeType  string
+}
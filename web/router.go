@@ -105,6 +105,7 @@ func configMainRoute(router *gin.Engine) {
 	atLeastLecturerGroup.Use(tools.AtLeastLecturer)
 	atLeastLecturerGroup.GET("/admin", routes.AdminPage)
 	atLeastLecturerGroup.GET("/admin/create-course", routes.AdminPage)
+	atLeastLecturerGroup.GET("/admin/publish", routes.BrowserPublishPage)
 
 	// info-pages (Make sure the IDs are correct!)
 	router.GET("/privacy", routes.InfoPage(1, "privacy"))
@@ -160,6 +161,7 @@ func configMainRoute(router *gin.Engine) {
 	router.GET("/healthcheck", routes.HealthCheck)
 	router.GET("/jwtPubKey", routes.JWTPubKey)
 
+	router.GET("/c/:slug", routes.ClipPage)
 	router.GET("/:shortLink", routes.HighlightPage)
 	router.GET("/edit-course", routes.editCourseByTokenPage)
 	router.GET("/edit-course/opt-out", routes.optOutPage)
@@ -161,6 +161,8 @@ export const initPlayer = function (
             reloadSourceOnError: true,
             vhs: {
                 overrideNative: !videojs.browser.IS_SAFARI,
+                // follow partial segments of courses streaming with low latency, plain HLS is unaffected
+                experimentalLLHLS: true,
             },
             nativeVideoTracks: false,
             nativeAudioTracks: false,
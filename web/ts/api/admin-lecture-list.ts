@@ -180,6 +180,7 @@ export interface Lecture {
     seriesIdentifier: string;
     start: string;
     streamKey: string;
+    srtIngest: string;
     transcodingProgresses: TranscodingProgress[];
     videoSections: VideoSection[];
 
@@ -1,5 +1,5 @@
 import { StatusCodes } from "http-status-codes";
-import { postData, showMessage } from "./global";
+import { copyToClipboard, Delete, postData, showMessage } from "./global";
 
 type UserStream = {
     streamID: number;
@@ -49,3 +49,62 @@ export class WatchedTracker {
         return this.streams?.findIndex((s) => !s.watched && s.recording) === -1;
     }
 }
+
+/**
+ * Alpine component of the podcast subscription of a course. Podcast apps don't log in, so the feed urls carry
+ * the feed token of the user. Revoking it stops all feeds subscribed with it and issues a new one.
+ */
+export function podcastFeeds(year: number, term: string, slug: string) {
+    const feedUrl = (kind: string, token: string) =>
+        `${window.location.origin}/api/podcast/${year}/${term}/${slug}/${kind}.xml?token=${encodeURIComponent(token)}`;
+
+    return {
+        open: false,
+        token: "",
+        copied: "",
+
+        get audioUrl(): string {
+            return this.token === "" ? "" : feedUrl("audio", this.token);
+        },
+
+        get videoUrl(): string {
+            return this.token === "" ? "" : feedUrl("video", this.token);
+        },
+
+        async toggle() {
+            this.open = !this.open;
+            if (this.open && this.token === "") {
+                await this.loadToken();
+            }
+        },
+
+        async loadToken() {
+            const response = await postData("/api/podcast/token");
+            if (response.status !== StatusCodes.OK) {
+                showMessage("There was an error getting your feed token.");
+                return;
+            }
+            this.token = (await response.json()).token;
+        },
+
+        async copy(kind: "audio" | "video") {
+            if (await copyToClipboard(kind === "audio" ? this.audioUrl : this.videoUrl)) {
+                this.copied = kind;
+                setTimeout(() => (this.copied = ""), 1000);
+            }
+        },
+
+        async revoke() {
+            if (!confirm("Feeds you subscribed to in podcast apps will stop working. Do you want to continue?")) {
+                return;
+            }
+            const response = await Delete("/api/podcast/token");
+            if (response.status !== StatusCodes.OK) {
+                showMessage("There was an error revoking your feed token.");
+                return;
+            }
+            this.token = "";
+            await this.loadToken();
+        },
+    };
+}
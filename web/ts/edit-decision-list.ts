@@ -0,0 +1,109 @@
+import { StatusCodes } from "http-status-codes";
+import { postData, putData } from "./global";
+import { Time } from "./utilities/time";
+
+// StreamEdit is a range of the edit decision list of a stream in milliseconds, see model.StreamEdit.
+interface StreamEdit {
+    start: number;
+    end: number;
+    discard: boolean;
+}
+
+interface StreamEditsResponse {
+    edits: StreamEdit[];
+    renderedFile: number;
+}
+
+interface editDecisionList {
+    edits: StreamEdit[];
+    renderedFile: number;
+    renderQueued: boolean;
+    saved: boolean;
+    error: string;
+
+    init(): void;
+    add(discard: boolean): void;
+    remove(i: number): void;
+    setStart(i: number): void;
+    setEnd(i: number): void;
+    format(ms: number): string;
+    parse(i: number, field: "start" | "end", value: string): void;
+    save(): Promise<void>;
+    render(): Promise<void>;
+}
+
+/**
+ * Alpine component of the editor of the edit decision list of a stream. The list is applied when the VoD is
+ * played and can be rendered into a new file, the original VoD is kept.
+ * @param streamID the edited stream
+ * @param currentTime returns the position of the reference player in seconds
+ */
+export function editDecisionList(streamID: number, currentTime: () => number): editDecisionList {
+    const url = `/api/stream/${streamID}/edits`;
+    return {
+        edits: [],
+        renderedFile: 0,
+        renderQueued: false,
+        saved: true,
+        error: "",
+
+        init() {
+            fetch(url)
+                .then((r) => r.json() as Promise<StreamEditsResponse>)
+                .then((r) => {
+                    this.edits = r.edits.map((e) => ({ start: e.start, end: e.end, discard: e.discard }));
+                    this.renderedFile = r.renderedFile;
+                });
+        },
+        add(discard: boolean) {
+            const start = Math.floor(currentTime()) * 1000;
+            this.edits.push({ start, end: start + 60 * 1000, discard });
+            this.saved = false;
+        },
+        remove(i: number) {
+            this.edits.splice(i, 1);
+            this.saved = false;
+        },
+        setStart(i: number) {
+            this.edits[i].start = Math.floor(currentTime()) * 1000;
+            this.saved = false;
+        },
+        setEnd(i: number) {
+            this.edits[i].end = Math.floor(currentTime()) * 1000;
+            this.saved = false;
+        },
+        format(ms: number): string {
+            return Time.FromSeconds(ms / 1000).toStringWithLeadingZeros();
+        },
+        parse(i: number, field: "start" | "end", value: string) {
+            const parts = value.split(":").map((p) => parseInt(p));
+            if (parts.some(isNaN)) {
+                return;
+            }
+            const seconds = parts.reduce((s, p) => s * 60 + p, 0);
+            this.edits[i][field] = seconds * 1000;
+            this.saved = false;
+        },
+        async save() {
+            const r = await putData(url, this.edits);
+            if (r.status !== StatusCodes.OK) {
+                this.error = await r.text();
+                return;
+            }
+            const res = (await r.json()) as StreamEditsResponse;
+            this.edits = res.edits.map((e) => ({ start: e.start, end: e.end, discard: e.discard }));
+            this.renderedFile = res.renderedFile;
+            this.saved = true;
+            this.error = "";
+        },
+        async render() {
+            const r = await postData(`${url}/render`);
+            if (r.status !== StatusCodes.ACCEPTED) {
+                this.error = await r.text();
+                return;
+            }
+            this.renderQueued = true;
+            this.error = "";
+        },
+    };
+}
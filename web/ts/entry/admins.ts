@@ -14,3 +14,5 @@ export * from "../notification-management";
 export * from "../audits";
 export * from "../maintenance";
 export * from "../change-set";
+export * from "../edit-decision-list";
+export * from "../whip-publisher";
@@ -2,6 +2,7 @@ import { StatusCodes } from "http-status-codes";
 
 interface maintenancePage {
     generateThumbnails(): Promise<boolean>;
+    rebuildSearchIndex(): Promise<boolean>;
 
     running: boolean;
     progress: number;
@@ -31,6 +32,11 @@ export function maintenancePage(): maintenancePage {
                 return true;
             });
         },
+        rebuildSearchIndex() {
+            return fetch("/api/maintenance/search/rebuild", { method: "POST" }).then(
+                (r) => r.status === StatusCodes.ACCEPTED,
+            );
+        },
         running: false,
         progress: 0,
         keepUpdated() {
@@ -39,6 +39,21 @@ export function loadLectureStats(endpoint: string, targetEl: string, streamID: s
     });
 }
 
+let streamHealthChart: Chart;
+
+// loadStreamHealth charts a metric (bitrate, fps, speed, dropped, dup) of the ffmpeg progress reported for a stream.
+export function loadStreamHealth(targetEl: string, streamID: string, metric: string) {
+    const canvas = <HTMLCanvasElement>document.getElementById(targetEl);
+    getAsync(`/api/stream/${streamID}/health?metric=${metric}`).then((res) => {
+        if (res.status === StatusCodes.OK) {
+            res.json().then((value) => {
+                streamHealthChart?.destroy();
+                streamHealthChart = new Chart(canvas.getContext("2d"), value);
+            });
+        }
+    });
+}
+
 export function initStatsPage() {
     const dates = ["numStudents", "vodViews", "liveViews"];
     dates.forEach((endpoint) => {
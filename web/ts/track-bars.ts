@@ -1,24 +1,34 @@
 import { VideoJsPlayer } from "video.js";
 
-const LANGUAGES = [
-    { id: "en", label: "English" },
-    { id: "de", label: "Deutsch" },
-];
+type SubtitleTrack = {
+    language: string;
+    source: string;
+    src: string;
+};
 
 export async function loadAndSetTrackbars(player: VideoJsPlayer, streamID: number) {
-    for (const language of LANGUAGES) {
-        await fetch(`/api/stream/${streamID}/subtitles/${language.id}`).then((res) => {
-            if (res.ok) {
-                window.dispatchEvent(new CustomEvent("togglesearch", { detail: { streamID: streamID } }));
-                player.addRemoteTextTrack(
-                    {
-                        src: `/api/stream/${streamID}/subtitles/${language.id}`,
-                        kind: "captions",
-                        label: language.label,
-                    },
-                    false,
-                );
-            }
-        });
+    const res = await fetch(`/api/stream/${streamID}/subtitles/tracks`);
+    if (!res.ok) {
+        return;
+    }
+    const tracks: SubtitleTrack[] = await res.json();
+    if (tracks.length > 0) {
+        window.dispatchEvent(new CustomEvent("togglesearch", { detail: { streamID: streamID } }));
+    }
+    const languageNames = new Intl.DisplayNames([navigator.language, "en"], { type: "language" });
+    for (const track of tracks) {
+        let label = languageNames.of(track.language) ?? track.language;
+        if (track.source === "translated") {
+            label += " (auto-translated)";
+        }
+        player.addRemoteTextTrack(
+            {
+                src: track.src,
+                kind: "captions",
+                srclang: track.language,
+                label: label,
+            },
+            false,
+        );
     }
 }
@@ -1,5 +1,6 @@
 import { postData } from "./global";
 import { StatusCodes } from "http-status-codes";
+import { Realtime } from "./socket";
 
 export function usePreset(cID: number, lectureHallID: number, presetID: number) {
     const streamID = (document.getElementById("streamID") as HTMLInputElement).value;
@@ -44,3 +45,42 @@ export function sendIssue(
         return response.status === StatusCodes.OK;
     });
 }
+
+type StreamIncident = {
+    id: number;
+    version: string;
+    type: string;
+    description: string;
+    startedAt: string;
+    endedAt?: string;
+};
+
+// StreamIncidents keeps the problems the workers detected in a running stream, e.g. a black screen or a muted microphone.
+export class StreamIncidents {
+    incidents: StreamIncident[] = [];
+
+    async init(streamID: number) {
+        await Realtime.get().subscribeChannel(`stream-incidents/${streamID}`, (payload) => this.handle(payload));
+    }
+
+    handle(payload: object) {
+        switch (payload["type"]) {
+            case "stream_incidents":
+                this.incidents = payload["data"];
+                break;
+            case "stream_incident": {
+                const incident: StreamIncident = payload["data"];
+                this.incidents = [incident, ...this.incidents.filter((i) => i.id !== incident.id)];
+                break;
+            }
+        }
+    }
+
+    open(): StreamIncident[] {
+        return this.incidents.filter((i) => !i.endedAt);
+    }
+
+    since(incident: StreamIncident): string {
+        return new Date(incident.startedAt).toLocaleTimeString([], { hour: "2-digit", minute: "2-digit" });
+    }
+}
@@ -0,0 +1,144 @@
+import { StatusCodes } from "http-status-codes";
+
+type PublisherState = "idle" | "connecting" | "live";
+
+interface whipPublisher {
+    token: string;
+    source: "camera" | "screen";
+    state: PublisherState;
+    error: string;
+
+    init(): void;
+    preview(): Promise<void>;
+    start(): Promise<void>;
+    stop(): Promise<void>;
+}
+
+/**
+ * Alpine component of the browser publisher. It captures the camera or the screen with the microphone and
+ * publishes them with WHIP to the workers, so lecturers can self-stream without installing OBS.
+ * @param whipURL the WHIP endpoint of the workers, see tools.Config.WhipURL
+ * @param preview the video element that shows what is published
+ */
+export function whipPublisher(whipURL: string, preview: HTMLVideoElement): whipPublisher {
+    let media: MediaStream | null = null;
+    let pc: RTCPeerConnection | null = null;
+    let session: string | null = null;
+
+    const stopMedia = () => {
+        media?.getTracks().forEach((t) => t.stop());
+        media = null;
+        preview.srcObject = null;
+    };
+
+    return {
+        token: "",
+        source: "camera",
+        state: "idle",
+        error: "",
+
+        init() {
+            // the token management page links here with the new token in the fragment, fragments aren't sent to servers
+            const token = new URLSearchParams(window.location.hash.substring(1)).get("token");
+            if (token) {
+                this.token = token;
+                history.replaceState(null, "", window.location.pathname);
+            }
+        },
+
+        async preview() {
+            stopMedia();
+            this.error = "";
+            try {
+                if (this.source === "screen") {
+                    const screen = await navigator.mediaDevices.getDisplayMedia({ video: true });
+                    const mic = await navigator.mediaDevices.getUserMedia({ audio: true });
+                    media = new MediaStream([...screen.getVideoTracks(), ...mic.getAudioTracks()]);
+                } else {
+                    media = await navigator.mediaDevices.getUserMedia({
+                        video: { width: 1280, height: 720 },
+                        audio: true,
+                    });
+                }
+                preview.srcObject = media;
+            } catch (e) {
+                this.error = `Can't access ${this.source === "screen" ? "the screen" : "the camera"}: ${e}`;
+            }
+        },
+
+        async start() {
+            if (this.token === "") {
+                this.error = "Enter your lecturer token first.";
+                return;
+            }
+            if (media === null) {
+                await this.preview();
+                if (media === null) {
+                    return;
+                }
+            }
+            this.state = "connecting";
+            this.error = "";
+            try {
+                pc = new RTCPeerConnection();
+                for (const track of media.getTracks()) {
+                    const transceiver = pc.addTransceiver(track, { direction: "sendonly", streams: [media] });
+                    // the workers record and transcode H.264
+                    if (track.kind === "video" && "setCodecPreferences" in transceiver) {
+                        const codecs = RTCRtpSender.getCapabilities("video")?.codecs ?? [];
+                        transceiver.setCodecPreferences(codecs.filter((c) => c.mimeType === "video/H264"));
+                    }
+                }
+                await pc.setLocalDescription(await pc.createOffer());
+                await iceGatheringComplete(pc);
+
+                const r = await fetch(whipURL, {
+                    method: "POST",
+                    headers: { "Authorization": `Bearer ${this.token}`, "Content-Type": "application/sdp" },
+                    body: pc.localDescription.sdp,
+                });
+                if (r.status !== StatusCodes.CREATED) {
+                    throw new Error(r.status === StatusCodes.FORBIDDEN ? "the token is invalid" : await r.text());
+                }
+                const location = r.headers.get("Location");
+                session = location ? new URL(location, whipURL).href : null;
+                await pc.setRemoteDescription({ type: "answer", sdp: await r.text() });
+                this.state = "live";
+            } catch (e) {
+                pc?.close();
+                pc = null;
+                this.state = "idle";
+                this.error = `Can't go live: ${e.message ?? e}`;
+            }
+        },
+
+        async stop() {
+            if (session !== null) {
+                await fetch(session, { method: "DELETE" }).catch(() => {});
+                session = null;
+            }
+            pc?.close();
+            pc = null;
+            stopMedia();
+            this.state = "idle";
+        },
+    };
+}
+
+// iceGatheringComplete waits for all ICE candidates, so they are part of the offer and no trickle ICE is needed.
+function iceGatheringComplete(pc: RTCPeerConnection): Promise<void> {
+    if (pc.iceGatheringState === "complete") {
+        return Promise.resolve();
+    }
+    return new Promise((resolve) => {
+        const check = () => {
+            if (pc.iceGatheringState === "complete") {
+                pc.removeEventListener("icegatheringstatechange", check);
+                resolve();
+            }
+        };
+        pc.addEventListener("icegatheringstatechange", check);
+        // don't wait forever for unreachable STUN servers
+        setTimeout(resolve, 2000);
+    });
+}
@@ -118,6 +118,9 @@ func (r mainRoutes) WatchPage(c *gin.Context) {
 
 	if _, dvr := c.GetQuery("dvr"); dvr || mode.Beta {
 		data.DVR = "?dvr"
+		if useDvrPlaylists(tumLiveContext.Stream) {
+			data.DVR = ""
+		}
 	} else {
 		data.DVR = ""
 	}
@@ -158,6 +161,23 @@ type WatchPageData struct {
 	ChatData        ChatData
 }
 
+// useDvrPlaylists replaces the live playlists of a stream with the DVR playlists kept by the workers, if there are any.
+func useDvrPlaylists(s *model.Stream) bool {
+	if !s.LiveNow || (s.DvrPlaylistUrl == "" && s.DvrPlaylistUrlPRES == "" && s.DvrPlaylistUrlCAM == "") {
+		return false
+	}
+	if s.PlaylistUrl != "" {
+		s.PlaylistUrl = s.DvrPlaylistUrl
+	}
+	if s.PlaylistUrlPRES != "" {
+		s.PlaylistUrlPRES = s.DvrPlaylistUrlPRES
+	}
+	if s.PlaylistUrlCAM != "" {
+		s.PlaylistUrlCAM = s.DvrPlaylistUrlCAM
+	}
+	return true
+}
+
 // Prepare populates the data for the watch page.
 func (d *WatchPageData) Prepare(c *gin.Context, lectureHallsDao dao.LectureHallsDao) error {
 	// todo prepare rest of data here as well
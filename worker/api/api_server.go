@@ -28,7 +28,14 @@ type server struct {
 
 // RequestCut is a gRPC endpoint for the worker to Cut a video
 func (s server) RequestCut(ctx context.Context, request *pb.CutRequest) (*pb.CutResponse, error) {
-	return nil, errors.New("not implemented")
+	if request.WorkerId != cfg.WorkerID {
+		return nil, errors.New("unauthenticated: wrong worker id")
+	}
+	if err := worker.Cut(request); err != nil {
+		log.WithError(err).Error("Error while cutting")
+		return &pb.CutResponse{Success: false, Error: err.Error()}, nil
+	}
+	return &pb.CutResponse{Success: true}, nil
 }
 
 // RequestWaveform is a gRPC endpoint for the worker to generate a waveform
@@ -67,6 +74,16 @@ func (s server) RequestStreamEnd(ctx context.Context, request *pb.EndStreamReque
 	return &pb.Status{Ok: true}, nil
 }
 
+// RequestStitch is a gRPC endpoint to stitch the recording parts of a stream that failed over between workers
+func (s server) RequestStitch(ctx context.Context, request *pb.StitchRequest) (*pb.Status, error) {
+	if request.WorkerID != cfg.WorkerID {
+		log.Info("Rejected request to stitch recording")
+		return &pb.Status{Ok: false}, errors.New("unauthenticated: wrong worker id")
+	}
+	go worker.HandleStitchRequest(request)
+	return &pb.Status{Ok: true}, nil
+}
+
 func (s server) GenerateThumbnails(ctx context.Context, request *pb.GenerateThumbnailRequest) (*pb.Status, error) {
 	if request.WorkerID != cfg.WorkerID {
 		log.Info("Rejected request to generate thumbnails")
@@ -157,7 +174,7 @@ func InitApi(addr string) {
 	if err != nil {
 		log.WithError(err).Fatal("failed to listen")
 	}
-	grpcServer := grpc.NewServer(grpc.KeepaliveParams(keepalive.ServerParameters{
+	grpcServer := grpc.NewServer(grpc.Creds(worker.ServerCredentials()), grpc.KeepaliveParams(keepalive.ServerParameters{
 		MaxConnectionIdle:     time.Minute,
 		MaxConnectionAge:      time.Minute,
 		MaxConnectionAgeGrace: time.Second * 5,
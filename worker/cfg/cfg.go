@@ -2,6 +2,7 @@ package cfg
 
 import (
 	"os"
+	"strings"
 	"time"
 
 	"github.com/getsentry/sentry-go"
@@ -18,12 +19,15 @@ var (
 	LrzPhone       string
 	LrzSubDir      string
 	MainBase       string
+	WebUrl         string // url of the tumlive web interface, e.g. https://live.rbg.tum.de
 	LrzUploadUrl   string
 	VodURLTemplate string
 	LogDir         string
 	Hostname       string
-	Token          string // setup token. Used to connect initially and to get a "WorkerID"
-	PersistDir     string // PersistDir is the directory, tum-live-worker will use to store persistent data
+	Token          string   // setup token. Used to connect initially and to get a "WorkerID"
+	PersistDir     string   // PersistDir is the directory, tum-live-worker will use to store persistent data
+	Tags           []string // capabilities of this worker used for scheduling, e.g. has-gpu-encoder or campus-network
+	CAFile         string   // CA certificate of tumlive, enables mutual TLS on all gRPC channels if set
 	LogLevel       = log.InfoLevel
 )
 
@@ -47,6 +51,19 @@ func SetConfig() {
 	LrzUploadUrl = os.Getenv("LrzUploadUrl")
 	MainBase = os.Getenv("MainBase")             // eg. live.mm.rbg.tum.de
 	VodURLTemplate = os.Getenv("VodURLTemplate") // eg. https://stream.lrz.de/vod/_definst_/mp4:tum/RBG/%s.mp4/playlist.m3u8
+	WebUrl = os.Getenv("WebUrl")
+	if WebUrl == "" {
+		WebUrl = "https://" + MainBase
+	}
+
+	CAFile = os.Getenv("CAFile")
+
+	// eg. has-gpu-encoder,can-reach-lecture-hall-HS1
+	for _, tag := range strings.Split(os.Getenv("Tags"), ",") {
+		if tag = strings.TrimSpace(tag); tag != "" {
+			Tags = append(Tags, tag)
+		}
+	}
 
 	// logging
 	LogDir = os.Getenv("LogDir")
@@ -20,7 +20,6 @@ import (
 	log "github.com/sirupsen/logrus"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/backoff"
-	"google.golang.org/grpc/credentials/insecure"
 )
 
 // OsSignal contains the current os signal received.
@@ -42,9 +41,12 @@ func prepare() {
 func main() {
 	cfg.SetConfig()
 	prepare()
+	if err := worker.InitCredentials(); err != nil {
+		log.Fatalf("Could not load credentials: %v", err)
+	}
 
 	log.Infof("Trying to connect worker %s to %s:50052", cfg.WorkerID, cfg.MainBase)
-	conn, err := grpc.Dial(fmt.Sprintf("%s:50052", cfg.MainBase), grpc.WithTransportCredentials(insecure.NewCredentials()), grpc.WithConnectParams(grpc.ConnectParams{
+	conn, err := grpc.Dial(fmt.Sprintf("%s:50052", cfg.MainBase), grpc.WithTransportCredentials(worker.TransportCredentials()), grpc.WithConnectParams(grpc.ConnectParams{
 		Backoff: backoff.Config{
 			BaseDelay:  1 * time.Second,
 			Multiplier: 1.6,
@@ -61,15 +63,23 @@ func main() {
 	client := pb.NewFromWorkerClient(conn)
 	ctx, cancel := context.WithTimeout(context.Background(), time.Second*5)
 	defer cancel()
+	csr, err := worker.CertificateRequest()
+	if err != nil {
+		log.Fatalf("Could not create certificate request: %v", err)
+	}
 	resp, err := client.JoinWorkers(ctx, &pb.JoinWorkersRequest{
-		Token:    cfg.Token,
-		Hostname: cfg.Hostname,
+		Token:              cfg.Token,
+		Hostname:           cfg.Hostname,
+		CertificateRequest: csr,
 	})
 	if err != nil {
 		log.Warnf("Could not join main tumlive: %v\n", err)
 		return
 	}
 	cfg.WorkerID = resp.WorkerId
+	if err = worker.SetCertificate(resp.Certificate); err != nil {
+		log.Fatalf("Could not use worker certificate: %v", err)
+	}
 	log.Infof("Joined main tumlive with worker id: %s\n", cfg.WorkerID)
 	worker.VersionTag = VersionTag
 	defer profile.Start(profile.MemProfile).Stop()
@@ -84,6 +94,8 @@ func main() {
 	// setup apis
 	go api.InitApi(":50051")
 	go rest.InitApi(":8060")
+	go rest.InitWhip(":8070")
+	go rest.InitDvr(":8085")
 	worker.Setup()
 	OsSignal = make(chan os.Signal, 1)
 	awaitSignal()
@@ -4,6 +4,7 @@ package main
 import (
 	"crypto/rsa"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"io"
 	"log"
@@ -18,6 +19,7 @@ import (
 	"path"
 	"path/filepath"
 	"regexp"
+	"strconv"
 	"strings"
 	"sync"
 	"syscall"
@@ -34,7 +36,7 @@ var (
 	inflightLock = sync.Mutex{}
 	inflight     = make(map[string]*sync.Mutex)
 
-	allowedRe = regexp.MustCompile(`^/[a-zA-Z0-9]+/([a-zA-Z0-9_]+/)*[a-zA-Z0-9_]+\.(ts|m3u8)$`) // e.g. /vm123/live/stream/1234.ts
+	allowedRe = regexp.MustCompile(`^/[a-zA-Z0-9]+/([a-zA-Z0-9_]+/)*[a-zA-Z0-9_]+\.(ts|m3u8|mp4|m4s)$`) // e.g. /vm123/live/stream/1234.ts, LL-HLS may use fragmented mp4
 	// allowedRe = regexp.MustCompile("^.*$") // e.g. /vm123/live/strean/1234.ts
 )
 
@@ -132,6 +134,7 @@ type JWTPlaylistClaims struct {
 	Download bool
 	StreamID string
 	CourseID string
+	Keep     string // parts of an edited VoD that may be played, see parseKeepRanges
 }
 
 func (c *JWTPlaylistClaims) GetFileName() string {
@@ -187,6 +190,10 @@ func validateToken(w http.ResponseWriter, r *http.Request, download bool) (claim
 
 	urlParts := strings.Split(allowedPlaylist.Path, "/")
 	allowedPath := "/vod/" + strings.Join(urlParts[2:len(urlParts)-1], "/")
+	if len(urlParts) > 2 && urlParts[2] == "dvr" {
+		// DVR playlists are proxied from the worker: /<worker>/dvr/<stream>/<version>/playlist.m3u8
+		allowedPath = path.Dir(allowedPlaylist.Path)
+	}
 	if !strings.HasPrefix(r.URL.Path, allowedPath+"/") {
 		w.WriteHeader(http.StatusForbidden)
 		_, _ = w.Write([]byte("Forbidden. URL doesn't match claim in jwt. " + allowedPath + " vs " + r.URL.Path))
@@ -219,7 +226,7 @@ func vodHandler(w http.ResponseWriter, r *http.Request) {
 		if claims.UserID != 0 {
 			uid = fmt.Sprintf("%d", claims.UserID)
 		}
-		// add the jwt to all .ts files in the playlist for subsequent verification
+		// add the jwt to all files referenced by the playlist for subsequent verification
 		if strings.HasSuffix(r.URL.Path, ".m3u8") {
 			playlistsRequested.WithLabelValues(claims.StreamID, claims.CourseID).Inc()
 			// map request path to path under `vod_path`
@@ -242,18 +249,34 @@ func vodHandler(w http.ResponseWriter, r *http.Request) {
 				_, _ = w.Write([]byte("Internal server error. Can't read file: " + f.Name()))
 				return
 			}
-			lines := strings.Split(string(fileContents), "\n")
-			for i, line := range lines {
-				if strings.HasSuffix(line, ".ts") {
-					lines[i] = line + "?jwt=" + r.URL.Query().Get("jwt")
-				}
+			playlist, token := string(fileContents), r.URL.Query().Get("jwt")
+			playRanges, err := claimedPlayRanges(claims, r.URL.Query())
+			if errors.Is(err, errRangesNotClaimed) {
+				http.Error(w, "Forbidden. "+err.Error(), http.StatusForbidden)
+				return
 			}
-			resp := strings.Join(lines, "\n")
+			if err != nil {
+				http.Error(w, err.Error(), http.StatusBadRequest)
+				return
+			}
+			if playRanges != nil {
+				playlist = trimPlaylist(playlist, playRanges)
+				// variants of master playlists are requested with the same ranges
+				token += "&" + playRanges.query().Encode()
+			}
+			resp := signPlaylist(playlist, token)
 			_, _ = w.Write([]byte(resp))
 			return
 		} else if strings.HasSuffix(r.URL.Path, ".ts") {
 			chunksRequested.WithLabelValues(claims.StreamID, claims.CourseID).Inc()
 		}
+		if claims.Keep != "" && (strings.HasSuffix(r.URL.Path, ".ts") || strings.HasSuffix(r.URL.Path, ".m4s")) {
+			file := path.Join(vodPath, path.Clean(strings.TrimPrefix(r.URL.Path, "/vod")))
+			if !segmentKept(file, claims.Keep) {
+				http.Error(w, "Forbidden. Segment isn't part of the edited VoD.", http.StatusForbidden)
+				return
+			}
+		}
 	}
 	if uid == "" {
 		ip, _, err := net.SplitHostPort(r.RemoteAddr)
@@ -266,6 +289,36 @@ func vodHandler(w http.ResponseWriter, r *http.Request) {
 	http.StripPrefix("/vod", vodFileServer).ServeHTTP(w, r)
 }
 
+// signProxiedPlaylist replaces the body of a proxied playlist with a signed copy, see signPlaylist.
+func signProxiedPlaylist(resp *http.Response, jwt string) error {
+	body, err := io.ReadAll(resp.Body)
+	_ = resp.Body.Close()
+	if err != nil {
+		return err
+	}
+	signed := signPlaylist(string(body), jwt)
+	resp.Body = io.NopCloser(strings.NewReader(signed))
+	resp.ContentLength = int64(len(signed))
+	resp.Header.Set("Content-Length", strconv.Itoa(len(signed)))
+	return nil
+}
+
+var playlistURIRe = regexp.MustCompile(`URI="([^"]+\.m3u8)"`)
+
+// signPlaylist appends the jwt to all segments and playlists referenced by a playlist.
+// Master playlists reference their variants and i-frame playlists, media playlists their segments.
+func signPlaylist(playlist string, jwt string) string {
+	lines := strings.Split(playlist, "\n")
+	for i, line := range lines {
+		if strings.HasSuffix(line, ".ts") || (strings.HasSuffix(line, ".m3u8") && !strings.HasPrefix(line, "#")) {
+			lines[i] = line + "?jwt=" + jwt
+		} else if strings.HasPrefix(line, "#") {
+			lines[i] = playlistURIRe.ReplaceAllString(line, `URI="$1?jwt=`+jwt+`"`)
+		}
+	}
+	return strings.Join(lines, "\n")
+}
+
 func handleTLS(mux *http.ServeMux) {
 	if os.Getenv(CertDirEnv) == "" {
 		return
@@ -305,8 +358,22 @@ func edgeHandler(writer http.ResponseWriter, request *http.Request) {
 
 	urlParts := strings.SplitN(request.URL.Path, "/", 3) // -> ["", "vm123", "live/stream/1234.ts"]
 
+	// unlike live streams, DVR playlists of running streams are only available with a jwt, like VoDs.
+	dvr := jwtPubKey != nil && strings.HasPrefix(urlParts[2], "dvr/")
+	jwtToken := request.URL.Query().Get("jwt")
+	if dvr {
+		if _, ok := validateToken(writer, request, false); !ok {
+			return
+		}
+	}
+
 	// proxy m3u8 playlist
 	if strings.HasSuffix(request.URL.Path, ".m3u8") {
+		query, err := llhlsQuery(request.URL.Query())
+		if err != nil {
+			http.Error(writer, err.Error(), http.StatusBadRequest)
+			return
+		}
 		request.Host = urlParts[1]
 		request.URL.Path = "" // override by proxy
 		u, err := url.Parse(fmt.Sprintf("%s%s:%s/%s", originProto, urlParts[1], originPort, urlParts[2]))
@@ -319,16 +386,33 @@ func edgeHandler(writer http.ResponseWriter, request *http.Request) {
 			req.URL.Scheme = u.Scheme
 			req.URL.Host = u.Host
 			req.URL.Path, req.URL.RawPath = u.Path, u.Path
+			req.URL.RawQuery = query.Encode()
 			req.RequestURI = u.RequestURI()
 			if _, ok := req.Header["User-Agent"]; !ok {
 				// explicitly disable User-Agent so it's not set to default value
 				req.Header.Set("User-Agent", "")
 			}
 		}
+		// blocking playlist reloads are answered once the origin has the requested part, send them right away.
+		proxy.FlushInterval = -1
+		proxy.ModifyResponse = func(resp *http.Response) error {
+			// live playlists change with every (partial) segment and must never be served from a cache
+			resp.Header.Set("Cache-Control", "no-cache")
+			if dvr && resp.StatusCode == http.StatusOK {
+				return signProxiedPlaylist(resp, jwtToken)
+			}
+			return nil
+		}
 		proxy.ServeHTTP(writer, request)
 		return
 	}
 	err := fetchFile(urlParts[1], urlParts[2])
+	var originErr originStatusError
+	if errors.As(err, &originErr) {
+		// e.g. a part that was announced by a preload hint but is gone by now
+		writer.WriteHeader(int(originErr))
+		return
+	}
 	if err != nil {
 		log.Printf("Could not fetch file: %v", err)
 		writer.WriteHeader(http.StatusBadGateway)
@@ -338,8 +422,42 @@ func edgeHandler(writer http.ResponseWriter, request *http.Request) {
 	http.ServeFile(writer, request, cacheDir+"/"+urlParts[2])
 }
 
+// llhlsParams are the query parameters of LL-HLS delivery directives that are forwarded to the origin.
+var llhlsParams = []string{"_HLS_msn", "_HLS_part", "_HLS_skip"}
+
+// llhlsQuery returns the LL-HLS delivery directives of a playlist request.
+// Other parameters are dropped, so they can't be used to bypass the origin's playlist handling.
+func llhlsQuery(q url.Values) (url.Values, error) {
+	res := url.Values{}
+	for _, p := range llhlsParams {
+		if v := q.Get(p); v != "" {
+			res.Set(p, v)
+		}
+	}
+	for _, p := range []string{"_HLS_msn", "_HLS_part"} {
+		if v := res.Get(p); v != "" {
+			if _, err := strconv.ParseUint(v, 10, 64); err != nil {
+				return nil, fmt.Errorf("invalid %s: %s", p, v)
+			}
+		}
+	}
+	if res.Has("_HLS_part") && !res.Has("_HLS_msn") {
+		return nil, errors.New("_HLS_part requires _HLS_msn")
+	}
+	return res, nil
+}
+
+// originStatusError is returned if the origin answers a file request with a status other than 200.
+type originStatusError int
+
+func (e originStatusError) Error() string {
+	return fmt.Sprintf("origin returned status %d", int(e))
+}
+
 // fetchFile fetches a file from the origin tumlive and persists it in the cache directory.
 // if the file is already in the cache, it is not fetched again.
+// Requests for LL-HLS parts announced by a preload hint block at the origin until the part is complete,
+// concurrent requests for the same file wait for that request instead of asking the origin again.
 func fetchFile(host, file string) error {
 	diskDir := cacheDir + "/" + file
 	// check if file is already in cache
@@ -355,10 +473,14 @@ func fetchFile(host, file string) error {
 		inflight[file] = &sync.Mutex{}
 	}
 	curLock := inflight[file]
-	curLock.Lock()
 	inflightLock.Unlock()
+	curLock.Lock()
 	defer curLock.Unlock()
-	defer delete(inflight, file)
+	defer func() {
+		inflightLock.Lock()
+		delete(inflight, file)
+		inflightLock.Unlock()
+	}()
 
 	// check if file is already in cache after acquiring lock:
 	_, err := os.Stat(diskDir)
@@ -383,15 +505,26 @@ func fetchFile(host, file string) error {
 		return err
 	}
 	defer fileResp.Body.Close()
-	f, err := os.Create(diskDir)
+	if fileResp.StatusCode != http.StatusOK {
+		// don't cache errors, e.g. parts that aren't available yet
+		return originStatusError(fileResp.StatusCode)
+	}
+	// write to a temporary file first, so incomplete downloads never end up in the cache
+	f, err := os.CreateTemp(d, ".fetch-*")
 	if err != nil {
 		return err
 	}
-	defer f.Close()
+	defer os.Remove(f.Name())
 	_, err = f.ReadFrom(fileResp.Body)
+	if closeErr := f.Close(); err == nil {
+		err = closeErr
+	}
 	if err != nil {
 		return err
 	}
+	if err = os.Rename(f.Name(), diskDir); err != nil {
+		return err
+	}
 	cacheLock.Lock()
 	cachedFiles[diskDir] = time.Now()
 	cacheLock.Unlock()
@@ -3,8 +3,13 @@ package main
 import (
 	"crypto/rand"
 	"crypto/rsa"
+	"errors"
 	"net/http"
 	"net/http/httptest"
+	"net/url"
+	"os"
+	"strings"
+	"sync/atomic"
 	"testing"
 	"time"
 
@@ -167,3 +172,265 @@ func prepareJWT(exp time.Duration, playlist string) (string, error) {
 	}
 	return t.SignedString(key)
 }
+
+func TestSignPlaylist(t *testing.T) {
+	master := "#EXTM3U\n" +
+		"#EXT-X-STREAM-INF:BANDWIDTH=2800000,RESOLUTION=1280x720\n" +
+		"720p/playlist.m3u8\n" +
+		"#EXT-X-I-FRAME-STREAM-INF:BANDWIDTH=300000,RESOLUTION=1280x720,URI=\"720p/iframes.m3u8\"\n"
+	expected := "#EXTM3U\n" +
+		"#EXT-X-STREAM-INF:BANDWIDTH=2800000,RESOLUTION=1280x720\n" +
+		"720p/playlist.m3u8?jwt=abc\n" +
+		"#EXT-X-I-FRAME-STREAM-INF:BANDWIDTH=300000,RESOLUTION=1280x720,URI=\"720p/iframes.m3u8?jwt=abc\"\n"
+	if res := signPlaylist(master, "abc"); res != expected {
+		t.Errorf("unexpected master playlist:\n%s", res)
+	}
+
+	media := "#EXTM3U\n#EXTINF:4.000000,\nsegment0000.ts\n#EXT-X-ENDLIST\n"
+	if res := signPlaylist(media, "abc"); res != "#EXTM3U\n#EXTINF:4.000000,\nsegment0000.ts?jwt=abc\n#EXT-X-ENDLIST\n" {
+		t.Errorf("unexpected media playlist:\n%s", res)
+	}
+}
+
+func TestLLHLSQuery(t *testing.T) {
+	q, err := llhlsQuery(url.Values{"_HLS_msn": {"12"}, "_HLS_part": {"3"}, "foo": {"bar"}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if q.Encode() != "_HLS_msn=12&_HLS_part=3" {
+		t.Errorf("unexpected query %s", q.Encode())
+	}
+	if _, err = llhlsQuery(url.Values{"_HLS_part": {"3"}}); err == nil {
+		t.Error("_HLS_part without _HLS_msn should be rejected")
+	}
+	if _, err = llhlsQuery(url.Values{"_HLS_msn": {"abc"}}); err == nil {
+		t.Error("non numeric _HLS_msn should be rejected")
+	}
+}
+
+func TestFetchFileDoesNotCacheErrors(t *testing.T) {
+	var available atomic.Bool
+	origin := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if !available.Load() {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		_, _ = w.Write([]byte("part"))
+	}))
+	defer origin.Close()
+	u, _ := url.Parse(origin.URL)
+	defer func(port string) { originPort = port }(originPort)
+	originPort = u.Port()
+	file := "live/test/part1.m4s"
+	defer os.Remove(cacheDir + "/" + file)
+
+	var originErr originStatusError
+	if err := fetchFile(u.Hostname(), file); !errors.As(err, &originErr) || int(originErr) != http.StatusNotFound {
+		t.Fatalf("expected origin status error, got %v", err)
+	}
+	available.Store(true)
+	if err := fetchFile(u.Hostname(), file); err != nil {
+		t.Fatal(err)
+	}
+	if b, err := os.ReadFile(cacheDir + "/" + file); err != nil || string(b) != "part" {
+		t.Errorf("expected part to be cached, got %s (%v)", b, err)
+	}
+}
+
+func TestValidateTokenDvr(t *testing.T) {
+	str, err := prepareJWT(time.Hour, "https://edge.example.com/vm123/dvr/3/COMB/playlist.m3u8")
+	if err != nil {
+		t.Fatal(err)
+	}
+	r, _ := http.NewRequest("GET", "http://localhost/vm123/dvr/3/COMB/20231018101010.ts?jwt="+str, nil)
+	if _, res := validateToken(httptest.NewRecorder(), r, false); !res {
+		t.Error("segments of the dvr playlist should be allowed")
+	}
+	r, _ = http.NewRequest("GET", "http://localhost/vm123/dvr/4/COMB/playlist.m3u8?jwt="+str, nil)
+	if _, res := validateToken(httptest.NewRecorder(), r, false); res {
+		t.Error("dvr playlists of other streams should be forbidden")
+	}
+}
+
+func TestEdgeHandlerSignsDvrPlaylist(t *testing.T) {
+	origin := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Query().Get("jwt") != "" {
+			t.Error("jwt should not be forwarded to the origin")
+		}
+		_, _ = w.Write([]byte("#EXTM3U\n#EXTINF:4.000000,\n20231018101010.ts\n"))
+	}))
+	defer origin.Close()
+	u, _ := url.Parse(origin.URL)
+	defer func(port string) { originPort = port }(originPort)
+	originPort = u.Port()
+	playlist := "http://localhost/localhost/dvr/3/COMB/playlist.m3u8"
+	str, err := prepareJWT(time.Hour, playlist)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer func() { jwtPubKey = nil }()
+
+	w := httptest.NewRecorder()
+	edgeHandler(w, httptest.NewRequest(http.MethodGet, "/localhost/dvr/3/COMB/playlist.m3u8", nil))
+	if w.Code != http.StatusForbidden {
+		t.Errorf("expected dvr playlist without jwt to be forbidden, got %d", w.Code)
+	}
+
+	w = httptest.NewRecorder()
+	edgeHandler(w, httptest.NewRequest(http.MethodGet, "/localhost/dvr/3/COMB/playlist.m3u8?jwt="+str, nil))
+	if w.Code != http.StatusOK {
+		t.Fatalf("expected status code %d, got %d", http.StatusOK, w.Code)
+	}
+	if !strings.Contains(w.Body.String(), "20231018101010.ts?jwt="+str) {
+		t.Errorf("expected segments to be signed, got %s", w.Body.String())
+	}
+}
+
+func TestParsePlayRanges(t *testing.T) {
+	r, err := parsePlayRanges(url.Values{"jwt": {"abc"}})
+	if err != nil || r != nil {
+		t.Errorf("expected no range, got %v, %v", r, err)
+	}
+	r, err = parsePlayRanges(url.Values{"wowzaplaystart": {"2040000"}, "wowzaplayduration": {"420000"}})
+	if err != nil || len(r) != 1 || r[0].start != 34*time.Minute || r[0].end != 41*time.Minute {
+		t.Errorf("unexpected range %v, %v", r, err)
+	}
+	if r.query().Encode() != "keep=2040000-2460000" {
+		t.Errorf("unexpected query %s", r.query().Encode())
+	}
+	if _, err = parsePlayRanges(url.Values{"wowzaplaystart": {"-1"}}); err == nil {
+		t.Error("negative start should be rejected")
+	}
+
+	r, err = parsePlayRanges(url.Values{"keep": {"3300000-,0-2700000"}})
+	if err != nil || len(r) != 2 || r[0].end != 45*time.Minute || r[1].start != 55*time.Minute || r[1].end != 0 {
+		t.Errorf("unexpected ranges %v, %v", r, err)
+	}
+	if _, err = parsePlayRanges(url.Values{"keep": {"2700000-0"}}); err == nil {
+		t.Error("range ending before its start should be rejected")
+	}
+
+	// a unit of an edited lecture only plays the kept parts within the unit
+	r, err = parsePlayRanges(url.Values{"keep": {"0-2700000,3300000-"}, "wowzaplaystart": {"2400000"}, "wowzaplayduration": {"1200000"}})
+	if err != nil || len(r) != 2 || r[0] != (playRange{start: 40 * time.Minute, end: 45 * time.Minute}) || r[1] != (playRange{start: 55 * time.Minute, end: 60 * time.Minute}) {
+		t.Errorf("unexpected ranges within unit %v, %v", r, err)
+	}
+}
+
+func TestTrimPlaylist(t *testing.T) {
+	media := "#EXTM3U\n#EXT-X-VERSION:3\n#EXT-X-TARGETDURATION:4\n#EXT-X-MEDIA-SEQUENCE:0\n" +
+		"#EXTINF:4.000000,\nsegment0000.ts\n" +
+		"#EXTINF:4.000000,\nsegment0001.ts\n" +
+		"#EXTINF:4.000000,\nsegment0002.ts\n" +
+		"#EXTINF:4.000000,\nsegment0003.ts\n" +
+		"#EXT-X-ENDLIST\n"
+	expected := "#EXTM3U\n#EXT-X-VERSION:3\n#EXT-X-TARGETDURATION:4\n#EXT-X-MEDIA-SEQUENCE:1\n#EXT-X-START:TIME-OFFSET=1.000,PRECISE=YES\n" +
+		"#EXTINF:4.000000,\nsegment0001.ts\n" +
+		"#EXTINF:4.000000,\nsegment0002.ts\n" +
+		"#EXT-X-ENDLIST\n"
+	if res := trimPlaylist(media, playRanges{{start: 5 * time.Second, end: 10 * time.Second}}); res != expected {
+		t.Errorf("unexpected sub-playlist:\n%s", res)
+	}
+	if res := trimPlaylist(media, playRanges{{start: 12 * time.Second}}); !strings.HasSuffix(res, "#EXT-X-MEDIA-SEQUENCE:3\n#EXTINF:4.000000,\nsegment0003.ts\n#EXT-X-ENDLIST\n") {
+		t.Errorf("unexpected sub-playlist until the end:\n%s", res)
+	}
+
+	// removing the middle of the VoD skips its segments with a discontinuity
+	expected = "#EXTM3U\n#EXT-X-VERSION:3\n#EXT-X-TARGETDURATION:4\n#EXT-X-MEDIA-SEQUENCE:0\n" +
+		"#EXTINF:4.000000,\nsegment0000.ts\n" +
+		"#EXT-X-DISCONTINUITY\n" +
+		"#EXTINF:4.000000,\nsegment0003.ts\n" +
+		"#EXT-X-ENDLIST\n"
+	if res := trimPlaylist(media, playRanges{{end: 4 * time.Second}, {start: 12 * time.Second}}); res != expected {
+		t.Errorf("unexpected edited playlist:\n%s", res)
+	}
+
+	master := "#EXTM3U\n#EXT-X-STREAM-INF:BANDWIDTH=2800000\n720p/playlist.m3u8\n"
+	if res := trimPlaylist(master, playRanges{{start: time.Second}}); res != master {
+		t.Errorf("master playlist should not be changed:\n%s", res)
+	}
+}
+
+func TestClaimedPlayRanges(t *testing.T) {
+	claims := &JWTPlaylistClaims{Keep: "0-2700000,3300000-"}
+	// removing keep from the query doesn't play the discarded parts
+	r, err := claimedPlayRanges(claims, url.Values{"jwt": {"abc"}})
+	if err != nil || len(r) != 2 || r[0].end != 45*time.Minute || r[1].start != 55*time.Minute {
+		t.Errorf("expected claimed ranges, got %v, %v", r, err)
+	}
+	if _, err = claimedPlayRanges(claims, url.Values{"keep": {"0-"}}); !errors.Is(err, errRangesNotClaimed) {
+		t.Errorf("expected ranges that aren't claimed to be rejected, got %v", err)
+	}
+	// variants of master playlists are requested with the ranges within a unit
+	r, err = claimedPlayRanges(claims, url.Values{"keep": {"2400000-2700000,3300000-3600000"}})
+	if err != nil || len(r) != 2 {
+		t.Errorf("expected claimed parts to be allowed, got %v, %v", r, err)
+	}
+	r, err = claimedPlayRanges(claims, url.Values{"wowzaplaystart": {"2400000"}, "wowzaplayduration": {"1200000"}})
+	if err != nil || len(r) != 2 || r[0] != (playRange{start: 40 * time.Minute, end: 45 * time.Minute}) {
+		t.Errorf("expected claimed ranges within unit, got %v, %v", r, err)
+	}
+	// VoDs that aren't edited play what the query selects
+	r, err = claimedPlayRanges(&JWTPlaylistClaims{}, url.Values{"keep": {"0-"}})
+	if err != nil || len(r) != 1 {
+		t.Errorf("expected requested range, got %v, %v", r, err)
+	}
+}
+
+func TestSegmentKept(t *testing.T) {
+	dir := t.TempDir()
+	media := "#EXTM3U\n#EXT-X-TARGETDURATION:4\n" +
+		"#EXTINF:4.000000,\nsegment0000.ts\n" +
+		"#EXTINF:4.000000,\nsegment0001.ts\n" +
+		"#EXTINF:4.000000,\nsegment0002.ts\n" +
+		"#EXT-X-ENDLIST\n"
+	if err := os.WriteFile(dir+"/playlist.m3u8", []byte(media), 0o644); err != nil {
+		t.Fatal(err)
+	}
+	keep := "0-4000,8000-"
+	if !segmentKept(dir+"/segment0000.ts", keep) || !segmentKept(dir+"/segment0002.ts", keep) {
+		t.Error("expected kept segments to be allowed")
+	}
+	if segmentKept(dir+"/segment0001.ts", keep) {
+		t.Error("expected discarded segment to be forbidden")
+	}
+	if segmentKept(t.TempDir()+"/segment0000.ts", keep) {
+		t.Error("expected segments without playlist to be forbidden")
+	}
+	if segmentKept(dir+"/segment0000.ts", "invalid") {
+		t.Error("expected segments of invalid claims to be forbidden")
+	}
+}
+
+func TestKeptSegmentsCache(t *testing.T) {
+	dir := t.TempDir()
+	playlist := dir + "/playlist.m3u8"
+	media := "#EXTM3U\n#EXT-X-TARGETDURATION:4\n#EXTINF:4.000000,\nsegment0000.ts\n#EXTINF:4.000000,\nsegment0001.ts\n#EXT-X-ENDLIST\n"
+	if err := os.WriteFile(playlist, []byte(media), 0o644); err != nil {
+		t.Fatal(err)
+	}
+	c := newKeptSegmentsCache(1)
+	segments, err := c.get(playlist, "0-4000")
+	if err != nil || !segments["segment0000.ts"] || segments["segment0001.ts"] {
+		t.Fatalf("expected only the first segment, got %v (%v)", segments, err)
+	}
+	if _, err = c.get(playlist, "4000-"); err != nil {
+		t.Fatal(err)
+	}
+	if c.order.Len() != 1 || c.entries[playlist+"?keep=0-4000"] != nil {
+		t.Errorf("expected least recently used entry to be evicted, got %d entries", c.order.Len())
+	}
+
+	// packaging the VoD anew replaces the playlist
+	media = strings.Replace(media, "segment0001.ts", "segment0001-new.ts", 1)
+	if err = os.WriteFile(playlist, []byte(media), 0o644); err != nil {
+		t.Fatal(err)
+	}
+	later := time.Now().Add(time.Minute)
+	if err = os.Chtimes(playlist, later, later); err != nil {
+		t.Fatal(err)
+	}
+	if segments, _ = c.get(playlist, "4000-"); !segments["segment0001-new.ts"] {
+		t.Errorf("expected changed playlist to be parsed again, got %v", segments)
+	}
+}
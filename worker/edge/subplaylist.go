@@ -0,0 +1,326 @@
+package main
+
+import (
+	"container/list"
+	"errors"
+	"fmt"
+	"net/url"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+// playRange is a part of a VoD a sub-playlist plays. An end of 0 plays the VoD until its end.
+type playRange struct {
+	start, end time.Duration
+}
+
+// playRanges are the parts of a VoD a sub-playlist plays, ordered by their start.
+//
+// TUM-Live selects them with the sub-clip parameters of wowza, wowzaplaystart and wowzaplayduration in milliseconds,
+// e.g. for lecture units and clips, and with the edit decision list of a stream as keep=<start>-<end>,... in
+// milliseconds. If both are given, the parts of the edit decision list within the sub-clip are played.
+type playRanges []playRange
+
+// parsePlayRanges returns the ranges selected by the query of a playlist request or nil if the whole VoD is requested.
+func parsePlayRanges(q url.Values) (playRanges, error) {
+	clip, err := parseClipRange(q)
+	if err != nil {
+		return nil, err
+	}
+	keep, err := parseKeepRanges(q.Get("keep"))
+	if err != nil {
+		return nil, err
+	}
+	switch {
+	case keep == nil && clip == nil:
+		return nil, nil
+	case keep == nil:
+		return playRanges{*clip}, nil
+	case clip == nil:
+		return keep, nil
+	default:
+		return keep.within(*clip), nil
+	}
+}
+
+// errRangesNotClaimed is returned if a request selects parts of an edited VoD its jwt doesn't allow.
+var errRangesNotClaimed = errors.New("ranges don't match claim in jwt")
+
+// claimedPlayRanges returns the ranges a playlist request plays. The jwt of an edited VoD claims the parts that
+// are kept, they are played even if the query doesn't select them. The query may only narrow them down, e.g. for
+// lecture units and clips.
+func claimedPlayRanges(claims *JWTPlaylistClaims, q url.Values) (playRanges, error) {
+	requested, err := parsePlayRanges(q)
+	if err != nil || claims.Keep == "" {
+		return requested, err
+	}
+	claimed, err := parseKeepRanges(claims.Keep)
+	if err != nil {
+		return nil, errRangesNotClaimed
+	}
+	if q.Get("keep") != "" {
+		if !claimed.covers(requested) {
+			return nil, errRangesNotClaimed
+		}
+		return requested, nil
+	}
+	if clip, _ := parseClipRange(q); clip != nil {
+		return claimed.within(*clip), nil
+	}
+	return claimed, nil
+}
+
+// parseClipRange returns the range of the sub-clip parameters of wowza or nil if there are none.
+func parseClipRange(q url.Values) (*playRange, error) {
+	if q.Get("wowzaplaystart") == "" && q.Get("wowzaplayduration") == "" {
+		return nil, nil
+	}
+	var r playRange
+	if s := q.Get("wowzaplaystart"); s != "" {
+		start, err := strconv.ParseUint(s, 10, 64)
+		if err != nil {
+			return nil, fmt.Errorf("invalid wowzaplaystart: %w", err)
+		}
+		r.start = time.Duration(start) * time.Millisecond
+	}
+	if s := q.Get("wowzaplayduration"); s != "" {
+		duration, err := strconv.ParseUint(s, 10, 64)
+		if err != nil {
+			return nil, fmt.Errorf("invalid wowzaplayduration: %w", err)
+		}
+		if duration != 0 {
+			r.end = r.start + time.Duration(duration)*time.Millisecond
+		}
+	}
+	return &r, nil
+}
+
+// parseKeepRanges parses the ranges of an edit decision list, e.g. 0-2700000,3300000- or nil if it's empty.
+func parseKeepRanges(s string) (playRanges, error) {
+	if s == "" {
+		return nil, nil
+	}
+	var res playRanges
+	for _, part := range strings.Split(s, ",") {
+		startStr, endStr, ok := strings.Cut(part, "-")
+		if !ok {
+			return nil, fmt.Errorf("invalid range %q", part)
+		}
+		start, err := strconv.ParseUint(startStr, 10, 64)
+		if err != nil {
+			return nil, fmt.Errorf("invalid start of range %q: %w", part, err)
+		}
+		r := playRange{start: time.Duration(start) * time.Millisecond}
+		if endStr != "" {
+			end, err := strconv.ParseUint(endStr, 10, 64)
+			if err != nil || end <= start {
+				return nil, fmt.Errorf("invalid end of range %q", part)
+			}
+			r.end = time.Duration(end) * time.Millisecond
+		}
+		res = append(res, r)
+	}
+	sort.Slice(res, func(i, j int) bool { return res[i].start < res[j].start })
+	return res, nil
+}
+
+// within returns the parts of the ranges that are within r.
+func (rs playRanges) within(r playRange) playRanges {
+	res := playRanges{}
+	for _, k := range rs {
+		start, end := max(k.start, r.start), k.end
+		if end == 0 || (r.end != 0 && r.end < end) {
+			end = r.end
+		}
+		if end == 0 || start < end {
+			res = append(res, playRange{start: start, end: end})
+		}
+	}
+	return res
+}
+
+// covers returns whether every range of other is within one of the ranges.
+func (rs playRanges) covers(other playRanges) bool {
+	for _, o := range other {
+		covered := false
+		for _, r := range rs {
+			if r.start <= o.start && (r.end == 0 || (o.end != 0 && o.end <= r.end)) {
+				covered = true
+				break
+			}
+		}
+		if !covered {
+			return false
+		}
+	}
+	return true
+}
+
+// query returns the query that selects the ranges, it's passed on to the variants of master playlists.
+func (rs playRanges) query() url.Values {
+	parts := make([]string, len(rs))
+	for i, r := range rs {
+		parts[i] = strconv.FormatInt(r.start.Milliseconds(), 10) + "-"
+		if r.end != 0 {
+			parts[i] += strconv.FormatInt(r.end.Milliseconds(), 10)
+		}
+	}
+	return url.Values{"keep": {strings.Join(parts, ",")}}
+}
+
+// contains returns whether a segment starting at start with the given duration overlaps one of the ranges.
+func (rs playRanges) contains(start, duration time.Duration) bool {
+	for _, r := range rs {
+		if start+duration > r.start && (r.end == 0 || start < r.end) {
+			return true
+		}
+	}
+	return false
+}
+
+// keptSegmentsCacheSize is how many media playlists of edited VoDs the kept segments are cached of.
+const keptSegmentsCacheSize = 512
+
+// keptSegments caches the segments edits keep, so segment requests don't parse the whole media playlist.
+var keptSegments = newKeptSegmentsCache(keptSegmentsCacheSize)
+
+// segmentKept returns whether the segment file is played with the kept ranges claimed in the jwt. It's looked up in
+// the media playlist next to it, like vod-service packages renditions.
+func segmentKept(file string, keep string) bool {
+	segments, err := keptSegments.get(filepath.Join(filepath.Dir(file), "playlist.m3u8"), keep)
+	return err == nil && segments[filepath.Base(file)]
+}
+
+// keptSegmentsCache is a least recently used cache of the segments of media playlists that edits keep,
+// keyed by the playlist and the kept ranges. Entries are dropped when the playlist changes, e.g. when it's packaged anew.
+type keptSegmentsCache struct {
+	mu      sync.Mutex
+	size    int
+	order   *list.List // of *keptSegmentsEntry, most recently used first
+	entries map[string]*list.Element
+}
+
+type keptSegmentsEntry struct {
+	key      string
+	modTime  time.Time
+	segments map[string]bool
+}
+
+func newKeptSegmentsCache(size int) *keptSegmentsCache {
+	return &keptSegmentsCache{size: size, order: list.New(), entries: map[string]*list.Element{}}
+}
+
+// get returns the names of the segments of the media playlist that the keep ranges play.
+func (c *keptSegmentsCache) get(playlist, keep string) (map[string]bool, error) {
+	info, err := os.Stat(playlist)
+	if err != nil {
+		return nil, err
+	}
+	key := playlist + "?keep=" + keep
+	c.mu.Lock()
+	if e, ok := c.entries[key]; ok && e.Value.(*keptSegmentsEntry).modTime.Equal(info.ModTime()) {
+		c.order.MoveToFront(e)
+		c.mu.Unlock()
+		return e.Value.(*keptSegmentsEntry).segments, nil
+	}
+	c.mu.Unlock()
+
+	ranges, err := parseKeepRanges(keep)
+	if err != nil {
+		return nil, err
+	}
+	content, err := os.ReadFile(playlist)
+	if err != nil {
+		return nil, err
+	}
+	segments := map[string]bool{}
+	for _, line := range strings.Split(trimPlaylist(string(content), ranges), "\n") {
+		if line = strings.TrimSpace(line); line != "" && !strings.HasPrefix(line, "#") {
+			segments[line] = true
+		}
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if e, ok := c.entries[key]; ok {
+		c.order.Remove(e)
+	}
+	c.entries[key] = c.order.PushFront(&keptSegmentsEntry{key: key, modTime: info.ModTime(), segments: segments})
+	for c.order.Len() > c.size {
+		oldest := c.order.Back()
+		c.order.Remove(oldest)
+		delete(c.entries, oldest.Value.(*keptSegmentsEntry).key)
+	}
+	return segments, nil
+}
+
+// trimPlaylist returns the sub-playlist of a media playlist that only contains the segments overlapping the ranges.
+// Players start at the exact start of the first range within its first segment, otherwise the ranges are played
+// in whole segments. Skipped segments between ranges are marked as discontinuity.
+// Master playlists are returned as they are, their variants are trimmed when they are requested.
+func trimPlaylist(playlist string, ranges playRanges) string {
+	if !strings.Contains(playlist, "#EXTINF:") {
+		return playlist
+	}
+	lines := strings.Split(playlist, "\n")
+	var header, out []string
+	var segment []string // tags and uri of the current segment
+	var pos, duration, firstStart time.Duration
+	inHeader, skipped, mediaSequence, lastKept := true, 0, -1, false
+	for _, line := range lines {
+		trimmed := strings.TrimSpace(line)
+		switch {
+		case inHeader && !strings.HasPrefix(trimmed, "#EXTINF:"):
+			if strings.HasPrefix(trimmed, "#EXT-X-MEDIA-SEQUENCE:") {
+				mediaSequence, _ = strconv.Atoi(strings.TrimPrefix(trimmed, "#EXT-X-MEDIA-SEQUENCE:"))
+				continue
+			}
+			if trimmed != "" {
+				header = append(header, line)
+			}
+		case strings.HasPrefix(trimmed, "#EXTINF:"):
+			inHeader = false
+			seconds, _ := strconv.ParseFloat(strings.Split(strings.TrimPrefix(trimmed, "#EXTINF:"), ",")[0], 64)
+			duration = time.Duration(seconds * float64(time.Second))
+			segment = append(segment, line)
+		case trimmed == "" || trimmed == "#EXT-X-ENDLIST":
+			// the end of the playlist is added again after the kept segments
+		case strings.HasPrefix(trimmed, "#"):
+			segment = append(segment, line)
+		default: // uri of the segment
+			segment = append(segment, line)
+			kept := ranges.contains(pos, duration)
+			switch {
+			case kept && len(out) == 0:
+				firstStart = pos
+				if mediaSequence != -1 {
+					mediaSequence += skipped
+				}
+			case kept && !lastKept:
+				out = append(out, "#EXT-X-DISCONTINUITY")
+			case !kept && len(out) == 0:
+				skipped++
+			}
+			if kept {
+				out = append(out, segment...)
+			}
+			lastKept = kept
+			pos += duration
+			segment, duration = nil, 0
+		}
+	}
+	if mediaSequence != -1 {
+		header = append(header, fmt.Sprintf("#EXT-X-MEDIA-SEQUENCE:%d", mediaSequence))
+	}
+	if len(out) != 0 && ranges[0].start > firstStart {
+		offset := ranges[0].start - firstStart
+		header = append(header, fmt.Sprintf("#EXT-X-START:TIME-OFFSET=%.3f,PRECISE=YES", offset.Seconds()))
+	}
+	out = append(header, out...)
+	return strings.Join(append(out, "#EXT-X-ENDLIST", ""), "\n")
+}
@@ -0,0 +1,60 @@
+// Package loudnorm normalises the loudness of recordings to EBU R128 with two passes of ffmpeg's loudnorm filter.
+// The vod-service normalises to the same targets with its own copy, it is built without the worker module.
+package loudnorm
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math"
+	"strconv"
+)
+
+// Targets of the loudness normalisation according to EBU R128.
+const (
+	Target   = -23.0 // LUFS
+	TruePeak = -1.0  // dBTP
+	Range    = 11.0  // LU, lectures are mostly speech
+)
+
+// Measurement is the result of the first pass of ffmpeg's loudnorm filter.
+type Measurement struct {
+	InputI       string `json:"input_i"`
+	InputTP      string `json:"input_tp"`
+	InputLRA     string `json:"input_lra"`
+	InputThresh  string `json:"input_thresh"`
+	TargetOffset string `json:"target_offset"`
+}
+
+// Filter returns the loudnorm options of both passes, with the measurement of the first pass for the second.
+func Filter(measured *Measurement) string {
+	filter := fmt.Sprintf("loudnorm=I=%.0f:TP=%.0f:LRA=%.0f", Target, TruePeak, Range)
+	if measured == nil {
+		return filter + ":print_format=json"
+	}
+	return filter + fmt.Sprintf(":measured_I=%s:measured_TP=%s:measured_LRA=%s:measured_thresh=%s:offset=%s:linear=true",
+		measured.InputI, measured.InputTP, measured.InputLRA, measured.InputThresh, measured.TargetOffset)
+}
+
+// MeasureArgs returns the arguments of ffmpeg for the first pass on the first audio stream of file.
+func MeasureArgs(file string) []string {
+	return []string{"-hide_banner", "-nostats", "-i", file, "-map", "0:a:0", "-af", Filter(nil), "-f", "null", "-"}
+}
+
+// Parse parses the measurement loudnorm prints as last JSON object of the log of ffmpeg.
+func Parse(output []byte) (*Measurement, error) {
+	start, end := bytes.LastIndexByte(output, '{'), bytes.LastIndexByte(output, '}')
+	if start == -1 || end < start {
+		return nil, errors.New("no loudness measurement in output of ffmpeg")
+	}
+	var m Measurement
+	if err := json.Unmarshal(output[start:end+1], &m); err != nil {
+		return nil, fmt.Errorf("parse loudness measurement: %w", err)
+	}
+	// silent recordings measure -inf, they can't be normalised
+	if i, err := strconv.ParseFloat(m.InputI, 64); err != nil || math.IsInf(i, 0) {
+		return nil, fmt.Errorf("can't normalise loudness of %s LUFS", m.InputI)
+	}
+	return &m, nil
+}
@@ -0,0 +1,43 @@
+package loudnorm
+
+import (
+	"strings"
+	"testing"
+)
+
+const loudnormOutput = `size=N/A time=01:30:00.00 bitrate=N/A speed= 412x
+[Parsed_loudnorm_0 @ 0x55d5c1a3c0c0] 
+{
+	"input_i" : "-31.42",
+	"input_tp" : "-6.03",
+	"input_lra" : "8.70",
+	"input_thresh" : "-42.10",
+	"output_i" : "-23.46",
+	"output_tp" : "-1.00",
+	"output_lra" : "7.60",
+	"output_thresh" : "-34.02",
+	"normalization_type" : "dynamic",
+	"target_offset" : "0.46"
+}
+`
+
+func TestParse(t *testing.T) {
+	m, err := Parse([]byte(loudnormOutput))
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := "loudnorm=I=-23:TP=-1:LRA=11:measured_I=-31.42:measured_TP=-6.03:measured_LRA=8.70:measured_thresh=-42.10:offset=0.46:linear=true"
+	if filter := Filter(m); filter != want {
+		t.Errorf("expected %s, got %s", want, filter)
+	}
+	if filter := Filter(nil); filter != "loudnorm=I=-23:TP=-1:LRA=11:print_format=json" {
+		t.Errorf("unexpected filter of the first pass: %s", filter)
+	}
+
+	if _, err = Parse([]byte(strings.Replace(loudnormOutput, `"-31.42"`, `"-inf"`, 1))); err == nil {
+		t.Error("expected error for silent recording")
+	}
+	if _, err = Parse([]byte("Stream map '0:a:0' matches no streams.")); err == nil {
+		t.Error("expected error without measurement")
+	}
+}
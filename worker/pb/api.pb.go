@@ -369,6 +369,7 @@ type CutRequest struct {
 	Files        []string              `protobuf:"bytes,2,rep,name=Files,proto3" json:"Files,omitempty"`
 	Segments     []*CutRequest_Segment `protobuf:"bytes,3,rep,name=segments,proto3" json:"segments,omitempty"`
 	UploadResult bool                  `protobuf:"varint,4,opt,name=UploadResult,proto3" json:"UploadResult,omitempty"`
+	OutputFile   string                `protobuf:"bytes,5,opt,name=OutputFile,proto3" json:"OutputFile,omitempty"` // where the cut video is saved
 }
 
 func (x *CutRequest) Reset() {
@@ -431,6 +432,13 @@ func (x *CutRequest) GetUploadResult() bool {
 	return false
 }
 
+func (x *CutRequest) GetOutputFile() string {
+	if x != nil {
+		return x.OutputFile
+	}
+	return ""
+}
+
 type CutResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -606,6 +614,13 @@ type StreamRequest struct {
 	StreamName   string                 `protobuf:"bytes,13,opt,name=StreamName,proto3" json:"StreamName,omitempty"`
 	IngestServer string                 `protobuf:"bytes,14,opt,name=IngestServer,proto3" json:"IngestServer,omitempty"`
 	OutUrl       string                 `protobuf:"bytes,15,opt,name=OutUrl,proto3" json:"OutUrl,omitempty"`
+	Renditions   []*Rendition           `protobuf:"bytes,16,rep,name=Renditions,proto3" json:"Renditions,omitempty"`  // if empty, a single rendition is pushed to StreamName
+	Part         uint32                 `protobuf:"varint,17,opt,name=Part,proto3" json:"Part,omitempty"`             // greater than 0 if the stream failed over from another worker, the recording is only a part of the VoD
+	LowLatency   bool                   `protobuf:"varint,18,opt,name=LowLatency,proto3" json:"LowLatency,omitempty"` // push with a short gop to IngestServer which packages LL-HLS
+	Dvr          bool                   `protobuf:"varint,19,opt,name=Dvr,proto3" json:"Dvr,omitempty"`               // keep the stream as HLS on the worker so viewers can rewind it
+	DvrWindow    uint32                 `protobuf:"varint,20,opt,name=DvrWindow,proto3" json:"DvrWindow,omitempty"`   // seconds kept for DVR, 0 keeps the whole stream
+	Profile      *EncodingProfile       `protobuf:"bytes,21,opt,name=Profile,proto3" json:"Profile,omitempty"`        // if unset, the worker uses its defaults for SourceType
+	SlideText    bool                   `protobuf:"varint,22,opt,name=SlideText,proto3" json:"SlideText,omitempty"`   // save the full resolution frames of slide changes, tumlive extracts their text
 }
 
 func (x *StreamRequest) Reset() {
@@ -731,21 +746,78 @@ func (x *StreamRequest) GetOutUrl() string {
 	return ""
 }
 
-type PremiereRequest struct {
+func (x *StreamRequest) GetRenditions() []*Rendition {
+	if x != nil {
+		return x.Renditions
+	}
+	return nil
+}
+
+func (x *StreamRequest) GetPart() uint32 {
+	if x != nil {
+		return x.Part
+	}
+	return 0
+}
+
+func (x *StreamRequest) GetLowLatency() bool {
+	if x != nil {
+		return x.LowLatency
+	}
+	return false
+}
+
+func (x *StreamRequest) GetDvr() bool {
+	if x != nil {
+		return x.Dvr
+	}
+	return false
+}
+
+func (x *StreamRequest) GetDvrWindow() uint32 {
+	if x != nil {
+		return x.DvrWindow
+	}
+	return 0
+}
+
+func (x *StreamRequest) GetProfile() *EncodingProfile {
+	if x != nil {
+		return x.Profile
+	}
+	return nil
+}
+
+func (x *StreamRequest) GetSlideText() bool {
+	if x != nil {
+		return x.SlideText
+	}
+	return false
+}
+
+// EncodingProfile are the encoder settings of a stream, unset fields keep the defaults of the worker.
+// Live streams are pushed as H.264 and AAC regardless of the codecs, only the VoD is encoded with them.
+type EncodingProfile struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	StreamID     uint32 `protobuf:"varint,1,opt,name=StreamID,proto3" json:"StreamID,omitempty"`
-	FilePath     string `protobuf:"bytes,2,opt,name=FilePath,proto3" json:"FilePath,omitempty"`
-	WorkerID     string `protobuf:"bytes,3,opt,name=WorkerID,proto3" json:"WorkerID,omitempty"`
-	StreamName   string `protobuf:"bytes,4,opt,name=StreamName,proto3" json:"StreamName,omitempty"`
-	IngestServer string `protobuf:"bytes,5,opt,name=IngestServer,proto3" json:"IngestServer,omitempty"`
-	OutUrl       string `protobuf:"bytes,6,opt,name=OutUrl,proto3" json:"OutUrl,omitempty"`
-}
-
-func (x *PremiereRequest) Reset() {
-	*x = PremiereRequest{}
+	Name            string   `protobuf:"bytes,1,opt,name=Name,proto3" json:"Name,omitempty"`
+	VideoCodec      string   `protobuf:"bytes,2,opt,name=VideoCodec,proto3" json:"VideoCodec,omitempty"`      // ffmpeg encoder of the VoD, e.g. libx264 or libx265
+	Crf             uint32   `protobuf:"varint,3,opt,name=Crf,proto3" json:"Crf,omitempty"`                   // quality of the VoD
+	VideoBitrate    uint32   `protobuf:"varint,4,opt,name=VideoBitrate,proto3" json:"VideoBitrate,omitempty"` // kbit/s of live streams without ladder and premieres
+	Preset          string   `protobuf:"bytes,5,opt,name=Preset,proto3" json:"Preset,omitempty"`              // e.g. veryfast
+	Tune            string   `protobuf:"bytes,6,opt,name=Tune,proto3" json:"Tune,omitempty"`                  // e.g. stillimage, ignored for live streams
+	Height          uint32   `protobuf:"varint,7,opt,name=Height,proto3" json:"Height,omitempty"`             // 0 keeps the resolution of the source
+	AudioCodec      string   `protobuf:"bytes,8,opt,name=AudioCodec,proto3" json:"AudioCodec,omitempty"`      // ffmpeg encoder of the VoD, e.g. aac
+	AudioBitrate    uint32   `protobuf:"varint,9,opt,name=AudioBitrate,proto3" json:"AudioBitrate,omitempty"` // kbit/s
+	AudioChannels   uint32   `protobuf:"varint,10,opt,name=AudioChannels,proto3" json:"AudioChannels,omitempty"`
+	AudioSampleRate uint32   `protobuf:"varint,11,opt,name=AudioSampleRate,proto3" json:"AudioSampleRate,omitempty"` // Hz
+	VodCodecs       []string `protobuf:"bytes,12,rep,name=VodCodecs,proto3" json:"VodCodecs,omitempty"`              // hevc and/or av1, encoded as additional VoD renditions after the H.264 VoD
+}
+
+func (x *EncodingProfile) Reset() {
+	*x = EncodingProfile{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_api_proto_msgTypes[10]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -753,13 +825,13 @@ func (x *PremiereRequest) Reset() {
 	}
 }
 
-func (x *PremiereRequest) String() string {
+func (x *EncodingProfile) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*PremiereRequest) ProtoMessage() {}
+func (*EncodingProfile) ProtoMessage() {}
 
-func (x *PremiereRequest) ProtoReflect() protoreflect.Message {
+func (x *EncodingProfile) ProtoReflect() protoreflect.Message {
 	mi := &file_api_proto_msgTypes[10]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -771,65 +843,110 @@ func (x *PremiereRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use PremiereRequest.ProtoReflect.Descriptor instead.
-func (*PremiereRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use EncodingProfile.ProtoReflect.Descriptor instead.
+func (*EncodingProfile) Descriptor() ([]byte, []int) {
 	return file_api_proto_rawDescGZIP(), []int{10}
 }
 
-func (x *PremiereRequest) GetStreamID() uint32 {
+func (x *EncodingProfile) GetName() string {
 	if x != nil {
-		return x.StreamID
+		return x.Name
 	}
-	return 0
+	return ""
 }
 
-func (x *PremiereRequest) GetFilePath() string {
+func (x *EncodingProfile) GetVideoCodec() string {
 	if x != nil {
-		return x.FilePath
+		return x.VideoCodec
 	}
 	return ""
 }
 
-func (x *PremiereRequest) GetWorkerID() string {
+func (x *EncodingProfile) GetCrf() uint32 {
 	if x != nil {
-		return x.WorkerID
+		return x.Crf
 	}
-	return ""
+	return 0
 }
 
-func (x *PremiereRequest) GetStreamName() string {
+func (x *EncodingProfile) GetVideoBitrate() uint32 {
 	if x != nil {
-		return x.StreamName
+		return x.VideoBitrate
+	}
+	return 0
+}
+
+func (x *EncodingProfile) GetPreset() string {
+	if x != nil {
+		return x.Preset
 	}
 	return ""
 }
 
-func (x *PremiereRequest) GetIngestServer() string {
+func (x *EncodingProfile) GetTune() string {
 	if x != nil {
-		return x.IngestServer
+		return x.Tune
 	}
 	return ""
 }
 
-func (x *PremiereRequest) GetOutUrl() string {
+func (x *EncodingProfile) GetHeight() uint32 {
 	if x != nil {
-		return x.OutUrl
+		return x.Height
+	}
+	return 0
+}
+
+func (x *EncodingProfile) GetAudioCodec() string {
+	if x != nil {
+		return x.AudioCodec
 	}
 	return ""
 }
 
-type EndStreamRequest struct {
+func (x *EncodingProfile) GetAudioBitrate() uint32 {
+	if x != nil {
+		return x.AudioBitrate
+	}
+	return 0
+}
+
+func (x *EncodingProfile) GetAudioChannels() uint32 {
+	if x != nil {
+		return x.AudioChannels
+	}
+	return 0
+}
+
+func (x *EncodingProfile) GetAudioSampleRate() uint32 {
+	if x != nil {
+		return x.AudioSampleRate
+	}
+	return 0
+}
+
+func (x *EncodingProfile) GetVodCodecs() []string {
+	if x != nil {
+		return x.VodCodecs
+	}
+	return nil
+}
+
+// Rendition is one quality of the adaptive bitrate ladder pushed for a live stream.
+// It is pushed to the ingest server as <StreamName>_<Name>.
+type Rendition struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	StreamID   uint32 `protobuf:"varint,1,opt,name=StreamID,proto3" json:"StreamID,omitempty"`
-	WorkerID   string `protobuf:"bytes,2,opt,name=WorkerID,proto3" json:"WorkerID,omitempty"`
-	DiscardVoD bool   `protobuf:"varint,3,opt,name=DiscardVoD,proto3" json:"DiscardVoD,omitempty"`
+	Name         string `protobuf:"bytes,1,opt,name=Name,proto3" json:"Name,omitempty"`                  // e.g. 720p
+	Height       uint32 `protobuf:"varint,2,opt,name=Height,proto3" json:"Height,omitempty"`             // 0 for audio only renditions
+	VideoBitrate uint32 `protobuf:"varint,3,opt,name=VideoBitrate,proto3" json:"VideoBitrate,omitempty"` // kbit/s
+	AudioBitrate uint32 `protobuf:"varint,4,opt,name=AudioBitrate,proto3" json:"AudioBitrate,omitempty"` // kbit/s
 }
 
-func (x *EndStreamRequest) Reset() {
-	*x = EndStreamRequest{}
+func (x *Rendition) Reset() {
+	*x = Rendition{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_api_proto_msgTypes[11]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -837,13 +954,13 @@ func (x *EndStreamRequest) Reset() {
 	}
 }
 
-func (x *EndStreamRequest) String() string {
+func (x *Rendition) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*EndStreamRequest) ProtoMessage() {}
+func (*Rendition) ProtoMessage() {}
 
-func (x *EndStreamRequest) ProtoReflect() protoreflect.Message {
+func (x *Rendition) ProtoReflect() protoreflect.Message {
 	mi := &file_api_proto_msgTypes[11]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -855,42 +972,55 @@ func (x *EndStreamRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use EndStreamRequest.ProtoReflect.Descriptor instead.
-func (*EndStreamRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use Rendition.ProtoReflect.Descriptor instead.
+func (*Rendition) Descriptor() ([]byte, []int) {
 	return file_api_proto_rawDescGZIP(), []int{11}
 }
 
-func (x *EndStreamRequest) GetStreamID() uint32 {
+func (x *Rendition) GetName() string {
 	if x != nil {
-		return x.StreamID
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Rendition) GetHeight() uint32 {
+	if x != nil {
+		return x.Height
 	}
 	return 0
 }
 
-func (x *EndStreamRequest) GetWorkerID() string {
+func (x *Rendition) GetVideoBitrate() uint32 {
 	if x != nil {
-		return x.WorkerID
+		return x.VideoBitrate
 	}
-	return ""
+	return 0
 }
 
-func (x *EndStreamRequest) GetDiscardVoD() bool {
+func (x *Rendition) GetAudioBitrate() uint32 {
 	if x != nil {
-		return x.DiscardVoD
+		return x.AudioBitrate
 	}
-	return false
+	return 0
 }
 
-type Status struct {
+type PremiereRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Ok bool `protobuf:"varint,1,opt,name=ok,proto3" json:"ok,omitempty"`
+	StreamID     uint32           `protobuf:"varint,1,opt,name=StreamID,proto3" json:"StreamID,omitempty"`
+	FilePath     string           `protobuf:"bytes,2,opt,name=FilePath,proto3" json:"FilePath,omitempty"`
+	WorkerID     string           `protobuf:"bytes,3,opt,name=WorkerID,proto3" json:"WorkerID,omitempty"`
+	StreamName   string           `protobuf:"bytes,4,opt,name=StreamName,proto3" json:"StreamName,omitempty"`
+	IngestServer string           `protobuf:"bytes,5,opt,name=IngestServer,proto3" json:"IngestServer,omitempty"`
+	OutUrl       string           `protobuf:"bytes,6,opt,name=OutUrl,proto3" json:"OutUrl,omitempty"`
+	Profile      *EncodingProfile `protobuf:"bytes,7,opt,name=Profile,proto3" json:"Profile,omitempty"`
 }
 
-func (x *Status) Reset() {
-	*x = Status{}
+func (x *PremiereRequest) Reset() {
+	*x = PremiereRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_api_proto_msgTypes[12]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -898,13 +1028,13 @@ func (x *Status) Reset() {
 	}
 }
 
-func (x *Status) String() string {
+func (x *PremiereRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Status) ProtoMessage() {}
+func (*PremiereRequest) ProtoMessage() {}
 
-func (x *Status) ProtoReflect() protoreflect.Message {
+func (x *PremiereRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_api_proto_msgTypes[12]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -916,115 +1046,96 @@ func (x *Status) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Status.ProtoReflect.Descriptor instead.
-func (*Status) Descriptor() ([]byte, []int) {
+// Deprecated: Use PremiereRequest.ProtoReflect.Descriptor instead.
+func (*PremiereRequest) Descriptor() ([]byte, []int) {
 	return file_api_proto_rawDescGZIP(), []int{12}
 }
 
-func (x *Status) GetOk() bool {
+func (x *PremiereRequest) GetStreamID() uint32 {
 	if x != nil {
-		return x.Ok
+		return x.StreamID
 	}
-	return false
-}
-
-type NotifyTranscodingProgressRequest struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
-
-	WorkerID string `protobuf:"bytes,1,opt,name=WorkerID,proto3" json:"WorkerID,omitempty"`
-	StreamId uint32 `protobuf:"varint,2,opt,name=StreamId,proto3" json:"StreamId,omitempty"`
-	Version  string `protobuf:"bytes,3,opt,name=Version,proto3" json:"Version,omitempty"`
-	Progress int32  `protobuf:"varint,4,opt,name=Progress,proto3" json:"Progress,omitempty"`
+	return 0
 }
 
-func (x *NotifyTranscodingProgressRequest) Reset() {
-	*x = NotifyTranscodingProgressRequest{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_api_proto_msgTypes[13]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
+func (x *PremiereRequest) GetFilePath() string {
+	if x != nil {
+		return x.FilePath
 	}
+	return ""
 }
 
-func (x *NotifyTranscodingProgressRequest) String() string {
-	return protoimpl.X.MessageStringOf(x)
-}
-
-func (*NotifyTranscodingProgressRequest) ProtoMessage() {}
-
-func (x *NotifyTranscodingProgressRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_api_proto_msgTypes[13]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+func (x *PremiereRequest) GetWorkerID() string {
+	if x != nil {
+		return x.WorkerID
 	}
-	return mi.MessageOf(x)
-}
-
-// Deprecated: Use NotifyTranscodingProgressRequest.ProtoReflect.Descriptor instead.
-func (*NotifyTranscodingProgressRequest) Descriptor() ([]byte, []int) {
-	return file_api_proto_rawDescGZIP(), []int{13}
+	return ""
 }
 
-func (x *NotifyTranscodingProgressRequest) GetWorkerID() string {
+func (x *PremiereRequest) GetStreamName() string {
 	if x != nil {
-		return x.WorkerID
+		return x.StreamName
 	}
 	return ""
 }
 
-func (x *NotifyTranscodingProgressRequest) GetStreamId() uint32 {
+func (x *PremiereRequest) GetIngestServer() string {
 	if x != nil {
-		return x.StreamId
+		return x.IngestServer
 	}
-	return 0
+	return ""
 }
 
-func (x *NotifyTranscodingProgressRequest) GetVersion() string {
+func (x *PremiereRequest) GetOutUrl() string {
 	if x != nil {
-		return x.Version
+		return x.OutUrl
 	}
 	return ""
 }
 
-func (x *NotifyTranscodingProgressRequest) GetProgress() int32 {
+func (x *PremiereRequest) GetProfile() *EncodingProfile {
 	if x != nil {
-		return x.Progress
+		return x.Profile
 	}
-	return 0
+	return nil
 }
 
-type JoinWorkersRequest struct {
+type StitchRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Token    string `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`       // token to authenticate the worker
-	Hostname string `protobuf:"bytes,2,opt,name=hostname,proto3" json:"hostname,omitempty"` // hostname of the worker
-}
-
-func (x *JoinWorkersRequest) Reset() {
-	*x = JoinWorkersRequest{}
+	WorkerID   string                 `protobuf:"bytes,1,opt,name=WorkerID,proto3" json:"WorkerID,omitempty"`
+	StreamID   uint32                 `protobuf:"varint,2,opt,name=StreamID,proto3" json:"StreamID,omitempty"`
+	SourceType string                 `protobuf:"bytes,3,opt,name=SourceType,proto3" json:"SourceType,omitempty"`
+	Parts      []string               `protobuf:"bytes,4,rep,name=Parts,proto3" json:"Parts,omitempty"` // transcoded recording parts in order
+	CourseSlug string                 `protobuf:"bytes,5,opt,name=CourseSlug,proto3" json:"CourseSlug,omitempty"`
+	CourseTerm string                 `protobuf:"bytes,6,opt,name=CourseTerm,proto3" json:"CourseTerm,omitempty"`
+	CourseYear uint32                 `protobuf:"varint,7,opt,name=CourseYear,proto3" json:"CourseYear,omitempty"`
+	Start      *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=Start,proto3" json:"Start,omitempty"`
+	End        *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=End,proto3" json:"End,omitempty"`
+	PublishVoD bool                   `protobuf:"varint,10,opt,name=PublishVoD,proto3" json:"PublishVoD,omitempty"`
+	Profile    *EncodingProfile       `protobuf:"bytes,11,opt,name=Profile,proto3" json:"Profile,omitempty"`
+	SlideText  bool                   `protobuf:"varint,12,opt,name=SlideText,proto3" json:"SlideText,omitempty"`
+}
+
+func (x *StitchRequest) Reset() {
+	*x = StitchRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_api_proto_msgTypes[14]
+		mi := &file_api_proto_msgTypes[13]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *JoinWorkersRequest) String() string {
+func (x *StitchRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*JoinWorkersRequest) ProtoMessage() {}
+func (*StitchRequest) ProtoMessage() {}
 
-func (x *JoinWorkersRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_api_proto_msgTypes[14]
+func (x *StitchRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_msgTypes[13]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1035,49 +1146,190 @@ func (x *JoinWorkersRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use JoinWorkersRequest.ProtoReflect.Descriptor instead.
-func (*JoinWorkersRequest) Descriptor() ([]byte, []int) {
-	return file_api_proto_rawDescGZIP(), []int{14}
+// Deprecated: Use StitchRequest.ProtoReflect.Descriptor instead.
+func (*StitchRequest) Descriptor() ([]byte, []int) {
+	return file_api_proto_rawDescGZIP(), []int{13}
 }
 
-func (x *JoinWorkersRequest) GetToken() string {
+func (x *StitchRequest) GetWorkerID() string {
 	if x != nil {
-		return x.Token
+		return x.WorkerID
 	}
 	return ""
 }
 
-func (x *JoinWorkersRequest) GetHostname() string {
+func (x *StitchRequest) GetStreamID() uint32 {
 	if x != nil {
-		return x.Hostname
+		return x.StreamID
 	}
-	return ""
+	return 0
 }
 
-type JoinWorkersResponse struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
-
-	WorkerId string `protobuf:"bytes,1,opt,name=worker_id,json=workerId,proto3" json:"worker_id,omitempty"` // worker id, secret set for further communication in case of success
+func (x *StitchRequest) GetSourceType() string {
+	if x != nil {
+		return x.SourceType
+	}
+	return ""
 }
 
-func (x *JoinWorkersResponse) Reset() {
-	*x = JoinWorkersResponse{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_api_proto_msgTypes[15]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
+func (x *StitchRequest) GetParts() []string {
+	if x != nil {
+		return x.Parts
 	}
+	return nil
 }
 
-func (x *JoinWorkersResponse) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *StitchRequest) GetCourseSlug() string {
+	if x != nil {
+		return x.CourseSlug
+	}
+	return ""
 }
 
-func (*JoinWorkersResponse) ProtoMessage() {}
+func (x *StitchRequest) GetCourseTerm() string {
+	if x != nil {
+		return x.CourseTerm
+	}
+	return ""
+}
 
-func (x *JoinWorkersResponse) ProtoReflect() protoreflect.Message {
+func (x *StitchRequest) GetCourseYear() uint32 {
+	if x != nil {
+		return x.CourseYear
+	}
+	return 0
+}
+
+func (x *StitchRequest) GetStart() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Start
+	}
+	return nil
+}
+
+func (x *StitchRequest) GetEnd() *timestamppb.Timestamp {
+	if x != nil {
+		return x.End
+	}
+	return nil
+}
+
+func (x *StitchRequest) GetPublishVoD() bool {
+	if x != nil {
+		return x.PublishVoD
+	}
+	return false
+}
+
+func (x *StitchRequest) GetProfile() *EncodingProfile {
+	if x != nil {
+		return x.Profile
+	}
+	return nil
+}
+
+func (x *StitchRequest) GetSlideText() bool {
+	if x != nil {
+		return x.SlideText
+	}
+	return false
+}
+
+type EndStreamRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	StreamID   uint32 `protobuf:"varint,1,opt,name=StreamID,proto3" json:"StreamID,omitempty"`
+	WorkerID   string `protobuf:"bytes,2,opt,name=WorkerID,proto3" json:"WorkerID,omitempty"`
+	DiscardVoD bool   `protobuf:"varint,3,opt,name=DiscardVoD,proto3" json:"DiscardVoD,omitempty"`
+	FailedOver bool   `protobuf:"varint,4,opt,name=FailedOver,proto3" json:"FailedOver,omitempty"` // the stream continues on another worker, the recording is only a part of the VoD
+}
+
+func (x *EndStreamRequest) Reset() {
+	*x = EndStreamRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_proto_msgTypes[14]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *EndStreamRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EndStreamRequest) ProtoMessage() {}
+
+func (x *EndStreamRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_msgTypes[14]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EndStreamRequest.ProtoReflect.Descriptor instead.
+func (*EndStreamRequest) Descriptor() ([]byte, []int) {
+	return file_api_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *EndStreamRequest) GetStreamID() uint32 {
+	if x != nil {
+		return x.StreamID
+	}
+	return 0
+}
+
+func (x *EndStreamRequest) GetWorkerID() string {
+	if x != nil {
+		return x.WorkerID
+	}
+	return ""
+}
+
+func (x *EndStreamRequest) GetDiscardVoD() bool {
+	if x != nil {
+		return x.DiscardVoD
+	}
+	return false
+}
+
+func (x *EndStreamRequest) GetFailedOver() bool {
+	if x != nil {
+		return x.FailedOver
+	}
+	return false
+}
+
+type Status struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Ok bool `protobuf:"varint,1,opt,name=ok,proto3" json:"ok,omitempty"`
+}
+
+func (x *Status) Reset() {
+	*x = Status{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_proto_msgTypes[15]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Status) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Status) ProtoMessage() {}
+
+func (x *Status) ProtoReflect() protoreflect.Message {
 	mi := &file_api_proto_msgTypes[15]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1086,48 +1338,861 @@ func (x *JoinWorkersResponse) ProtoReflect() protoreflect.Message {
 		}
 		return ms
 	}
-	return mi.MessageOf(x)
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Status.ProtoReflect.Descriptor instead.
+func (*Status) Descriptor() ([]byte, []int) {
+	return file_api_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *Status) GetOk() bool {
+	if x != nil {
+		return x.Ok
+	}
+	return false
+}
+
+type NotifyTranscodingProgressRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	WorkerID string `protobuf:"bytes,1,opt,name=WorkerID,proto3" json:"WorkerID,omitempty"`
+	StreamId uint32 `protobuf:"varint,2,opt,name=StreamId,proto3" json:"StreamId,omitempty"`
+	Version  string `protobuf:"bytes,3,opt,name=Version,proto3" json:"Version,omitempty"`
+	Progress int32  `protobuf:"varint,4,opt,name=Progress,proto3" json:"Progress,omitempty"`
+}
+
+func (x *NotifyTranscodingProgressRequest) Reset() {
+	*x = NotifyTranscodingProgressRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_proto_msgTypes[16]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *NotifyTranscodingProgressRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*NotifyTranscodingProgressRequest) ProtoMessage() {}
+
+func (x *NotifyTranscodingProgressRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_msgTypes[16]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use NotifyTranscodingProgressRequest.ProtoReflect.Descriptor instead.
+func (*NotifyTranscodingProgressRequest) Descriptor() ([]byte, []int) {
+	return file_api_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *NotifyTranscodingProgressRequest) GetWorkerID() string {
+	if x != nil {
+		return x.WorkerID
+	}
+	return ""
+}
+
+func (x *NotifyTranscodingProgressRequest) GetStreamId() uint32 {
+	if x != nil {
+		return x.StreamId
+	}
+	return 0
+}
+
+func (x *NotifyTranscodingProgressRequest) GetVersion() string {
+	if x != nil {
+		return x.Version
+	}
+	return ""
+}
+
+func (x *NotifyTranscodingProgressRequest) GetProgress() int32 {
+	if x != nil {
+		return x.Progress
+	}
+	return 0
+}
+
+type JoinWorkersRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Token              string `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`                           // token to authenticate the worker
+	Hostname           string `protobuf:"bytes,2,opt,name=hostname,proto3" json:"hostname,omitempty"`                     // hostname of the worker
+	CertificateRequest string `protobuf:"bytes,3,opt,name=CertificateRequest,proto3" json:"CertificateRequest,omitempty"` // pem encoded CSR, required if TUM-Live uses mutual TLS
+}
+
+func (x *JoinWorkersRequest) Reset() {
+	*x = JoinWorkersRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_proto_msgTypes[17]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *JoinWorkersRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*JoinWorkersRequest) ProtoMessage() {}
+
+func (x *JoinWorkersRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_msgTypes[17]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use JoinWorkersRequest.ProtoReflect.Descriptor instead.
+func (*JoinWorkersRequest) Descriptor() ([]byte, []int) {
+	return file_api_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *JoinWorkersRequest) GetToken() string {
+	if x != nil {
+		return x.Token
+	}
+	return ""
+}
+
+func (x *JoinWorkersRequest) GetHostname() string {
+	if x != nil {
+		return x.Hostname
+	}
+	return ""
+}
+
+func (x *JoinWorkersRequest) GetCertificateRequest() string {
+	if x != nil {
+		return x.CertificateRequest
+	}
+	return ""
+}
+
+type JoinWorkersResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	WorkerId    string `protobuf:"bytes,1,opt,name=worker_id,json=workerId,proto3" json:"worker_id,omitempty"` // worker id, secret set for further communication in case of success
+	Certificate string `protobuf:"bytes,2,opt,name=Certificate,proto3" json:"Certificate,omitempty"`           // pem encoded client certificate of the worker, empty without mutual TLS
+}
+
+func (x *JoinWorkersResponse) Reset() {
+	*x = JoinWorkersResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_proto_msgTypes[18]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *JoinWorkersResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*JoinWorkersResponse) ProtoMessage() {}
+
+func (x *JoinWorkersResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_msgTypes[18]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use JoinWorkersResponse.ProtoReflect.Descriptor instead.
+func (*JoinWorkersResponse) Descriptor() ([]byte, []int) {
+	return file_api_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *JoinWorkersResponse) GetWorkerId() string {
+	if x != nil {
+		return x.WorkerId
+	}
+	return ""
+}
+
+func (x *JoinWorkersResponse) GetCertificate() string {
+	if x != nil {
+		return x.Certificate
+	}
+	return ""
+}
+
+type RenewCertificateRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	WorkerID           string `protobuf:"bytes,1,opt,name=WorkerID,proto3" json:"WorkerID,omitempty"`
+	CertificateRequest string `protobuf:"bytes,2,opt,name=CertificateRequest,proto3" json:"CertificateRequest,omitempty"` // pem encoded CSR
+}
+
+func (x *RenewCertificateRequest) Reset() {
+	*x = RenewCertificateRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_proto_msgTypes[19]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RenewCertificateRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RenewCertificateRequest) ProtoMessage() {}
+
+func (x *RenewCertificateRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_msgTypes[19]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RenewCertificateRequest.ProtoReflect.Descriptor instead.
+func (*RenewCertificateRequest) Descriptor() ([]byte, []int) {
+	return file_api_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *RenewCertificateRequest) GetWorkerID() string {
+	if x != nil {
+		return x.WorkerID
+	}
+	return ""
+}
+
+func (x *RenewCertificateRequest) GetCertificateRequest() string {
+	if x != nil {
+		return x.CertificateRequest
+	}
+	return ""
+}
+
+type RenewCertificateResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Certificate string `protobuf:"bytes,1,opt,name=Certificate,proto3" json:"Certificate,omitempty"` // pem encoded client certificate of the worker
+}
+
+func (x *RenewCertificateResponse) Reset() {
+	*x = RenewCertificateResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_proto_msgTypes[20]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RenewCertificateResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RenewCertificateResponse) ProtoMessage() {}
+
+func (x *RenewCertificateResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_msgTypes[20]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RenewCertificateResponse.ProtoReflect.Descriptor instead.
+func (*RenewCertificateResponse) Descriptor() ([]byte, []int) {
+	return file_api_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *RenewCertificateResponse) GetCertificate() string {
+	if x != nil {
+		return x.Certificate
+	}
+	return ""
+}
+
+type SelfStreamRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	WorkerID   string `protobuf:"bytes,1,opt,name=WorkerID,proto3" json:"WorkerID,omitempty"`
+	StreamKey  string `protobuf:"bytes,2,opt,name=StreamKey,proto3" json:"StreamKey,omitempty"`
+	CourseSlug string `protobuf:"bytes,3,opt,name=CourseSlug,proto3" json:"CourseSlug,omitempty"`
+}
+
+func (x *SelfStreamRequest) Reset() {
+	*x = SelfStreamRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_proto_msgTypes[21]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SelfStreamRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SelfStreamRequest) ProtoMessage() {}
+
+func (x *SelfStreamRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_msgTypes[21]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SelfStreamRequest.ProtoReflect.Descriptor instead.
+func (*SelfStreamRequest) Descriptor() ([]byte, []int) {
+	return file_api_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *SelfStreamRequest) GetWorkerID() string {
+	if x != nil {
+		return x.WorkerID
+	}
+	return ""
+}
+
+func (x *SelfStreamRequest) GetStreamKey() string {
+	if x != nil {
+		return x.StreamKey
+	}
+	return ""
+}
+
+func (x *SelfStreamRequest) GetCourseSlug() string {
+	if x != nil {
+		return x.CourseSlug
+	}
+	return ""
+}
+
+type SelfStreamResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	StreamID     uint32                 `protobuf:"varint,1,opt,name=StreamID,proto3" json:"StreamID,omitempty"`
+	CourseSlug   string                 `protobuf:"bytes,2,opt,name=CourseSlug,proto3" json:"CourseSlug,omitempty"`
+	CourseYear   uint32                 `protobuf:"varint,3,opt,name=CourseYear,proto3" json:"CourseYear,omitempty"`
+	StreamStart  *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=StreamStart,proto3" json:"StreamStart,omitempty"`
+	CourseTerm   string                 `protobuf:"bytes,5,opt,name=CourseTerm,proto3" json:"CourseTerm,omitempty"`
+	UploadVoD    bool                   `protobuf:"varint,6,opt,name=uploadVoD,proto3" json:"uploadVoD,omitempty"`
+	IngestServer string                 `protobuf:"bytes,7,opt,name=IngestServer,proto3" json:"IngestServer,omitempty"`
+	StreamName   string                 `protobuf:"bytes,8,opt,name=StreamName,proto3" json:"StreamName,omitempty"`
+	OutUrl       string                 `protobuf:"bytes,9,opt,name=OutUrl,proto3" json:"OutUrl,omitempty"`
+	Renditions   []*Rendition           `protobuf:"bytes,10,rep,name=Renditions,proto3" json:"Renditions,omitempty"`
+	LowLatency   bool                   `protobuf:"varint,11,opt,name=LowLatency,proto3" json:"LowLatency,omitempty"`
+	Dvr          bool                   `protobuf:"varint,12,opt,name=Dvr,proto3" json:"Dvr,omitempty"`
+	DvrWindow    uint32                 `protobuf:"varint,13,opt,name=DvrWindow,proto3" json:"DvrWindow,omitempty"`
+	Profile      *EncodingProfile       `protobuf:"bytes,14,opt,name=Profile,proto3" json:"Profile,omitempty"`
+}
+
+func (x *SelfStreamResponse) Reset() {
+	*x = SelfStreamResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_proto_msgTypes[22]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SelfStreamResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SelfStreamResponse) ProtoMessage() {}
+
+func (x *SelfStreamResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_msgTypes[22]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SelfStreamResponse.ProtoReflect.Descriptor instead.
+func (*SelfStreamResponse) Descriptor() ([]byte, []int) {
+	return file_api_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *SelfStreamResponse) GetStreamID() uint32 {
+	if x != nil {
+		return x.StreamID
+	}
+	return 0
+}
+
+func (x *SelfStreamResponse) GetCourseSlug() string {
+	if x != nil {
+		return x.CourseSlug
+	}
+	return ""
+}
+
+func (x *SelfStreamResponse) GetCourseYear() uint32 {
+	if x != nil {
+		return x.CourseYear
+	}
+	return 0
+}
+
+func (x *SelfStreamResponse) GetStreamStart() *timestamppb.Timestamp {
+	if x != nil {
+		return x.StreamStart
+	}
+	return nil
+}
+
+func (x *SelfStreamResponse) GetCourseTerm() string {
+	if x != nil {
+		return x.CourseTerm
+	}
+	return ""
+}
+
+func (x *SelfStreamResponse) GetUploadVoD() bool {
+	if x != nil {
+		return x.UploadVoD
+	}
+	return false
+}
+
+func (x *SelfStreamResponse) GetIngestServer() string {
+	if x != nil {
+		return x.IngestServer
+	}
+	return ""
+}
+
+func (x *SelfStreamResponse) GetStreamName() string {
+	if x != nil {
+		return x.StreamName
+	}
+	return ""
+}
+
+func (x *SelfStreamResponse) GetOutUrl() string {
+	if x != nil {
+		return x.OutUrl
+	}
+	return ""
+}
+
+func (x *SelfStreamResponse) GetRenditions() []*Rendition {
+	if x != nil {
+		return x.Renditions
+	}
+	return nil
+}
+
+func (x *SelfStreamResponse) GetLowLatency() bool {
+	if x != nil {
+		return x.LowLatency
+	}
+	return false
+}
+
+func (x *SelfStreamResponse) GetDvr() bool {
+	if x != nil {
+		return x.Dvr
+	}
+	return false
+}
+
+func (x *SelfStreamResponse) GetDvrWindow() uint32 {
+	if x != nil {
+		return x.DvrWindow
+	}
+	return 0
+}
+
+func (x *SelfStreamResponse) GetProfile() *EncodingProfile {
+	if x != nil {
+		return x.Profile
+	}
+	return nil
+}
+
+type HeartBeat struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	WorkerID string   `protobuf:"bytes,1,opt,name=WorkerID,proto3" json:"WorkerID,omitempty"`
+	Workload uint32   `protobuf:"varint,2,opt,name=Workload,proto3" json:"Workload,omitempty"`
+	Jobs     []string `protobuf:"bytes,3,rep,name=Jobs,proto3" json:"Jobs,omitempty"`
+	Version  string   `protobuf:"bytes,4,opt,name=Version,proto3" json:"Version,omitempty"`
+	CPU      string   `protobuf:"bytes,5,opt,name=CPU,proto3" json:"CPU,omitempty"`
+	Memory   string   `protobuf:"bytes,6,opt,name=Memory,proto3" json:"Memory,omitempty"`
+	Disk     string   `protobuf:"bytes,7,opt,name=Disk,proto3" json:"Disk,omitempty"`
+	Uptime   string   `protobuf:"bytes,8,opt,name=Uptime,proto3" json:"Uptime,omitempty"`
+	// resources for scheduling, the strings above are for humans
+	CpuPercent   float64  `protobuf:"fixed64,9,opt,name=CpuPercent,proto3" json:"CpuPercent,omitempty"`
+	MemAvailable uint64   `protobuf:"varint,10,opt,name=MemAvailable,proto3" json:"MemAvailable,omitempty"` // bytes
+	MemTotal     uint64   `protobuf:"varint,11,opt,name=MemTotal,proto3" json:"MemTotal,omitempty"`
+	DiskUsed     uint64   `protobuf:"varint,12,opt,name=DiskUsed,proto3" json:"DiskUsed,omitempty"`
+	DiskTotal    uint64   `protobuf:"varint,13,opt,name=DiskTotal,proto3" json:"DiskTotal,omitempty"`
+	Tags         []string `protobuf:"bytes,14,rep,name=Tags,proto3" json:"Tags,omitempty"` // capabilities of the worker, e.g. has-gpu-encoder
+}
+
+func (x *HeartBeat) Reset() {
+	*x = HeartBeat{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_proto_msgTypes[23]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *HeartBeat) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*HeartBeat) ProtoMessage() {}
+
+func (x *HeartBeat) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_msgTypes[23]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use HeartBeat.ProtoReflect.Descriptor instead.
+func (*HeartBeat) Descriptor() ([]byte, []int) {
+	return file_api_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *HeartBeat) GetWorkerID() string {
+	if x != nil {
+		return x.WorkerID
+	}
+	return ""
+}
+
+func (x *HeartBeat) GetWorkload() uint32 {
+	if x != nil {
+		return x.Workload
+	}
+	return 0
+}
+
+func (x *HeartBeat) GetJobs() []string {
+	if x != nil {
+		return x.Jobs
+	}
+	return nil
+}
+
+func (x *HeartBeat) GetVersion() string {
+	if x != nil {
+		return x.Version
+	}
+	return ""
+}
+
+func (x *HeartBeat) GetCPU() string {
+	if x != nil {
+		return x.CPU
+	}
+	return ""
+}
+
+func (x *HeartBeat) GetMemory() string {
+	if x != nil {
+		return x.Memory
+	}
+	return ""
+}
+
+func (x *HeartBeat) GetDisk() string {
+	if x != nil {
+		return x.Disk
+	}
+	return ""
+}
+
+func (x *HeartBeat) GetUptime() string {
+	if x != nil {
+		return x.Uptime
+	}
+	return ""
+}
+
+func (x *HeartBeat) GetCpuPercent() float64 {
+	if x != nil {
+		return x.CpuPercent
+	}
+	return 0
+}
+
+func (x *HeartBeat) GetMemAvailable() uint64 {
+	if x != nil {
+		return x.MemAvailable
+	}
+	return 0
+}
+
+func (x *HeartBeat) GetMemTotal() uint64 {
+	if x != nil {
+		return x.MemTotal
+	}
+	return 0
+}
+
+func (x *HeartBeat) GetDiskUsed() uint64 {
+	if x != nil {
+		return x.DiskUsed
+	}
+	return 0
+}
+
+func (x *HeartBeat) GetDiskTotal() uint64 {
+	if x != nil {
+		return x.DiskTotal
+	}
+	return 0
+}
+
+func (x *HeartBeat) GetTags() []string {
+	if x != nil {
+		return x.Tags
+	}
+	return nil
+}
+
+type StreamFinished struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	WorkerID   string `protobuf:"bytes,1,opt,name=WorkerID,proto3" json:"WorkerID,omitempty"`
+	StreamID   uint32 `protobuf:"varint,2,opt,name=StreamID,proto3" json:"StreamID,omitempty"`
+	SourceType string `protobuf:"bytes,3,opt,name=SourceType,proto3" json:"SourceType,omitempty"`
+}
+
+func (x *StreamFinished) Reset() {
+	*x = StreamFinished{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_proto_msgTypes[24]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *StreamFinished) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StreamFinished) ProtoMessage() {}
+
+func (x *StreamFinished) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_msgTypes[24]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StreamFinished.ProtoReflect.Descriptor instead.
+func (*StreamFinished) Descriptor() ([]byte, []int) {
+	return file_api_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *StreamFinished) GetWorkerID() string {
+	if x != nil {
+		return x.WorkerID
+	}
+	return ""
+}
+
+func (x *StreamFinished) GetStreamID() uint32 {
+	if x != nil {
+		return x.StreamID
+	}
+	return 0
+}
+
+func (x *StreamFinished) GetSourceType() string {
+	if x != nil {
+		return x.SourceType
+	}
+	return ""
+}
+
+type ThumbnailsFinished struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	WorkerID           string `protobuf:"bytes,1,opt,name=WorkerID,proto3" json:"WorkerID,omitempty"`
+	StreamID           uint32 `protobuf:"varint,2,opt,name=StreamID,proto3" json:"StreamID,omitempty"`
+	FilePath           string `protobuf:"bytes,3,opt,name=FilePath,proto3" json:"FilePath,omitempty"`
+	Interval           uint32 `protobuf:"varint,4,opt,name=Interval,proto3" json:"Interval,omitempty"`
+	SourceType         string `protobuf:"bytes,5,opt,name=SourceType,proto3" json:"SourceType,omitempty"`
+	LargeThumbnailPath string `protobuf:"bytes,6,opt,name=LargeThumbnailPath,proto3" json:"LargeThumbnailPath,omitempty"`
+}
+
+func (x *ThumbnailsFinished) Reset() {
+	*x = ThumbnailsFinished{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_proto_msgTypes[25]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ThumbnailsFinished) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ThumbnailsFinished) ProtoMessage() {}
+
+func (x *ThumbnailsFinished) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_msgTypes[25]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ThumbnailsFinished.ProtoReflect.Descriptor instead.
+func (*ThumbnailsFinished) Descriptor() ([]byte, []int) {
+	return file_api_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *ThumbnailsFinished) GetWorkerID() string {
+	if x != nil {
+		return x.WorkerID
+	}
+	return ""
+}
+
+func (x *ThumbnailsFinished) GetStreamID() uint32 {
+	if x != nil {
+		return x.StreamID
+	}
+	return 0
+}
+
+func (x *ThumbnailsFinished) GetFilePath() string {
+	if x != nil {
+		return x.FilePath
+	}
+	return ""
+}
+
+func (x *ThumbnailsFinished) GetInterval() uint32 {
+	if x != nil {
+		return x.Interval
+	}
+	return 0
 }
 
-// Deprecated: Use JoinWorkersResponse.ProtoReflect.Descriptor instead.
-func (*JoinWorkersResponse) Descriptor() ([]byte, []int) {
-	return file_api_proto_rawDescGZIP(), []int{15}
+func (x *ThumbnailsFinished) GetSourceType() string {
+	if x != nil {
+		return x.SourceType
+	}
+	return ""
 }
 
-func (x *JoinWorkersResponse) GetWorkerId() string {
+func (x *ThumbnailsFinished) GetLargeThumbnailPath() string {
 	if x != nil {
-		return x.WorkerId
+		return x.LargeThumbnailPath
 	}
 	return ""
 }
 
-type SelfStreamRequest struct {
+type TranscodingFinished struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	WorkerID   string `protobuf:"bytes,1,opt,name=WorkerID,proto3" json:"WorkerID,omitempty"`
-	StreamKey  string `protobuf:"bytes,2,opt,name=StreamKey,proto3" json:"StreamKey,omitempty"`
-	CourseSlug string `protobuf:"bytes,3,opt,name=CourseSlug,proto3" json:"CourseSlug,omitempty"`
+	WorkerID   string    `protobuf:"bytes,1,opt,name=WorkerID,proto3" json:"WorkerID,omitempty"`
+	StreamID   uint32    `protobuf:"varint,2,opt,name=StreamID,proto3" json:"StreamID,omitempty"`
+	FilePath   string    `protobuf:"bytes,3,opt,name=FilePath,proto3" json:"FilePath,omitempty"`
+	Duration   uint32    `protobuf:"varint,4,opt,name=Duration,proto3" json:"Duration,omitempty"`
+	SourceType string    `protobuf:"bytes,5,opt,name=SourceType,proto3" json:"SourceType,omitempty"`
+	Partial    bool      `protobuf:"varint,6,opt,name=Partial,proto3" json:"Partial,omitempty"` // the file is a part of the recording that is stitched into the VoD later
+	Part       uint32    `protobuf:"varint,7,opt,name=Part,proto3" json:"Part,omitempty"`
+	Codec      string    `protobuf:"bytes,8,opt,name=Codec,proto3" json:"Codec,omitempty"`       // hevc, av1 or aac (audio-only) if the file is an additional rendition of the VoD, empty for the H.264 VoD
+	Loudness   *Loudness `protobuf:"bytes,9,opt,name=Loudness,proto3" json:"Loudness,omitempty"` // of the recording before normalisation, unset if it couldn't be measured
 }
 
-func (x *SelfStreamRequest) Reset() {
-	*x = SelfStreamRequest{}
+func (x *TranscodingFinished) Reset() {
+	*x = TranscodingFinished{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_api_proto_msgTypes[16]
+		mi := &file_api_proto_msgTypes[26]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *SelfStreamRequest) String() string {
+func (x *TranscodingFinished) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SelfStreamRequest) ProtoMessage() {}
+func (*TranscodingFinished) ProtoMessage() {}
 
-func (x *SelfStreamRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_api_proto_msgTypes[16]
+func (x *TranscodingFinished) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_msgTypes[26]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1138,65 +2203,101 @@ func (x *SelfStreamRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SelfStreamRequest.ProtoReflect.Descriptor instead.
-func (*SelfStreamRequest) Descriptor() ([]byte, []int) {
-	return file_api_proto_rawDescGZIP(), []int{16}
+// Deprecated: Use TranscodingFinished.ProtoReflect.Descriptor instead.
+func (*TranscodingFinished) Descriptor() ([]byte, []int) {
+	return file_api_proto_rawDescGZIP(), []int{26}
 }
 
-func (x *SelfStreamRequest) GetWorkerID() string {
+func (x *TranscodingFinished) GetWorkerID() string {
 	if x != nil {
 		return x.WorkerID
 	}
 	return ""
 }
 
-func (x *SelfStreamRequest) GetStreamKey() string {
+func (x *TranscodingFinished) GetStreamID() uint32 {
 	if x != nil {
-		return x.StreamKey
+		return x.StreamID
+	}
+	return 0
+}
+
+func (x *TranscodingFinished) GetFilePath() string {
+	if x != nil {
+		return x.FilePath
 	}
 	return ""
 }
 
-func (x *SelfStreamRequest) GetCourseSlug() string {
+func (x *TranscodingFinished) GetDuration() uint32 {
 	if x != nil {
-		return x.CourseSlug
+		return x.Duration
+	}
+	return 0
+}
+
+func (x *TranscodingFinished) GetSourceType() string {
+	if x != nil {
+		return x.SourceType
 	}
 	return ""
 }
 
-type SelfStreamResponse struct {
+func (x *TranscodingFinished) GetPartial() bool {
+	if x != nil {
+		return x.Partial
+	}
+	return false
+}
+
+func (x *TranscodingFinished) GetPart() uint32 {
+	if x != nil {
+		return x.Part
+	}
+	return 0
+}
+
+func (x *TranscodingFinished) GetCodec() string {
+	if x != nil {
+		return x.Codec
+	}
+	return ""
+}
+
+func (x *TranscodingFinished) GetLoudness() *Loudness {
+	if x != nil {
+		return x.Loudness
+	}
+	return nil
+}
+
+// Loudness is the loudness of a recording according to EBU R128.
+type Loudness struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	StreamID     uint32                 `protobuf:"varint,1,opt,name=StreamID,proto3" json:"StreamID,omitempty"`
-	CourseSlug   string                 `protobuf:"bytes,2,opt,name=CourseSlug,proto3" json:"CourseSlug,omitempty"`
-	CourseYear   uint32                 `protobuf:"varint,3,opt,name=CourseYear,proto3" json:"CourseYear,omitempty"`
-	StreamStart  *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=StreamStart,proto3" json:"StreamStart,omitempty"`
-	CourseTerm   string                 `protobuf:"bytes,5,opt,name=CourseTerm,proto3" json:"CourseTerm,omitempty"`
-	UploadVoD    bool                   `protobuf:"varint,6,opt,name=uploadVoD,proto3" json:"uploadVoD,omitempty"`
-	IngestServer string                 `protobuf:"bytes,7,opt,name=IngestServer,proto3" json:"IngestServer,omitempty"`
-	StreamName   string                 `protobuf:"bytes,8,opt,name=StreamName,proto3" json:"StreamName,omitempty"`
-	OutUrl       string                 `protobuf:"bytes,9,opt,name=OutUrl,proto3" json:"OutUrl,omitempty"`
+	Integrated float64 `protobuf:"fixed64,1,opt,name=Integrated,proto3" json:"Integrated,omitempty"` // LUFS
+	TruePeak   float64 `protobuf:"fixed64,2,opt,name=TruePeak,proto3" json:"TruePeak,omitempty"`     // dBTP
 }
 
-func (x *SelfStreamResponse) Reset() {
-	*x = SelfStreamResponse{}
+func (x *Loudness) Reset() {
+	*x = Loudness{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_api_proto_msgTypes[17]
+		mi := &file_api_proto_msgTypes[27]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *SelfStreamResponse) String() string {
+func (x *Loudness) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SelfStreamResponse) ProtoMessage() {}
+func (*Loudness) ProtoMessage() {}
 
-func (x *SelfStreamResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_api_proto_msgTypes[17]
+func (x *Loudness) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_msgTypes[27]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1207,106 +2308,209 @@ func (x *SelfStreamResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SelfStreamResponse.ProtoReflect.Descriptor instead.
-func (*SelfStreamResponse) Descriptor() ([]byte, []int) {
-	return file_api_proto_rawDescGZIP(), []int{17}
+// Deprecated: Use Loudness.ProtoReflect.Descriptor instead.
+func (*Loudness) Descriptor() ([]byte, []int) {
+	return file_api_proto_rawDescGZIP(), []int{27}
 }
 
-func (x *SelfStreamResponse) GetStreamID() uint32 {
+func (x *Loudness) GetIntegrated() float64 {
 	if x != nil {
-		return x.StreamID
+		return x.Integrated
 	}
 	return 0
 }
 
-func (x *SelfStreamResponse) GetCourseSlug() string {
+func (x *Loudness) GetTruePeak() float64 {
 	if x != nil {
-		return x.CourseSlug
+		return x.TruePeak
+	}
+	return 0
+}
+
+type UploadFinished struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	WorkerID     string `protobuf:"bytes,1,opt,name=WorkerID,proto3" json:"WorkerID,omitempty"`
+	StreamID     uint32 `protobuf:"varint,2,opt,name=StreamID,proto3" json:"StreamID,omitempty"`
+	HLSUrl       string `protobuf:"bytes,4,opt,name=HLSUrl,proto3" json:"HLSUrl,omitempty"`
+	SourceType   string `protobuf:"bytes,5,opt,name=SourceType,proto3" json:"SourceType,omitempty"`
+	ThumbnailUrl string `protobuf:"bytes,6,opt,name=ThumbnailUrl,proto3" json:"ThumbnailUrl,omitempty"`
+}
+
+func (x *UploadFinished) Reset() {
+	*x = UploadFinished{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_proto_msgTypes[28]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UploadFinished) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UploadFinished) ProtoMessage() {}
+
+func (x *UploadFinished) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_msgTypes[28]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UploadFinished.ProtoReflect.Descriptor instead.
+func (*UploadFinished) Descriptor() ([]byte, []int) {
+	return file_api_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *UploadFinished) GetWorkerID() string {
+	if x != nil {
+		return x.WorkerID
 	}
 	return ""
 }
 
-func (x *SelfStreamResponse) GetCourseYear() uint32 {
+func (x *UploadFinished) GetStreamID() uint32 {
 	if x != nil {
-		return x.CourseYear
+		return x.StreamID
 	}
 	return 0
 }
 
-func (x *SelfStreamResponse) GetStreamStart() *timestamppb.Timestamp {
+func (x *UploadFinished) GetHLSUrl() string {
 	if x != nil {
-		return x.StreamStart
+		return x.HLSUrl
 	}
-	return nil
+	return ""
 }
 
-func (x *SelfStreamResponse) GetCourseTerm() string {
+func (x *UploadFinished) GetSourceType() string {
 	if x != nil {
-		return x.CourseTerm
+		return x.SourceType
 	}
 	return ""
 }
 
-func (x *SelfStreamResponse) GetUploadVoD() bool {
+func (x *UploadFinished) GetThumbnailUrl() string {
 	if x != nil {
-		return x.UploadVoD
+		return x.ThumbnailUrl
 	}
-	return false
+	return ""
 }
 
-func (x *SelfStreamResponse) GetIngestServer() string {
+type StreamStarted struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	WorkerID   string `protobuf:"bytes,1,opt,name=WorkerID,proto3" json:"WorkerID,omitempty"`
+	StreamID   uint32 `protobuf:"varint,2,opt,name=StreamID,proto3" json:"StreamID,omitempty"`
+	HlsUrl     string `protobuf:"bytes,3,opt,name=HlsUrl,proto3" json:"HlsUrl,omitempty"`
+	SourceType string `protobuf:"bytes,5,opt,name=SourceType,proto3" json:"SourceType,omitempty"`
+}
+
+func (x *StreamStarted) Reset() {
+	*x = StreamStarted{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_proto_msgTypes[29]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *StreamStarted) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StreamStarted) ProtoMessage() {}
+
+func (x *StreamStarted) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_msgTypes[29]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StreamStarted.ProtoReflect.Descriptor instead.
+func (*StreamStarted) Descriptor() ([]byte, []int) {
+	return file_api_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *StreamStarted) GetWorkerID() string {
 	if x != nil {
-		return x.IngestServer
+		return x.WorkerID
 	}
 	return ""
 }
 
-func (x *SelfStreamResponse) GetStreamName() string {
+func (x *StreamStarted) GetStreamID() uint32 {
 	if x != nil {
-		return x.StreamName
+		return x.StreamID
+	}
+	return 0
+}
+
+func (x *StreamStarted) GetHlsUrl() string {
+	if x != nil {
+		return x.HlsUrl
 	}
 	return ""
 }
 
-func (x *SelfStreamResponse) GetOutUrl() string {
+func (x *StreamStarted) GetSourceType() string {
 	if x != nil {
-		return x.OutUrl
+		return x.SourceType
 	}
 	return ""
 }
 
-type HeartBeat struct {
+type StreamHealth struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	WorkerID string   `protobuf:"bytes,1,opt,name=WorkerID,proto3" json:"WorkerID,omitempty"`
-	Workload uint32   `protobuf:"varint,2,opt,name=Workload,proto3" json:"Workload,omitempty"`
-	Jobs     []string `protobuf:"bytes,3,rep,name=Jobs,proto3" json:"Jobs,omitempty"`
-	Version  string   `protobuf:"bytes,4,opt,name=Version,proto3" json:"Version,omitempty"`
-	CPU      string   `protobuf:"bytes,5,opt,name=CPU,proto3" json:"CPU,omitempty"`
-	Memory   string   `protobuf:"bytes,6,opt,name=Memory,proto3" json:"Memory,omitempty"`
-	Disk     string   `protobuf:"bytes,7,opt,name=Disk,proto3" json:"Disk,omitempty"`
-	Uptime   string   `protobuf:"bytes,8,opt,name=Uptime,proto3" json:"Uptime,omitempty"`
+	WorkerID         string                 `protobuf:"bytes,1,opt,name=WorkerID,proto3" json:"WorkerID,omitempty"`
+	StreamID         uint32                 `protobuf:"varint,2,opt,name=StreamID,proto3" json:"StreamID,omitempty"`
+	Version          string                 `protobuf:"bytes,3,opt,name=Version,proto3" json:"Version,omitempty"` // e.g. COMB, PRES or CAM
+	Time             *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=Time,proto3" json:"Time,omitempty"`
+	Bitrate          float32                `protobuf:"fixed32,5,opt,name=Bitrate,proto3" json:"Bitrate,omitempty"` // kbit/s of the recording
+	Fps              float32                `protobuf:"fixed32,6,opt,name=Fps,proto3" json:"Fps,omitempty"`
+	DroppedFrames    uint64                 `protobuf:"varint,7,opt,name=DroppedFrames,proto3" json:"DroppedFrames,omitempty"`       // total since ffmpeg started
+	DuplicatedFrames uint64                 `protobuf:"varint,8,opt,name=DuplicatedFrames,proto3" json:"DuplicatedFrames,omitempty"` // total since ffmpeg started
+	Speed            float32                `protobuf:"fixed32,9,opt,name=Speed,proto3" json:"Speed,omitempty"`                      // 1 if ffmpeg keeps up with the input
+	Stalled          bool                   `protobuf:"varint,10,opt,name=Stalled,proto3" json:"Stalled,omitempty"`                  // no input was read since the last report
 }
 
-func (x *HeartBeat) Reset() {
-	*x = HeartBeat{}
+func (x *StreamHealth) Reset() {
+	*x = StreamHealth{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_api_proto_msgTypes[18]
+		mi := &file_api_proto_msgTypes[30]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *HeartBeat) String() string {
+func (x *StreamHealth) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*HeartBeat) ProtoMessage() {}
+func (*StreamHealth) ProtoMessage() {}
 
-func (x *HeartBeat) ProtoReflect() protoreflect.Message {
-	mi := &file_api_proto_msgTypes[18]
+func (x *StreamHealth) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_msgTypes[30]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1317,93 +2521,111 @@ func (x *HeartBeat) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use HeartBeat.ProtoReflect.Descriptor instead.
-func (*HeartBeat) Descriptor() ([]byte, []int) {
-	return file_api_proto_rawDescGZIP(), []int{18}
+// Deprecated: Use StreamHealth.ProtoReflect.Descriptor instead.
+func (*StreamHealth) Descriptor() ([]byte, []int) {
+	return file_api_proto_rawDescGZIP(), []int{30}
 }
 
-func (x *HeartBeat) GetWorkerID() string {
+func (x *StreamHealth) GetWorkerID() string {
 	if x != nil {
 		return x.WorkerID
 	}
 	return ""
 }
 
-func (x *HeartBeat) GetWorkload() uint32 {
+func (x *StreamHealth) GetStreamID() uint32 {
 	if x != nil {
-		return x.Workload
+		return x.StreamID
 	}
 	return 0
 }
 
-func (x *HeartBeat) GetJobs() []string {
+func (x *StreamHealth) GetVersion() string {
 	if x != nil {
-		return x.Jobs
+		return x.Version
+	}
+	return ""
+}
+
+func (x *StreamHealth) GetTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Time
 	}
 	return nil
 }
 
-func (x *HeartBeat) GetVersion() string {
+func (x *StreamHealth) GetBitrate() float32 {
 	if x != nil {
-		return x.Version
+		return x.Bitrate
 	}
-	return ""
+	return 0
 }
 
-func (x *HeartBeat) GetCPU() string {
+func (x *StreamHealth) GetFps() float32 {
 	if x != nil {
-		return x.CPU
+		return x.Fps
 	}
-	return ""
+	return 0
 }
 
-func (x *HeartBeat) GetMemory() string {
+func (x *StreamHealth) GetDroppedFrames() uint64 {
 	if x != nil {
-		return x.Memory
+		return x.DroppedFrames
 	}
-	return ""
+	return 0
 }
 
-func (x *HeartBeat) GetDisk() string {
+func (x *StreamHealth) GetDuplicatedFrames() uint64 {
 	if x != nil {
-		return x.Disk
+		return x.DuplicatedFrames
 	}
-	return ""
+	return 0
 }
 
-func (x *HeartBeat) GetUptime() string {
+func (x *StreamHealth) GetSpeed() float32 {
 	if x != nil {
-		return x.Uptime
+		return x.Speed
 	}
-	return ""
+	return 0
 }
 
-type StreamFinished struct {
+func (x *StreamHealth) GetStalled() bool {
+	if x != nil {
+		return x.Stalled
+	}
+	return false
+}
+
+type StreamIncident struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	WorkerID string `protobuf:"bytes,1,opt,name=WorkerID,proto3" json:"WorkerID,omitempty"`
-	StreamID uint32 `protobuf:"varint,2,opt,name=StreamID,proto3" json:"StreamID,omitempty"`
+	WorkerID string                 `protobuf:"bytes,1,opt,name=WorkerID,proto3" json:"WorkerID,omitempty"`
+	StreamID uint32                 `protobuf:"varint,2,opt,name=StreamID,proto3" json:"StreamID,omitempty"`
+	Version  string                 `protobuf:"bytes,3,opt,name=Version,proto3" json:"Version,omitempty"` // e.g. COMB, PRES or CAM
+	Type     string                 `protobuf:"bytes,4,opt,name=Type,proto3" json:"Type,omitempty"`       // black, freeze or silence
+	Start    *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=Start,proto3" json:"Start,omitempty"`
+	End      *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=End,proto3" json:"End,omitempty"` // set once the incident is over
 }
 
-func (x *StreamFinished) Reset() {
-	*x = StreamFinished{}
+func (x *StreamIncident) Reset() {
+	*x = StreamIncident{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_api_proto_msgTypes[19]
+		mi := &file_api_proto_msgTypes[31]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *StreamFinished) String() string {
+func (x *StreamIncident) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*StreamFinished) ProtoMessage() {}
+func (*StreamIncident) ProtoMessage() {}
 
-func (x *StreamFinished) ProtoReflect() protoreflect.Message {
-	mi := &file_api_proto_msgTypes[19]
+func (x *StreamIncident) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_msgTypes[31]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1414,55 +2636,81 @@ func (x *StreamFinished) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use StreamFinished.ProtoReflect.Descriptor instead.
-func (*StreamFinished) Descriptor() ([]byte, []int) {
-	return file_api_proto_rawDescGZIP(), []int{19}
+// Deprecated: Use StreamIncident.ProtoReflect.Descriptor instead.
+func (*StreamIncident) Descriptor() ([]byte, []int) {
+	return file_api_proto_rawDescGZIP(), []int{31}
 }
 
-func (x *StreamFinished) GetWorkerID() string {
+func (x *StreamIncident) GetWorkerID() string {
 	if x != nil {
 		return x.WorkerID
 	}
 	return ""
 }
 
-func (x *StreamFinished) GetStreamID() uint32 {
+func (x *StreamIncident) GetStreamID() uint32 {
 	if x != nil {
 		return x.StreamID
 	}
 	return 0
 }
 
-type ThumbnailsFinished struct {
+func (x *StreamIncident) GetVersion() string {
+	if x != nil {
+		return x.Version
+	}
+	return ""
+}
+
+func (x *StreamIncident) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *StreamIncident) GetStart() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Start
+	}
+	return nil
+}
+
+func (x *StreamIncident) GetEnd() *timestamppb.Timestamp {
+	if x != nil {
+		return x.End
+	}
+	return nil
+}
+
+type SilenceResults struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	WorkerID           string `protobuf:"bytes,1,opt,name=WorkerID,proto3" json:"WorkerID,omitempty"`
-	StreamID           uint32 `protobuf:"varint,2,opt,name=StreamID,proto3" json:"StreamID,omitempty"`
-	FilePath           string `protobuf:"bytes,3,opt,name=FilePath,proto3" json:"FilePath,omitempty"`
-	Interval           uint32 `protobuf:"varint,4,opt,name=Interval,proto3" json:"Interval,omitempty"`
-	SourceType         string `protobuf:"bytes,5,opt,name=SourceType,proto3" json:"SourceType,omitempty"`
-	LargeThumbnailPath string `protobuf:"bytes,6,opt,name=LargeThumbnailPath,proto3" json:"LargeThumbnailPath,omitempty"`
+	WorkerID string   `protobuf:"bytes,1,opt,name=WorkerID,proto3" json:"WorkerID,omitempty"`
+	StreamID uint32   `protobuf:"varint,2,opt,name=StreamID,proto3" json:"StreamID,omitempty"`
+	Starts   []uint32 `protobuf:"varint,3,rep,packed,name=starts,proto3" json:"starts,omitempty"`
+	Ends     []uint32 `protobuf:"varint,4,rep,packed,name=ends,proto3" json:"ends,omitempty"`
 }
 
-func (x *ThumbnailsFinished) Reset() {
-	*x = ThumbnailsFinished{}
+func (x *SilenceResults) Reset() {
+	*x = SilenceResults{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_api_proto_msgTypes[20]
+		mi := &file_api_proto_msgTypes[32]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *ThumbnailsFinished) String() string {
+func (x *SilenceResults) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ThumbnailsFinished) ProtoMessage() {}
+func (*SilenceResults) ProtoMessage() {}
 
-func (x *ThumbnailsFinished) ProtoReflect() protoreflect.Message {
-	mi := &file_api_proto_msgTypes[20]
+func (x *SilenceResults) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_msgTypes[32]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1473,82 +2721,65 @@ func (x *ThumbnailsFinished) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ThumbnailsFinished.ProtoReflect.Descriptor instead.
-func (*ThumbnailsFinished) Descriptor() ([]byte, []int) {
-	return file_api_proto_rawDescGZIP(), []int{20}
+// Deprecated: Use SilenceResults.ProtoReflect.Descriptor instead.
+func (*SilenceResults) Descriptor() ([]byte, []int) {
+	return file_api_proto_rawDescGZIP(), []int{32}
 }
 
-func (x *ThumbnailsFinished) GetWorkerID() string {
+func (x *SilenceResults) GetWorkerID() string {
 	if x != nil {
 		return x.WorkerID
 	}
 	return ""
 }
 
-func (x *ThumbnailsFinished) GetStreamID() uint32 {
+func (x *SilenceResults) GetStreamID() uint32 {
 	if x != nil {
 		return x.StreamID
 	}
 	return 0
 }
 
-func (x *ThumbnailsFinished) GetFilePath() string {
-	if x != nil {
-		return x.FilePath
-	}
-	return ""
-}
-
-func (x *ThumbnailsFinished) GetInterval() uint32 {
-	if x != nil {
-		return x.Interval
-	}
-	return 0
-}
-
-func (x *ThumbnailsFinished) GetSourceType() string {
+func (x *SilenceResults) GetStarts() []uint32 {
 	if x != nil {
-		return x.SourceType
+		return x.Starts
 	}
-	return ""
+	return nil
 }
 
-func (x *ThumbnailsFinished) GetLargeThumbnailPath() string {
+func (x *SilenceResults) GetEnds() []uint32 {
 	if x != nil {
-		return x.LargeThumbnailPath
+		return x.Ends
 	}
-	return ""
+	return nil
 }
 
-type TranscodingFinished struct {
+type SectionSuggestion struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	WorkerID   string `protobuf:"bytes,1,opt,name=WorkerID,proto3" json:"WorkerID,omitempty"`
-	StreamID   uint32 `protobuf:"varint,2,opt,name=StreamID,proto3" json:"StreamID,omitempty"`
-	FilePath   string `protobuf:"bytes,3,opt,name=FilePath,proto3" json:"FilePath,omitempty"`
-	Duration   uint32 `protobuf:"varint,4,opt,name=Duration,proto3" json:"Duration,omitempty"`
-	SourceType string `protobuf:"bytes,5,opt,name=SourceType,proto3" json:"SourceType,omitempty"`
+	Start         uint32 `protobuf:"varint,1,opt,name=Start,proto3" json:"Start,omitempty"` // seconds into the VoD
+	ThumbnailPath string `protobuf:"bytes,2,opt,name=ThumbnailPath,proto3" json:"ThumbnailPath,omitempty"`
 }
 
-func (x *TranscodingFinished) Reset() {
-	*x = TranscodingFinished{}
+func (x *SectionSuggestion) Reset() {
+	*x = SectionSuggestion{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_api_proto_msgTypes[21]
+		mi := &file_api_proto_msgTypes[33]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *TranscodingFinished) String() string {
+func (x *SectionSuggestion) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*TranscodingFinished) ProtoMessage() {}
+func (*SectionSuggestion) ProtoMessage() {}
 
-func (x *TranscodingFinished) ProtoReflect() protoreflect.Message {
-	mi := &file_api_proto_msgTypes[21]
+func (x *SectionSuggestion) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_msgTypes[33]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1559,75 +2790,52 @@ func (x *TranscodingFinished) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use TranscodingFinished.ProtoReflect.Descriptor instead.
-func (*TranscodingFinished) Descriptor() ([]byte, []int) {
-	return file_api_proto_rawDescGZIP(), []int{21}
-}
-
-func (x *TranscodingFinished) GetWorkerID() string {
-	if x != nil {
-		return x.WorkerID
-	}
-	return ""
-}
-
-func (x *TranscodingFinished) GetStreamID() uint32 {
-	if x != nil {
-		return x.StreamID
-	}
-	return 0
-}
-
-func (x *TranscodingFinished) GetFilePath() string {
-	if x != nil {
-		return x.FilePath
-	}
-	return ""
+// Deprecated: Use SectionSuggestion.ProtoReflect.Descriptor instead.
+func (*SectionSuggestion) Descriptor() ([]byte, []int) {
+	return file_api_proto_rawDescGZIP(), []int{33}
 }
 
-func (x *TranscodingFinished) GetDuration() uint32 {
+func (x *SectionSuggestion) GetStart() uint32 {
 	if x != nil {
-		return x.Duration
+		return x.Start
 	}
 	return 0
 }
 
-func (x *TranscodingFinished) GetSourceType() string {
+func (x *SectionSuggestion) GetThumbnailPath() string {
 	if x != nil {
-		return x.SourceType
+		return x.ThumbnailPath
 	}
 	return ""
 }
 
-type UploadFinished struct {
+type SectionSuggestions struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	WorkerID     string `protobuf:"bytes,1,opt,name=WorkerID,proto3" json:"WorkerID,omitempty"`
-	StreamID     uint32 `protobuf:"varint,2,opt,name=StreamID,proto3" json:"StreamID,omitempty"`
-	HLSUrl       string `protobuf:"bytes,4,opt,name=HLSUrl,proto3" json:"HLSUrl,omitempty"`
-	SourceType   string `protobuf:"bytes,5,opt,name=SourceType,proto3" json:"SourceType,omitempty"`
-	ThumbnailUrl string `protobuf:"bytes,6,opt,name=ThumbnailUrl,proto3" json:"ThumbnailUrl,omitempty"`
+	WorkerID    string               `protobuf:"bytes,1,opt,name=WorkerID,proto3" json:"WorkerID,omitempty"`
+	StreamID    uint32               `protobuf:"varint,2,opt,name=StreamID,proto3" json:"StreamID,omitempty"`
+	Suggestions []*SectionSuggestion `protobuf:"bytes,3,rep,name=Suggestions,proto3" json:"Suggestions,omitempty"`
 }
 
-func (x *UploadFinished) Reset() {
-	*x = UploadFinished{}
+func (x *SectionSuggestions) Reset() {
+	*x = SectionSuggestions{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_api_proto_msgTypes[22]
+		mi := &file_api_proto_msgTypes[34]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *UploadFinished) String() string {
+func (x *SectionSuggestions) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UploadFinished) ProtoMessage() {}
+func (*SectionSuggestions) ProtoMessage() {}
 
-func (x *UploadFinished) ProtoReflect() protoreflect.Message {
-	mi := &file_api_proto_msgTypes[22]
+func (x *SectionSuggestions) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_msgTypes[34]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1638,74 +2846,58 @@ func (x *UploadFinished) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UploadFinished.ProtoReflect.Descriptor instead.
-func (*UploadFinished) Descriptor() ([]byte, []int) {
-	return file_api_proto_rawDescGZIP(), []int{22}
+// Deprecated: Use SectionSuggestions.ProtoReflect.Descriptor instead.
+func (*SectionSuggestions) Descriptor() ([]byte, []int) {
+	return file_api_proto_rawDescGZIP(), []int{34}
 }
 
-func (x *UploadFinished) GetWorkerID() string {
+func (x *SectionSuggestions) GetWorkerID() string {
 	if x != nil {
 		return x.WorkerID
 	}
 	return ""
 }
 
-func (x *UploadFinished) GetStreamID() uint32 {
+func (x *SectionSuggestions) GetStreamID() uint32 {
 	if x != nil {
 		return x.StreamID
 	}
 	return 0
 }
 
-func (x *UploadFinished) GetHLSUrl() string {
-	if x != nil {
-		return x.HLSUrl
-	}
-	return ""
-}
-
-func (x *UploadFinished) GetSourceType() string {
-	if x != nil {
-		return x.SourceType
-	}
-	return ""
-}
-
-func (x *UploadFinished) GetThumbnailUrl() string {
+func (x *SectionSuggestions) GetSuggestions() []*SectionSuggestion {
 	if x != nil {
-		return x.ThumbnailUrl
+		return x.Suggestions
 	}
-	return ""
+	return nil
 }
 
-type StreamStarted struct {
+type Slide struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	WorkerID   string `protobuf:"bytes,1,opt,name=WorkerID,proto3" json:"WorkerID,omitempty"`
-	StreamID   uint32 `protobuf:"varint,2,opt,name=StreamID,proto3" json:"StreamID,omitempty"`
-	HlsUrl     string `protobuf:"bytes,3,opt,name=HlsUrl,proto3" json:"HlsUrl,omitempty"`
-	SourceType string `protobuf:"bytes,5,opt,name=SourceType,proto3" json:"SourceType,omitempty"`
+	Start uint32 `protobuf:"varint,1,opt,name=Start,proto3" json:"Start,omitempty"` // seconds into the VoD
+	Path  string `protobuf:"bytes,2,opt,name=Path,proto3" json:"Path,omitempty"`    // full resolution frame
 }
 
-func (x *StreamStarted) Reset() {
-	*x = StreamStarted{}
+func (x *Slide) Reset() {
+	*x = Slide{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_api_proto_msgTypes[23]
+		mi := &file_api_proto_msgTypes[35]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *StreamStarted) String() string {
+func (x *Slide) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*StreamStarted) ProtoMessage() {}
+func (*Slide) ProtoMessage() {}
 
-func (x *StreamStarted) ProtoReflect() protoreflect.Message {
-	mi := &file_api_proto_msgTypes[23]
+func (x *Slide) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_msgTypes[35]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1716,67 +2908,52 @@ func (x *StreamStarted) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use StreamStarted.ProtoReflect.Descriptor instead.
-func (*StreamStarted) Descriptor() ([]byte, []int) {
-	return file_api_proto_rawDescGZIP(), []int{23}
-}
-
-func (x *StreamStarted) GetWorkerID() string {
-	if x != nil {
-		return x.WorkerID
-	}
-	return ""
-}
-
-func (x *StreamStarted) GetStreamID() uint32 {
-	if x != nil {
-		return x.StreamID
-	}
-	return 0
+// Deprecated: Use Slide.ProtoReflect.Descriptor instead.
+func (*Slide) Descriptor() ([]byte, []int) {
+	return file_api_proto_rawDescGZIP(), []int{35}
 }
 
-func (x *StreamStarted) GetHlsUrl() string {
+func (x *Slide) GetStart() uint32 {
 	if x != nil {
-		return x.HlsUrl
+		return x.Start
 	}
-	return ""
+	return 0
 }
 
-func (x *StreamStarted) GetSourceType() string {
+func (x *Slide) GetPath() string {
 	if x != nil {
-		return x.SourceType
+		return x.Path
 	}
 	return ""
 }
 
-type SilenceResults struct {
+type Slides struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
 	WorkerID string   `protobuf:"bytes,1,opt,name=WorkerID,proto3" json:"WorkerID,omitempty"`
 	StreamID uint32   `protobuf:"varint,2,opt,name=StreamID,proto3" json:"StreamID,omitempty"`
-	Starts   []uint32 `protobuf:"varint,3,rep,packed,name=starts,proto3" json:"starts,omitempty"`
-	Ends     []uint32 `protobuf:"varint,4,rep,packed,name=ends,proto3" json:"ends,omitempty"`
+	Slides   []*Slide `protobuf:"bytes,3,rep,name=Slides,proto3" json:"Slides,omitempty"`
 }
 
-func (x *SilenceResults) Reset() {
-	*x = SilenceResults{}
+func (x *Slides) Reset() {
+	*x = Slides{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_api_proto_msgTypes[24]
+		mi := &file_api_proto_msgTypes[36]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *SilenceResults) String() string {
+func (x *Slides) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SilenceResults) ProtoMessage() {}
+func (*Slides) ProtoMessage() {}
 
-func (x *SilenceResults) ProtoReflect() protoreflect.Message {
-	mi := &file_api_proto_msgTypes[24]
+func (x *Slides) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_msgTypes[36]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1787,35 +2964,28 @@ func (x *SilenceResults) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SilenceResults.ProtoReflect.Descriptor instead.
-func (*SilenceResults) Descriptor() ([]byte, []int) {
-	return file_api_proto_rawDescGZIP(), []int{24}
+// Deprecated: Use Slides.ProtoReflect.Descriptor instead.
+func (*Slides) Descriptor() ([]byte, []int) {
+	return file_api_proto_rawDescGZIP(), []int{36}
 }
 
-func (x *SilenceResults) GetWorkerID() string {
+func (x *Slides) GetWorkerID() string {
 	if x != nil {
 		return x.WorkerID
 	}
 	return ""
 }
 
-func (x *SilenceResults) GetStreamID() uint32 {
+func (x *Slides) GetStreamID() uint32 {
 	if x != nil {
 		return x.StreamID
 	}
 	return 0
 }
 
-func (x *SilenceResults) GetStarts() []uint32 {
-	if x != nil {
-		return x.Starts
-	}
-	return nil
-}
-
-func (x *SilenceResults) GetEnds() []uint32 {
+func (x *Slides) GetSlides() []*Slide {
 	if x != nil {
-		return x.Ends
+		return x.Slides
 	}
 	return nil
 }
@@ -1832,7 +3002,7 @@ type GetStreamInfoForUploadRequest struct {
 func (x *GetStreamInfoForUploadRequest) Reset() {
 	*x = GetStreamInfoForUploadRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_api_proto_msgTypes[25]
+		mi := &file_api_proto_msgTypes[37]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1845,7 +3015,7 @@ func (x *GetStreamInfoForUploadRequest) String() string {
 func (*GetStreamInfoForUploadRequest) ProtoMessage() {}
 
 func (x *GetStreamInfoForUploadRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_api_proto_msgTypes[25]
+	mi := &file_api_proto_msgTypes[37]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1858,7 +3028,7 @@ func (x *GetStreamInfoForUploadRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetStreamInfoForUploadRequest.ProtoReflect.Descriptor instead.
 func (*GetStreamInfoForUploadRequest) Descriptor() ([]byte, []int) {
-	return file_api_proto_rawDescGZIP(), []int{25}
+	return file_api_proto_rawDescGZIP(), []int{37}
 }
 
 func (x *GetStreamInfoForUploadRequest) GetWorkerID() string {
@@ -1887,12 +3057,13 @@ type GetStreamInfoForUploadResponse struct {
 	StreamEnd   *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=StreamEnd,proto3" json:"StreamEnd,omitempty"`
 	StreamID    uint32                 `protobuf:"varint,6,opt,name=StreamID,proto3" json:"StreamID,omitempty"`
 	VideoType   string                 `protobuf:"bytes,7,opt,name=VideoType,proto3" json:"VideoType,omitempty"`
+	SlideText   bool                   `protobuf:"varint,8,opt,name=SlideText,proto3" json:"SlideText,omitempty"`
 }
 
 func (x *GetStreamInfoForUploadResponse) Reset() {
 	*x = GetStreamInfoForUploadResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_api_proto_msgTypes[26]
+		mi := &file_api_proto_msgTypes[38]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1905,7 +3076,7 @@ func (x *GetStreamInfoForUploadResponse) String() string {
 func (*GetStreamInfoForUploadResponse) ProtoMessage() {}
 
 func (x *GetStreamInfoForUploadResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_api_proto_msgTypes[26]
+	mi := &file_api_proto_msgTypes[38]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1918,7 +3089,7 @@ func (x *GetStreamInfoForUploadResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetStreamInfoForUploadResponse.ProtoReflect.Descriptor instead.
 func (*GetStreamInfoForUploadResponse) Descriptor() ([]byte, []int) {
-	return file_api_proto_rawDescGZIP(), []int{26}
+	return file_api_proto_rawDescGZIP(), []int{38}
 }
 
 func (x *GetStreamInfoForUploadResponse) GetCourseSlug() string {
@@ -1970,6 +3141,13 @@ func (x *GetStreamInfoForUploadResponse) GetVideoType() string {
 	return ""
 }
 
+func (x *GetStreamInfoForUploadResponse) GetSlideText() bool {
+	if x != nil {
+		return x.SlideText
+	}
+	return false
+}
+
 type LivePreviewRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -1982,7 +3160,7 @@ type LivePreviewRequest struct {
 func (x *LivePreviewRequest) Reset() {
 	*x = LivePreviewRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_api_proto_msgTypes[27]
+		mi := &file_api_proto_msgTypes[39]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1995,7 +3173,7 @@ func (x *LivePreviewRequest) String() string {
 func (*LivePreviewRequest) ProtoMessage() {}
 
 func (x *LivePreviewRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_api_proto_msgTypes[27]
+	mi := &file_api_proto_msgTypes[39]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2008,7 +3186,7 @@ func (x *LivePreviewRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use LivePreviewRequest.ProtoReflect.Descriptor instead.
 func (*LivePreviewRequest) Descriptor() ([]byte, []int) {
-	return file_api_proto_rawDescGZIP(), []int{27}
+	return file_api_proto_rawDescGZIP(), []int{39}
 }
 
 func (x *LivePreviewRequest) GetWorkerID() string {
@@ -2036,7 +3214,7 @@ type LivePreviewResponse struct {
 func (x *LivePreviewResponse) Reset() {
 	*x = LivePreviewResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_api_proto_msgTypes[28]
+		mi := &file_api_proto_msgTypes[40]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2049,7 +3227,7 @@ func (x *LivePreviewResponse) String() string {
 func (*LivePreviewResponse) ProtoMessage() {}
 
 func (x *LivePreviewResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_api_proto_msgTypes[28]
+	mi := &file_api_proto_msgTypes[40]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2062,7 +3240,7 @@ func (x *LivePreviewResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use LivePreviewResponse.ProtoReflect.Descriptor instead.
 func (*LivePreviewResponse) Descriptor() ([]byte, []int) {
-	return file_api_proto_rawDescGZIP(), []int{28}
+	return file_api_proto_rawDescGZIP(), []int{40}
 }
 
 func (x *LivePreviewResponse) GetLiveThumb() []byte {
@@ -2088,7 +3266,7 @@ type NotifyTranscodingFailureRequest struct {
 func (x *NotifyTranscodingFailureRequest) Reset() {
 	*x = NotifyTranscodingFailureRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_api_proto_msgTypes[29]
+		mi := &file_api_proto_msgTypes[41]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2101,7 +3279,7 @@ func (x *NotifyTranscodingFailureRequest) String() string {
 func (*NotifyTranscodingFailureRequest) ProtoMessage() {}
 
 func (x *NotifyTranscodingFailureRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_api_proto_msgTypes[29]
+	mi := &file_api_proto_msgTypes[41]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2114,7 +3292,7 @@ func (x *NotifyTranscodingFailureRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use NotifyTranscodingFailureRequest.ProtoReflect.Descriptor instead.
 func (*NotifyTranscodingFailureRequest) Descriptor() ([]byte, []int) {
-	return file_api_proto_rawDescGZIP(), []int{29}
+	return file_api_proto_rawDescGZIP(), []int{41}
 }
 
 func (x *NotifyTranscodingFailureRequest) GetWorkerID() string {
@@ -2168,7 +3346,7 @@ type NotifyTranscodingFailureResponse struct {
 func (x *NotifyTranscodingFailureResponse) Reset() {
 	*x = NotifyTranscodingFailureResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_api_proto_msgTypes[30]
+		mi := &file_api_proto_msgTypes[42]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2181,7 +3359,7 @@ func (x *NotifyTranscodingFailureResponse) String() string {
 func (*NotifyTranscodingFailureResponse) ProtoMessage() {}
 
 func (x *NotifyTranscodingFailureResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_api_proto_msgTypes[30]
+	mi := &file_api_proto_msgTypes[42]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2194,7 +3372,7 @@ func (x *NotifyTranscodingFailureResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use NotifyTranscodingFailureResponse.ProtoReflect.Descriptor instead.
 func (*NotifyTranscodingFailureResponse) Descriptor() ([]byte, []int) {
-	return file_api_proto_rawDescGZIP(), []int{30}
+	return file_api_proto_rawDescGZIP(), []int{42}
 }
 
 type CombineThumbnailsRequest struct {
@@ -2210,7 +3388,7 @@ type CombineThumbnailsRequest struct {
 func (x *CombineThumbnailsRequest) Reset() {
 	*x = CombineThumbnailsRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_api_proto_msgTypes[31]
+		mi := &file_api_proto_msgTypes[43]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2223,7 +3401,7 @@ func (x *CombineThumbnailsRequest) String() string {
 func (*CombineThumbnailsRequest) ProtoMessage() {}
 
 func (x *CombineThumbnailsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_api_proto_msgTypes[31]
+	mi := &file_api_proto_msgTypes[43]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2236,7 +3414,7 @@ func (x *CombineThumbnailsRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CombineThumbnailsRequest.ProtoReflect.Descriptor instead.
 func (*CombineThumbnailsRequest) Descriptor() ([]byte, []int) {
-	return file_api_proto_rawDescGZIP(), []int{31}
+	return file_api_proto_rawDescGZIP(), []int{43}
 }
 
 func (x *CombineThumbnailsRequest) GetPrimaryThumbnail() string {
@@ -2271,7 +3449,7 @@ type CombineThumbnailsResponse struct {
 func (x *CombineThumbnailsResponse) Reset() {
 	*x = CombineThumbnailsResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_api_proto_msgTypes[32]
+		mi := &file_api_proto_msgTypes[44]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2284,7 +3462,7 @@ func (x *CombineThumbnailsResponse) String() string {
 func (*CombineThumbnailsResponse) ProtoMessage() {}
 
 func (x *CombineThumbnailsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_api_proto_msgTypes[32]
+	mi := &file_api_proto_msgTypes[44]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2297,7 +3475,7 @@ func (x *CombineThumbnailsResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CombineThumbnailsResponse.ProtoReflect.Descriptor instead.
 func (*CombineThumbnailsResponse) Descriptor() ([]byte, []int) {
-	return file_api_proto_rawDescGZIP(), []int{32}
+	return file_api_proto_rawDescGZIP(), []int{44}
 }
 
 func (x *CombineThumbnailsResponse) GetFilePath() string {
@@ -2314,13 +3492,13 @@ type CutRequest_Segment struct {
 
 	StartTime int64 `protobuf:"varint,1,opt,name=Start_time,json=StartTime,proto3" json:"Start_time,omitempty"` // milliseconds
 	EndTime   int64 `protobuf:"varint,2,opt,name=End_time,json=EndTime,proto3" json:"End_time,omitempty"`
-	Discard   bool  `protobuf:"varint,3,opt,name=Discard,proto3" json:"Discard,omitempty"`
+	Discard   bool  `protobuf:"varint,3,opt,name=Discard,proto3" json:"Discard,omitempty"` // segments are kept unless discarded, if any segment is kept the rest of the video is discarded
 }
 
 func (x *CutRequest_Segment) Reset() {
 	*x = CutRequest_Segment{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_api_proto_msgTypes[33]
+		mi := &file_api_proto_msgTypes[45]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2333,7 +3511,7 @@ func (x *CutRequest_Segment) String() string {
 func (*CutRequest_Segment) ProtoMessage() {}
 
 func (x *CutRequest_Segment) ProtoReflect() protoreflect.Message {
-	mi := &file_api_proto_msgTypes[33]
+	mi := &file_api_proto_msgTypes[45]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2419,8 +3597,8 @@ var file_api_proto_rawDesc = []byte{
 	0x52, 0x12, 0x43, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x54, 0x65, 0x61, 0x63, 0x68, 0x69, 0x6e, 0x67,
 	0x54, 0x65, 0x72, 0x6d, 0x12, 0x28, 0x0a, 0x08, 0x53, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73,
 	0x18, 0x05, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x53, 0x65, 0x63,
-	0x74, 0x69, 0x6f, 0x6e, 0x52, 0x08, 0x53, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x22, 0xf6,
-	0x01, 0x0a, 0x0a, 0x43, 0x75, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1a, 0x0a,
+	0x74, 0x69, 0x6f, 0x6e, 0x52, 0x08, 0x53, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x22, 0x96,
+	0x02, 0x0a, 0x0a, 0x43, 0x75, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1a, 0x0a,
 	0x08, 0x57, 0x6f, 0x72, 0x6b, 0x65, 0x72, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
 	0x08, 0x57, 0x6f, 0x72, 0x6b, 0x65, 0x72, 0x49, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x46, 0x69, 0x6c,
 	0x65, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x05, 0x46, 0x69, 0x6c, 0x65, 0x73, 0x12,
@@ -2429,7 +3607,9 @@ var file_api_proto_rawDesc = []byte{
 	0x73, 0x74, 0x2e, 0x53, 0x65, 0x67, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x08, 0x73, 0x65, 0x67, 0x6d,
 	0x65, 0x6e, 0x74, 0x73, 0x12, 0x22, 0x0a, 0x0c, 0x55, 0x70, 0x6c, 0x6f, 0x61, 0x64, 0x52, 0x65,
 	0x73, 0x75, 0x6c, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0c, 0x55, 0x70, 0x6c, 0x6f,
-	0x61, 0x64, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x1a, 0x5d, 0x0a, 0x07, 0x53, 0x65, 0x67, 0x6d,
+	0x61, 0x64, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x12, 0x1e, 0x0a, 0x0a, 0x4f, 0x75, 0x74, 0x70,
+	0x75, 0x74, 0x46, 0x69, 0x6c, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x4f, 0x75,
+	0x74, 0x70, 0x75, 0x74, 0x46, 0x69, 0x6c, 0x65, 0x1a, 0x5d, 0x0a, 0x07, 0x53, 0x65, 0x67, 0x6d,
 	0x65, 0x6e, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x53, 0x74, 0x61, 0x72, 0x74, 0x5f, 0x74, 0x69, 0x6d,
 	0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x53, 0x74, 0x61, 0x72, 0x74, 0x54, 0x69,
 	0x6d, 0x65, 0x12, 0x19, 0x0a, 0x08, 0x45, 0x6e, 0x64, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x02,
@@ -2446,7 +3626,7 @@ var file_api_proto_rawDesc = []byte{
 	0x01, 0x28, 0x09, 0x52, 0x04, 0x46, 0x69, 0x6c, 0x65, 0x22, 0x2e, 0x0a, 0x10, 0x57, 0x61, 0x76,
 	0x65, 0x46, 0x6f, 0x72, 0x6d, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1a, 0x0a,
 	0x08, 0x57, 0x61, 0x76, 0x65, 0x66, 0x6f, 0x72, 0x6d, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52,
-	0x08, 0x57, 0x61, 0x76, 0x65, 0x66, 0x6f, 0x72, 0x6d, 0x22, 0xc1, 0x03, 0x0a, 0x0d, 0x53, 0x74,
+	0x08, 0x57, 0x61, 0x76, 0x65, 0x66, 0x6f, 0x72, 0x6d, 0x22, 0xa3, 0x05, 0x0a, 0x0d, 0x53, 0x74,
 	0x72, 0x65, 0x61, 0x6d, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x57,
 	0x6f, 0x72, 0x6b, 0x65, 0x72, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x57,
 	0x6f, 0x72, 0x6b, 0x65, 0x72, 0x49, 0x64, 0x12, 0x1e, 0x0a, 0x0a, 0x53, 0x6f, 0x75, 0x72, 0x63,
@@ -2474,299 +3654,509 @@ var file_api_proto_rawDesc = []byte{
 	0x65, 0x12, 0x22, 0x0a, 0x0c, 0x49, 0x6e, 0x67, 0x65, 0x73, 0x74, 0x53, 0x65, 0x72, 0x76, 0x65,
 	0x72, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x49, 0x6e, 0x67, 0x65, 0x73, 0x74, 0x53,
 	0x65, 0x72, 0x76, 0x65, 0x72, 0x12, 0x16, 0x0a, 0x06, 0x4f, 0x75, 0x74, 0x55, 0x72, 0x6c, 0x18,
-	0x0f, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x4f, 0x75, 0x74, 0x55, 0x72, 0x6c, 0x22, 0xc1, 0x01,
-	0x0a, 0x0f, 0x50, 0x72, 0x65, 0x6d, 0x69, 0x65, 0x72, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x12, 0x1a, 0x0a, 0x08, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x49, 0x44, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x0d, 0x52, 0x08, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x49, 0x44, 0x12, 0x1a, 0x0a,
-	0x08, 0x46, 0x69, 0x6c, 0x65, 0x50, 0x61, 0x74, 0x68, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x08, 0x46, 0x69, 0x6c, 0x65, 0x50, 0x61, 0x74, 0x68, 0x12, 0x1a, 0x0a, 0x08, 0x57, 0x6f, 0x72,
-	0x6b, 0x65, 0x72, 0x49, 0x44, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x57, 0x6f, 0x72,
-	0x6b, 0x65, 0x72, 0x49, 0x44, 0x12, 0x1e, 0x0a, 0x0a, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x4e,
-	0x61, 0x6d, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x53, 0x74, 0x72, 0x65, 0x61,
-	0x6d, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x22, 0x0a, 0x0c, 0x49, 0x6e, 0x67, 0x65, 0x73, 0x74, 0x53,
-	0x65, 0x72, 0x76, 0x65, 0x72, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x49, 0x6e, 0x67,
-	0x65, 0x73, 0x74, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x12, 0x16, 0x0a, 0x06, 0x4f, 0x75, 0x74,
-	0x55, 0x72, 0x6c, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x4f, 0x75, 0x74, 0x55, 0x72,
-	0x6c, 0x22, 0x6a, 0x0a, 0x10, 0x45, 0x6e, 0x64, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x52, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x49,
-	0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x08, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x49,
-	0x44, 0x12, 0x1a, 0x0a, 0x08, 0x57, 0x6f, 0x72, 0x6b, 0x65, 0x72, 0x49, 0x44, 0x18, 0x02, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x08, 0x57, 0x6f, 0x72, 0x6b, 0x65, 0x72, 0x49, 0x44, 0x12, 0x1e, 0x0a,
-	0x0a, 0x44, 0x69, 0x73, 0x63, 0x61, 0x72, 0x64, 0x56, 0x6f, 0x44, 0x18, 0x03, 0x20, 0x01, 0x28,
-	0x08, 0x52, 0x0a, 0x44, 0x69, 0x73, 0x63, 0x61, 0x72, 0x64, 0x56, 0x6f, 0x44, 0x22, 0x18, 0x0a,
-	0x06, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x0e, 0x0a, 0x02, 0x6f, 0x6b, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x08, 0x52, 0x02, 0x6f, 0x6b, 0x22, 0x90, 0x01, 0x0a, 0x20, 0x4e, 0x6f, 0x74, 0x69,
-	0x66, 0x79, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x63, 0x6f, 0x64, 0x69, 0x6e, 0x67, 0x50, 0x72, 0x6f,
-	0x67, 0x72, 0x65, 0x73, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1a, 0x0a, 0x08,
-	0x57, 0x6f, 0x72, 0x6b, 0x65, 0x72, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08,
-	0x57, 0x6f, 0x72, 0x6b, 0x65, 0x72, 0x49, 0x44, 0x12, 0x1a, 0x0a, 0x08, 0x53, 0x74, 0x72, 0x65,
-	0x61, 0x6d, 0x49, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x08, 0x53, 0x74, 0x72, 0x65,
-	0x61, 0x6d, 0x49, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18,
-	0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x1a,
-	0x0a, 0x08, 0x50, 0x72, 0x6f, 0x67, 0x72, 0x65, 0x73, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05,
-	0x52, 0x08, 0x50, 0x72, 0x6f, 0x67, 0x72, 0x65, 0x73, 0x73, 0x22, 0x46, 0x0a, 0x12, 0x4a, 0x6f,
-	0x69, 0x6e, 0x57, 0x6f, 0x72, 0x6b, 0x65, 0x72, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x12, 0x14, 0x0a, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x1a, 0x0a, 0x08, 0x68, 0x6f, 0x73, 0x74, 0x6e, 0x61,
-	0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x68, 0x6f, 0x73, 0x74, 0x6e, 0x61,
-	0x6d, 0x65, 0x22, 0x32, 0x0a, 0x13, 0x4a, 0x6f, 0x69, 0x6e, 0x57, 0x6f, 0x72, 0x6b, 0x65, 0x72,
-	0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1b, 0x0a, 0x09, 0x77, 0x6f, 0x72,
-	0x6b, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x77, 0x6f,
-	0x72, 0x6b, 0x65, 0x72, 0x49, 0x64, 0x22, 0x6d, 0x0a, 0x11, 0x53, 0x65, 0x6c, 0x66, 0x53, 0x74,
-	0x72, 0x65, 0x61, 0x6d, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x57,
-	0x6f, 0x72, 0x6b, 0x65, 0x72, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x57,
-	0x6f, 0x72, 0x6b, 0x65, 0x72, 0x49, 0x44, 0x12, 0x1c, 0x0a, 0x09, 0x53, 0x74, 0x72, 0x65, 0x61,
-	0x6d, 0x4b, 0x65, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x53, 0x74, 0x72, 0x65,
-	0x61, 0x6d, 0x4b, 0x65, 0x79, 0x12, 0x1e, 0x0a, 0x0a, 0x43, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x53,
-	0x6c, 0x75, 0x67, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x43, 0x6f, 0x75, 0x72, 0x73,
-	0x65, 0x53, 0x6c, 0x75, 0x67, 0x22, 0xc8, 0x02, 0x0a, 0x12, 0x53, 0x65, 0x6c, 0x66, 0x53, 0x74,
-	0x72, 0x65, 0x61, 0x6d, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1a, 0x0a, 0x08,
-	0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x08,
-	0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x49, 0x44, 0x12, 0x1e, 0x0a, 0x0a, 0x43, 0x6f, 0x75, 0x72,
-	0x73, 0x65, 0x53, 0x6c, 0x75, 0x67, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x43, 0x6f,
+	0x0f, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x4f, 0x75, 0x74, 0x55, 0x72, 0x6c, 0x12, 0x2e, 0x0a,
+	0x0a, 0x52, 0x65, 0x6e, 0x64, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x10, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x0e, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x52, 0x65, 0x6e, 0x64, 0x69, 0x74, 0x69, 0x6f,
+	0x6e, 0x52, 0x0a, 0x52, 0x65, 0x6e, 0x64, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x12, 0x0a,
+	0x04, 0x50, 0x61, 0x72, 0x74, 0x18, 0x11, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x04, 0x50, 0x61, 0x72,
+	0x74, 0x12, 0x1e, 0x0a, 0x0a, 0x4c, 0x6f, 0x77, 0x4c, 0x61, 0x74, 0x65, 0x6e, 0x63, 0x79, 0x18,
+	0x12, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0a, 0x4c, 0x6f, 0x77, 0x4c, 0x61, 0x74, 0x65, 0x6e, 0x63,
+	0x79, 0x12, 0x10, 0x0a, 0x03, 0x44, 0x76, 0x72, 0x18, 0x13, 0x20, 0x01, 0x28, 0x08, 0x52, 0x03,
+	0x44, 0x76, 0x72, 0x12, 0x1c, 0x0a, 0x09, 0x44, 0x76, 0x72, 0x57, 0x69, 0x6e, 0x64, 0x6f, 0x77,
+	0x18, 0x14, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x09, 0x44, 0x76, 0x72, 0x57, 0x69, 0x6e, 0x64, 0x6f,
+	0x77, 0x12, 0x2e, 0x0a, 0x07, 0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x18, 0x15, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x14, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x45, 0x6e, 0x63, 0x6f, 0x64, 0x69, 0x6e,
+	0x67, 0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x52, 0x07, 0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c,
+	0x65, 0x12, 0x1c, 0x0a, 0x09, 0x53, 0x6c, 0x69, 0x64, 0x65, 0x54, 0x65, 0x78, 0x74, 0x18, 0x16,
+	0x20, 0x01, 0x28, 0x08, 0x52, 0x09, 0x53, 0x6c, 0x69, 0x64, 0x65, 0x54, 0x65, 0x78, 0x74, 0x22,
+	0xf1, 0x02, 0x0a, 0x0f, 0x45, 0x6e, 0x63, 0x6f, 0x64, 0x69, 0x6e, 0x67, 0x50, 0x72, 0x6f, 0x66,
+	0x69, 0x6c, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x04, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x1e, 0x0a, 0x0a, 0x56, 0x69, 0x64, 0x65, 0x6f,
+	0x43, 0x6f, 0x64, 0x65, 0x63, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x56, 0x69, 0x64,
+	0x65, 0x6f, 0x43, 0x6f, 0x64, 0x65, 0x63, 0x12, 0x10, 0x0a, 0x03, 0x43, 0x72, 0x66, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x0d, 0x52, 0x03, 0x43, 0x72, 0x66, 0x12, 0x22, 0x0a, 0x0c, 0x56, 0x69, 0x64,
+	0x65, 0x6f, 0x42, 0x69, 0x74, 0x72, 0x61, 0x74, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0d, 0x52,
+	0x0c, 0x56, 0x69, 0x64, 0x65, 0x6f, 0x42, 0x69, 0x74, 0x72, 0x61, 0x74, 0x65, 0x12, 0x16, 0x0a,
+	0x06, 0x50, 0x72, 0x65, 0x73, 0x65, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x50,
+	0x72, 0x65, 0x73, 0x65, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x54, 0x75, 0x6e, 0x65, 0x18, 0x06, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x04, 0x54, 0x75, 0x6e, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x48, 0x65, 0x69,
+	0x67, 0x68, 0x74, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x06, 0x48, 0x65, 0x69, 0x67, 0x68,
+	0x74, 0x12, 0x1e, 0x0a, 0x0a, 0x41, 0x75, 0x64, 0x69, 0x6f, 0x43, 0x6f, 0x64, 0x65, 0x63, 0x18,
+	0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x41, 0x75, 0x64, 0x69, 0x6f, 0x43, 0x6f, 0x64, 0x65,
+	0x63, 0x12, 0x22, 0x0a, 0x0c, 0x41, 0x75, 0x64, 0x69, 0x6f, 0x42, 0x69, 0x74, 0x72, 0x61, 0x74,
+	0x65, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0c, 0x41, 0x75, 0x64, 0x69, 0x6f, 0x42, 0x69,
+	0x74, 0x72, 0x61, 0x74, 0x65, 0x12, 0x24, 0x0a, 0x0d, 0x41, 0x75, 0x64, 0x69, 0x6f, 0x43, 0x68,
+	0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x73, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0d, 0x41, 0x75,
+	0x64, 0x69, 0x6f, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x73, 0x12, 0x28, 0x0a, 0x0f, 0x41,
+	0x75, 0x64, 0x69, 0x6f, 0x53, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x52, 0x61, 0x74, 0x65, 0x18, 0x0b,
+	0x20, 0x01, 0x28, 0x0d, 0x52, 0x0f, 0x41, 0x75, 0x64, 0x69, 0x6f, 0x53, 0x61, 0x6d, 0x70, 0x6c,
+	0x65, 0x52, 0x61, 0x74, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x56, 0x6f, 0x64, 0x43, 0x6f, 0x64, 0x65,
+	0x63, 0x73, 0x18, 0x0c, 0x20, 0x03, 0x28, 0x09, 0x52, 0x09, 0x56, 0x6f, 0x64, 0x43, 0x6f, 0x64,
+	0x65, 0x63, 0x73, 0x22, 0x7f, 0x0a, 0x09, 0x52, 0x65, 0x6e, 0x64, 0x69, 0x74, 0x69, 0x6f, 0x6e,
+	0x12, 0x12, 0x0a, 0x04, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04,
+	0x4e, 0x61, 0x6d, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x48, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x0d, 0x52, 0x06, 0x48, 0x65, 0x69, 0x67, 0x68, 0x74, 0x12, 0x22, 0x0a, 0x0c,
+	0x56, 0x69, 0x64, 0x65, 0x6f, 0x42, 0x69, 0x74, 0x72, 0x61, 0x74, 0x65, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x0d, 0x52, 0x0c, 0x56, 0x69, 0x64, 0x65, 0x6f, 0x42, 0x69, 0x74, 0x72, 0x61, 0x74, 0x65,
+	0x12, 0x22, 0x0a, 0x0c, 0x41, 0x75, 0x64, 0x69, 0x6f, 0x42, 0x69, 0x74, 0x72, 0x61, 0x74, 0x65,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0c, 0x41, 0x75, 0x64, 0x69, 0x6f, 0x42, 0x69, 0x74,
+	0x72, 0x61, 0x74, 0x65, 0x22, 0xf1, 0x01, 0x0a, 0x0f, 0x50, 0x72, 0x65, 0x6d, 0x69, 0x65, 0x72,
+	0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x53, 0x74, 0x72, 0x65,
+	0x61, 0x6d, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x08, 0x53, 0x74, 0x72, 0x65,
+	0x61, 0x6d, 0x49, 0x44, 0x12, 0x1a, 0x0a, 0x08, 0x46, 0x69, 0x6c, 0x65, 0x50, 0x61, 0x74, 0x68,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x46, 0x69, 0x6c, 0x65, 0x50, 0x61, 0x74, 0x68,
+	0x12, 0x1a, 0x0a, 0x08, 0x57, 0x6f, 0x72, 0x6b, 0x65, 0x72, 0x49, 0x44, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x08, 0x57, 0x6f, 0x72, 0x6b, 0x65, 0x72, 0x49, 0x44, 0x12, 0x1e, 0x0a, 0x0a,
+	0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0a, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x22, 0x0a, 0x0c,
+	0x49, 0x6e, 0x67, 0x65, 0x73, 0x74, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x18, 0x05, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x0c, 0x49, 0x6e, 0x67, 0x65, 0x73, 0x74, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72,
+	0x12, 0x16, 0x0a, 0x06, 0x4f, 0x75, 0x74, 0x55, 0x72, 0x6c, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x06, 0x4f, 0x75, 0x74, 0x55, 0x72, 0x6c, 0x12, 0x2e, 0x0a, 0x07, 0x50, 0x72, 0x6f, 0x66,
+	0x69, 0x6c, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x61, 0x70, 0x69, 0x2e,
+	0x45, 0x6e, 0x63, 0x6f, 0x64, 0x69, 0x6e, 0x67, 0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x52,
+	0x07, 0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x22, 0xab, 0x03, 0x0a, 0x0d, 0x53, 0x74, 0x69,
+	0x74, 0x63, 0x68, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x57, 0x6f,
+	0x72, 0x6b, 0x65, 0x72, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x57, 0x6f,
+	0x72, 0x6b, 0x65, 0x72, 0x49, 0x44, 0x12, 0x1a, 0x0a, 0x08, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d,
+	0x49, 0x44, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x08, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d,
+	0x49, 0x44, 0x12, 0x1e, 0x0a, 0x0a, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x54, 0x79, 0x70, 0x65,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x54, 0x79,
+	0x70, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x50, 0x61, 0x72, 0x74, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28,
+	0x09, 0x52, 0x05, 0x50, 0x61, 0x72, 0x74, 0x73, 0x12, 0x1e, 0x0a, 0x0a, 0x43, 0x6f, 0x75, 0x72,
+	0x73, 0x65, 0x53, 0x6c, 0x75, 0x67, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x43, 0x6f,
 	0x75, 0x72, 0x73, 0x65, 0x53, 0x6c, 0x75, 0x67, 0x12, 0x1e, 0x0a, 0x0a, 0x43, 0x6f, 0x75, 0x72,
-	0x73, 0x65, 0x59, 0x65, 0x61, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0a, 0x43, 0x6f,
-	0x75, 0x72, 0x73, 0x65, 0x59, 0x65, 0x61, 0x72, 0x12, 0x3c, 0x0a, 0x0b, 0x53, 0x74, 0x72, 0x65,
-	0x61, 0x6d, 0x53, 0x74, 0x61, 0x72, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e,
-	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e,
-	0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x0b, 0x53, 0x74, 0x72, 0x65, 0x61,
-	0x6d, 0x53, 0x74, 0x61, 0x72, 0x74, 0x12, 0x1e, 0x0a, 0x0a, 0x43, 0x6f, 0x75, 0x72, 0x73, 0x65,
-	0x54, 0x65, 0x72, 0x6d, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x43, 0x6f, 0x75, 0x72,
-	0x73, 0x65, 0x54, 0x65, 0x72, 0x6d, 0x12, 0x1c, 0x0a, 0x09, 0x75, 0x70, 0x6c, 0x6f, 0x61, 0x64,
-	0x56, 0x6f, 0x44, 0x18, 0x06, 0x20, 0x01, 0x28, 0x08, 0x52, 0x09, 0x75, 0x70, 0x6c, 0x6f, 0x61,
-	0x64, 0x56, 0x6f, 0x44, 0x12, 0x22, 0x0a, 0x0c, 0x49, 0x6e, 0x67, 0x65, 0x73, 0x74, 0x53, 0x65,
-	0x72, 0x76, 0x65, 0x72, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x49, 0x6e, 0x67, 0x65,
-	0x73, 0x74, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x12, 0x1e, 0x0a, 0x0a, 0x53, 0x74, 0x72, 0x65,
-	0x61, 0x6d, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x53, 0x74,
-	0x72, 0x65, 0x61, 0x6d, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x4f, 0x75, 0x74, 0x55,
-	0x72, 0x6c, 0x18, 0x09, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x4f, 0x75, 0x74, 0x55, 0x72, 0x6c,
-	0x22, 0xc7, 0x01, 0x0a, 0x09, 0x48, 0x65, 0x61, 0x72, 0x74, 0x42, 0x65, 0x61, 0x74, 0x12, 0x1a,
-	0x0a, 0x08, 0x57, 0x6f, 0x72, 0x6b, 0x65, 0x72, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x08, 0x57, 0x6f, 0x72, 0x6b, 0x65, 0x72, 0x49, 0x44, 0x12, 0x1a, 0x0a, 0x08, 0x57, 0x6f,
-	0x72, 0x6b, 0x6c, 0x6f, 0x61, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x08, 0x57, 0x6f,
-	0x72, 0x6b, 0x6c, 0x6f, 0x61, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x4a, 0x6f, 0x62, 0x73, 0x18, 0x03,
-	0x20, 0x03, 0x28, 0x09, 0x52, 0x04, 0x4a, 0x6f, 0x62, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x56, 0x65,
-	0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x56, 0x65, 0x72,
-	0x73, 0x69, 0x6f, 0x6e, 0x12, 0x10, 0x0a, 0x03, 0x43, 0x50, 0x55, 0x18, 0x05, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x03, 0x43, 0x50, 0x55, 0x12, 0x16, 0x0a, 0x06, 0x4d, 0x65, 0x6d, 0x6f, 0x72, 0x79,
-	0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x4d, 0x65, 0x6d, 0x6f, 0x72, 0x79, 0x12, 0x12,
-	0x0a, 0x04, 0x44, 0x69, 0x73, 0x6b, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x44, 0x69,
-	0x73, 0x6b, 0x12, 0x16, 0x0a, 0x06, 0x55, 0x70, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x08, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x06, 0x55, 0x70, 0x74, 0x69, 0x6d, 0x65, 0x22, 0x48, 0x0a, 0x0e, 0x53, 0x74,
-	0x72, 0x65, 0x61, 0x6d, 0x46, 0x69, 0x6e, 0x69, 0x73, 0x68, 0x65, 0x64, 0x12, 0x1a, 0x0a, 0x08,
-	0x57, 0x6f, 0x72, 0x6b, 0x65, 0x72, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08,
-	0x57, 0x6f, 0x72, 0x6b, 0x65, 0x72, 0x49, 0x44, 0x12, 0x1a, 0x0a, 0x08, 0x53, 0x74, 0x72, 0x65,
-	0x61, 0x6d, 0x49, 0x44, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x08, 0x53, 0x74, 0x72, 0x65,
-	0x61, 0x6d, 0x49, 0x44, 0x22, 0xd4, 0x01, 0x0a, 0x12, 0x54, 0x68, 0x75, 0x6d, 0x62, 0x6e, 0x61,
-	0x69, 0x6c, 0x73, 0x46, 0x69, 0x6e, 0x69, 0x73, 0x68, 0x65, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x57,
+	0x73, 0x65, 0x54, 0x65, 0x72, 0x6d, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x43, 0x6f,
+	0x75, 0x72, 0x73, 0x65, 0x54, 0x65, 0x72, 0x6d, 0x12, 0x1e, 0x0a, 0x0a, 0x43, 0x6f, 0x75, 0x72,
+	0x73, 0x65, 0x59, 0x65, 0x61, 0x72, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0a, 0x43, 0x6f,
+	0x75, 0x72, 0x73, 0x65, 0x59, 0x65, 0x61, 0x72, 0x12, 0x30, 0x0a, 0x05, 0x53, 0x74, 0x61, 0x72,
+	0x74, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74,
+	0x61, 0x6d, 0x70, 0x52, 0x05, 0x53, 0x74, 0x61, 0x72, 0x74, 0x12, 0x2c, 0x0a, 0x03, 0x45, 0x6e,
+	0x64, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74,
+	0x61, 0x6d, 0x70, 0x52, 0x03, 0x45, 0x6e, 0x64, 0x12, 0x1e, 0x0a, 0x0a, 0x50, 0x75, 0x62, 0x6c,
+	0x69, 0x73, 0x68, 0x56, 0x6f, 0x44, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0a, 0x50, 0x75,
+	0x62, 0x6c, 0x69, 0x73, 0x68, 0x56, 0x6f, 0x44, 0x12, 0x2e, 0x0a, 0x07, 0x50, 0x72, 0x6f, 0x66,
+	0x69, 0x6c, 0x65, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x61, 0x70, 0x69, 0x2e,
+	0x45, 0x6e, 0x63, 0x6f, 0x64, 0x69, 0x6e, 0x67, 0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x52,
+	0x07, 0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x53, 0x6c, 0x69, 0x64,
+	0x65, 0x54, 0x65, 0x78, 0x74, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x08, 0x52, 0x09, 0x53, 0x6c, 0x69,
+	0x64, 0x65, 0x54, 0x65, 0x78, 0x74, 0x22, 0x8a, 0x01, 0x0a, 0x10, 0x45, 0x6e, 0x64, 0x53, 0x74,
+	0x72, 0x65, 0x61, 0x6d, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x53,
+	0x74, 0x72, 0x65, 0x61, 0x6d, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x08, 0x53,
+	0x74, 0x72, 0x65, 0x61, 0x6d, 0x49, 0x44, 0x12, 0x1a, 0x0a, 0x08, 0x57, 0x6f, 0x72, 0x6b, 0x65,
+	0x72, 0x49, 0x44, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x57, 0x6f, 0x72, 0x6b, 0x65,
+	0x72, 0x49, 0x44, 0x12, 0x1e, 0x0a, 0x0a, 0x44, 0x69, 0x73, 0x63, 0x61, 0x72, 0x64, 0x56, 0x6f,
+	0x44, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0a, 0x44, 0x69, 0x73, 0x63, 0x61, 0x72, 0x64,
+	0x56, 0x6f, 0x44, 0x12, 0x1e, 0x0a, 0x0a, 0x46, 0x61, 0x69, 0x6c, 0x65, 0x64, 0x4f, 0x76, 0x65,
+	0x72, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0a, 0x46, 0x61, 0x69, 0x6c, 0x65, 0x64, 0x4f,
+	0x76, 0x65, 0x72, 0x22, 0x18, 0x0a, 0x06, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x0e, 0x0a,
+	0x02, 0x6f, 0x6b, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x02, 0x6f, 0x6b, 0x22, 0x90, 0x01,
+	0x0a, 0x20, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x79, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x63, 0x6f, 0x64,
+	0x69, 0x6e, 0x67, 0x50, 0x72, 0x6f, 0x67, 0x72, 0x65, 0x73, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x57, 0x6f, 0x72, 0x6b, 0x65, 0x72, 0x49, 0x44, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x57, 0x6f, 0x72, 0x6b, 0x65, 0x72, 0x49, 0x44, 0x12, 0x1a,
+	0x0a, 0x08, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x49, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d,
+	0x52, 0x08, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x49, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x56, 0x65,
+	0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x56, 0x65, 0x72,
+	0x73, 0x69, 0x6f, 0x6e, 0x12, 0x1a, 0x0a, 0x08, 0x50, 0x72, 0x6f, 0x67, 0x72, 0x65, 0x73, 0x73,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x50, 0x72, 0x6f, 0x67, 0x72, 0x65, 0x73, 0x73,
+	0x22, 0x76, 0x0a, 0x12, 0x4a, 0x6f, 0x69, 0x6e, 0x57, 0x6f, 0x72, 0x6b, 0x65, 0x72, 0x73, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x1a, 0x0a, 0x08,
+	0x68, 0x6f, 0x73, 0x74, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08,
+	0x68, 0x6f, 0x73, 0x74, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x2e, 0x0a, 0x12, 0x43, 0x65, 0x72, 0x74,
+	0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x12, 0x43, 0x65, 0x72, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74,
+	0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x54, 0x0a, 0x13, 0x4a, 0x6f, 0x69, 0x6e,
+	0x57, 0x6f, 0x72, 0x6b, 0x65, 0x72, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x1b, 0x0a, 0x09, 0x77, 0x6f, 0x72, 0x6b, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x08, 0x77, 0x6f, 0x72, 0x6b, 0x65, 0x72, 0x49, 0x64, 0x12, 0x20, 0x0a, 0x0b,
+	0x43, 0x65, 0x72, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0b, 0x43, 0x65, 0x72, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x65, 0x22, 0x65,
+	0x0a, 0x17, 0x52, 0x65, 0x6e, 0x65, 0x77, 0x43, 0x65, 0x72, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61,
+	0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x57, 0x6f, 0x72,
+	0x6b, 0x65, 0x72, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x57, 0x6f, 0x72,
+	0x6b, 0x65, 0x72, 0x49, 0x44, 0x12, 0x2e, 0x0a, 0x12, 0x43, 0x65, 0x72, 0x74, 0x69, 0x66, 0x69,
+	0x63, 0x61, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x12, 0x43, 0x65, 0x72, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x65, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x3c, 0x0a, 0x18, 0x52, 0x65, 0x6e, 0x65, 0x77, 0x43, 0x65,
+	0x72, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x20, 0x0a, 0x0b, 0x43, 0x65, 0x72, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x65,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x43, 0x65, 0x72, 0x74, 0x69, 0x66, 0x69, 0x63,
+	0x61, 0x74, 0x65, 0x22, 0x6d, 0x0a, 0x11, 0x53, 0x65, 0x6c, 0x66, 0x53, 0x74, 0x72, 0x65, 0x61,
+	0x6d, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x57, 0x6f, 0x72, 0x6b,
+	0x65, 0x72, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x57, 0x6f, 0x72, 0x6b,
+	0x65, 0x72, 0x49, 0x44, 0x12, 0x1c, 0x0a, 0x09, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x4b, 0x65,
+	0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x4b,
+	0x65, 0x79, 0x12, 0x1e, 0x0a, 0x0a, 0x43, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x53, 0x6c, 0x75, 0x67,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x43, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x53, 0x6c,
+	0x75, 0x67, 0x22, 0xf8, 0x03, 0x0a, 0x12, 0x53, 0x65, 0x6c, 0x66, 0x53, 0x74, 0x72, 0x65, 0x61,
+	0x6d, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x53, 0x74, 0x72,
+	0x65, 0x61, 0x6d, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x08, 0x53, 0x74, 0x72,
+	0x65, 0x61, 0x6d, 0x49, 0x44, 0x12, 0x1e, 0x0a, 0x0a, 0x43, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x53,
+	0x6c, 0x75, 0x67, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x43, 0x6f, 0x75, 0x72, 0x73,
+	0x65, 0x53, 0x6c, 0x75, 0x67, 0x12, 0x1e, 0x0a, 0x0a, 0x43, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x59,
+	0x65, 0x61, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0a, 0x43, 0x6f, 0x75, 0x72, 0x73,
+	0x65, 0x59, 0x65, 0x61, 0x72, 0x12, 0x3c, 0x0a, 0x0b, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x53,
+	0x74, 0x61, 0x72, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f,
+	0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d,
+	0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x0b, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x53, 0x74,
+	0x61, 0x72, 0x74, 0x12, 0x1e, 0x0a, 0x0a, 0x43, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x54, 0x65, 0x72,
+	0x6d, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x43, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x54,
+	0x65, 0x72, 0x6d, 0x12, 0x1c, 0x0a, 0x09, 0x75, 0x70, 0x6c, 0x6f, 0x61, 0x64, 0x56, 0x6f, 0x44,
+	0x18, 0x06, 0x20, 0x01, 0x28, 0x08, 0x52, 0x09, 0x75, 0x70, 0x6c, 0x6f, 0x61, 0x64, 0x56, 0x6f,
+	0x44, 0x12, 0x22, 0x0a, 0x0c, 0x49, 0x6e, 0x67, 0x65, 0x73, 0x74, 0x53, 0x65, 0x72, 0x76, 0x65,
+	0x72, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x49, 0x6e, 0x67, 0x65, 0x73, 0x74, 0x53,
+	0x65, 0x72, 0x76, 0x65, 0x72, 0x12, 0x1e, 0x0a, 0x0a, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x4e,
+	0x61, 0x6d, 0x65, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x53, 0x74, 0x72, 0x65, 0x61,
+	0x6d, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x4f, 0x75, 0x74, 0x55, 0x72, 0x6c, 0x18,
+	0x09, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x4f, 0x75, 0x74, 0x55, 0x72, 0x6c, 0x12, 0x2e, 0x0a,
+	0x0a, 0x52, 0x65, 0x6e, 0x64, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x0a, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x0e, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x52, 0x65, 0x6e, 0x64, 0x69, 0x74, 0x69, 0x6f,
+	0x6e, 0x52, 0x0a, 0x52, 0x65, 0x6e, 0x64, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x1e, 0x0a,
+	0x0a, 0x4c, 0x6f, 0x77, 0x4c, 0x61, 0x74, 0x65, 0x6e, 0x63, 0x79, 0x18, 0x0b, 0x20, 0x01, 0x28,
+	0x08, 0x52, 0x0a, 0x4c, 0x6f, 0x77, 0x4c, 0x61, 0x74, 0x65, 0x6e, 0x63, 0x79, 0x12, 0x10, 0x0a,
+	0x03, 0x44, 0x76, 0x72, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x08, 0x52, 0x03, 0x44, 0x76, 0x72, 0x12,
+	0x1c, 0x0a, 0x09, 0x44, 0x76, 0x72, 0x57, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x18, 0x0d, 0x20, 0x01,
+	0x28, 0x0d, 0x52, 0x09, 0x44, 0x76, 0x72, 0x57, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x12, 0x2e, 0x0a,
+	0x07, 0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14,
+	0x2e, 0x61, 0x70, 0x69, 0x2e, 0x45, 0x6e, 0x63, 0x6f, 0x64, 0x69, 0x6e, 0x67, 0x50, 0x72, 0x6f,
+	0x66, 0x69, 0x6c, 0x65, 0x52, 0x07, 0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x22, 0xf5, 0x02,
+	0x0a, 0x09, 0x48, 0x65, 0x61, 0x72, 0x74, 0x42, 0x65, 0x61, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x57,
 	0x6f, 0x72, 0x6b, 0x65, 0x72, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x57,
-	0x6f, 0x72, 0x6b, 0x65, 0x72, 0x49, 0x44, 0x12, 0x1a, 0x0a, 0x08, 0x53, 0x74, 0x72, 0x65, 0x61,
-	0x6d, 0x49, 0x44, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x08, 0x53, 0x74, 0x72, 0x65, 0x61,
-	0x6d, 0x49, 0x44, 0x12, 0x1a, 0x0a, 0x08, 0x46, 0x69, 0x6c, 0x65, 0x50, 0x61, 0x74, 0x68, 0x18,
-	0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x46, 0x69, 0x6c, 0x65, 0x50, 0x61, 0x74, 0x68, 0x12,
-	0x1a, 0x0a, 0x08, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x76, 0x61, 0x6c, 0x18, 0x04, 0x20, 0x01, 0x28,
-	0x0d, 0x52, 0x08, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x76, 0x61, 0x6c, 0x12, 0x1e, 0x0a, 0x0a, 0x53,
-	0x6f, 0x75, 0x72, 0x63, 0x65, 0x54, 0x79, 0x70, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x0a, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x54, 0x79, 0x70, 0x65, 0x12, 0x2e, 0x0a, 0x12, 0x4c,
-	0x61, 0x72, 0x67, 0x65, 0x54, 0x68, 0x75, 0x6d, 0x62, 0x6e, 0x61, 0x69, 0x6c, 0x50, 0x61, 0x74,
-	0x68, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x12, 0x4c, 0x61, 0x72, 0x67, 0x65, 0x54, 0x68,
-	0x75, 0x6d, 0x62, 0x6e, 0x61, 0x69, 0x6c, 0x50, 0x61, 0x74, 0x68, 0x22, 0xa5, 0x01, 0x0a, 0x13,
-	0x54, 0x72, 0x61, 0x6e, 0x73, 0x63, 0x6f, 0x64, 0x69, 0x6e, 0x67, 0x46, 0x69, 0x6e, 0x69, 0x73,
-	0x68, 0x65, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x57, 0x6f, 0x72, 0x6b, 0x65, 0x72, 0x49, 0x44, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x57, 0x6f, 0x72, 0x6b, 0x65, 0x72, 0x49, 0x44, 0x12,
-	0x1a, 0x0a, 0x08, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x49, 0x44, 0x18, 0x02, 0x20, 0x01, 0x28,
-	0x0d, 0x52, 0x08, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x49, 0x44, 0x12, 0x1a, 0x0a, 0x08, 0x46,
-	0x69, 0x6c, 0x65, 0x50, 0x61, 0x74, 0x68, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x46,
-	0x69, 0x6c, 0x65, 0x50, 0x61, 0x74, 0x68, 0x12, 0x1a, 0x0a, 0x08, 0x44, 0x75, 0x72, 0x61, 0x74,
-	0x69, 0x6f, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x08, 0x44, 0x75, 0x72, 0x61, 0x74,
-	0x69, 0x6f, 0x6e, 0x12, 0x1e, 0x0a, 0x0a, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x54, 0x79, 0x70,
-	0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x54,
-	0x79, 0x70, 0x65, 0x22, 0xa4, 0x01, 0x0a, 0x0e, 0x55, 0x70, 0x6c, 0x6f, 0x61, 0x64, 0x46, 0x69,
+	0x6f, 0x72, 0x6b, 0x65, 0x72, 0x49, 0x44, 0x12, 0x1a, 0x0a, 0x08, 0x57, 0x6f, 0x72, 0x6b, 0x6c,
+	0x6f, 0x61, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x08, 0x57, 0x6f, 0x72, 0x6b, 0x6c,
+	0x6f, 0x61, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x4a, 0x6f, 0x62, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28,
+	0x09, 0x52, 0x04, 0x4a, 0x6f, 0x62, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x56, 0x65, 0x72, 0x73, 0x69,
+	0x6f, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f,
+	0x6e, 0x12, 0x10, 0x0a, 0x03, 0x43, 0x50, 0x55, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03,
+	0x43, 0x50, 0x55, 0x12, 0x16, 0x0a, 0x06, 0x4d, 0x65, 0x6d, 0x6f, 0x72, 0x79, 0x18, 0x06, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x06, 0x4d, 0x65, 0x6d, 0x6f, 0x72, 0x79, 0x12, 0x12, 0x0a, 0x04, 0x44,
+	0x69, 0x73, 0x6b, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x44, 0x69, 0x73, 0x6b, 0x12,
+	0x16, 0x0a, 0x06, 0x55, 0x70, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x06, 0x55, 0x70, 0x74, 0x69, 0x6d, 0x65, 0x12, 0x1e, 0x0a, 0x0a, 0x43, 0x70, 0x75, 0x50, 0x65,
+	0x72, 0x63, 0x65, 0x6e, 0x74, 0x18, 0x09, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0a, 0x43, 0x70, 0x75,
+	0x50, 0x65, 0x72, 0x63, 0x65, 0x6e, 0x74, 0x12, 0x22, 0x0a, 0x0c, 0x4d, 0x65, 0x6d, 0x41, 0x76,
+	0x61, 0x69, 0x6c, 0x61, 0x62, 0x6c, 0x65, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0c, 0x4d,
+	0x65, 0x6d, 0x41, 0x76, 0x61, 0x69, 0x6c, 0x61, 0x62, 0x6c, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x4d,
+	0x65, 0x6d, 0x54, 0x6f, 0x74, 0x61, 0x6c, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x04, 0x52, 0x08, 0x4d,
+	0x65, 0x6d, 0x54, 0x6f, 0x74, 0x61, 0x6c, 0x12, 0x1a, 0x0a, 0x08, 0x44, 0x69, 0x73, 0x6b, 0x55,
+	0x73, 0x65, 0x64, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x04, 0x52, 0x08, 0x44, 0x69, 0x73, 0x6b, 0x55,
+	0x73, 0x65, 0x64, 0x12, 0x1c, 0x0a, 0x09, 0x44, 0x69, 0x73, 0x6b, 0x54, 0x6f, 0x74, 0x61, 0x6c,
+	0x18, 0x0d, 0x20, 0x01, 0x28, 0x04, 0x52, 0x09, 0x44, 0x69, 0x73, 0x6b, 0x54, 0x6f, 0x74, 0x61,
+	0x6c, 0x12, 0x12, 0x0a, 0x04, 0x54, 0x61, 0x67, 0x73, 0x18, 0x0e, 0x20, 0x03, 0x28, 0x09, 0x52,
+	0x04, 0x54, 0x61, 0x67, 0x73, 0x22, 0x68, 0x0a, 0x0e, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x46,
+	0x69, 0x6e, 0x69, 0x73, 0x68, 0x65, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x57, 0x6f, 0x72, 0x6b, 0x65,
+	0x72, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x57, 0x6f, 0x72, 0x6b, 0x65,
+	0x72, 0x49, 0x44, 0x12, 0x1a, 0x0a, 0x08, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x49, 0x44, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x08, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x49, 0x44, 0x12,
+	0x1e, 0x0a, 0x0a, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x54, 0x79, 0x70, 0x65, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0a, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x54, 0x79, 0x70, 0x65, 0x22,
+	0xd4, 0x01, 0x0a, 0x12, 0x54, 0x68, 0x75, 0x6d, 0x62, 0x6e, 0x61, 0x69, 0x6c, 0x73, 0x46, 0x69,
 	0x6e, 0x69, 0x73, 0x68, 0x65, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x57, 0x6f, 0x72, 0x6b, 0x65, 0x72,
 	0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x57, 0x6f, 0x72, 0x6b, 0x65, 0x72,
 	0x49, 0x44, 0x12, 0x1a, 0x0a, 0x08, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x49, 0x44, 0x18, 0x02,
-	0x20, 0x01, 0x28, 0x0d, 0x52, 0x08, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x49, 0x44, 0x12, 0x16,
-	0x0a, 0x06, 0x48, 0x4c, 0x53, 0x55, 0x72, 0x6c, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06,
-	0x48, 0x4c, 0x53, 0x55, 0x72, 0x6c, 0x12, 0x1e, 0x0a, 0x0a, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65,
+	0x20, 0x01, 0x28, 0x0d, 0x52, 0x08, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x49, 0x44, 0x12, 0x1a,
+	0x0a, 0x08, 0x46, 0x69, 0x6c, 0x65, 0x50, 0x61, 0x74, 0x68, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x08, 0x46, 0x69, 0x6c, 0x65, 0x50, 0x61, 0x74, 0x68, 0x12, 0x1a, 0x0a, 0x08, 0x49, 0x6e,
+	0x74, 0x65, 0x72, 0x76, 0x61, 0x6c, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x08, 0x49, 0x6e,
+	0x74, 0x65, 0x72, 0x76, 0x61, 0x6c, 0x12, 0x1e, 0x0a, 0x0a, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65,
 	0x54, 0x79, 0x70, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x53, 0x6f, 0x75, 0x72,
-	0x63, 0x65, 0x54, 0x79, 0x70, 0x65, 0x12, 0x22, 0x0a, 0x0c, 0x54, 0x68, 0x75, 0x6d, 0x62, 0x6e,
-	0x61, 0x69, 0x6c, 0x55, 0x72, 0x6c, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x54, 0x68,
-	0x75, 0x6d, 0x62, 0x6e, 0x61, 0x69, 0x6c, 0x55, 0x72, 0x6c, 0x22, 0x7f, 0x0a, 0x0d, 0x53, 0x74,
-	0x72, 0x65, 0x61, 0x6d, 0x53, 0x74, 0x61, 0x72, 0x74, 0x65, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x57,
-	0x6f, 0x72, 0x6b, 0x65, 0x72, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x57,
-	0x6f, 0x72, 0x6b, 0x65, 0x72, 0x49, 0x44, 0x12, 0x1a, 0x0a, 0x08, 0x53, 0x74, 0x72, 0x65, 0x61,
-	0x6d, 0x49, 0x44, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x08, 0x53, 0x74, 0x72, 0x65, 0x61,
-	0x6d, 0x49, 0x44, 0x12, 0x16, 0x0a, 0x06, 0x48, 0x6c, 0x73, 0x55, 0x72, 0x6c, 0x18, 0x03, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x06, 0x48, 0x6c, 0x73, 0x55, 0x72, 0x6c, 0x12, 0x1e, 0x0a, 0x0a, 0x53,
-	0x6f, 0x75, 0x72, 0x63, 0x65, 0x54, 0x79, 0x70, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x0a, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x54, 0x79, 0x70, 0x65, 0x22, 0x7c, 0x0a, 0x0e, 0x53,
-	0x69, 0x6c, 0x65, 0x6e, 0x63, 0x65, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x73, 0x12, 0x1a, 0x0a,
+	0x63, 0x65, 0x54, 0x79, 0x70, 0x65, 0x12, 0x2e, 0x0a, 0x12, 0x4c, 0x61, 0x72, 0x67, 0x65, 0x54,
+	0x68, 0x75, 0x6d, 0x62, 0x6e, 0x61, 0x69, 0x6c, 0x50, 0x61, 0x74, 0x68, 0x18, 0x06, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x12, 0x4c, 0x61, 0x72, 0x67, 0x65, 0x54, 0x68, 0x75, 0x6d, 0x62, 0x6e, 0x61,
+	0x69, 0x6c, 0x50, 0x61, 0x74, 0x68, 0x22, 0x94, 0x02, 0x0a, 0x13, 0x54, 0x72, 0x61, 0x6e, 0x73,
+	0x63, 0x6f, 0x64, 0x69, 0x6e, 0x67, 0x46, 0x69, 0x6e, 0x69, 0x73, 0x68, 0x65, 0x64, 0x12, 0x1a,
+	0x0a, 0x08, 0x57, 0x6f, 0x72, 0x6b, 0x65, 0x72, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x08, 0x57, 0x6f, 0x72, 0x6b, 0x65, 0x72, 0x49, 0x44, 0x12, 0x1a, 0x0a, 0x08, 0x53, 0x74,
+	0x72, 0x65, 0x61, 0x6d, 0x49, 0x44, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x08, 0x53, 0x74,
+	0x72, 0x65, 0x61, 0x6d, 0x49, 0x44, 0x12, 0x1a, 0x0a, 0x08, 0x46, 0x69, 0x6c, 0x65, 0x50, 0x61,
+	0x74, 0x68, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x46, 0x69, 0x6c, 0x65, 0x50, 0x61,
+	0x74, 0x68, 0x12, 0x1a, 0x0a, 0x08, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x0d, 0x52, 0x08, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1e,
+	0x0a, 0x0a, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x54, 0x79, 0x70, 0x65, 0x18, 0x05, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x0a, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x54, 0x79, 0x70, 0x65, 0x12, 0x18,
+	0x0a, 0x07, 0x50, 0x61, 0x72, 0x74, 0x69, 0x61, 0x6c, 0x18, 0x06, 0x20, 0x01, 0x28, 0x08, 0x52,
+	0x07, 0x50, 0x61, 0x72, 0x74, 0x69, 0x61, 0x6c, 0x12, 0x12, 0x0a, 0x04, 0x50, 0x61, 0x72, 0x74,
+	0x18, 0x07, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x04, 0x50, 0x61, 0x72, 0x74, 0x12, 0x14, 0x0a, 0x05,
+	0x43, 0x6f, 0x64, 0x65, 0x63, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x43, 0x6f, 0x64,
+	0x65, 0x63, 0x12, 0x29, 0x0a, 0x08, 0x4c, 0x6f, 0x75, 0x64, 0x6e, 0x65, 0x73, 0x73, 0x18, 0x09,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x0d, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x4c, 0x6f, 0x75, 0x64, 0x6e,
+	0x65, 0x73, 0x73, 0x52, 0x08, 0x4c, 0x6f, 0x75, 0x64, 0x6e, 0x65, 0x73, 0x73, 0x22, 0x46, 0x0a,
+	0x08, 0x4c, 0x6f, 0x75, 0x64, 0x6e, 0x65, 0x73, 0x73, 0x12, 0x1e, 0x0a, 0x0a, 0x49, 0x6e, 0x74,
+	0x65, 0x67, 0x72, 0x61, 0x74, 0x65, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0a, 0x49,
+	0x6e, 0x74, 0x65, 0x67, 0x72, 0x61, 0x74, 0x65, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x54, 0x72, 0x75,
+	0x65, 0x50, 0x65, 0x61, 0x6b, 0x18, 0x02, 0x20, 0x01, 0x28, 0x01, 0x52, 0x08, 0x54, 0x72, 0x75,
+	0x65, 0x50, 0x65, 0x61, 0x6b, 0x22, 0xa4, 0x01, 0x0a, 0x0e, 0x55, 0x70, 0x6c, 0x6f, 0x61, 0x64,
+	0x46, 0x69, 0x6e, 0x69, 0x73, 0x68, 0x65, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x57, 0x6f, 0x72, 0x6b,
+	0x65, 0x72, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x57, 0x6f, 0x72, 0x6b,
+	0x65, 0x72, 0x49, 0x44, 0x12, 0x1a, 0x0a, 0x08, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x49, 0x44,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x08, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x49, 0x44,
+	0x12, 0x16, 0x0a, 0x06, 0x48, 0x4c, 0x53, 0x55, 0x72, 0x6c, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x06, 0x48, 0x4c, 0x53, 0x55, 0x72, 0x6c, 0x12, 0x1e, 0x0a, 0x0a, 0x53, 0x6f, 0x75, 0x72,
+	0x63, 0x65, 0x54, 0x79, 0x70, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x53, 0x6f,
+	0x75, 0x72, 0x63, 0x65, 0x54, 0x79, 0x70, 0x65, 0x12, 0x22, 0x0a, 0x0c, 0x54, 0x68, 0x75, 0x6d,
+	0x62, 0x6e, 0x61, 0x69, 0x6c, 0x55, 0x72, 0x6c, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c,
+	0x54, 0x68, 0x75, 0x6d, 0x62, 0x6e, 0x61, 0x69, 0x6c, 0x55, 0x72, 0x6c, 0x22, 0x7f, 0x0a, 0x0d,
+	0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x53, 0x74, 0x61, 0x72, 0x74, 0x65, 0x64, 0x12, 0x1a, 0x0a,
 	0x08, 0x57, 0x6f, 0x72, 0x6b, 0x65, 0x72, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
 	0x08, 0x57, 0x6f, 0x72, 0x6b, 0x65, 0x72, 0x49, 0x44, 0x12, 0x1a, 0x0a, 0x08, 0x53, 0x74, 0x72,
 	0x65, 0x61, 0x6d, 0x49, 0x44, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x08, 0x53, 0x74, 0x72,
-	0x65, 0x61, 0x6d, 0x49, 0x44, 0x12, 0x1a, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x72, 0x74, 0x73, 0x18,
-	0x03, 0x20, 0x03, 0x28, 0x0d, 0x42, 0x02, 0x10, 0x01, 0x52, 0x06, 0x73, 0x74, 0x61, 0x72, 0x74,
-	0x73, 0x12, 0x16, 0x0a, 0x04, 0x65, 0x6e, 0x64, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0d, 0x42,
-	0x02, 0x10, 0x01, 0x52, 0x04, 0x65, 0x6e, 0x64, 0x73, 0x22, 0x59, 0x0a, 0x1d, 0x47, 0x65, 0x74,
-	0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x49, 0x6e, 0x66, 0x6f, 0x46, 0x6f, 0x72, 0x55, 0x70, 0x6c,
-	0x6f, 0x61, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x57, 0x6f,
-	0x72, 0x6b, 0x65, 0x72, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x57, 0x6f,
-	0x72, 0x6b, 0x65, 0x72, 0x49, 0x44, 0x12, 0x1c, 0x0a, 0x09, 0x55, 0x70, 0x6c, 0x6f, 0x61, 0x64,
-	0x4b, 0x65, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x55, 0x70, 0x6c, 0x6f, 0x61,
-	0x64, 0x4b, 0x65, 0x79, 0x22, 0xb2, 0x02, 0x0a, 0x1e, 0x47, 0x65, 0x74, 0x53, 0x74, 0x72, 0x65,
-	0x61, 0x6d, 0x49, 0x6e, 0x66, 0x6f, 0x46, 0x6f, 0x72, 0x55, 0x70, 0x6c, 0x6f, 0x61, 0x64, 0x52,
-	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1e, 0x0a, 0x0a, 0x43, 0x6f, 0x75, 0x72, 0x73,
-	0x65, 0x53, 0x6c, 0x75, 0x67, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x43, 0x6f, 0x75,
-	0x72, 0x73, 0x65, 0x53, 0x6c, 0x75, 0x67, 0x12, 0x1e, 0x0a, 0x0a, 0x43, 0x6f, 0x75, 0x72, 0x73,
-	0x65, 0x54, 0x65, 0x72, 0x6d, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x43, 0x6f, 0x75,
-	0x72, 0x73, 0x65, 0x54, 0x65, 0x72, 0x6d, 0x12, 0x1e, 0x0a, 0x0a, 0x43, 0x6f, 0x75, 0x72, 0x73,
-	0x65, 0x59, 0x65, 0x61, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0a, 0x43, 0x6f, 0x75,
-	0x72, 0x73, 0x65, 0x59, 0x65, 0x61, 0x72, 0x12, 0x3c, 0x0a, 0x0b, 0x53, 0x74, 0x72, 0x65, 0x61,
-	0x6d, 0x53, 0x74, 0x61, 0x72, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67,
-	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54,
-	0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x0b, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d,
-	0x53, 0x74, 0x61, 0x72, 0x74, 0x12, 0x38, 0x0a, 0x09, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x45,
-	0x6e, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
-	0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73,
-	0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x45, 0x6e, 0x64, 0x12,
-	0x1a, 0x0a, 0x08, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x49, 0x44, 0x18, 0x06, 0x20, 0x01, 0x28,
-	0x0d, 0x52, 0x08, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x49, 0x44, 0x12, 0x1c, 0x0a, 0x09, 0x56,
-	0x69, 0x64, 0x65, 0x6f, 0x54, 0x79, 0x70, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09,
-	0x56, 0x69, 0x64, 0x65, 0x6f, 0x54, 0x79, 0x70, 0x65, 0x22, 0x48, 0x0a, 0x12, 0x4c, 0x69, 0x76,
-	0x65, 0x50, 0x72, 0x65, 0x76, 0x69, 0x65, 0x77, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
-	0x1a, 0x0a, 0x08, 0x57, 0x6f, 0x72, 0x6b, 0x65, 0x72, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x08, 0x57, 0x6f, 0x72, 0x6b, 0x65, 0x72, 0x49, 0x44, 0x12, 0x16, 0x0a, 0x06, 0x48,
-	0x4c, 0x53, 0x55, 0x72, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x48, 0x4c, 0x53,
-	0x55, 0x72, 0x6c, 0x22, 0x33, 0x0a, 0x13, 0x4c, 0x69, 0x76, 0x65, 0x50, 0x72, 0x65, 0x76, 0x69,
-	0x65, 0x77, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x4c, 0x69,
-	0x76, 0x65, 0x54, 0x68, 0x75, 0x6d, 0x62, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x09, 0x4c,
-	0x69, 0x76, 0x65, 0x54, 0x68, 0x75, 0x6d, 0x62, 0x22, 0xbf, 0x01, 0x0a, 0x1f, 0x4e, 0x6f, 0x74,
-	0x69, 0x66, 0x79, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x63, 0x6f, 0x64, 0x69, 0x6e, 0x67, 0x46, 0x61,
-	0x69, 0x6c, 0x75, 0x72, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1a, 0x0a, 0x08,
+	0x65, 0x61, 0x6d, 0x49, 0x44, 0x12, 0x16, 0x0a, 0x06, 0x48, 0x6c, 0x73, 0x55, 0x72, 0x6c, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x48, 0x6c, 0x73, 0x55, 0x72, 0x6c, 0x12, 0x1e, 0x0a,
+	0x0a, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x54, 0x79, 0x70, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0a, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x54, 0x79, 0x70, 0x65, 0x22, 0xbe, 0x02,
+	0x0a, 0x0c, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x48, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x12, 0x1a,
+	0x0a, 0x08, 0x57, 0x6f, 0x72, 0x6b, 0x65, 0x72, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x08, 0x57, 0x6f, 0x72, 0x6b, 0x65, 0x72, 0x49, 0x44, 0x12, 0x1a, 0x0a, 0x08, 0x53, 0x74,
+	0x72, 0x65, 0x61, 0x6d, 0x49, 0x44, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x08, 0x53, 0x74,
+	0x72, 0x65, 0x61, 0x6d, 0x49, 0x44, 0x12, 0x18, 0x0a, 0x07, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f,
+	0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e,
+	0x12, 0x2e, 0x0a, 0x04, 0x54, 0x69, 0x6d, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a,
+	0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66,
+	0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x04, 0x54, 0x69, 0x6d, 0x65,
+	0x12, 0x18, 0x0a, 0x07, 0x42, 0x69, 0x74, 0x72, 0x61, 0x74, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28,
+	0x02, 0x52, 0x07, 0x42, 0x69, 0x74, 0x72, 0x61, 0x74, 0x65, 0x12, 0x10, 0x0a, 0x03, 0x46, 0x70,
+	0x73, 0x18, 0x06, 0x20, 0x01, 0x28, 0x02, 0x52, 0x03, 0x46, 0x70, 0x73, 0x12, 0x24, 0x0a, 0x0d,
+	0x44, 0x72, 0x6f, 0x70, 0x70, 0x65, 0x64, 0x46, 0x72, 0x61, 0x6d, 0x65, 0x73, 0x18, 0x07, 0x20,
+	0x01, 0x28, 0x04, 0x52, 0x0d, 0x44, 0x72, 0x6f, 0x70, 0x70, 0x65, 0x64, 0x46, 0x72, 0x61, 0x6d,
+	0x65, 0x73, 0x12, 0x2a, 0x0a, 0x10, 0x44, 0x75, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x65, 0x64,
+	0x46, 0x72, 0x61, 0x6d, 0x65, 0x73, 0x18, 0x08, 0x20, 0x01, 0x28, 0x04, 0x52, 0x10, 0x44, 0x75,
+	0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x65, 0x64, 0x46, 0x72, 0x61, 0x6d, 0x65, 0x73, 0x12, 0x14,
+	0x0a, 0x05, 0x53, 0x70, 0x65, 0x65, 0x64, 0x18, 0x09, 0x20, 0x01, 0x28, 0x02, 0x52, 0x05, 0x53,
+	0x70, 0x65, 0x65, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x53, 0x74, 0x61, 0x6c, 0x6c, 0x65, 0x64, 0x18,
+	0x0a, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x53, 0x74, 0x61, 0x6c, 0x6c, 0x65, 0x64, 0x22, 0xd6,
+	0x01, 0x0a, 0x0e, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x49, 0x6e, 0x63, 0x69, 0x64, 0x65, 0x6e,
+	0x74, 0x12, 0x1a, 0x0a, 0x08, 0x57, 0x6f, 0x72, 0x6b, 0x65, 0x72, 0x49, 0x44, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x08, 0x57, 0x6f, 0x72, 0x6b, 0x65, 0x72, 0x49, 0x44, 0x12, 0x1a, 0x0a,
+	0x08, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x49, 0x44, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52,
+	0x08, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x49, 0x44, 0x12, 0x18, 0x0a, 0x07, 0x56, 0x65, 0x72,
+	0x73, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x56, 0x65, 0x72, 0x73,
+	0x69, 0x6f, 0x6e, 0x12, 0x12, 0x0a, 0x04, 0x54, 0x79, 0x70, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x04, 0x54, 0x79, 0x70, 0x65, 0x12, 0x30, 0x0a, 0x05, 0x53, 0x74, 0x61, 0x72, 0x74,
+	0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61,
+	0x6d, 0x70, 0x52, 0x05, 0x53, 0x74, 0x61, 0x72, 0x74, 0x12, 0x2c, 0x0a, 0x03, 0x45, 0x6e, 0x64,
+	0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61,
+	0x6d, 0x70, 0x52, 0x03, 0x45, 0x6e, 0x64, 0x22, 0x7c, 0x0a, 0x0e, 0x53, 0x69, 0x6c, 0x65, 0x6e,
+	0x63, 0x65, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x73, 0x12, 0x1a, 0x0a, 0x08, 0x57, 0x6f, 0x72,
+	0x6b, 0x65, 0x72, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x57, 0x6f, 0x72,
+	0x6b, 0x65, 0x72, 0x49, 0x44, 0x12, 0x1a, 0x0a, 0x08, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x49,
+	0x44, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x08, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x49,
+	0x44, 0x12, 0x1a, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x72, 0x74, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28,
+	0x0d, 0x42, 0x02, 0x10, 0x01, 0x52, 0x06, 0x73, 0x74, 0x61, 0x72, 0x74, 0x73, 0x12, 0x16, 0x0a,
+	0x04, 0x65, 0x6e, 0x64, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0d, 0x42, 0x02, 0x10, 0x01, 0x52,
+	0x04, 0x65, 0x6e, 0x64, 0x73, 0x22, 0x4f, 0x0a, 0x11, 0x53, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e,
+	0x53, 0x75, 0x67, 0x67, 0x65, 0x73, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x14, 0x0a, 0x05, 0x53, 0x74,
+	0x61, 0x72, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x05, 0x53, 0x74, 0x61, 0x72, 0x74,
+	0x12, 0x24, 0x0a, 0x0d, 0x54, 0x68, 0x75, 0x6d, 0x62, 0x6e, 0x61, 0x69, 0x6c, 0x50, 0x61, 0x74,
+	0x68, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x54, 0x68, 0x75, 0x6d, 0x62, 0x6e, 0x61,
+	0x69, 0x6c, 0x50, 0x61, 0x74, 0x68, 0x22, 0x86, 0x01, 0x0a, 0x12, 0x53, 0x65, 0x63, 0x74, 0x69,
+	0x6f, 0x6e, 0x53, 0x75, 0x67, 0x67, 0x65, 0x73, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x1a, 0x0a,
+	0x08, 0x57, 0x6f, 0x72, 0x6b, 0x65, 0x72, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x08, 0x57, 0x6f, 0x72, 0x6b, 0x65, 0x72, 0x49, 0x44, 0x12, 0x1a, 0x0a, 0x08, 0x53, 0x74, 0x72,
+	0x65, 0x61, 0x6d, 0x49, 0x44, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x08, 0x53, 0x74, 0x72,
+	0x65, 0x61, 0x6d, 0x49, 0x44, 0x12, 0x38, 0x0a, 0x0b, 0x53, 0x75, 0x67, 0x67, 0x65, 0x73, 0x74,
+	0x69, 0x6f, 0x6e, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x61, 0x70, 0x69,
+	0x2e, 0x53, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x75, 0x67, 0x67, 0x65, 0x73, 0x74, 0x69,
+	0x6f, 0x6e, 0x52, 0x0b, 0x53, 0x75, 0x67, 0x67, 0x65, 0x73, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x22,
+	0x31, 0x0a, 0x05, 0x53, 0x6c, 0x69, 0x64, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x53, 0x74, 0x61, 0x72,
+	0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x05, 0x53, 0x74, 0x61, 0x72, 0x74, 0x12, 0x12,
+	0x0a, 0x04, 0x50, 0x61, 0x74, 0x68, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x50, 0x61,
+	0x74, 0x68, 0x22, 0x64, 0x0a, 0x06, 0x53, 0x6c, 0x69, 0x64, 0x65, 0x73, 0x12, 0x1a, 0x0a, 0x08,
 	0x57, 0x6f, 0x72, 0x6b, 0x65, 0x72, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08,
 	0x57, 0x6f, 0x72, 0x6b, 0x65, 0x72, 0x49, 0x44, 0x12, 0x1a, 0x0a, 0x08, 0x53, 0x74, 0x72, 0x65,
 	0x61, 0x6d, 0x49, 0x44, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x08, 0x53, 0x74, 0x72, 0x65,
-	0x61, 0x6d, 0x49, 0x44, 0x12, 0x18, 0x0a, 0x07, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18,
-	0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x1a,
-	0x0a, 0x08, 0x46, 0x69, 0x6c, 0x65, 0x50, 0x61, 0x74, 0x68, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x08, 0x46, 0x69, 0x6c, 0x65, 0x50, 0x61, 0x74, 0x68, 0x12, 0x12, 0x0a, 0x04, 0x4c, 0x6f,
-	0x67, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x4c, 0x6f, 0x67, 0x73, 0x12, 0x1a,
-	0x0a, 0x08, 0x45, 0x78, 0x69, 0x74, 0x43, 0x6f, 0x64, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x03,
-	0x52, 0x08, 0x45, 0x78, 0x69, 0x74, 0x43, 0x6f, 0x64, 0x65, 0x22, 0x22, 0x0a, 0x20, 0x4e, 0x6f,
-	0x74, 0x69, 0x66, 0x79, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x63, 0x6f, 0x64, 0x69, 0x6e, 0x67, 0x46,
-	0x61, 0x69, 0x6c, 0x75, 0x72, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x8a,
-	0x01, 0x0a, 0x18, 0x43, 0x6f, 0x6d, 0x62, 0x69, 0x6e, 0x65, 0x54, 0x68, 0x75, 0x6d, 0x62, 0x6e,
-	0x61, 0x69, 0x6c, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x2a, 0x0a, 0x10, 0x50,
-	0x72, 0x69, 0x6d, 0x61, 0x72, 0x79, 0x54, 0x68, 0x75, 0x6d, 0x62, 0x6e, 0x61, 0x69, 0x6c, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x10, 0x50, 0x72, 0x69, 0x6d, 0x61, 0x72, 0x79, 0x54, 0x68,
-	0x75, 0x6d, 0x62, 0x6e, 0x61, 0x69, 0x6c, 0x12, 0x2e, 0x0a, 0x12, 0x53, 0x65, 0x63, 0x6f, 0x6e,
-	0x64, 0x61, 0x72, 0x79, 0x54, 0x68, 0x75, 0x6d, 0x62, 0x6e, 0x61, 0x69, 0x6c, 0x18, 0x02, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x12, 0x53, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x61, 0x72, 0x79, 0x54, 0x68,
-	0x75, 0x6d, 0x62, 0x6e, 0x61, 0x69, 0x6c, 0x12, 0x12, 0x0a, 0x04, 0x50, 0x61, 0x74, 0x68, 0x18,
-	0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x50, 0x61, 0x74, 0x68, 0x22, 0x37, 0x0a, 0x19, 0x43,
-	0x6f, 0x6d, 0x62, 0x69, 0x6e, 0x65, 0x54, 0x68, 0x75, 0x6d, 0x62, 0x6e, 0x61, 0x69, 0x6c, 0x73,
-	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x46, 0x69, 0x6c, 0x65,
-	0x50, 0x61, 0x74, 0x68, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x46, 0x69, 0x6c, 0x65,
-	0x50, 0x61, 0x74, 0x68, 0x32, 0xb0, 0x05, 0x0a, 0x08, 0x54, 0x6f, 0x57, 0x6f, 0x72, 0x6b, 0x65,
-	0x72, 0x12, 0x32, 0x0a, 0x0d, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x53, 0x74, 0x72, 0x65,
-	0x61, 0x6d, 0x12, 0x12, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x0b, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x53, 0x74, 0x61,
-	0x74, 0x75, 0x73, 0x22, 0x00, 0x12, 0x36, 0x0a, 0x0f, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x50, 0x72, 0x65, 0x6d, 0x69, 0x65, 0x72, 0x65, 0x12, 0x14, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x50,
-	0x72, 0x65, 0x6d, 0x69, 0x65, 0x72, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x0b,
-	0x2e, 0x61, 0x70, 0x69, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x22, 0x00, 0x12, 0x38, 0x0a,
-	0x10, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x45, 0x6e,
-	0x64, 0x12, 0x15, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x45, 0x6e, 0x64, 0x53, 0x74, 0x72, 0x65, 0x61,
-	0x6d, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x0b, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x53,
-	0x74, 0x61, 0x74, 0x75, 0x73, 0x22, 0x00, 0x12, 0x40, 0x0a, 0x0f, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x57, 0x61, 0x76, 0x65, 0x66, 0x6f, 0x72, 0x6d, 0x12, 0x14, 0x2e, 0x61, 0x70, 0x69,
-	0x2e, 0x57, 0x61, 0x76, 0x65, 0x66, 0x6f, 0x72, 0x6d, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x1a, 0x15, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x57, 0x61, 0x76, 0x65, 0x46, 0x6f, 0x72, 0x6d, 0x52,
-	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x31, 0x0a, 0x0a, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x43, 0x75, 0x74, 0x12, 0x0f, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x43, 0x75,
-	0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x10, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x43,
-	0x75, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x42, 0x0a, 0x12,
-	0x47, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x65, 0x54, 0x68, 0x75, 0x6d, 0x62, 0x6e, 0x61, 0x69,
-	0x6c, 0x73, 0x12, 0x1d, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x47, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74,
-	0x65, 0x54, 0x68, 0x75, 0x6d, 0x62, 0x6e, 0x61, 0x69, 0x6c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x1a, 0x0b, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x22, 0x00,
-	0x12, 0x4a, 0x0a, 0x13, 0x47, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x65, 0x4c, 0x69, 0x76, 0x65,
-	0x50, 0x72, 0x65, 0x76, 0x69, 0x65, 0x77, 0x12, 0x17, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x4c, 0x69,
-	0x76, 0x65, 0x50, 0x72, 0x65, 0x76, 0x69, 0x65, 0x77, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x1a, 0x18, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x4c, 0x69, 0x76, 0x65, 0x50, 0x72, 0x65, 0x76, 0x69,
-	0x65, 0x77, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x5e, 0x0a, 0x15,
-	0x47, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x65, 0x53, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x49,
-	0x6d, 0x61, 0x67, 0x65, 0x73, 0x12, 0x20, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x47, 0x65, 0x6e, 0x65,
-	0x72, 0x61, 0x74, 0x65, 0x53, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x6d, 0x61, 0x67, 0x65,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x21, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x47, 0x65,
-	0x6e, 0x65, 0x72, 0x61, 0x74, 0x65, 0x53, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x6d, 0x61,
-	0x67, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x43, 0x0a, 0x12,
-	0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x53, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x6d, 0x61,
-	0x67, 0x65, 0x12, 0x1e, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x53,
-	0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x6d, 0x61, 0x67, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x1a, 0x0b, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x22,
-	0x00, 0x12, 0x54, 0x0a, 0x11, 0x43, 0x6f, 0x6d, 0x62, 0x69, 0x6e, 0x65, 0x54, 0x68, 0x75, 0x6d,
-	0x62, 0x6e, 0x61, 0x69, 0x6c, 0x73, 0x12, 0x1d, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x43, 0x6f, 0x6d,
-	0x62, 0x69, 0x6e, 0x65, 0x54, 0x68, 0x75, 0x6d, 0x62, 0x6e, 0x61, 0x69, 0x6c, 0x73, 0x52, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1e, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x43, 0x6f, 0x6d, 0x62,
-	0x69, 0x6e, 0x65, 0x54, 0x68, 0x75, 0x6d, 0x62, 0x6e, 0x61, 0x69, 0x6c, 0x73, 0x52, 0x65, 0x73,
-	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x32, 0xe9, 0x06, 0x0a, 0x0a, 0x46, 0x72, 0x6f, 0x6d,
-	0x57, 0x6f, 0x72, 0x6b, 0x65, 0x72, 0x12, 0x42, 0x0a, 0x0b, 0x4a, 0x6f, 0x69, 0x6e, 0x57, 0x6f,
-	0x72, 0x6b, 0x65, 0x72, 0x73, 0x12, 0x17, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x4a, 0x6f, 0x69, 0x6e,
-	0x57, 0x6f, 0x72, 0x6b, 0x65, 0x72, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x18,
-	0x2e, 0x61, 0x70, 0x69, 0x2e, 0x4a, 0x6f, 0x69, 0x6e, 0x57, 0x6f, 0x72, 0x6b, 0x65, 0x72, 0x73,
-	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x2e, 0x0a, 0x0d, 0x53, 0x65,
-	0x6e, 0x64, 0x48, 0x65, 0x61, 0x72, 0x74, 0x42, 0x65, 0x61, 0x74, 0x12, 0x0e, 0x2e, 0x61, 0x70,
-	0x69, 0x2e, 0x48, 0x65, 0x61, 0x72, 0x74, 0x42, 0x65, 0x61, 0x74, 0x1a, 0x0b, 0x2e, 0x61, 0x70,
-	0x69, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x22, 0x00, 0x12, 0x53, 0x0a, 0x19, 0x4e, 0x6f,
-	0x74, 0x69, 0x66, 0x79, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x63, 0x6f, 0x64, 0x69, 0x6e, 0x67, 0x50,
-	0x72, 0x6f, 0x67, 0x72, 0x65, 0x73, 0x73, 0x12, 0x25, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x4e, 0x6f,
-	0x74, 0x69, 0x66, 0x79, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x63, 0x6f, 0x64, 0x69, 0x6e, 0x67, 0x50,
-	0x72, 0x6f, 0x67, 0x72, 0x65, 0x73, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x0b,
-	0x2e, 0x61, 0x70, 0x69, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x22, 0x00, 0x28, 0x01, 0x12,
-	0x44, 0x0a, 0x19, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x79, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x63, 0x6f,
-	0x64, 0x69, 0x6e, 0x67, 0x46, 0x69, 0x6e, 0x69, 0x73, 0x68, 0x65, 0x64, 0x12, 0x18, 0x2e, 0x61,
-	0x70, 0x69, 0x2e, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x63, 0x6f, 0x64, 0x69, 0x6e, 0x67, 0x46, 0x69,
-	0x6e, 0x69, 0x73, 0x68, 0x65, 0x64, 0x1a, 0x0b, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x53, 0x74, 0x61,
-	0x74, 0x75, 0x73, 0x22, 0x00, 0x12, 0x3a, 0x0a, 0x14, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x79, 0x53,
-	0x69, 0x6c, 0x65, 0x6e, 0x63, 0x65, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x73, 0x12, 0x13, 0x2e,
-	0x61, 0x70, 0x69, 0x2e, 0x53, 0x69, 0x6c, 0x65, 0x6e, 0x63, 0x65, 0x52, 0x65, 0x73, 0x75, 0x6c,
-	0x74, 0x73, 0x1a, 0x0b, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x22,
-	0x00, 0x12, 0x38, 0x0a, 0x13, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x79, 0x53, 0x74, 0x72, 0x65, 0x61,
-	0x6d, 0x53, 0x74, 0x61, 0x72, 0x74, 0x65, 0x64, 0x12, 0x12, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x53,
-	0x74, 0x72, 0x65, 0x61, 0x6d, 0x53, 0x74, 0x61, 0x72, 0x74, 0x65, 0x64, 0x1a, 0x0b, 0x2e, 0x61,
-	0x70, 0x69, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x22, 0x00, 0x12, 0x3a, 0x0a, 0x14, 0x4e,
-	0x6f, 0x74, 0x69, 0x66, 0x79, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x46, 0x69, 0x6e, 0x69, 0x73,
-	0x68, 0x65, 0x64, 0x12, 0x13, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d,
-	0x46, 0x69, 0x6e, 0x69, 0x73, 0x68, 0x65, 0x64, 0x1a, 0x0b, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x53,
-	0x74, 0x61, 0x74, 0x75, 0x73, 0x22, 0x00, 0x12, 0x3a, 0x0a, 0x14, 0x4e, 0x6f, 0x74, 0x69, 0x66,
-	0x79, 0x55, 0x70, 0x6c, 0x6f, 0x61, 0x64, 0x46, 0x69, 0x6e, 0x69, 0x73, 0x68, 0x65, 0x64, 0x12,
-	0x13, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x55, 0x70, 0x6c, 0x6f, 0x61, 0x64, 0x46, 0x69, 0x6e, 0x69,
-	0x73, 0x68, 0x65, 0x64, 0x1a, 0x0b, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x75,
-	0x73, 0x22, 0x00, 0x12, 0x42, 0x0a, 0x18, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x79, 0x54, 0x68, 0x75,
-	0x6d, 0x62, 0x6e, 0x61, 0x69, 0x6c, 0x73, 0x46, 0x69, 0x6e, 0x69, 0x73, 0x68, 0x65, 0x64, 0x12,
-	0x17, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x54, 0x68, 0x75, 0x6d, 0x62, 0x6e, 0x61, 0x69, 0x6c, 0x73,
-	0x46, 0x69, 0x6e, 0x69, 0x73, 0x68, 0x65, 0x64, 0x1a, 0x0b, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x53,
-	0x74, 0x61, 0x74, 0x75, 0x73, 0x22, 0x00, 0x12, 0x4a, 0x0a, 0x15, 0x53, 0x65, 0x6e, 0x64, 0x53,
-	0x65, 0x6c, 0x66, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x12, 0x16, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x53, 0x65, 0x6c, 0x66, 0x53, 0x74, 0x72, 0x65, 0x61,
-	0x6d, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x17, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x53,
-	0x65, 0x6c, 0x66, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
-	0x65, 0x22, 0x00, 0x12, 0x63, 0x0a, 0x16, 0x47, 0x65, 0x74, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d,
-	0x49, 0x6e, 0x66, 0x6f, 0x46, 0x6f, 0x72, 0x55, 0x70, 0x6c, 0x6f, 0x61, 0x64, 0x12, 0x22, 0x2e,
-	0x61, 0x70, 0x69, 0x2e, 0x47, 0x65, 0x74, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x49, 0x6e, 0x66,
-	0x6f, 0x46, 0x6f, 0x72, 0x55, 0x70, 0x6c, 0x6f, 0x61, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x1a, 0x23, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x47, 0x65, 0x74, 0x53, 0x74, 0x72, 0x65, 0x61,
+	0x61, 0x6d, 0x49, 0x44, 0x12, 0x22, 0x0a, 0x06, 0x53, 0x6c, 0x69, 0x64, 0x65, 0x73, 0x18, 0x03,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x0a, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x53, 0x6c, 0x69, 0x64, 0x65,
+	0x52, 0x06, 0x53, 0x6c, 0x69, 0x64, 0x65, 0x73, 0x22, 0x59, 0x0a, 0x1d, 0x47, 0x65, 0x74, 0x53,
+	0x74, 0x72, 0x65, 0x61, 0x6d, 0x49, 0x6e, 0x66, 0x6f, 0x46, 0x6f, 0x72, 0x55, 0x70, 0x6c, 0x6f,
+	0x61, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x57, 0x6f, 0x72,
+	0x6b, 0x65, 0x72, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x57, 0x6f, 0x72,
+	0x6b, 0x65, 0x72, 0x49, 0x44, 0x12, 0x1c, 0x0a, 0x09, 0x55, 0x70, 0x6c, 0x6f, 0x61, 0x64, 0x4b,
+	0x65, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x55, 0x70, 0x6c, 0x6f, 0x61, 0x64,
+	0x4b, 0x65, 0x79, 0x22, 0xd0, 0x02, 0x0a, 0x1e, 0x47, 0x65, 0x74, 0x53, 0x74, 0x72, 0x65, 0x61,
 	0x6d, 0x49, 0x6e, 0x66, 0x6f, 0x46, 0x6f, 0x72, 0x55, 0x70, 0x6c, 0x6f, 0x61, 0x64, 0x52, 0x65,
-	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x69, 0x0a, 0x18, 0x4e, 0x6f, 0x74, 0x69,
-	0x66, 0x79, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x63, 0x6f, 0x64, 0x69, 0x6e, 0x67, 0x46, 0x61, 0x69,
-	0x6c, 0x75, 0x72, 0x65, 0x12, 0x24, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x4e, 0x6f, 0x74, 0x69, 0x66,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1e, 0x0a, 0x0a, 0x43, 0x6f, 0x75, 0x72, 0x73, 0x65,
+	0x53, 0x6c, 0x75, 0x67, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x43, 0x6f, 0x75, 0x72,
+	0x73, 0x65, 0x53, 0x6c, 0x75, 0x67, 0x12, 0x1e, 0x0a, 0x0a, 0x43, 0x6f, 0x75, 0x72, 0x73, 0x65,
+	0x54, 0x65, 0x72, 0x6d, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x43, 0x6f, 0x75, 0x72,
+	0x73, 0x65, 0x54, 0x65, 0x72, 0x6d, 0x12, 0x1e, 0x0a, 0x0a, 0x43, 0x6f, 0x75, 0x72, 0x73, 0x65,
+	0x59, 0x65, 0x61, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0a, 0x43, 0x6f, 0x75, 0x72,
+	0x73, 0x65, 0x59, 0x65, 0x61, 0x72, 0x12, 0x3c, 0x0a, 0x0b, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d,
+	0x53, 0x74, 0x61, 0x72, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f,
+	0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69,
+	0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x0b, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x53,
+	0x74, 0x61, 0x72, 0x74, 0x12, 0x38, 0x0a, 0x09, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x45, 0x6e,
+	0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74,
+	0x61, 0x6d, 0x70, 0x52, 0x09, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x45, 0x6e, 0x64, 0x12, 0x1a,
+	0x0a, 0x08, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x49, 0x44, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0d,
+	0x52, 0x08, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x49, 0x44, 0x12, 0x1c, 0x0a, 0x09, 0x56, 0x69,
+	0x64, 0x65, 0x6f, 0x54, 0x79, 0x70, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x56,
+	0x69, 0x64, 0x65, 0x6f, 0x54, 0x79, 0x70, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x53, 0x6c, 0x69, 0x64,
+	0x65, 0x54, 0x65, 0x78, 0x74, 0x18, 0x08, 0x20, 0x01, 0x28, 0x08, 0x52, 0x09, 0x53, 0x6c, 0x69,
+	0x64, 0x65, 0x54, 0x65, 0x78, 0x74, 0x22, 0x48, 0x0a, 0x12, 0x4c, 0x69, 0x76, 0x65, 0x50, 0x72,
+	0x65, 0x76, 0x69, 0x65, 0x77, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1a, 0x0a, 0x08,
+	0x57, 0x6f, 0x72, 0x6b, 0x65, 0x72, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08,
+	0x57, 0x6f, 0x72, 0x6b, 0x65, 0x72, 0x49, 0x44, 0x12, 0x16, 0x0a, 0x06, 0x48, 0x4c, 0x53, 0x55,
+	0x72, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x48, 0x4c, 0x53, 0x55, 0x72, 0x6c,
+	0x22, 0x33, 0x0a, 0x13, 0x4c, 0x69, 0x76, 0x65, 0x50, 0x72, 0x65, 0x76, 0x69, 0x65, 0x77, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x4c, 0x69, 0x76, 0x65, 0x54,
+	0x68, 0x75, 0x6d, 0x62, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x09, 0x4c, 0x69, 0x76, 0x65,
+	0x54, 0x68, 0x75, 0x6d, 0x62, 0x22, 0xbf, 0x01, 0x0a, 0x1f, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x79,
+	0x54, 0x72, 0x61, 0x6e, 0x73, 0x63, 0x6f, 0x64, 0x69, 0x6e, 0x67, 0x46, 0x61, 0x69, 0x6c, 0x75,
+	0x72, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x57, 0x6f, 0x72,
+	0x6b, 0x65, 0x72, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x57, 0x6f, 0x72,
+	0x6b, 0x65, 0x72, 0x49, 0x44, 0x12, 0x1a, 0x0a, 0x08, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x49,
+	0x44, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x08, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x49,
+	0x44, 0x12, 0x18, 0x0a, 0x07, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x07, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x1a, 0x0a, 0x08, 0x46,
+	0x69, 0x6c, 0x65, 0x50, 0x61, 0x74, 0x68, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x46,
+	0x69, 0x6c, 0x65, 0x50, 0x61, 0x74, 0x68, 0x12, 0x12, 0x0a, 0x04, 0x4c, 0x6f, 0x67, 0x73, 0x18,
+	0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x4c, 0x6f, 0x67, 0x73, 0x12, 0x1a, 0x0a, 0x08, 0x45,
+	0x78, 0x69, 0x74, 0x43, 0x6f, 0x64, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x45,
+	0x78, 0x69, 0x74, 0x43, 0x6f, 0x64, 0x65, 0x22, 0x22, 0x0a, 0x20, 0x4e, 0x6f, 0x74, 0x69, 0x66,
+	0x79, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x63, 0x6f, 0x64, 0x69, 0x6e, 0x67, 0x46, 0x61, 0x69, 0x6c,
+	0x75, 0x72, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x8a, 0x01, 0x0a, 0x18,
+	0x43, 0x6f, 0x6d, 0x62, 0x69, 0x6e, 0x65, 0x54, 0x68, 0x75, 0x6d, 0x62, 0x6e, 0x61, 0x69, 0x6c,
+	0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x2a, 0x0a, 0x10, 0x50, 0x72, 0x69, 0x6d,
+	0x61, 0x72, 0x79, 0x54, 0x68, 0x75, 0x6d, 0x62, 0x6e, 0x61, 0x69, 0x6c, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x10, 0x50, 0x72, 0x69, 0x6d, 0x61, 0x72, 0x79, 0x54, 0x68, 0x75, 0x6d, 0x62,
+	0x6e, 0x61, 0x69, 0x6c, 0x12, 0x2e, 0x0a, 0x12, 0x53, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x61, 0x72,
+	0x79, 0x54, 0x68, 0x75, 0x6d, 0x62, 0x6e, 0x61, 0x69, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x12, 0x53, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x61, 0x72, 0x79, 0x54, 0x68, 0x75, 0x6d, 0x62,
+	0x6e, 0x61, 0x69, 0x6c, 0x12, 0x12, 0x0a, 0x04, 0x50, 0x61, 0x74, 0x68, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x04, 0x50, 0x61, 0x74, 0x68, 0x22, 0x37, 0x0a, 0x19, 0x43, 0x6f, 0x6d, 0x62,
+	0x69, 0x6e, 0x65, 0x54, 0x68, 0x75, 0x6d, 0x62, 0x6e, 0x61, 0x69, 0x6c, 0x73, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x46, 0x69, 0x6c, 0x65, 0x50, 0x61, 0x74,
+	0x68, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x46, 0x69, 0x6c, 0x65, 0x50, 0x61, 0x74,
+	0x68, 0x32, 0xe4, 0x05, 0x0a, 0x08, 0x54, 0x6f, 0x57, 0x6f, 0x72, 0x6b, 0x65, 0x72, 0x12, 0x32,
+	0x0a, 0x0d, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x12,
+	0x12, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x0b, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73,
+	0x22, 0x00, 0x12, 0x36, 0x0a, 0x0f, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x50, 0x72, 0x65,
+	0x6d, 0x69, 0x65, 0x72, 0x65, 0x12, 0x14, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x50, 0x72, 0x65, 0x6d,
+	0x69, 0x65, 0x72, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x0b, 0x2e, 0x61, 0x70,
+	0x69, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x22, 0x00, 0x12, 0x38, 0x0a, 0x10, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x45, 0x6e, 0x64, 0x12, 0x15,
+	0x2e, 0x61, 0x70, 0x69, 0x2e, 0x45, 0x6e, 0x64, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x0b, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x53, 0x74, 0x61, 0x74,
+	0x75, 0x73, 0x22, 0x00, 0x12, 0x40, 0x0a, 0x0f, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x57,
+	0x61, 0x76, 0x65, 0x66, 0x6f, 0x72, 0x6d, 0x12, 0x14, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x57, 0x61,
+	0x76, 0x65, 0x66, 0x6f, 0x72, 0x6d, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x15, 0x2e,
+	0x61, 0x70, 0x69, 0x2e, 0x57, 0x61, 0x76, 0x65, 0x46, 0x6f, 0x72, 0x6d, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x31, 0x0a, 0x0a, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x43, 0x75, 0x74, 0x12, 0x0f, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x43, 0x75, 0x74, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x10, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x43, 0x75, 0x74, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x42, 0x0a, 0x12, 0x47, 0x65, 0x6e,
+	0x65, 0x72, 0x61, 0x74, 0x65, 0x54, 0x68, 0x75, 0x6d, 0x62, 0x6e, 0x61, 0x69, 0x6c, 0x73, 0x12,
+	0x1d, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x47, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x65, 0x54, 0x68,
+	0x75, 0x6d, 0x62, 0x6e, 0x61, 0x69, 0x6c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x0b,
+	0x2e, 0x61, 0x70, 0x69, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x22, 0x00, 0x12, 0x4a, 0x0a,
+	0x13, 0x47, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x65, 0x4c, 0x69, 0x76, 0x65, 0x50, 0x72, 0x65,
+	0x76, 0x69, 0x65, 0x77, 0x12, 0x17, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x4c, 0x69, 0x76, 0x65, 0x50,
+	0x72, 0x65, 0x76, 0x69, 0x65, 0x77, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x18, 0x2e,
+	0x61, 0x70, 0x69, 0x2e, 0x4c, 0x69, 0x76, 0x65, 0x50, 0x72, 0x65, 0x76, 0x69, 0x65, 0x77, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x5e, 0x0a, 0x15, 0x47, 0x65, 0x6e,
+	0x65, 0x72, 0x61, 0x74, 0x65, 0x53, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x6d, 0x61, 0x67,
+	0x65, 0x73, 0x12, 0x20, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x47, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74,
+	0x65, 0x53, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x6d, 0x61, 0x67, 0x65, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x21, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x47, 0x65, 0x6e, 0x65, 0x72,
+	0x61, 0x74, 0x65, 0x53, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x6d, 0x61, 0x67, 0x65, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x43, 0x0a, 0x12, 0x44, 0x65, 0x6c,
+	0x65, 0x74, 0x65, 0x53, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x6d, 0x61, 0x67, 0x65, 0x12,
+	0x1e, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x53, 0x65, 0x63, 0x74,
+	0x69, 0x6f, 0x6e, 0x49, 0x6d, 0x61, 0x67, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x0b, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x22, 0x00, 0x12, 0x54,
+	0x0a, 0x11, 0x43, 0x6f, 0x6d, 0x62, 0x69, 0x6e, 0x65, 0x54, 0x68, 0x75, 0x6d, 0x62, 0x6e, 0x61,
+	0x69, 0x6c, 0x73, 0x12, 0x1d, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x43, 0x6f, 0x6d, 0x62, 0x69, 0x6e,
+	0x65, 0x54, 0x68, 0x75, 0x6d, 0x62, 0x6e, 0x61, 0x69, 0x6c, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x1e, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x43, 0x6f, 0x6d, 0x62, 0x69, 0x6e, 0x65,
+	0x54, 0x68, 0x75, 0x6d, 0x62, 0x6e, 0x61, 0x69, 0x6c, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x22, 0x00, 0x12, 0x32, 0x0a, 0x0d, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x53,
+	0x74, 0x69, 0x74, 0x63, 0x68, 0x12, 0x12, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x53, 0x74, 0x69, 0x74,
+	0x63, 0x68, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x0b, 0x2e, 0x61, 0x70, 0x69, 0x2e,
+	0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x22, 0x00, 0x32, 0xa2, 0x09, 0x0a, 0x0a, 0x46, 0x72, 0x6f,
+	0x6d, 0x57, 0x6f, 0x72, 0x6b, 0x65, 0x72, 0x12, 0x42, 0x0a, 0x0b, 0x4a, 0x6f, 0x69, 0x6e, 0x57,
+	0x6f, 0x72, 0x6b, 0x65, 0x72, 0x73, 0x12, 0x17, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x4a, 0x6f, 0x69,
+	0x6e, 0x57, 0x6f, 0x72, 0x6b, 0x65, 0x72, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x18, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x4a, 0x6f, 0x69, 0x6e, 0x57, 0x6f, 0x72, 0x6b, 0x65, 0x72,
+	0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x51, 0x0a, 0x10, 0x52,
+	0x65, 0x6e, 0x65, 0x77, 0x43, 0x65, 0x72, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x65, 0x12,
+	0x1c, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x52, 0x65, 0x6e, 0x65, 0x77, 0x43, 0x65, 0x72, 0x74, 0x69,
+	0x66, 0x69, 0x63, 0x61, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1d, 0x2e,
+	0x61, 0x70, 0x69, 0x2e, 0x52, 0x65, 0x6e, 0x65, 0x77, 0x43, 0x65, 0x72, 0x74, 0x69, 0x66, 0x69,
+	0x63, 0x61, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x2e,
+	0x0a, 0x0d, 0x53, 0x65, 0x6e, 0x64, 0x48, 0x65, 0x61, 0x72, 0x74, 0x42, 0x65, 0x61, 0x74, 0x12,
+	0x0e, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x48, 0x65, 0x61, 0x72, 0x74, 0x42, 0x65, 0x61, 0x74, 0x1a,
+	0x0b, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x22, 0x00, 0x12, 0x53,
+	0x0a, 0x19, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x79, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x63, 0x6f, 0x64,
+	0x69, 0x6e, 0x67, 0x50, 0x72, 0x6f, 0x67, 0x72, 0x65, 0x73, 0x73, 0x12, 0x25, 0x2e, 0x61, 0x70,
+	0x69, 0x2e, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x79, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x63, 0x6f, 0x64,
+	0x69, 0x6e, 0x67, 0x50, 0x72, 0x6f, 0x67, 0x72, 0x65, 0x73, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x0b, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x22,
+	0x00, 0x28, 0x01, 0x12, 0x44, 0x0a, 0x19, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x79, 0x54, 0x72, 0x61,
+	0x6e, 0x73, 0x63, 0x6f, 0x64, 0x69, 0x6e, 0x67, 0x46, 0x69, 0x6e, 0x69, 0x73, 0x68, 0x65, 0x64,
+	0x12, 0x18, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x63, 0x6f, 0x64, 0x69,
+	0x6e, 0x67, 0x46, 0x69, 0x6e, 0x69, 0x73, 0x68, 0x65, 0x64, 0x1a, 0x0b, 0x2e, 0x61, 0x70, 0x69,
+	0x2e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x22, 0x00, 0x12, 0x3a, 0x0a, 0x14, 0x4e, 0x6f, 0x74,
+	0x69, 0x66, 0x79, 0x53, 0x69, 0x6c, 0x65, 0x6e, 0x63, 0x65, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74,
+	0x73, 0x12, 0x13, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x53, 0x69, 0x6c, 0x65, 0x6e, 0x63, 0x65, 0x52,
+	0x65, 0x73, 0x75, 0x6c, 0x74, 0x73, 0x1a, 0x0b, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x53, 0x74, 0x61,
+	0x74, 0x75, 0x73, 0x22, 0x00, 0x12, 0x42, 0x0a, 0x18, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x79, 0x53,
+	0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x75, 0x67, 0x67, 0x65, 0x73, 0x74, 0x69, 0x6f, 0x6e,
+	0x73, 0x12, 0x17, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x53, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x53,
+	0x75, 0x67, 0x67, 0x65, 0x73, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x1a, 0x0b, 0x2e, 0x61, 0x70, 0x69,
+	0x2e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x22, 0x00, 0x12, 0x2a, 0x0a, 0x0c, 0x4e, 0x6f, 0x74,
+	0x69, 0x66, 0x79, 0x53, 0x6c, 0x69, 0x64, 0x65, 0x73, 0x12, 0x0b, 0x2e, 0x61, 0x70, 0x69, 0x2e,
+	0x53, 0x6c, 0x69, 0x64, 0x65, 0x73, 0x1a, 0x0b, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x53, 0x74, 0x61,
+	0x74, 0x75, 0x73, 0x22, 0x00, 0x12, 0x38, 0x0a, 0x13, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x79, 0x53,
+	0x74, 0x72, 0x65, 0x61, 0x6d, 0x53, 0x74, 0x61, 0x72, 0x74, 0x65, 0x64, 0x12, 0x12, 0x2e, 0x61,
+	0x70, 0x69, 0x2e, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x53, 0x74, 0x61, 0x72, 0x74, 0x65, 0x64,
+	0x1a, 0x0b, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x22, 0x00, 0x12,
+	0x3a, 0x0a, 0x14, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x79, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x46,
+	0x69, 0x6e, 0x69, 0x73, 0x68, 0x65, 0x64, 0x12, 0x13, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x53, 0x74,
+	0x72, 0x65, 0x61, 0x6d, 0x46, 0x69, 0x6e, 0x69, 0x73, 0x68, 0x65, 0x64, 0x1a, 0x0b, 0x2e, 0x61,
+	0x70, 0x69, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x22, 0x00, 0x12, 0x38, 0x0a, 0x12, 0x4e,
+	0x6f, 0x74, 0x69, 0x66, 0x79, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x48, 0x65, 0x61, 0x6c, 0x74,
+	0x68, 0x12, 0x11, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x48, 0x65,
+	0x61, 0x6c, 0x74, 0x68, 0x1a, 0x0b, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x75,
+	0x73, 0x22, 0x00, 0x28, 0x01, 0x12, 0x3a, 0x0a, 0x14, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x79, 0x53,
+	0x74, 0x72, 0x65, 0x61, 0x6d, 0x49, 0x6e, 0x63, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x12, 0x13, 0x2e,
+	0x61, 0x70, 0x69, 0x2e, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x49, 0x6e, 0x63, 0x69, 0x64, 0x65,
+	0x6e, 0x74, 0x1a, 0x0b, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x22,
+	0x00, 0x12, 0x3a, 0x0a, 0x14, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x79, 0x55, 0x70, 0x6c, 0x6f, 0x61,
+	0x64, 0x46, 0x69, 0x6e, 0x69, 0x73, 0x68, 0x65, 0x64, 0x12, 0x13, 0x2e, 0x61, 0x70, 0x69, 0x2e,
+	0x55, 0x70, 0x6c, 0x6f, 0x61, 0x64, 0x46, 0x69, 0x6e, 0x69, 0x73, 0x68, 0x65, 0x64, 0x1a, 0x0b,
+	0x2e, 0x61, 0x70, 0x69, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x22, 0x00, 0x12, 0x42, 0x0a,
+	0x18, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x79, 0x54, 0x68, 0x75, 0x6d, 0x62, 0x6e, 0x61, 0x69, 0x6c,
+	0x73, 0x46, 0x69, 0x6e, 0x69, 0x73, 0x68, 0x65, 0x64, 0x12, 0x17, 0x2e, 0x61, 0x70, 0x69, 0x2e,
+	0x54, 0x68, 0x75, 0x6d, 0x62, 0x6e, 0x61, 0x69, 0x6c, 0x73, 0x46, 0x69, 0x6e, 0x69, 0x73, 0x68,
+	0x65, 0x64, 0x1a, 0x0b, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x22,
+	0x00, 0x12, 0x4a, 0x0a, 0x15, 0x53, 0x65, 0x6e, 0x64, 0x53, 0x65, 0x6c, 0x66, 0x53, 0x74, 0x72,
+	0x65, 0x61, 0x6d, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x16, 0x2e, 0x61, 0x70, 0x69,
+	0x2e, 0x53, 0x65, 0x6c, 0x66, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x17, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x53, 0x65, 0x6c, 0x66, 0x53, 0x74, 0x72,
+	0x65, 0x61, 0x6d, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x63, 0x0a,
+	0x16, 0x47, 0x65, 0x74, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x49, 0x6e, 0x66, 0x6f, 0x46, 0x6f,
+	0x72, 0x55, 0x70, 0x6c, 0x6f, 0x61, 0x64, 0x12, 0x22, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x47, 0x65,
+	0x74, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x49, 0x6e, 0x66, 0x6f, 0x46, 0x6f, 0x72, 0x55, 0x70,
+	0x6c, 0x6f, 0x61, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x23, 0x2e, 0x61, 0x70,
+	0x69, 0x2e, 0x47, 0x65, 0x74, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x49, 0x6e, 0x66, 0x6f, 0x46,
+	0x6f, 0x72, 0x55, 0x70, 0x6c, 0x6f, 0x61, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x22, 0x00, 0x12, 0x69, 0x0a, 0x18, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x79, 0x54, 0x72, 0x61, 0x6e,
+	0x73, 0x63, 0x6f, 0x64, 0x69, 0x6e, 0x67, 0x46, 0x61, 0x69, 0x6c, 0x75, 0x72, 0x65, 0x12, 0x24,
+	0x2e, 0x61, 0x70, 0x69, 0x2e, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x79, 0x54, 0x72, 0x61, 0x6e, 0x73,
+	0x63, 0x6f, 0x64, 0x69, 0x6e, 0x67, 0x46, 0x61, 0x69, 0x6c, 0x75, 0x72, 0x65, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x25, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x4e, 0x6f, 0x74, 0x69, 0x66,
 	0x79, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x63, 0x6f, 0x64, 0x69, 0x6e, 0x67, 0x46, 0x61, 0x69, 0x6c,
-	0x75, 0x72, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x25, 0x2e, 0x61, 0x70, 0x69,
-	0x2e, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x79, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x63, 0x6f, 0x64, 0x69,
-	0x6e, 0x67, 0x46, 0x61, 0x69, 0x6c, 0x75, 0x72, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
-	0x65, 0x22, 0x00, 0x42, 0x0b, 0x5a, 0x09, 0x77, 0x6f, 0x72, 0x6b, 0x65, 0x72, 0x2f, 0x70, 0x62,
-	0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x75, 0x72, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x42, 0x0b, 0x5a,
+	0x09, 0x77, 0x6f, 0x72, 0x6b, 0x65, 0x72, 0x2f, 0x70, 0x62, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x33,
 }
 
 var (
@@ -2781,7 +4171,7 @@ func file_api_proto_rawDescGZIP() []byte {
 	return file_api_proto_rawDescData
 }
 
-var file_api_proto_msgTypes = make([]protoimpl.MessageInfo, 34)
+var file_api_proto_msgTypes = make([]protoimpl.MessageInfo, 46)
 var file_api_proto_goTypes = []interface{}{
 	(*DeleteSectionImageRequest)(nil),        // 0: api.DeleteSectionImageRequest
 	(*GenerateSectionImageResponse)(nil),     // 1: api.GenerateSectionImageResponse
@@ -2793,90 +4183,128 @@ var file_api_proto_goTypes = []interface{}{
 	(*WaveformRequest)(nil),                  // 7: api.WaveformRequest
 	(*WaveFormResponse)(nil),                 // 8: api.WaveFormResponse
 	(*StreamRequest)(nil),                    // 9: api.StreamRequest
-	(*PremiereRequest)(nil),                  // 10: api.PremiereRequest
-	(*EndStreamRequest)(nil),                 // 11: api.EndStreamRequest
-	(*Status)(nil),                           // 12: api.Status
-	(*NotifyTranscodingProgressRequest)(nil), // 13: api.NotifyTranscodingProgressRequest
-	(*JoinWorkersRequest)(nil),               // 14: api.JoinWorkersRequest
-	(*JoinWorkersResponse)(nil),              // 15: api.JoinWorkersResponse
-	(*SelfStreamRequest)(nil),                // 16: api.SelfStreamRequest
-	(*SelfStreamResponse)(nil),               // 17: api.SelfStreamResponse
-	(*HeartBeat)(nil),                        // 18: api.HeartBeat
-	(*StreamFinished)(nil),                   // 19: api.StreamFinished
-	(*ThumbnailsFinished)(nil),               // 20: api.ThumbnailsFinished
-	(*TranscodingFinished)(nil),              // 21: api.TranscodingFinished
-	(*UploadFinished)(nil),                   // 22: api.UploadFinished
-	(*StreamStarted)(nil),                    // 23: api.StreamStarted
-	(*SilenceResults)(nil),                   // 24: api.SilenceResults
-	(*GetStreamInfoForUploadRequest)(nil),    // 25: api.GetStreamInfoForUploadRequest
-	(*GetStreamInfoForUploadResponse)(nil),   // 26: api.GetStreamInfoForUploadResponse
-	(*LivePreviewRequest)(nil),               // 27: api.LivePreviewRequest
-	(*LivePreviewResponse)(nil),              // 28: api.LivePreviewResponse
-	(*NotifyTranscodingFailureRequest)(nil),  // 29: api.NotifyTranscodingFailureRequest
-	(*NotifyTranscodingFailureResponse)(nil), // 30: api.NotifyTranscodingFailureResponse
-	(*CombineThumbnailsRequest)(nil),         // 31: api.CombineThumbnailsRequest
-	(*CombineThumbnailsResponse)(nil),        // 32: api.CombineThumbnailsResponse
-	(*CutRequest_Segment)(nil),               // 33: api.CutRequest.Segment
-	(*timestamppb.Timestamp)(nil),            // 34: google.protobuf.Timestamp
+	(*EncodingProfile)(nil),                  // 10: api.EncodingProfile
+	(*Rendition)(nil),                        // 11: api.Rendition
+	(*PremiereRequest)(nil),                  // 12: api.PremiereRequest
+	(*StitchRequest)(nil),                    // 13: api.StitchRequest
+	(*EndStreamRequest)(nil),                 // 14: api.EndStreamRequest
+	(*Status)(nil),                           // 15: api.Status
+	(*NotifyTranscodingProgressRequest)(nil), // 16: api.NotifyTranscodingProgressRequest
+	(*JoinWorkersRequest)(nil),               // 17: api.JoinWorkersRequest
+	(*JoinWorkersResponse)(nil),              // 18: api.JoinWorkersResponse
+	(*RenewCertificateRequest)(nil),          // 19: api.RenewCertificateRequest
+	(*RenewCertificateResponse)(nil),         // 20: api.RenewCertificateResponse
+	(*SelfStreamRequest)(nil),                // 21: api.SelfStreamRequest
+	(*SelfStreamResponse)(nil),               // 22: api.SelfStreamResponse
+	(*HeartBeat)(nil),                        // 23: api.HeartBeat
+	(*StreamFinished)(nil),                   // 24: api.StreamFinished
+	(*ThumbnailsFinished)(nil),               // 25: api.ThumbnailsFinished
+	(*TranscodingFinished)(nil),              // 26: api.TranscodingFinished
+	(*Loudness)(nil),                         // 27: api.Loudness
+	(*UploadFinished)(nil),                   // 28: api.UploadFinished
+	(*StreamStarted)(nil),                    // 29: api.StreamStarted
+	(*StreamHealth)(nil),                     // 30: api.StreamHealth
+	(*StreamIncident)(nil),                   // 31: api.StreamIncident
+	(*SilenceResults)(nil),                   // 32: api.SilenceResults
+	(*SectionSuggestion)(nil),                // 33: api.SectionSuggestion
+	(*SectionSuggestions)(nil),               // 34: api.SectionSuggestions
+	(*Slide)(nil),                            // 35: api.Slide
+	(*Slides)(nil),                           // 36: api.Slides
+	(*GetStreamInfoForUploadRequest)(nil),    // 37: api.GetStreamInfoForUploadRequest
+	(*GetStreamInfoForUploadResponse)(nil),   // 38: api.GetStreamInfoForUploadResponse
+	(*LivePreviewRequest)(nil),               // 39: api.LivePreviewRequest
+	(*LivePreviewResponse)(nil),              // 40: api.LivePreviewResponse
+	(*NotifyTranscodingFailureRequest)(nil),  // 41: api.NotifyTranscodingFailureRequest
+	(*NotifyTranscodingFailureResponse)(nil), // 42: api.NotifyTranscodingFailureResponse
+	(*CombineThumbnailsRequest)(nil),         // 43: api.CombineThumbnailsRequest
+	(*CombineThumbnailsResponse)(nil),        // 44: api.CombineThumbnailsResponse
+	(*CutRequest_Segment)(nil),               // 45: api.CutRequest.Segment
+	(*timestamppb.Timestamp)(nil),            // 46: google.protobuf.Timestamp
 }
 var file_api_proto_depIdxs = []int32{
-	34, // 0: api.GenerateThumbnailRequest.start:type_name -> google.protobuf.Timestamp
+	46, // 0: api.GenerateThumbnailRequest.start:type_name -> google.protobuf.Timestamp
 	3,  // 1: api.GenerateSectionImageRequest.Sections:type_name -> api.Section
-	33, // 2: api.CutRequest.segments:type_name -> api.CutRequest.Segment
-	34, // 3: api.StreamRequest.Start:type_name -> google.protobuf.Timestamp
-	34, // 4: api.StreamRequest.End:type_name -> google.protobuf.Timestamp
-	34, // 5: api.SelfStreamResponse.StreamStart:type_name -> google.protobuf.Timestamp
-	34, // 6: api.GetStreamInfoForUploadResponse.StreamStart:type_name -> google.protobuf.Timestamp
-	34, // 7: api.GetStreamInfoForUploadResponse.StreamEnd:type_name -> google.protobuf.Timestamp
-	9,  // 8: api.ToWorker.RequestStream:input_type -> api.StreamRequest
-	10, // 9: api.ToWorker.RequestPremiere:input_type -> api.PremiereRequest
-	11, // 10: api.ToWorker.RequestStreamEnd:input_type -> api.EndStreamRequest
-	7,  // 11: api.ToWorker.RequestWaveform:input_type -> api.WaveformRequest
-	5,  // 12: api.ToWorker.RequestCut:input_type -> api.CutRequest
-	2,  // 13: api.ToWorker.GenerateThumbnails:input_type -> api.GenerateThumbnailRequest
-	27, // 14: api.ToWorker.GenerateLivePreview:input_type -> api.LivePreviewRequest
-	4,  // 15: api.ToWorker.GenerateSectionImages:input_type -> api.GenerateSectionImageRequest
-	0,  // 16: api.ToWorker.DeleteSectionImage:input_type -> api.DeleteSectionImageRequest
-	31, // 17: api.ToWorker.CombineThumbnails:input_type -> api.CombineThumbnailsRequest
-	14, // 18: api.FromWorker.JoinWorkers:input_type -> api.JoinWorkersRequest
-	18, // 19: api.FromWorker.SendHeartBeat:input_type -> api.HeartBeat
-	13, // 20: api.FromWorker.NotifyTranscodingProgress:input_type -> api.NotifyTranscodingProgressRequest
-	21, // 21: api.FromWorker.NotifyTranscodingFinished:input_type -> api.TranscodingFinished
-	24, // 22: api.FromWorker.NotifySilenceResults:input_type -> api.SilenceResults
-	23, // 23: api.FromWorker.NotifyStreamStarted:input_type -> api.StreamStarted
-	19, // 24: api.FromWorker.NotifyStreamFinished:input_type -> api.StreamFinished
-	22, // 25: api.FromWorker.NotifyUploadFinished:input_type -> api.UploadFinished
-	20, // 26: api.FromWorker.NotifyThumbnailsFinished:input_type -> api.ThumbnailsFinished
-	16, // 27: api.FromWorker.SendSelfStreamRequest:input_type -> api.SelfStreamRequest
-	25, // 28: api.FromWorker.GetStreamInfoForUpload:input_type -> api.GetStreamInfoForUploadRequest
-	29, // 29: api.FromWorker.NotifyTranscodingFailure:input_type -> api.NotifyTranscodingFailureRequest
-	12, // 30: api.ToWorker.RequestStream:output_type -> api.Status
-	12, // 31: api.ToWorker.RequestPremiere:output_type -> api.Status
-	12, // 32: api.ToWorker.RequestStreamEnd:output_type -> api.Status
-	8,  // 33: api.ToWorker.RequestWaveform:output_type -> api.WaveFormResponse
-	6,  // 34: api.ToWorker.RequestCut:output_type -> api.CutResponse
-	12, // 35: api.ToWorker.GenerateThumbnails:output_type -> api.Status
-	28, // 36: api.ToWorker.GenerateLivePreview:output_type -> api.LivePreviewResponse
-	1,  // 37: api.ToWorker.GenerateSectionImages:output_type -> api.GenerateSectionImageResponse
-	12, // 38: api.ToWorker.DeleteSectionImage:output_type -> api.Status
-	32, // 39: api.ToWorker.CombineThumbnails:output_type -> api.CombineThumbnailsResponse
-	15, // 40: api.FromWorker.JoinWorkers:output_type -> api.JoinWorkersResponse
-	12, // 41: api.FromWorker.SendHeartBeat:output_type -> api.Status
-	12, // 42: api.FromWorker.NotifyTranscodingProgress:output_type -> api.Status
-	12, // 43: api.FromWorker.NotifyTranscodingFinished:output_type -> api.Status
-	12, // 44: api.FromWorker.NotifySilenceResults:output_type -> api.Status
-	12, // 45: api.FromWorker.NotifyStreamStarted:output_type -> api.Status
-	12, // 46: api.FromWorker.NotifyStreamFinished:output_type -> api.Status
-	12, // 47: api.FromWorker.NotifyUploadFinished:output_type -> api.Status
-	12, // 48: api.FromWorker.NotifyThumbnailsFinished:output_type -> api.Status
-	17, // 49: api.FromWorker.SendSelfStreamRequest:output_type -> api.SelfStreamResponse
-	26, // 50: api.FromWorker.GetStreamInfoForUpload:output_type -> api.GetStreamInfoForUploadResponse
-	30, // 51: api.FromWorker.NotifyTranscodingFailure:output_type -> api.NotifyTranscodingFailureResponse
-	30, // [30:52] is the sub-list for method output_type
-	8,  // [8:30] is the sub-list for method input_type
-	8,  // [8:8] is the sub-list for extension type_name
-	8,  // [8:8] is the sub-list for extension extendee
-	0,  // [0:8] is the sub-list for field type_name
+	45, // 2: api.CutRequest.segments:type_name -> api.CutRequest.Segment
+	46, // 3: api.StreamRequest.Start:type_name -> google.protobuf.Timestamp
+	46, // 4: api.StreamRequest.End:type_name -> google.protobuf.Timestamp
+	11, // 5: api.StreamRequest.Renditions:type_name -> api.Rendition
+	10, // 6: api.StreamRequest.Profile:type_name -> api.EncodingProfile
+	10, // 7: api.PremiereRequest.Profile:type_name -> api.EncodingProfile
+	46, // 8: api.StitchRequest.Start:type_name -> google.protobuf.Timestamp
+	46, // 9: api.StitchRequest.End:type_name -> google.protobuf.Timestamp
+	10, // 10: api.StitchRequest.Profile:type_name -> api.EncodingProfile
+	46, // 11: api.SelfStreamResponse.StreamStart:type_name -> google.protobuf.Timestamp
+	11, // 12: api.SelfStreamResponse.Renditions:type_name -> api.Rendition
+	10, // 13: api.SelfStreamResponse.Profile:type_name -> api.EncodingProfile
+	27, // 14: api.TranscodingFinished.Loudness:type_name -> api.Loudness
+	46, // 15: api.StreamHealth.Time:type_name -> google.protobuf.Timestamp
+	46, // 16: api.StreamIncident.Start:type_name -> google.protobuf.Timestamp
+	46, // 17: api.StreamIncident.End:type_name -> google.protobuf.Timestamp
+	33, // 18: api.SectionSuggestions.Suggestions:type_name -> api.SectionSuggestion
+	35, // 19: api.Slides.Slides:type_name -> api.Slide
+	46, // 20: api.GetStreamInfoForUploadResponse.StreamStart:type_name -> google.protobuf.Timestamp
+	46, // 21: api.GetStreamInfoForUploadResponse.StreamEnd:type_name -> google.protobuf.Timestamp
+	9,  // 22: api.ToWorker.RequestStream:input_type -> api.StreamRequest
+	12, // 23: api.ToWorker.RequestPremiere:input_type -> api.PremiereRequest
+	14, // 24: api.ToWorker.RequestStreamEnd:input_type -> api.EndStreamRequest
+	7,  // 25: api.ToWorker.RequestWaveform:input_type -> api.WaveformRequest
+	5,  // 26: api.ToWorker.RequestCut:input_type -> api.CutRequest
+	2,  // 27: api.ToWorker.GenerateThumbnails:input_type -> api.GenerateThumbnailRequest
+	39, // 28: api.ToWorker.GenerateLivePreview:input_type -> api.LivePreviewRequest
+	4,  // 29: api.ToWorker.GenerateSectionImages:input_type -> api.GenerateSectionImageRequest
+	0,  // 30: api.ToWorker.DeleteSectionImage:input_type -> api.DeleteSectionImageRequest
+	43, // 31: api.ToWorker.CombineThumbnails:input_type -> api.CombineThumbnailsRequest
+	13, // 32: api.ToWorker.RequestStitch:input_type -> api.StitchRequest
+	17, // 33: api.FromWorker.JoinWorkers:input_type -> api.JoinWorkersRequest
+	19, // 34: api.FromWorker.RenewCertificate:input_type -> api.RenewCertificateRequest
+	23, // 35: api.FromWorker.SendHeartBeat:input_type -> api.HeartBeat
+	16, // 36: api.FromWorker.NotifyTranscodingProgress:input_type -> api.NotifyTranscodingProgressRequest
+	26, // 37: api.FromWorker.NotifyTranscodingFinished:input_type -> api.TranscodingFinished
+	32, // 38: api.FromWorker.NotifySilenceResults:input_type -> api.SilenceResults
+	34, // 39: api.FromWorker.NotifySectionSuggestions:input_type -> api.SectionSuggestions
+	36, // 40: api.FromWorker.NotifySlides:input_type -> api.Slides
+	29, // 41: api.FromWorker.NotifyStreamStarted:input_type -> api.StreamStarted
+	24, // 42: api.FromWorker.NotifyStreamFinished:input_type -> api.StreamFinished
+	30, // 43: api.FromWorker.NotifyStreamHealth:input_type -> api.StreamHealth
+	31, // 44: api.FromWorker.NotifyStreamIncident:input_type -> api.StreamIncident
+	28, // 45: api.FromWorker.NotifyUploadFinished:input_type -> api.UploadFinished
+	25, // 46: api.FromWorker.NotifyThumbnailsFinished:input_type -> api.ThumbnailsFinished
+	21, // 47: api.FromWorker.SendSelfStreamRequest:input_type -> api.SelfStreamRequest
+	37, // 48: api.FromWorker.GetStreamInfoForUpload:input_type -> api.GetStreamInfoForUploadRequest
+	41, // 49: api.FromWorker.NotifyTranscodingFailure:input_type -> api.NotifyTranscodingFailureRequest
+	15, // 50: api.ToWorker.RequestStream:output_type -> api.Status
+	15, // 51: api.ToWorker.RequestPremiere:output_type -> api.Status
+	15, // 52: api.ToWorker.RequestStreamEnd:output_type -> api.Status
+	8,  // 53: api.ToWorker.RequestWaveform:output_type -> api.WaveFormResponse
+	6,  // 54: api.ToWorker.RequestCut:output_type -> api.CutResponse
+	15, // 55: api.ToWorker.GenerateThumbnails:output_type -> api.Status
+	40, // 56: api.ToWorker.GenerateLivePreview:output_type -> api.LivePreviewResponse
+	1,  // 57: api.ToWorker.GenerateSectionImages:output_type -> api.GenerateSectionImageResponse
+	15, // 58: api.ToWorker.DeleteSectionImage:output_type -> api.Status
+	44, // 59: api.ToWorker.CombineThumbnails:output_type -> api.CombineThumbnailsResponse
+	15, // 60: api.ToWorker.RequestStitch:output_type -> api.Status
+	18, // 61: api.FromWorker.JoinWorkers:output_type -> api.JoinWorkersResponse
+	20, // 62: api.FromWorker.RenewCertificate:output_type -> api.RenewCertificateResponse
+	15, // 63: api.FromWorker.SendHeartBeat:output_type -> api.Status
+	15, // 64: api.FromWorker.NotifyTranscodingProgress:output_type -> api.Status
+	15, // 65: api.FromWorker.NotifyTranscodingFinished:output_type -> api.Status
+	15, // 66: api.FromWorker.NotifySilenceResults:output_type -> api.Status
+	15, // 67: api.FromWorker.NotifySectionSuggestions:output_type -> api.Status
+	15, // 68: api.FromWorker.NotifySlides:output_type -> api.Status
+	15, // 69: api.FromWorker.NotifyStreamStarted:output_type -> api.Status
+	15, // 70: api.FromWorker.NotifyStreamFinished:output_type -> api.Status
+	15, // 71: api.FromWorker.NotifyStreamHealth:output_type -> api.Status
+	15, // 72: api.FromWorker.NotifyStreamIncident:output_type -> api.Status
+	15, // 73: api.FromWorker.NotifyUploadFinished:output_type -> api.Status
+	15, // 74: api.FromWorker.NotifyThumbnailsFinished:output_type -> api.Status
+	22, // 75: api.FromWorker.SendSelfStreamRequest:output_type -> api.SelfStreamResponse
+	38, // 76: api.FromWorker.GetStreamInfoForUpload:output_type -> api.GetStreamInfoForUploadResponse
+	42, // 77: api.FromWorker.NotifyTranscodingFailure:output_type -> api.NotifyTranscodingFailureResponse
+	50, // [50:78] is the sub-list for method output_type
+	22, // [22:50] is the sub-list for method input_type
+	22, // [22:22] is the sub-list for extension type_name
+	22, // [22:22] is the sub-list for extension extendee
+	0,  // [0:22] is the sub-list for field type_name
 }
 
 func init() { file_api_proto_init() }
@@ -3006,7 +4434,7 @@ func file_api_proto_init() {
 			}
 		}
 		file_api_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*PremiereRequest); i {
+			switch v := v.(*EncodingProfile); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3018,7 +4446,7 @@ func file_api_proto_init() {
 			}
 		}
 		file_api_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*EndStreamRequest); i {
+			switch v := v.(*Rendition); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3030,7 +4458,7 @@ func file_api_proto_init() {
 			}
 		}
 		file_api_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Status); i {
+			switch v := v.(*PremiereRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3042,7 +4470,7 @@ func file_api_proto_init() {
 			}
 		}
 		file_api_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*NotifyTranscodingProgressRequest); i {
+			switch v := v.(*StitchRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3054,7 +4482,7 @@ func file_api_proto_init() {
 			}
 		}
 		file_api_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*JoinWorkersRequest); i {
+			switch v := v.(*EndStreamRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3066,7 +4494,7 @@ func file_api_proto_init() {
 			}
 		}
 		file_api_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*JoinWorkersResponse); i {
+			switch v := v.(*Status); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3078,7 +4506,7 @@ func file_api_proto_init() {
 			}
 		}
 		file_api_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SelfStreamRequest); i {
+			switch v := v.(*NotifyTranscodingProgressRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3090,7 +4518,7 @@ func file_api_proto_init() {
 			}
 		}
 		file_api_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SelfStreamResponse); i {
+			switch v := v.(*JoinWorkersRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3102,7 +4530,7 @@ func file_api_proto_init() {
 			}
 		}
 		file_api_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*HeartBeat); i {
+			switch v := v.(*JoinWorkersResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3114,7 +4542,7 @@ func file_api_proto_init() {
 			}
 		}
 		file_api_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*StreamFinished); i {
+			switch v := v.(*RenewCertificateRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3126,7 +4554,7 @@ func file_api_proto_init() {
 			}
 		}
 		file_api_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ThumbnailsFinished); i {
+			switch v := v.(*RenewCertificateResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3138,7 +4566,7 @@ func file_api_proto_init() {
 			}
 		}
 		file_api_proto_msgTypes[21].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*TranscodingFinished); i {
+			switch v := v.(*SelfStreamRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3150,7 +4578,7 @@ func file_api_proto_init() {
 			}
 		}
 		file_api_proto_msgTypes[22].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*UploadFinished); i {
+			switch v := v.(*SelfStreamResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3162,7 +4590,7 @@ func file_api_proto_init() {
 			}
 		}
 		file_api_proto_msgTypes[23].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*StreamStarted); i {
+			switch v := v.(*HeartBeat); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3174,7 +4602,7 @@ func file_api_proto_init() {
 			}
 		}
 		file_api_proto_msgTypes[24].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SilenceResults); i {
+			switch v := v.(*StreamFinished); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3186,7 +4614,7 @@ func file_api_proto_init() {
 			}
 		}
 		file_api_proto_msgTypes[25].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetStreamInfoForUploadRequest); i {
+			switch v := v.(*ThumbnailsFinished); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3198,7 +4626,7 @@ func file_api_proto_init() {
 			}
 		}
 		file_api_proto_msgTypes[26].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetStreamInfoForUploadResponse); i {
+			switch v := v.(*TranscodingFinished); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3210,7 +4638,7 @@ func file_api_proto_init() {
 			}
 		}
 		file_api_proto_msgTypes[27].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*LivePreviewRequest); i {
+			switch v := v.(*Loudness); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3222,7 +4650,7 @@ func file_api_proto_init() {
 			}
 		}
 		file_api_proto_msgTypes[28].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*LivePreviewResponse); i {
+			switch v := v.(*UploadFinished); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3234,7 +4662,7 @@ func file_api_proto_init() {
 			}
 		}
 		file_api_proto_msgTypes[29].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*NotifyTranscodingFailureRequest); i {
+			switch v := v.(*StreamStarted); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3246,7 +4674,7 @@ func file_api_proto_init() {
 			}
 		}
 		file_api_proto_msgTypes[30].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*NotifyTranscodingFailureResponse); i {
+			switch v := v.(*StreamHealth); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3258,7 +4686,7 @@ func file_api_proto_init() {
 			}
 		}
 		file_api_proto_msgTypes[31].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*CombineThumbnailsRequest); i {
+			switch v := v.(*StreamIncident); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3270,7 +4698,7 @@ func file_api_proto_init() {
 			}
 		}
 		file_api_proto_msgTypes[32].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*CombineThumbnailsResponse); i {
+			switch v := v.(*SilenceResults); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3282,6 +4710,150 @@ func file_api_proto_init() {
 			}
 		}
 		file_api_proto_msgTypes[33].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SectionSuggestion); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_proto_msgTypes[34].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SectionSuggestions); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_proto_msgTypes[35].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Slide); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_proto_msgTypes[36].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Slides); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_proto_msgTypes[37].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetStreamInfoForUploadRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_proto_msgTypes[38].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetStreamInfoForUploadResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_proto_msgTypes[39].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*LivePreviewRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_proto_msgTypes[40].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*LivePreviewResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_proto_msgTypes[41].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*NotifyTranscodingFailureRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_proto_msgTypes[42].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*NotifyTranscodingFailureResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_proto_msgTypes[43].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CombineThumbnailsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_proto_msgTypes[44].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CombineThumbnailsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_proto_msgTypes[45].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*CutRequest_Segment); i {
 			case 0:
 				return &v.state
@@ -3300,7 +4872,7 @@ func file_api_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_api_proto_rawDesc,
 			NumEnums:      0,
-			NumMessages:   34,
+			NumMessages:   46,
 			NumExtensions: 0,
 			NumServices:   2,
 		},
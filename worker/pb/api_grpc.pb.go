@@ -29,6 +29,7 @@ const (
 	ToWorker_GenerateSectionImages_FullMethodName = "/api.ToWorker/GenerateSectionImages"
 	ToWorker_DeleteSectionImage_FullMethodName    = "/api.ToWorker/DeleteSectionImage"
 	ToWorker_CombineThumbnails_FullMethodName     = "/api.ToWorker/CombineThumbnails"
+	ToWorker_RequestStitch_FullMethodName         = "/api.ToWorker/RequestStitch"
 )
 
 // ToWorkerClient is the client API for ToWorker service.
@@ -46,6 +47,8 @@ type ToWorkerClient interface {
 	GenerateSectionImages(ctx context.Context, in *GenerateSectionImageRequest, opts ...grpc.CallOption) (*GenerateSectionImageResponse, error)
 	DeleteSectionImage(ctx context.Context, in *DeleteSectionImageRequest, opts ...grpc.CallOption) (*Status, error)
 	CombineThumbnails(ctx context.Context, in *CombineThumbnailsRequest, opts ...grpc.CallOption) (*CombineThumbnailsResponse, error)
+	// Requests to stitch the recording parts of a stream that failed over between workers into one VoD
+	RequestStitch(ctx context.Context, in *StitchRequest, opts ...grpc.CallOption) (*Status, error)
 }
 
 type toWorkerClient struct {
@@ -146,6 +149,15 @@ func (c *toWorkerClient) CombineThumbnails(ctx context.Context, in *CombineThumb
 	return out, nil
 }
 
+func (c *toWorkerClient) RequestStitch(ctx context.Context, in *StitchRequest, opts ...grpc.CallOption) (*Status, error) {
+	out := new(Status)
+	err := c.cc.Invoke(ctx, ToWorker_RequestStitch_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // ToWorkerServer is the server API for ToWorker service.
 // All implementations must embed UnimplementedToWorkerServer
 // for forward compatibility
@@ -161,6 +173,8 @@ type ToWorkerServer interface {
 	GenerateSectionImages(context.Context, *GenerateSectionImageRequest) (*GenerateSectionImageResponse, error)
 	DeleteSectionImage(context.Context, *DeleteSectionImageRequest) (*Status, error)
 	CombineThumbnails(context.Context, *CombineThumbnailsRequest) (*CombineThumbnailsResponse, error)
+	// Requests to stitch the recording parts of a stream that failed over between workers into one VoD
+	RequestStitch(context.Context, *StitchRequest) (*Status, error)
 	mustEmbedUnimplementedToWorkerServer()
 }
 
@@ -198,6 +212,9 @@ func (UnimplementedToWorkerServer) DeleteSectionImage(context.Context, *DeleteSe
 func (UnimplementedToWorkerServer) CombineThumbnails(context.Context, *CombineThumbnailsRequest) (*CombineThumbnailsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method CombineThumbnails not implemented")
 }
+func (UnimplementedToWorkerServer) RequestStitch(context.Context, *StitchRequest) (*Status, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RequestStitch not implemented")
+}
 func (UnimplementedToWorkerServer) mustEmbedUnimplementedToWorkerServer() {}
 
 // UnsafeToWorkerServer may be embedded to opt out of forward compatibility for this service.
@@ -391,6 +408,24 @@ func _ToWorker_CombineThumbnails_Handler(srv interface{}, ctx context.Context, d
 	return interceptor(ctx, in, info, handler)
 }
 
+func _ToWorker_RequestStitch_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(StitchRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ToWorkerServer).RequestStitch(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ToWorker_RequestStitch_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ToWorkerServer).RequestStitch(ctx, req.(*StitchRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // ToWorker_ServiceDesc is the grpc.ServiceDesc for ToWorker service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -438,6 +473,10 @@ var ToWorker_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "CombineThumbnails",
 			Handler:    _ToWorker_CombineThumbnails_Handler,
 		},
+		{
+			MethodName: "RequestStitch",
+			Handler:    _ToWorker_RequestStitch_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "api.proto",
@@ -445,12 +484,17 @@ var ToWorker_ServiceDesc = grpc.ServiceDesc{
 
 const (
 	FromWorker_JoinWorkers_FullMethodName               = "/api.FromWorker/JoinWorkers"
+	FromWorker_RenewCertificate_FullMethodName          = "/api.FromWorker/RenewCertificate"
 	FromWorker_SendHeartBeat_FullMethodName             = "/api.FromWorker/SendHeartBeat"
 	FromWorker_NotifyTranscodingProgress_FullMethodName = "/api.FromWorker/NotifyTranscodingProgress"
 	FromWorker_NotifyTranscodingFinished_FullMethodName = "/api.FromWorker/NotifyTranscodingFinished"
 	FromWorker_NotifySilenceResults_FullMethodName      = "/api.FromWorker/NotifySilenceResults"
+	FromWorker_NotifySectionSuggestions_FullMethodName  = "/api.FromWorker/NotifySectionSuggestions"
+	FromWorker_NotifySlides_FullMethodName              = "/api.FromWorker/NotifySlides"
 	FromWorker_NotifyStreamStarted_FullMethodName       = "/api.FromWorker/NotifyStreamStarted"
 	FromWorker_NotifyStreamFinished_FullMethodName      = "/api.FromWorker/NotifyStreamFinished"
+	FromWorker_NotifyStreamHealth_FullMethodName        = "/api.FromWorker/NotifyStreamHealth"
+	FromWorker_NotifyStreamIncident_FullMethodName      = "/api.FromWorker/NotifyStreamIncident"
 	FromWorker_NotifyUploadFinished_FullMethodName      = "/api.FromWorker/NotifyUploadFinished"
 	FromWorker_NotifyThumbnailsFinished_FullMethodName  = "/api.FromWorker/NotifyThumbnailsFinished"
 	FromWorker_SendSelfStreamRequest_FullMethodName     = "/api.FromWorker/SendSelfStreamRequest"
@@ -464,12 +508,22 @@ const (
 type FromWorkerClient interface {
 	// JoinWorkers is a request to the server to join the worker pool.
 	JoinWorkers(ctx context.Context, in *JoinWorkersRequest, opts ...grpc.CallOption) (*JoinWorkersResponse, error)
+	// RenewCertificate issues a new client certificate to a worker before its current one expires.
+	RenewCertificate(ctx context.Context, in *RenewCertificateRequest, opts ...grpc.CallOption) (*RenewCertificateResponse, error)
 	SendHeartBeat(ctx context.Context, in *HeartBeat, opts ...grpc.CallOption) (*Status, error)
 	NotifyTranscodingProgress(ctx context.Context, opts ...grpc.CallOption) (FromWorker_NotifyTranscodingProgressClient, error)
 	NotifyTranscodingFinished(ctx context.Context, in *TranscodingFinished, opts ...grpc.CallOption) (*Status, error)
 	NotifySilenceResults(ctx context.Context, in *SilenceResults, opts ...grpc.CallOption) (*Status, error)
+	// NotifySectionSuggestions receives the chapters the scene change detection suggests for the presentation of a VoD.
+	NotifySectionSuggestions(ctx context.Context, in *SectionSuggestions, opts ...grpc.CallOption) (*Status, error)
+	// NotifySlides receives the frames of the slide changes of a VoD, tumlive extracts their text for the search.
+	NotifySlides(ctx context.Context, in *Slides, opts ...grpc.CallOption) (*Status, error)
 	NotifyStreamStarted(ctx context.Context, in *StreamStarted, opts ...grpc.CallOption) (*Status, error)
 	NotifyStreamFinished(ctx context.Context, in *StreamFinished, opts ...grpc.CallOption) (*Status, error)
+	// NotifyStreamHealth receives the ffmpeg progress of a running stream every few seconds.
+	NotifyStreamHealth(ctx context.Context, opts ...grpc.CallOption) (FromWorker_NotifyStreamHealthClient, error)
+	// NotifyStreamIncident is called when the live analysis of a stream detects a problem and again when it is over.
+	NotifyStreamIncident(ctx context.Context, in *StreamIncident, opts ...grpc.CallOption) (*Status, error)
 	NotifyUploadFinished(ctx context.Context, in *UploadFinished, opts ...grpc.CallOption) (*Status, error)
 	NotifyThumbnailsFinished(ctx context.Context, in *ThumbnailsFinished, opts ...grpc.CallOption) (*Status, error)
 	SendSelfStreamRequest(ctx context.Context, in *SelfStreamRequest, opts ...grpc.CallOption) (*SelfStreamResponse, error)
@@ -494,6 +548,15 @@ func (c *fromWorkerClient) JoinWorkers(ctx context.Context, in *JoinWorkersReque
 	return out, nil
 }
 
+func (c *fromWorkerClient) RenewCertificate(ctx context.Context, in *RenewCertificateRequest, opts ...grpc.CallOption) (*RenewCertificateResponse, error) {
+	out := new(RenewCertificateResponse)
+	err := c.cc.Invoke(ctx, FromWorker_RenewCertificate_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *fromWorkerClient) SendHeartBeat(ctx context.Context, in *HeartBeat, opts ...grpc.CallOption) (*Status, error) {
 	out := new(Status)
 	err := c.cc.Invoke(ctx, FromWorker_SendHeartBeat_FullMethodName, in, out, opts...)
@@ -555,6 +618,24 @@ func (c *fromWorkerClient) NotifySilenceResults(ctx context.Context, in *Silence
 	return out, nil
 }
 
+func (c *fromWorkerClient) NotifySectionSuggestions(ctx context.Context, in *SectionSuggestions, opts ...grpc.CallOption) (*Status, error) {
+	out := new(Status)
+	err := c.cc.Invoke(ctx, FromWorker_NotifySectionSuggestions_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *fromWorkerClient) NotifySlides(ctx context.Context, in *Slides, opts ...grpc.CallOption) (*Status, error) {
+	out := new(Status)
+	err := c.cc.Invoke(ctx, FromWorker_NotifySlides_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *fromWorkerClient) NotifyStreamStarted(ctx context.Context, in *StreamStarted, opts ...grpc.CallOption) (*Status, error) {
 	out := new(Status)
 	err := c.cc.Invoke(ctx, FromWorker_NotifyStreamStarted_FullMethodName, in, out, opts...)
@@ -573,6 +654,49 @@ func (c *fromWorkerClient) NotifyStreamFinished(ctx context.Context, in *StreamF
 	return out, nil
 }
 
+func (c *fromWorkerClient) NotifyStreamHealth(ctx context.Context, opts ...grpc.CallOption) (FromWorker_NotifyStreamHealthClient, error) {
+	stream, err := c.cc.NewStream(ctx, &FromWorker_ServiceDesc.Streams[1], FromWorker_NotifyStreamHealth_FullMethodName, opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &fromWorkerNotifyStreamHealthClient{stream}
+	return x, nil
+}
+
+type FromWorker_NotifyStreamHealthClient interface {
+	Send(*StreamHealth) error
+	CloseAndRecv() (*Status, error)
+	grpc.ClientStream
+}
+
+type fromWorkerNotifyStreamHealthClient struct {
+	grpc.ClientStream
+}
+
+func (x *fromWorkerNotifyStreamHealthClient) Send(m *StreamHealth) error {
+	return x.ClientStream.SendMsg(m)
+}
+
+func (x *fromWorkerNotifyStreamHealthClient) CloseAndRecv() (*Status, error) {
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	m := new(Status)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *fromWorkerClient) NotifyStreamIncident(ctx context.Context, in *StreamIncident, opts ...grpc.CallOption) (*Status, error) {
+	out := new(Status)
+	err := c.cc.Invoke(ctx, FromWorker_NotifyStreamIncident_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *fromWorkerClient) NotifyUploadFinished(ctx context.Context, in *UploadFinished, opts ...grpc.CallOption) (*Status, error) {
 	out := new(Status)
 	err := c.cc.Invoke(ctx, FromWorker_NotifyUploadFinished_FullMethodName, in, out, opts...)
@@ -624,12 +748,22 @@ func (c *fromWorkerClient) NotifyTranscodingFailure(ctx context.Context, in *Not
 type FromWorkerServer interface {
 	// JoinWorkers is a request to the server to join the worker pool.
 	JoinWorkers(context.Context, *JoinWorkersRequest) (*JoinWorkersResponse, error)
+	// RenewCertificate issues a new client certificate to a worker before its current one expires.
+	RenewCertificate(context.Context, *RenewCertificateRequest) (*RenewCertificateResponse, error)
 	SendHeartBeat(context.Context, *HeartBeat) (*Status, error)
 	NotifyTranscodingProgress(FromWorker_NotifyTranscodingProgressServer) error
 	NotifyTranscodingFinished(context.Context, *TranscodingFinished) (*Status, error)
 	NotifySilenceResults(context.Context, *SilenceResults) (*Status, error)
+	// NotifySectionSuggestions receives the chapters the scene change detection suggests for the presentation of a VoD.
+	NotifySectionSuggestions(context.Context, *SectionSuggestions) (*Status, error)
+	// NotifySlides receives the frames of the slide changes of a VoD, tumlive extracts their text for the search.
+	NotifySlides(context.Context, *Slides) (*Status, error)
 	NotifyStreamStarted(context.Context, *StreamStarted) (*Status, error)
 	NotifyStreamFinished(context.Context, *StreamFinished) (*Status, error)
+	// NotifyStreamHealth receives the ffmpeg progress of a running stream every few seconds.
+	NotifyStreamHealth(FromWorker_NotifyStreamHealthServer) error
+	// NotifyStreamIncident is called when the live analysis of a stream detects a problem and again when it is over.
+	NotifyStreamIncident(context.Context, *StreamIncident) (*Status, error)
 	NotifyUploadFinished(context.Context, *UploadFinished) (*Status, error)
 	NotifyThumbnailsFinished(context.Context, *ThumbnailsFinished) (*Status, error)
 	SendSelfStreamRequest(context.Context, *SelfStreamRequest) (*SelfStreamResponse, error)
@@ -645,6 +779,9 @@ type UnimplementedFromWorkerServer struct {
 func (UnimplementedFromWorkerServer) JoinWorkers(context.Context, *JoinWorkersRequest) (*JoinWorkersResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method JoinWorkers not implemented")
 }
+func (UnimplementedFromWorkerServer) RenewCertificate(context.Context, *RenewCertificateRequest) (*RenewCertificateResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RenewCertificate not implemented")
+}
 func (UnimplementedFromWorkerServer) SendHeartBeat(context.Context, *HeartBeat) (*Status, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method SendHeartBeat not implemented")
 }
@@ -657,12 +794,24 @@ func (UnimplementedFromWorkerServer) NotifyTranscodingFinished(context.Context,
 func (UnimplementedFromWorkerServer) NotifySilenceResults(context.Context, *SilenceResults) (*Status, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method NotifySilenceResults not implemented")
 }
+func (UnimplementedFromWorkerServer) NotifySectionSuggestions(context.Context, *SectionSuggestions) (*Status, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method NotifySectionSuggestions not implemented")
+}
+func (UnimplementedFromWorkerServer) NotifySlides(context.Context, *Slides) (*Status, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method NotifySlides not implemented")
+}
 func (UnimplementedFromWorkerServer) NotifyStreamStarted(context.Context, *StreamStarted) (*Status, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method NotifyStreamStarted not implemented")
 }
 func (UnimplementedFromWorkerServer) NotifyStreamFinished(context.Context, *StreamFinished) (*Status, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method NotifyStreamFinished not implemented")
 }
+func (UnimplementedFromWorkerServer) NotifyStreamHealth(FromWorker_NotifyStreamHealthServer) error {
+	return status.Errorf(codes.Unimplemented, "method NotifyStreamHealth not implemented")
+}
+func (UnimplementedFromWorkerServer) NotifyStreamIncident(context.Context, *StreamIncident) (*Status, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method NotifyStreamIncident not implemented")
+}
 func (UnimplementedFromWorkerServer) NotifyUploadFinished(context.Context, *UploadFinished) (*Status, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method NotifyUploadFinished not implemented")
 }
@@ -709,6 +858,24 @@ func _FromWorker_JoinWorkers_Handler(srv interface{}, ctx context.Context, dec f
 	return interceptor(ctx, in, info, handler)
 }
 
+func _FromWorker_RenewCertificate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RenewCertificateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FromWorkerServer).RenewCertificate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: FromWorker_RenewCertificate_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FromWorkerServer).RenewCertificate(ctx, req.(*RenewCertificateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _FromWorker_SendHeartBeat_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(HeartBeat)
 	if err := dec(in); err != nil {
@@ -789,6 +956,42 @@ func _FromWorker_NotifySilenceResults_Handler(srv interface{}, ctx context.Conte
 	return interceptor(ctx, in, info, handler)
 }
 
+func _FromWorker_NotifySectionSuggestions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SectionSuggestions)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FromWorkerServer).NotifySectionSuggestions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: FromWorker_NotifySectionSuggestions_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FromWorkerServer).NotifySectionSuggestions(ctx, req.(*SectionSuggestions))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _FromWorker_NotifySlides_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Slides)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FromWorkerServer).NotifySlides(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: FromWorker_NotifySlides_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FromWorkerServer).NotifySlides(ctx, req.(*Slides))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _FromWorker_NotifyStreamStarted_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(StreamStarted)
 	if err := dec(in); err != nil {
@@ -825,6 +1028,50 @@ func _FromWorker_NotifyStreamFinished_Handler(srv interface{}, ctx context.Conte
 	return interceptor(ctx, in, info, handler)
 }
 
+func _FromWorker_NotifyStreamHealth_Handler(srv interface{}, stream grpc.ServerStream) error {
+	return srv.(FromWorkerServer).NotifyStreamHealth(&fromWorkerNotifyStreamHealthServer{stream})
+}
+
+type FromWorker_NotifyStreamHealthServer interface {
+	SendAndClose(*Status) error
+	Recv() (*StreamHealth, error)
+	grpc.ServerStream
+}
+
+type fromWorkerNotifyStreamHealthServer struct {
+	grpc.ServerStream
+}
+
+func (x *fromWorkerNotifyStreamHealthServer) SendAndClose(m *Status) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func (x *fromWorkerNotifyStreamHealthServer) Recv() (*StreamHealth, error) {
+	m := new(StreamHealth)
+	if err := x.ServerStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func _FromWorker_NotifyStreamIncident_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(StreamIncident)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FromWorkerServer).NotifyStreamIncident(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: FromWorker_NotifyStreamIncident_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FromWorkerServer).NotifyStreamIncident(ctx, req.(*StreamIncident))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _FromWorker_NotifyUploadFinished_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(UploadFinished)
 	if err := dec(in); err != nil {
@@ -926,6 +1173,10 @@ var FromWorker_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "JoinWorkers",
 			Handler:    _FromWorker_JoinWorkers_Handler,
 		},
+		{
+			MethodName: "RenewCertificate",
+			Handler:    _FromWorker_RenewCertificate_Handler,
+		},
 		{
 			MethodName: "SendHeartBeat",
 			Handler:    _FromWorker_SendHeartBeat_Handler,
@@ -938,6 +1189,14 @@ var FromWorker_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "NotifySilenceResults",
 			Handler:    _FromWorker_NotifySilenceResults_Handler,
 		},
+		{
+			MethodName: "NotifySectionSuggestions",
+			Handler:    _FromWorker_NotifySectionSuggestions_Handler,
+		},
+		{
+			MethodName: "NotifySlides",
+			Handler:    _FromWorker_NotifySlides_Handler,
+		},
 		{
 			MethodName: "NotifyStreamStarted",
 			Handler:    _FromWorker_NotifyStreamStarted_Handler,
@@ -946,6 +1205,10 @@ var FromWorker_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "NotifyStreamFinished",
 			Handler:    _FromWorker_NotifyStreamFinished_Handler,
 		},
+		{
+			MethodName: "NotifyStreamIncident",
+			Handler:    _FromWorker_NotifyStreamIncident_Handler,
+		},
 		{
 			MethodName: "NotifyUploadFinished",
 			Handler:    _FromWorker_NotifyUploadFinished_Handler,
@@ -973,6 +1236,11 @@ var FromWorker_ServiceDesc = grpc.ServiceDesc{
 			Handler:       _FromWorker_NotifyTranscodingProgress_Handler,
 			ClientStreams: true,
 		},
+		{
+			StreamName:    "NotifyStreamHealth",
+			Handler:       _FromWorker_NotifyStreamHealth_Handler,
+			ClientStreams: true,
+		},
 	},
 	Metadata: "api.proto",
 }
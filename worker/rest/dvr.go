@@ -0,0 +1,33 @@
+package rest
+
+import (
+	"net/http"
+	"path/filepath"
+	"strings"
+
+	"github.com/TUM-Dev/gocast/worker/cfg"
+	log "github.com/sirupsen/logrus"
+)
+
+// InitDvr serves the DVR playlists and segments of running streams on addr, the origin port of the edge servers.
+// The edge checks the jwt of viewers, so this shouldn't be exposed to the public.
+func InitDvr(addr string) {
+	mux := http.NewServeMux()
+	mux.Handle("/dvr/", http.StripPrefix("/dvr/", dvrHandler(http.FileServer(http.Dir(filepath.Join(cfg.TempDir, "dvr"))))))
+	log.Fatal(http.ListenAndServe(addr, mux))
+}
+
+func dvrHandler(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if strings.HasSuffix(r.URL.Path, "/") {
+			http.NotFound(w, r) // no directory listings
+			return
+		}
+		w.Header().Set("Access-Control-Allow-Origin", "*")
+		if strings.HasSuffix(r.URL.Path, ".m3u8") {
+			// the playlist grows with every segment
+			w.Header().Set("Cache-Control", "no-cache")
+		}
+		next.ServeHTTP(w, r)
+	})
+}
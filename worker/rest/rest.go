@@ -28,9 +28,13 @@ func InitApi(addr string) {
 	log.Fatal(http.ListenAndServe(addr, nil))
 }
 
-// mustGetStreamInfo gets the stream key and slug from mediamtx requests and aborts with bad request if something is wrong
+// mustGetStreamInfo gets the stream key and slug from mediamtx requests and aborts with bad request if something is wrong.
+// RTMP publishes carry both in the query (secret=key/slug), SRT and WebRTC publishes only the key as the slug is their path.
 func mustGetStreamInfo(req OnStartReq) (streamKey string, slug string, err error) {
 	pts := strings.Split(req.Query, "/")
+	if (req.Protocol == "srt" || req.Protocol == "webrtc") && len(pts) == 1 {
+		pts = append(pts, req.Path)
+	}
 	if len(pts) != 2 {
 		return "", "", errors.New("stream key in wrong format")
 	}
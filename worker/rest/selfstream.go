@@ -84,7 +84,7 @@ func (s *safeStreams) onPublish(w http.ResponseWriter, r *http.Request) {
 
 		// todo is this right?
 		// register stream in local map
-		streamContext := worker.HandleSelfStream(resp, slug)
+		streamContext := worker.HandleSelfStream(resp, slug, req.Protocol)
 
 		s.mutex.Lock()
 		s.streams[streamKey] = streamContext // todo this is only added after the stream has ended
@@ -0,0 +1,164 @@
+package rest
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/TUM-Dev/gocast/worker/cfg"
+	log "github.com/sirupsen/logrus"
+)
+
+// whip.go lets browsers publish self-streams with WHIP (WebRTC-HTTP ingestion protocol).
+// Offers are authenticated with a lecturer token and forwarded to mediamtx,
+// which then calls onPublish just like for RTMP and SRT publishes.
+
+const (
+	whipPrefix        = "/whip/"
+	whipSessionPrefix = "/whip/session/"
+	maxWhipBodySize   = 1 << 20 // SDP offers and ICE fragments are a few KB
+)
+
+// mediamtxWebRTC is the WebRTC server of mediamtx on this worker.
+var mediamtxWebRTC = "http://127.0.0.1:8889"
+
+var whipClient = &http.Client{Timeout: 10 * time.Second}
+
+// InitWhip serves the WHIP endpoint on addr. Unlike the api of InitApi, this one is meant to be exposed to the public.
+func InitWhip(addr string) {
+	mux := http.NewServeMux()
+	mux.HandleFunc(whipSessionPrefix, handleWhipSession)
+	mux.HandleFunc(whipPrefix, handleWhipOffer)
+	log.Fatal(http.ListenAndServe(addr, mux))
+}
+
+// handleWhipOffer starts a session for an SDP offer posted to /whip/<lecturer token>?slug=<optional course slug>.
+// Clients like OBS send the token as bearer token to /whip/ instead.
+func handleWhipOffer(w http.ResponseWriter, r *http.Request) {
+	setWhipHeaders(w)
+	if r.Method == http.MethodOptions {
+		w.WriteHeader(http.StatusNoContent)
+		return
+	}
+	if r.Method != http.MethodPost {
+		http.Error(w, "Method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+	token := strings.TrimPrefix(r.URL.Path, whipPrefix)
+	if token == "" {
+		token = strings.TrimPrefix(r.Header.Get("Authorization"), "Bearer ")
+	}
+	if token == "" || strings.Contains(token, "/") {
+		http.NotFound(w, r)
+		return
+	}
+	streamKey, slug, err := fetchStreamKey(r.Context(), token, r.URL.Query().Get("slug"))
+	if err != nil {
+		log.WithError(err).Warn("whip: could not get stream key for token")
+		http.Error(w, "Could not authenticate token", http.StatusForbidden)
+		return
+	}
+	proxyWhip(w, r, fmt.Sprintf("%s/%s/whip?secret=%s", mediamtxWebRTC, slug, url.QueryEscape(streamKey)))
+}
+
+// handleWhipSession forwards trickle ICE candidates (PATCH) and the end (DELETE) of a session to mediamtx.
+// Session urls contain a random id of mediamtx, so they need no further authentication.
+func handleWhipSession(w http.ResponseWriter, r *http.Request) {
+	setWhipHeaders(w)
+	if r.Method == http.MethodOptions {
+		w.WriteHeader(http.StatusNoContent)
+		return
+	}
+	if r.Method != http.MethodPatch && r.Method != http.MethodDelete {
+		http.Error(w, "Method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+	session := strings.TrimPrefix(r.URL.Path, whipSessionPrefix)
+	if !strings.Contains(session, "/whip/") {
+		http.NotFound(w, r)
+		return
+	}
+	proxyWhip(w, r, mediamtxWebRTC+"/"+session)
+}
+
+// proxyWhip forwards a WHIP request to target and rewrites the session url of the response to our session endpoint.
+func proxyWhip(w http.ResponseWriter, r *http.Request, target string) {
+	req, err := http.NewRequestWithContext(r.Context(), r.Method, target, http.MaxBytesReader(w, r.Body, maxWhipBodySize))
+	if err != nil {
+		http.Error(w, "Could not create request", http.StatusInternalServerError)
+		return
+	}
+	for _, h := range []string{"Content-Type", "If-Match"} {
+		if v := r.Header.Get(h); v != "" {
+			req.Header.Set(h, v)
+		}
+	}
+	resp, err := whipClient.Do(req)
+	if err != nil {
+		log.WithError(err).Error("whip: could not reach mediamtx")
+		http.Error(w, "Could not reach media server", http.StatusBadGateway)
+		return
+	}
+	defer resp.Body.Close()
+
+	for _, h := range []string{"Content-Type", "ETag", "Link", "Accept-Patch"} {
+		for _, v := range resp.Header.Values(h) {
+			w.Header().Add(h, v)
+		}
+	}
+	if location := resp.Header.Get("Location"); location != "" {
+		// mediamtx answers with a session url relative to the offer, e.g. /<slug>/whip/<id>
+		if u, err := req.URL.Parse(location); err == nil {
+			w.Header().Set("Location", whipSessionPrefix+strings.TrimPrefix(u.Path, "/"))
+		}
+	}
+	w.WriteHeader(resp.StatusCode)
+	_, _ = io.Copy(w, resp.Body)
+}
+
+// setWhipHeaders allows browser pages of other origins (e.g. tumlive) to use the WHIP endpoint.
+func setWhipHeaders(w http.ResponseWriter) {
+	w.Header().Set("Access-Control-Allow-Origin", "*")
+	w.Header().Set("Access-Control-Allow-Methods", "OPTIONS, POST, PATCH, DELETE")
+	w.Header().Set("Access-Control-Allow-Headers", "Authorization, Content-Type, If-Match")
+	w.Header().Set("Access-Control-Expose-Headers", "Location, ETag, Link, Accept-Patch")
+}
+
+// fetchStreamKey gets the stream key and slug of the lecturers current stream from the token proxy of tumlive,
+// the same way the RTMP proxy does for lecturer tokens.
+func fetchStreamKey(ctx context.Context, token string, courseSlug string) (streamKey string, slug string, err error) {
+	u := fmt.Sprintf("%s/api/token/proxy/%s", strings.TrimSuffix(cfg.WebUrl, "/"), url.PathEscape(token))
+	if courseSlug != "" {
+		u += "?slug=" + url.QueryEscape(courseSlug)
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, u, nil)
+	if err != nil {
+		return "", "", err
+	}
+	resp, err := whipClient.Do(req)
+	if err != nil {
+		return "", "", err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return "", "", fmt.Errorf("token proxy returned %s", resp.Status)
+	}
+	var res struct {
+		Url string `json:"url"`
+	}
+	if err = json.NewDecoder(resp.Body).Decode(&res); err != nil {
+		return "", "", err
+	}
+	// the url is meant for RTMP clients: <ingest>/<slug>?secret=<stream key>/<slug>
+	_, query, found := strings.Cut(res.Url, "?")
+	if !found {
+		return "", "", errors.New("token proxy returned url without stream key")
+	}
+	return mustGetStreamInfo(OnStartReq{Query: query})
+}
@@ -0,0 +1,149 @@
+package rest
+
+import (
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/TUM-Dev/gocast/worker/cfg"
+)
+
+// setupWhip starts a token proxy of tumlive that knows the token "valid" and a mediamtx that accepts offers
+// for the course fpv. It returns the requests mediamtx received.
+func setupWhip(t *testing.T) *[]*http.Request {
+	tumlive := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPost || r.URL.Path != "/api/token/proxy/valid" {
+			w.WriteHeader(http.StatusForbidden)
+			return
+		}
+		_, _ = w.Write([]byte(`{"url":"rtmp://ingest.example.com/fpv?secret=key123/fpv"}`))
+	}))
+	var received []*http.Request
+	mediamtx := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		received = append(received, r)
+		body, _ := io.ReadAll(r.Body)
+		switch {
+		case r.Method == http.MethodPost && r.URL.Path == "/fpv/whip" && r.URL.Query().Get("secret") == "key123":
+			w.Header().Set("Content-Type", "application/sdp")
+			w.Header().Set("Location", "/fpv/whip/8b3f2a")
+			w.WriteHeader(http.StatusCreated)
+			_, _ = w.Write([]byte("answer to " + string(body)))
+		case r.URL.Path == "/fpv/whip/8b3f2a":
+			w.WriteHeader(http.StatusNoContent)
+		default:
+			w.WriteHeader(http.StatusBadRequest)
+		}
+	}))
+	t.Cleanup(tumlive.Close)
+	t.Cleanup(mediamtx.Close)
+
+	webUrl, mediamtxUrl := cfg.WebUrl, mediamtxWebRTC
+	cfg.WebUrl, mediamtxWebRTC = tumlive.URL, mediamtx.URL
+	t.Cleanup(func() { cfg.WebUrl, mediamtxWebRTC = webUrl, mediamtxUrl })
+	return &received
+}
+
+func whipRequest(method string, target string, body string, header map[string]string) *httptest.ResponseRecorder {
+	r := httptest.NewRequest(method, target, strings.NewReader(body))
+	for k, v := range header {
+		r.Header.Set(k, v)
+	}
+	w := httptest.NewRecorder()
+	if strings.HasPrefix(target, whipSessionPrefix) {
+		handleWhipSession(w, r)
+	} else {
+		handleWhipOffer(w, r)
+	}
+	return w
+}
+
+func TestWhipOfferAuth(t *testing.T) {
+	received := setupWhip(t)
+	sdp := map[string]string{"Content-Type": "application/sdp"}
+
+	if w := whipRequest(http.MethodOptions, "/whip/", "", nil); w.Code != http.StatusNoContent || w.Header().Get("Access-Control-Allow-Origin") != "*" {
+		t.Errorf("expected preflight to be allowed, got %d", w.Code)
+	}
+	if w := whipRequest(http.MethodGet, "/whip/valid", "", nil); w.Code != http.StatusMethodNotAllowed {
+		t.Errorf("expected %d for GET, got %d", http.StatusMethodNotAllowed, w.Code)
+	}
+	if w := whipRequest(http.MethodPost, "/whip/", "offer", sdp); w.Code != http.StatusNotFound {
+		t.Errorf("expected %d without token, got %d", http.StatusNotFound, w.Code)
+	}
+	if w := whipRequest(http.MethodPost, "/whip/valid/other", "offer", sdp); w.Code != http.StatusNotFound {
+		t.Errorf("expected %d for token with path, got %d", http.StatusNotFound, w.Code)
+	}
+	if w := whipRequest(http.MethodPost, "/whip/invalid", "offer", sdp); w.Code != http.StatusForbidden {
+		t.Errorf("expected %d for invalid token, got %d", http.StatusForbidden, w.Code)
+	}
+	bearer := map[string]string{"Content-Type": "application/sdp", "Authorization": "Bearer invalid"}
+	if w := whipRequest(http.MethodPost, "/whip/", "offer", bearer); w.Code != http.StatusForbidden {
+		t.Errorf("expected %d for invalid bearer token, got %d", http.StatusForbidden, w.Code)
+	}
+	if len(*received) != 0 {
+		t.Errorf("expected no requests to reach mediamtx, got %d", len(*received))
+	}
+}
+
+func TestWhipOfferProxy(t *testing.T) {
+	received := setupWhip(t)
+
+	for _, header := range []map[string]string{
+		{"Content-Type": "application/sdp"},
+		{"Content-Type": "application/sdp", "Authorization": "Bearer valid"},
+	} {
+		target := "/whip/valid"
+		if header["Authorization"] != "" {
+			target = "/whip/"
+		}
+		w := whipRequest(http.MethodPost, target, "offer", header)
+		if w.Code != http.StatusCreated {
+			t.Fatalf("expected %d, got %d: %s", http.StatusCreated, w.Code, w.Body.String())
+		}
+		if body := w.Body.String(); body != "answer to offer" {
+			t.Errorf("expected answer of mediamtx, got %s", body)
+		}
+		if ct := w.Header().Get("Content-Type"); ct != "application/sdp" {
+			t.Errorf("expected content type of mediamtx, got %s", ct)
+		}
+		// the session url points to our session endpoint, not to mediamtx
+		if location := w.Header().Get("Location"); location != "/whip/session/fpv/whip/8b3f2a" {
+			t.Errorf("unexpected session url %s", location)
+		}
+	}
+	if len(*received) != 2 || (*received)[0].Header.Get("Content-Type") != "application/sdp" {
+		t.Errorf("expected offers to be forwarded to mediamtx, got %v", *received)
+	}
+}
+
+func TestWhipSession(t *testing.T) {
+	received := setupWhip(t)
+
+	if w := whipRequest(http.MethodPost, "/whip/session/fpv/whip/8b3f2a", "", nil); w.Code != http.StatusMethodNotAllowed {
+		t.Errorf("expected %d for POST, got %d", http.StatusMethodNotAllowed, w.Code)
+	}
+	if w := whipRequest(http.MethodDelete, "/whip/session/8b3f2a", "", nil); w.Code != http.StatusNotFound {
+		t.Errorf("expected %d for session without path, got %d", http.StatusNotFound, w.Code)
+	}
+	ice := map[string]string{"Content-Type": "application/trickle-ice-sdpfrag", "If-Match": "*"}
+	if w := whipRequest(http.MethodPatch, "/whip/session/fpv/whip/8b3f2a", "a=candidate", ice); w.Code != http.StatusNoContent {
+		t.Errorf("expected candidates to be forwarded, got %d", w.Code)
+	}
+	if w := whipRequest(http.MethodDelete, "/whip/session/fpv/whip/8b3f2a", "", nil); w.Code != http.StatusNoContent {
+		t.Errorf("expected session to be ended, got %d", w.Code)
+	}
+	if len(*received) != 2 || (*received)[0].Method != http.MethodPatch || (*received)[0].Header.Get("If-Match") != "*" || (*received)[1].Method != http.MethodDelete {
+		t.Errorf("unexpected requests to mediamtx: %v", *received)
+	}
+}
+
+func TestWhipMediamtxUnavailable(t *testing.T) {
+	setupWhip(t)
+	mediamtxWebRTC = "http://127.0.0.1:1"
+
+	if w := whipRequest(http.MethodPost, "/whip/valid", "offer", nil); w.Code != http.StatusBadGateway {
+		t.Errorf("expected %d if mediamtx is down, got %d", http.StatusBadGateway, w.Code)
+	}
+}
@@ -0,0 +1,179 @@
+package worker
+
+// certificate.go manages the client certificate the worker authenticates with at tumlive.
+// The key never leaves the worker, tumlive issues the certificate from a certificate request on join.
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"os"
+	"sync"
+	"time"
+
+	"github.com/TUM-Dev/gocast/worker/cfg"
+	"github.com/TUM-Dev/gocast/worker/pb"
+	log "github.com/sirupsen/logrus"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/credentials/insecure"
+)
+
+// tumliveCommonName is the common name of the certificate tumlive authenticates with.
+const tumliveCommonName = "tumlive"
+
+var (
+	certLock   sync.RWMutex
+	renewLock  sync.Mutex
+	caPool     *x509.CertPool
+	workerKey  *ecdsa.PrivateKey
+	workerCert *tls.Certificate // nil until the worker joined
+)
+
+// InitCredentials loads the CA certificate of tumlive and generates the key of the worker if mutual TLS is enabled.
+func InitCredentials() error {
+	if cfg.CAFile == "" {
+		log.Warn("CAFile is not set, gRPC channels to tumlive are not encrypted")
+		return nil
+	}
+	caPEM, err := os.ReadFile(cfg.CAFile)
+	if err != nil {
+		return err
+	}
+	caPool = x509.NewCertPool()
+	if !caPool.AppendCertsFromPEM(caPEM) {
+		return errors.New("no certificate in CAFile")
+	}
+	workerKey, err = ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	return err
+}
+
+// CertificateRequest returns the pem encoded certificate request for the key of the worker,
+// or an empty string without mutual TLS.
+func CertificateRequest() (string, error) {
+	if workerKey == nil {
+		return "", nil
+	}
+	csr, err := x509.CreateCertificateRequest(rand.Reader, &x509.CertificateRequest{
+		Subject:  pkix.Name{CommonName: cfg.Hostname},
+		DNSNames: []string{cfg.Hostname},
+	}, workerKey)
+	if err != nil {
+		return "", err
+	}
+	return string(pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE REQUEST", Bytes: csr})), nil
+}
+
+// SetCertificate makes the worker authenticate with a certificate issued by tumlive.
+func SetCertificate(certPEM string) error {
+	if workerKey == nil {
+		return nil
+	}
+	block, _ := pem.Decode([]byte(certPEM))
+	if block == nil {
+		return errors.New("no certificate received")
+	}
+	leaf, err := x509.ParseCertificate(block.Bytes)
+	if err != nil {
+		return err
+	}
+	certLock.Lock()
+	workerCert = &tls.Certificate{Certificate: [][]byte{block.Bytes}, PrivateKey: workerKey, Leaf: leaf}
+	certLock.Unlock()
+	log.WithField("notAfter", leaf.NotAfter).Info("Using new worker certificate")
+	return nil
+}
+
+func currentCertificate() *tls.Certificate {
+	certLock.RLock()
+	defer certLock.RUnlock()
+	if workerCert == nil {
+		return &tls.Certificate{} // not joined yet, tumlive only accepts JoinWorkers
+	}
+	return workerCert
+}
+
+// TransportCredentials returns the credentials to dial tumlive with.
+func TransportCredentials() credentials.TransportCredentials {
+	if caPool == nil {
+		return insecure.NewCredentials()
+	}
+	return credentials.NewTLS(&tls.Config{
+		RootCAs:    caPool,
+		ServerName: cfg.MainBase,
+		MinVersion: tls.VersionTLS12,
+		GetClientCertificate: func(*tls.CertificateRequestInfo) (*tls.Certificate, error) {
+			return currentCertificate(), nil
+		},
+	})
+}
+
+// ServerCredentials returns the credentials of the gRPC server of the worker, which only accepts calls from tumlive.
+func ServerCredentials() credentials.TransportCredentials {
+	if caPool == nil {
+		return insecure.NewCredentials()
+	}
+	return credentials.NewTLS(&tls.Config{
+		ClientCAs:  caPool,
+		ClientAuth: tls.RequireAndVerifyClientCert,
+		MinVersion: tls.VersionTLS12,
+		GetCertificate: func(*tls.ClientHelloInfo) (*tls.Certificate, error) {
+			return currentCertificate(), nil
+		},
+		VerifyPeerCertificate: func(_ [][]byte, chains [][]*x509.Certificate) error {
+			if len(chains) == 0 || chains[0][0].Subject.CommonName != tumliveCommonName {
+				return errors.New("client is not tumlive")
+			}
+			return nil
+		},
+	})
+}
+
+// RenewCertificateIfDue requests a new certificate once two thirds of the lifetime of the current one passed.
+func RenewCertificateIfDue() {
+	if !renewLock.TryLock() {
+		return // already renewing
+	}
+	defer renewLock.Unlock()
+	certLock.RLock()
+	cert := workerCert
+	certLock.RUnlock()
+	if cert == nil {
+		return
+	}
+	lifetime := cert.Leaf.NotAfter.Sub(cert.Leaf.NotBefore)
+	if time.Until(cert.Leaf.NotAfter) > lifetime/3 {
+		return
+	}
+	if err := renewCertificate(); err != nil {
+		log.WithError(err).Error("Could not renew worker certificate")
+	}
+}
+
+func renewCertificate() error {
+	csr, err := CertificateRequest()
+	if err != nil {
+		return err
+	}
+	conn, err := grpc.Dial(fmt.Sprintf("%s:50052", cfg.MainBase), grpc.WithTransportCredentials(TransportCredentials()))
+	if err != nil {
+		return err
+	}
+	defer conn.Close()
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second*10)
+	defer cancel()
+	resp, err := pb.NewFromWorkerClient(conn).RenewCertificate(ctx, &pb.RenewCertificateRequest{
+		WorkerID:           cfg.WorkerID,
+		CertificateRequest: csr,
+	})
+	if err != nil {
+		return err
+	}
+	return SetCertificate(resp.Certificate)
+}
@@ -0,0 +1,195 @@
+package worker
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/TUM-Dev/gocast/worker/cfg"
+	"github.com/TUM-Dev/gocast/worker/pb"
+	log "github.com/sirupsen/logrus"
+)
+
+// Parameters of the chapter suggestions from the scene changes of presentations.
+const (
+	sceneChangeThreshold  = 0.3             // share of the image that changes, slides with only a new bullet point stay below
+	minChapterLength      = 2 * time.Minute // slides that are skipped through quickly don't make chapters
+	maxChapterSuggestions = 50
+)
+
+// getChapterDir returns the directory the thumbnails of the suggested chapters of a stream are saved to.
+// example: /srv/sharedMassStorage/2021/S/eidi/2021-09-23_10-00/eidi-2021-09-23-10-00PRES-chapters
+func (s StreamContext) getChapterDir() string {
+	return strings.TrimSuffix(s.getThumbnailSpriteFileName(), "-thumb.jpg") + "-chapters"
+}
+
+// getSlideDir returns the directory the full resolution frames of the slide changes of a stream are saved to, e.g. for OCR.
+// example: /srv/sharedMassStorage/2021/S/eidi/2021-09-23_10-00/eidi-2021-09-23-10-00PRES-slides
+func (s StreamContext) getSlideDir() string {
+	return strings.TrimSuffix(s.getThumbnailSpriteFileName(), "-thumb.jpg") + "-slides"
+}
+
+// sceneChangeArgs returns the ffmpeg arguments that save the first frame and every scene change of infile
+// as thumbnail to thumbDir and, unless slideDir is empty, in full resolution to slideDir.
+// The video is sampled once a second, the exact frame of a slide change doesn't matter for chapters.
+func sceneChangeArgs(infile string, thumbDir string, slideDir string) []string {
+	filter := fmt.Sprintf("[0:v:0]fps=1,select='eq(n,0)+gt(scene,%.2f)',showinfo", sceneChangeThreshold)
+	if slideDir == "" {
+		filter += ",scale=156:-1[thumb]"
+	} else {
+		filter += ",split[slide][frame];[frame]scale=156:-1[thumb]"
+	}
+	args := []string{
+		"-n", "10",
+		"ffmpeg", "-hide_banner", "-nostats", "-y",
+		"-i", infile,
+		"-filter_complex", filter,
+		"-map", "[thumb]", "-vsync", "vfr", "-q:v", "2", filepath.Join(thumbDir, "%04d.jpg"),
+	}
+	if slideDir != "" {
+		args = append(args, "-map", "[slide]", "-vsync", "vfr", "-q:v", "2", filepath.Join(slideDir, "%04d.jpg"))
+	}
+	return args
+}
+
+// showinfoRe matches the frames showinfo logs, e.g. "[Parsed_showinfo_2 @ 0x1] n:   3 pts:    754 pts_time:754".
+var showinfoRe = regexp.MustCompile(`\bn:\s*(\d+)\s+pts:\s*\S+\s+pts_time:([\d.]+)`)
+
+// sceneChange is a selected frame, its thumbnail is the n-th image ffmpeg wrote.
+type sceneChange struct {
+	n     int
+	start time.Duration
+}
+
+// parseSceneChanges returns the frames showinfo logged in the output of ffmpeg.
+func parseSceneChanges(output []byte) []sceneChange {
+	var changes []sceneChange
+	for _, m := range showinfoRe.FindAllSubmatch(output, -1) {
+		n, err := strconv.Atoi(string(m[1]))
+		if err != nil {
+			continue
+		}
+		seconds, err := strconv.ParseFloat(string(m[2]), 64)
+		if err != nil {
+			continue
+		}
+		changes = append(changes, sceneChange{n: n, start: time.Duration(seconds * float64(time.Second))})
+	}
+	return changes
+}
+
+// chapterStarts returns the scene changes that start chapters of at least minChapterLength, at most maxChapterSuggestions.
+func chapterStarts(changes []sceneChange) []sceneChange {
+	var starts []sceneChange
+	for _, change := range changes {
+		if len(starts) == maxChapterSuggestions {
+			break
+		}
+		if len(starts) > 0 && change.start-starts[len(starts)-1].start < minChapterLength {
+			continue
+		}
+		starts = append(starts, change)
+	}
+	return starts
+}
+
+// analyzeSlides detects the slide changes in the VoD of a presentation. They are suggested to tumlive as chapters
+// and, if tumlive extracts the text of slides, sent for the extraction of their text. tumlive removes their frames
+// once the text is extracted. The thumbnails of the scene changes that don't start chapters are removed again.
+func analyzeSlides(streamCtx *StreamContext) error {
+	thumbDir, slideDir := streamCtx.getChapterDir(), streamCtx.getSlideDir()
+	for _, dir := range []string{thumbDir, slideDir} {
+		if err := os.RemoveAll(dir); err != nil { // clean up the results of an earlier transcoding
+			return err
+		}
+	}
+	if !streamCtx.slideText {
+		slideDir = ""
+	}
+	for _, dir := range []string{thumbDir, slideDir} {
+		if dir == "" {
+			continue
+		}
+		if err := os.MkdirAll(dir, os.ModePerm); err != nil {
+			return err
+		}
+	}
+	cmd := exec.Command("nice", sceneChangeArgs(streamCtx.getTranscodingFileName(), thumbDir, slideDir)...)
+	log.WithField("command", cmd.String()).Info("Detecting slide changes")
+	output, err := cmd.CombinedOutput()
+	if err != nil {
+		return fmt.Errorf("detect slide changes: %w", err)
+	}
+
+	changes := parseSceneChanges(output)
+	if slideDir != "" {
+		slides := make([]*pb.Slide, len(changes))
+		for i, change := range changes {
+			slides[i] = &pb.Slide{
+				Start: uint32(change.start / time.Second),
+				Path:  filepath.Join(slideDir, fmt.Sprintf("%04d.jpg", change.n+1)),
+			}
+		}
+		if err = notifySlides(streamCtx, slides); err != nil {
+			log.WithError(err).Error("Could not notify slides")
+			_ = os.RemoveAll(slideDir)
+		}
+	}
+
+	starts := chapterStarts(changes)
+	suggestions := make([]*pb.SectionSuggestion, len(starts))
+	keep := map[int]bool{}
+	for i, start := range starts {
+		keep[start.n] = true
+		suggestions[i] = &pb.SectionSuggestion{
+			Start:         uint32(start.start / time.Second),
+			ThumbnailPath: filepath.Join(thumbDir, fmt.Sprintf("%04d.jpg", start.n+1)),
+		}
+	}
+	for _, change := range changes {
+		if !keep[change.n] {
+			_ = os.Remove(filepath.Join(thumbDir, fmt.Sprintf("%04d.jpg", change.n+1)))
+		}
+	}
+	return notifySectionSuggestions(streamCtx, suggestions)
+}
+
+// notifySectionSuggestions sends the suggested chapters of a stream to tumlive.
+func notifySectionSuggestions(streamCtx *StreamContext, suggestions []*pb.SectionSuggestion) error {
+	client, conn, err := GetClient()
+	if err != nil {
+		return err
+	}
+	defer closeConnection(conn)
+	ctx, cancel := context.WithTimeout(context.Background(), time.Minute)
+	defer cancel()
+	_, err = client.NotifySectionSuggestions(ctx, &pb.SectionSuggestions{
+		WorkerID:    cfg.WorkerID,
+		StreamID:    streamCtx.streamId,
+		Suggestions: suggestions,
+	})
+	return err
+}
+
+// notifySlides sends the frames of the slide changes of a stream to tumlive.
+func notifySlides(streamCtx *StreamContext, slides []*pb.Slide) error {
+	client, conn, err := GetClient()
+	if err != nil {
+		return err
+	}
+	defer closeConnection(conn)
+	ctx, cancel := context.WithTimeout(context.Background(), time.Minute)
+	defer cancel()
+	_, err = client.NotifySlides(ctx, &pb.Slides{
+		WorkerID: cfg.WorkerID,
+		StreamID: streamCtx.streamId,
+		Slides:   slides,
+	})
+	return err
+}
@@ -0,0 +1,65 @@
+package worker
+
+import (
+	"strings"
+	"testing"
+	"time"
+)
+
+const showinfoOutput = `[Parsed_showinfo_2 @ 0x55d5c1a3c0c0] config in time_base: 1/1, frame_rate: 1/1
+[Parsed_showinfo_2 @ 0x55d5c1a3c0c0] n:   0 pts:      0 pts_time:0       duration:      1 duration_time:1 fmt:yuv420p
+[Parsed_showinfo_2 @ 0x55d5c1a3c0c0] n:   1 pts:     95 pts_time:95      duration:      1 duration_time:1 fmt:yuv420p
+[Parsed_showinfo_2 @ 0x55d5c1a3c0c0] n:   2 pts:    301 pts_time:301     duration:      1 duration_time:1 fmt:yuv420p
+[Parsed_showinfo_2 @ 0x55d5c1a3c0c0] n:   3 pts:    350 pts_time:350     duration:      1 duration_time:1 fmt:yuv420p
+[Parsed_showinfo_2 @ 0x55d5c1a3c0c0] n:   4 pts:    482 pts_time:482.5   duration:      1 duration_time:1 fmt:yuv420p
+frame=    5 fps=0.0 q=-0.0 Lsize=N/A time=00:08:02.50 bitrate=N/A speed= 300x
+`
+
+func TestChapterStarts(t *testing.T) {
+	changes := parseSceneChanges([]byte(showinfoOutput))
+	if len(changes) != 5 {
+		t.Fatalf("expected 5 scene changes, got %d", len(changes))
+	}
+	if changes[4].start != 482*time.Second+500*time.Millisecond {
+		t.Errorf("expected last scene change at 482.5s, got %v", changes[4].start)
+	}
+
+	starts := chapterStarts(changes)
+	want := []int{0, 2, 4}
+	if len(starts) != len(want) {
+		t.Fatalf("expected chapters at frames %v, got %v", want, starts)
+	}
+	for i, n := range want {
+		if starts[i].n != n {
+			t.Errorf("expected chapter %d at frame %d, got %d", i, n, starts[i].n)
+		}
+	}
+}
+
+func TestChapterStartsLimit(t *testing.T) {
+	changes := make([]sceneChange, maxChapterSuggestions+10)
+	for i := range changes {
+		changes[i] = sceneChange{n: i, start: time.Duration(i) * minChapterLength}
+	}
+	if starts := chapterStarts(changes); len(starts) != maxChapterSuggestions {
+		t.Errorf("expected %d chapters, got %d", maxChapterSuggestions, len(starts))
+	}
+}
+
+func TestSceneChangeArgs(t *testing.T) {
+	args := sceneChangeArgs("/srv/vod.mp4", "/srv/chapters", "/srv/slides")
+	if got := args[len(args)-1]; got != "/srv/slides/%04d.jpg" {
+		t.Errorf("expected slides as last output, got %s", got)
+	}
+	if got := args[len(args)-8]; got != "/srv/chapters/%04d.jpg" {
+		t.Errorf("expected thumbnails as first output, got %s", got)
+	}
+
+	args = sceneChangeArgs("/srv/vod.mp4", "/srv/chapters", "")
+	if got := args[len(args)-1]; got != "/srv/chapters/%04d.jpg" {
+		t.Errorf("expected thumbnails as only output, got %s", got)
+	}
+	if strings.Contains(strings.Join(args, " "), "[slide]") {
+		t.Errorf("expected no full resolution frames without slide dir, got %v", args)
+	}
+}
@@ -0,0 +1,95 @@
+package worker
+
+import (
+	"context"
+	"fmt"
+	"os/exec"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/TUM-Dev/gocast/worker/cfg"
+	"github.com/TUM-Dev/gocast/worker/pb"
+	log "github.com/sirupsen/logrus"
+)
+
+// vodCodecArgs are the ffmpeg options of the software encoders for the additional VoD renditions.
+// Both reach the quality of the H.264 VoD at roughly half its size, which pays off for static slides the most.
+var vodCodecArgs = map[string][]string{
+	"hevc": {"-c:v", "libx265", "-preset", "medium", "-crf", "28", "-tag:v", "hvc1"}, // Safari only plays HEVC tagged as hvc1
+	"av1":  {"-c:v", "libsvtav1", "-preset", "8", "-crf", "35"},
+}
+
+// getCodecFileName returns the filename of the additional VoD rendition in codec.
+// example: /srv/sharedMassStorage/2021/S/eidi/2021-09-23_10-00/eidi_2021-09-23_10-00_PRES_hevc.mp4
+func (s StreamContext) getCodecFileName(codec string) string {
+	return strings.TrimSuffix(s.getTranscodingFileName(), ".mp4") + "_" + codec + ".mp4"
+}
+
+// buildCodecCommand returns the command that encodes the H.264 VoD in infile to outfile in codec.
+// The audio is copied, only the video differs between the renditions.
+func buildCodecCommand(infile string, outfile string, codec string) (*exec.Cmd, error) {
+	args, ok := vodCodecArgs[codec]
+	if !ok {
+		return nil, fmt.Errorf("unsupported codec %q", codec)
+	}
+	c := []string{
+		"-n", "15", // after everything else, software encoders take a multiple of the duration of the VoD
+		"ffmpeg", "-nostats", "-loglevel", "error", "-y",
+		"-i", infile,
+		"-map", "0:v:0", "-map", "0:a:0?",
+	}
+	c = append(c, args...)
+	c = append(c, "-c:a", "copy", "-movflags", "+faststart", outfile)
+	return exec.Command("nice", c...), nil
+}
+
+// transcodeCodecs encodes the additional VoD renditions of the encoding profile, reports them to tumlive
+// and adds them to the VoD package if the VoD is published.
+func transcodeCodecs(streamCtx *StreamContext) {
+	for _, codec := range streamCtx.profile.GetVodCodecs() {
+		out := streamCtx.getCodecFileName(codec)
+		cmd, err := buildCodecCommand(streamCtx.getTranscodingFileName(), out, codec)
+		if err != nil {
+			log.WithError(err).Error("Can't encode VoD rendition")
+			continue
+		}
+		name := streamCtx.getStreamName() + "_" + codec
+		log.WithFields(log.Fields{"output": out, "command": cmd.String()}).Info("Transcoding VoD rendition")
+		S.startTranscoding(name)
+		output, err := cmd.CombinedOutput()
+		S.endTranscoding(name)
+		if err != nil {
+			log.WithError(err).WithField("output", string(output)).Error("Transcoding VoD rendition failed")
+			continue
+		}
+		notifyCodecDone(streamCtx, codec, out)
+		if streamCtx.publishVoD {
+			if err = postCodec(out, codec, filepath.Base(streamCtx.getTranscodingFileName())); err != nil {
+				log.WithError(err).WithField("codec", codec).Error("Error uploading VoD rendition")
+			}
+		}
+	}
+}
+
+// notifyCodecDone tells tumlive about the additional VoD rendition of a stream in codec saved to file.
+func notifyCodecDone(streamCtx *StreamContext, codec string, file string) {
+	client, conn, err := GetClient()
+	if err != nil {
+		log.WithError(err).Error("Unable to dial tumlive")
+		return
+	}
+	defer closeConnection(conn)
+	ctx, cancel := context.WithTimeout(context.Background(), time.Minute)
+	defer cancel()
+	resp, err := client.NotifyTranscodingFinished(ctx, &pb.TranscodingFinished{
+		WorkerID:   cfg.WorkerID,
+		StreamID:   streamCtx.streamId,
+		FilePath:   file,
+		SourceType: streamCtx.streamVersion,
+		Codec:      codec,
+	})
+	if err != nil || !resp.Ok {
+		log.WithError(err).Error("Could not notify VoD rendition finished")
+	}
+}
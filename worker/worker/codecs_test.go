@@ -0,0 +1,32 @@
+package worker
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestBuildCodecCommand(t *testing.T) {
+	cmd, err := buildCodecCommand("in.mp4", "in_hevc.mp4", "hevc")
+	if err != nil {
+		t.Fatal(err)
+	}
+	out := strings.Join(cmd.Args, " ")
+	if !strings.Contains(out, "-i in.mp4 -map 0:v:0 -map 0:a:0? -c:v libx265") || !strings.HasSuffix(out, "-c:a copy -movflags +faststart in_hevc.mp4") {
+		t.Errorf("unexpected hevc command: %s", out)
+	}
+	cmd, err = buildCodecCommand("in.mp4", "in_av1.mp4", "av1")
+	if err != nil || !strings.Contains(strings.Join(cmd.Args, " "), "-c:v libsvtav1") {
+		t.Errorf("expected av1 command, got %v (%v)", cmd, err)
+	}
+	if _, err = buildCodecCommand("in.mp4", "out.mp4", "vp9"); err == nil {
+		t.Errorf("expected error for unsupported codec")
+	}
+}
+
+func TestGetCodecFileName(t *testing.T) {
+	ctx := StreamContext{courseSlug: "eidi", teachingTerm: "S", teachingYear: 2021, streamVersion: "PRES"}
+	name := ctx.getCodecFileName("av1")
+	if !strings.HasSuffix(name, "PRES_av1.mp4") || strings.TrimSuffix(name, "_av1.mp4")+".mp4" != ctx.getTranscodingFileName() {
+		t.Errorf("expected rendition next to the VoD, got %s", name)
+	}
+}
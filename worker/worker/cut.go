@@ -0,0 +1,115 @@
+package worker
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/TUM-Dev/gocast/worker/cfg"
+	"github.com/TUM-Dev/gocast/worker/pb"
+	log "github.com/sirupsen/logrus"
+)
+
+// cutRange is a part of a video that is kept when cutting it. An end of 0 keeps the video until its end.
+type cutRange struct {
+	start, end time.Duration
+}
+
+// keepRanges returns the ranges of a video that are kept by the segments of a cut request, ordered by their start.
+// If there are segments to keep, everything else is discarded. Otherwise, everything but the discarded segments is kept.
+func keepRanges(segments []*pb.CutRequest_Segment) []cutRange {
+	var keep, discard []cutRange
+	for _, s := range segments {
+		r := cutRange{start: time.Duration(s.GetStartTime()) * time.Millisecond, end: time.Duration(s.GetEndTime()) * time.Millisecond}
+		if r.end <= r.start {
+			continue
+		}
+		if s.GetDiscard() {
+			discard = append(discard, r)
+		} else {
+			keep = append(keep, r)
+		}
+	}
+	if len(keep) != 0 {
+		sort.Slice(keep, func(i, j int) bool { return keep[i].start < keep[j].start })
+		return keep
+	}
+	if len(discard) == 0 {
+		return nil
+	}
+	sort.Slice(discard, func(i, j int) bool { return discard[i].start < discard[j].start })
+	var start time.Duration
+	for _, r := range discard {
+		if r.start > start {
+			keep = append(keep, cutRange{start: start, end: r.start})
+		}
+		if r.end > start {
+			start = r.end
+		}
+	}
+	return append(keep, cutRange{start: start})
+}
+
+// cutList returns the list for the concat demuxer of ffmpeg that concatenates the ranges of file.
+func cutList(file string, ranges []cutRange) string {
+	var b strings.Builder
+	b.WriteString("ffconcat version 1.0\n")
+	for _, r := range ranges {
+		// paths are quoted for the concat demuxer
+		fmt.Fprintf(&b, "file '%s'\n", strings.ReplaceAll(file, "'", `'\''`))
+		fmt.Fprintf(&b, "inpoint %.3f\n", r.start.Seconds())
+		if r.end != 0 {
+			fmt.Fprintf(&b, "outpoint %.3f\n", r.end.Seconds())
+		}
+	}
+	return b.String()
+}
+
+// Cut saves the ranges of the file of a cut request that are kept to its output file.
+// The video is copied without transcoding, so the cuts snap to the keyframes before the requested times.
+func Cut(request *pb.CutRequest) error {
+	if len(request.GetFiles()) != 1 {
+		return errors.New("cutting requires exactly one file")
+	}
+	if request.GetUploadResult() {
+		return errors.New("uploading cut files is not supported")
+	}
+	out := request.GetOutputFile()
+	if out == "" {
+		return errors.New("no output file")
+	}
+	ranges := keepRanges(request.GetSegments())
+	if len(ranges) == 0 {
+		return errors.New("no segments to cut")
+	}
+	if err := os.MkdirAll(filepath.Dir(out), 0750); err != nil {
+		return fmt.Errorf("create directory of cut file: %w", err)
+	}
+	list, err := os.CreateTemp(cfg.TempDir, "cut-*.txt")
+	if err != nil {
+		return fmt.Errorf("create list of segments: %w", err)
+	}
+	defer os.Remove(list.Name())
+	if _, err = list.WriteString(cutList(request.GetFiles()[0], ranges)); err != nil {
+		_ = list.Close()
+		return fmt.Errorf("write list of segments: %w", err)
+	}
+	if err = list.Close(); err != nil {
+		return fmt.Errorf("write list of segments: %w", err)
+	}
+
+	cmd := exec.Command("nice", "ffmpeg", "-nostats", "-loglevel", "error", "-y",
+		"-f", "concat", "-safe", "0", "-i", list.Name(),
+		"-c", "copy", "-movflags", "+faststart", out)
+	log.WithField("command", cmd.String()).Info("Cutting")
+	if output, err := cmd.CombinedOutput(); err != nil {
+		_ = os.Remove(out)
+		return fmt.Errorf("cut video: %w: %s", err, output)
+	}
+	return nil
+}
@@ -0,0 +1,42 @@
+package worker
+
+import (
+	"testing"
+	"time"
+
+	"github.com/TUM-Dev/gocast/worker/pb"
+)
+
+func TestKeepRanges(t *testing.T) {
+	t.Run("keep", func(t *testing.T) {
+		ranges := keepRanges([]*pb.CutRequest_Segment{
+			{StartTime: 60_000, EndTime: 90_000},
+			{StartTime: 2_040_000, EndTime: 2_460_000},
+			{StartTime: 10_000, EndTime: 20_000, Discard: true},
+		})
+		want := []cutRange{{start: time.Minute, end: 90 * time.Second}, {start: 34 * time.Minute, end: 41 * time.Minute}}
+		if len(ranges) != len(want) || ranges[0] != want[0] || ranges[1] != want[1] {
+			t.Errorf("expected %v, got %v", want, ranges)
+		}
+	})
+	t.Run("discard", func(t *testing.T) {
+		ranges := keepRanges([]*pb.CutRequest_Segment{
+			{StartTime: 3_600_000, EndTime: 4_500_000, Discard: true},
+			{StartTime: 0, EndTime: 300_000, Discard: true},
+		})
+		want := []cutRange{{start: 5 * time.Minute, end: time.Hour}, {start: 75 * time.Minute}}
+		if len(ranges) != len(want) || ranges[0] != want[0] || ranges[1] != want[1] {
+			t.Errorf("expected %v, got %v", want, ranges)
+		}
+	})
+}
+
+func TestCutList(t *testing.T) {
+	list := cutList("/srv/it's.mp4", []cutRange{{start: 1500 * time.Millisecond, end: time.Minute}, {start: time.Hour}})
+	want := "ffconcat version 1.0\n" +
+		"file '/srv/it'\\''s.mp4'\ninpoint 1.500\noutpoint 60.000\n" +
+		"file '/srv/it'\\''s.mp4'\ninpoint 3600.000\n"
+	if list != want {
+		t.Errorf("expected\n%s\ngot\n%s", want, list)
+	}
+}
@@ -0,0 +1,95 @@
+package worker
+
+import (
+	"fmt"
+
+	"github.com/TUM-Dev/gocast/worker/pb"
+)
+
+// Defaults for the fields tumlive leaves unset in the encoding profile of a stream.
+const (
+	defaultVideoCodec   = "libx264"
+	defaultAudioCodec   = "aac"
+	defaultVideoBitrate = 2500 // kbit/s
+	defaultAudioBitrate = 128  // kbit/s
+	defaultLivePreset   = "veryfast"
+)
+
+// defaultCrf returns the quality of the VoD of a stream version.
+// Presentations are compressed less because text becomes unreadable quickly, cameras and unknown sources more.
+func defaultCrf(version string) uint32 {
+	switch version {
+	case "PRES":
+		return 20
+	case "COMB":
+		return 24
+	default:
+		return 26
+	}
+}
+
+// defaultTune returns the tuning of the VoD of a stream version.
+func defaultTune(version string) string {
+	if version == "PRES" {
+		return "stillimage"
+	}
+	return ""
+}
+
+// orDefault returns value or def if value is unset.
+func orDefault[T comparable](value, def T) T {
+	var zero T
+	if value == zero {
+		return def
+	}
+	return value
+}
+
+// kbits formats a bitrate in kbit/s for ffmpeg, e.g. 2500k.
+func kbits(bitrate uint32) string {
+	return fmt.Sprintf("%dk", bitrate)
+}
+
+// scaleArgs returns the filter that scales the video to height, or nothing to keep the resolution of the source.
+func scaleArgs(height uint32) []string {
+	if height == 0 {
+		return nil
+	}
+	return []string{"-vf", fmt.Sprintf("scale=-2:%d", height)}
+}
+
+// audioFormatArgs returns the channel count and sample rate of profile.
+// Unset values fall back to channels and sampleRate, 0 keeps those of the source.
+func audioFormatArgs(profile *pb.EncodingProfile, channels uint32, sampleRate uint32) []string {
+	var args []string
+	if c := orDefault(profile.GetAudioChannels(), channels); c != 0 {
+		args = append(args, "-ac", fmt.Sprintf("%d", c))
+	}
+	if r := orDefault(profile.GetAudioSampleRate(), sampleRate); r != 0 {
+		args = append(args, "-ar", fmt.Sprintf("%d", r))
+	}
+	return args
+}
+
+// vodArgs returns the ffmpeg output options that encode the VoD of a stream version with profile.
+func vodArgs(profile *pb.EncodingProfile, version string) []string {
+	codec := orDefault(profile.GetVideoCodec(), defaultVideoCodec)
+	args := []string{"-vsync", "2", "-c:v", codec}
+	switch codec {
+	case defaultVideoCodec:
+		args = append(args, "-level", "4.0")
+	case "libx265":
+		args = append(args, "-tag:v", "hvc1") // Safari only plays HEVC tagged as hvc1
+	}
+	args = append(args, "-movflags", "+faststart")
+	if preset := profile.GetPreset(); preset != "" {
+		args = append(args, "-preset", preset)
+	}
+	if tune := orDefault(profile.GetTune(), defaultTune(version)); tune != "" {
+		args = append(args, "-tune", tune)
+	}
+	args = append(args, scaleArgs(profile.GetHeight())...)
+	args = append(args, "-c:a", orDefault(profile.GetAudioCodec(), defaultAudioCodec), "-b:a", kbits(orDefault(profile.GetAudioBitrate(), defaultAudioBitrate)))
+	args = append(args, audioFormatArgs(profile, 0, 0)...)
+	return append(args, "-crf", fmt.Sprintf("%d", orDefault(profile.GetCrf(), defaultCrf(version))))
+}
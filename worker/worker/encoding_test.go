@@ -0,0 +1,71 @@
+package worker
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/TUM-Dev/gocast/worker/pb"
+)
+
+func TestVodArgsDefaults(t *testing.T) {
+	tests := []struct {
+		version string
+		want    string
+	}{
+		{"PRES", "-tune stillimage -c:a aac -b:a 128k -crf 20"},
+		{"COMB", "-movflags +faststart -c:a aac -b:a 128k -crf 24"},
+		{"CAM", "-movflags +faststart -c:a aac -b:a 128k -crf 26"},
+		{"", "-crf 26"},
+	}
+	for _, test := range tests {
+		out := strings.Join(vodArgs(nil, test.version), " ")
+		if !strings.HasPrefix(out, "-vsync 2 -c:v libx264 -level 4.0") || !strings.HasSuffix(out, test.want) {
+			t.Errorf("unexpected default arguments for %q: %s", test.version, out)
+		}
+	}
+}
+
+func TestVodArgsProfile(t *testing.T) {
+	profile := &pb.EncodingProfile{
+		VideoCodec:      "libx265",
+		Crf:             28,
+		Preset:          "slow",
+		Height:          720,
+		AudioCodec:      "libopus",
+		AudioBitrate:    96,
+		AudioChannels:   1,
+		AudioSampleRate: 48000,
+	}
+	out := strings.Join(vodArgs(profile, "PRES"), " ")
+	want := "-vsync 2 -c:v libx265 -tag:v hvc1 -movflags +faststart -preset slow -tune stillimage -vf scale=-2:720 -c:a libopus -b:a 96k -ac 1 -ar 48000 -crf 28"
+	if out != want {
+		t.Errorf("expected %s, got %s", want, out)
+	}
+}
+
+func TestBuildCommandSelfStream(t *testing.T) {
+	args := buildCommand(8, "in.ts", "out.mp4", nil, "COMB", true, nil).Args
+	out := strings.Join(args, " ")
+	if !strings.Contains(out, "-probesize 25M -analyzeduration 50M -i in.ts") {
+		t.Errorf("self streams should be probed longer before the input, got %s", out)
+	}
+	if args[len(args)-1] != "out.mp4" {
+		t.Errorf("expected output file last, got %s", out)
+	}
+}
+
+func TestPremiereArgs(t *testing.T) {
+	ctx := &StreamContext{sourceUrl: "/recordings/a.mp4", ingestServer: "rtmp://ingest.example.com/live/", streamName: "abc"}
+	out := strings.Join(premiereArgs(ctx), " ")
+	if !strings.Contains(out, "-b:v 2500k -bufsize 3000k -maxrate 3000k -preset veryfast") || !strings.Contains(out, "-b:a 128k -ac 2 -ar 48000") {
+		t.Errorf("premiere without profile should keep the defaults, got %s", out)
+	}
+	ctx.profile = &pb.EncodingProfile{VideoBitrate: 1000, Tune: "animation"}
+	out = strings.Join(premiereArgs(ctx), " ")
+	if !strings.Contains(out, "-b:v 1000k -bufsize 1200k -maxrate 1200k") || !strings.Contains(out, "-tune animation") {
+		t.Errorf("premiere should use the profile, got %s", out)
+	}
+	if !strings.HasSuffix(out, "-f flv rtmp://ingest.example.com/live/abc") {
+		t.Errorf("premiere should be pushed to the stream name, got %s", out)
+	}
+}
@@ -0,0 +1,146 @@
+package worker
+
+import (
+	"bufio"
+	"context"
+	"io"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/TUM-Dev/gocast/worker/cfg"
+	"github.com/TUM-Dev/gocast/worker/pb"
+	log "github.com/sirupsen/logrus"
+	"google.golang.org/grpc"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+const (
+	// healthInterval is the interval ffmpeg reports its progress in while streaming
+	healthInterval = 5 * time.Second
+	// healthStallTimeout is how long the worker waits for progress before it reports the stream as stalled
+	healthStallTimeout = 3 * healthInterval
+)
+
+// ffmpegProgress is a block of the key=value output of ffmpeg's -progress option.
+type ffmpegProgress struct {
+	bitrate    float64 // kbit/s
+	fps        float64
+	dropFrames uint64
+	dupFrames  uint64
+	speed      float64
+	outTime    int64 // microseconds of the input that were written
+}
+
+// parseProgress reads the -progress output of ffmpeg until r is closed and sends every block to out.
+// Blocks are dropped if nobody is reading, ffmpeg must never wait for the worker.
+func parseProgress(r io.ReadCloser, out chan<- ffmpegProgress) {
+	defer r.Close()
+	var p ffmpegProgress
+	scanner := bufio.NewScanner(r)
+	for scanner.Scan() {
+		key, value, found := strings.Cut(strings.TrimSpace(scanner.Text()), "=")
+		if !found {
+			continue
+		}
+		switch key {
+		case "bitrate": // e.g. 2412.3kbits/s or N/A
+			p.bitrate, _ = strconv.ParseFloat(strings.TrimSuffix(value, "kbits/s"), 64)
+		case "fps":
+			p.fps, _ = strconv.ParseFloat(value, 64)
+		case "drop_frames":
+			p.dropFrames, _ = strconv.ParseUint(value, 10, 64)
+		case "dup_frames":
+			p.dupFrames, _ = strconv.ParseUint(value, 10, 64)
+		case "speed": // e.g. 1.01x or N/A
+			p.speed, _ = strconv.ParseFloat(strings.TrimSuffix(value, "x"), 64)
+		case "out_time_us":
+			p.outTime, _ = strconv.ParseInt(value, 10, 64)
+		case "progress": // continue or end, always the last key of a block
+			select {
+			case out <- p:
+			default:
+			}
+			p = ffmpegProgress{}
+		}
+	}
+}
+
+// newHealthSample converts the progress of ffmpeg to a sample for tumlive.
+// The stream is stalled if ffmpeg wrote nothing since the previous block or there is no progress at all.
+func newHealthSample(streamCtx *StreamContext, p *ffmpegProgress, prev *ffmpegProgress) *pb.StreamHealth {
+	sample := &pb.StreamHealth{
+		WorkerID: cfg.WorkerID,
+		StreamID: streamCtx.streamId,
+		Version:  streamCtx.streamVersion,
+		Time:     timestamppb.Now(),
+		Stalled:  p == nil,
+	}
+	if p == nil {
+		return sample
+	}
+	sample.Bitrate = float32(p.bitrate)
+	sample.Fps = float32(p.fps)
+	sample.DroppedFrames = p.dropFrames
+	sample.DuplicatedFrames = p.dupFrames
+	sample.Speed = float32(p.speed)
+	sample.Stalled = prev != nil && p.outTime == prev.outTime
+	return sample
+}
+
+// reportHealth sends the progress of the ffmpeg processes of a stream to tumlive until done is closed.
+// If no progress arrives for healthStallTimeout, e.g. because ffmpeg can't read the input, the stream is reported as stalled.
+func reportHealth(streamCtx *StreamContext, progress <-chan ffmpegProgress, done <-chan struct{}) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	var conn *grpc.ClientConn
+	var str pb.FromWorker_NotifyStreamHealthClient
+	send := func(sample *pb.StreamHealth) {
+		if str == nil {
+			client, c, err := GetClient()
+			if err != nil {
+				log.WithError(err).Warn("Unable to dial tumlive for stream health")
+				return
+			}
+			conn = c
+			if str, err = client.NotifyStreamHealth(ctx); err != nil {
+				log.WithError(err).Warn("Unable to report stream health")
+				closeConnection(conn)
+				conn, str = nil, nil
+				return
+			}
+		}
+		if err := str.Send(sample); err != nil {
+			log.WithError(err).Warn("Unable to report stream health")
+			closeConnection(conn) // reconnect with the next sample
+			conn, str = nil, nil
+		}
+	}
+	defer func() {
+		if str != nil {
+			_, _ = str.CloseAndRecv()
+			closeConnection(conn)
+		}
+	}()
+
+	var prev *ffmpegProgress
+	stallTimer := time.NewTimer(healthStallTimeout)
+	defer stallTimer.Stop()
+	for {
+		select {
+		case <-done:
+			return
+		case p := <-progress:
+			send(newHealthSample(streamCtx, &p, prev))
+			prev = &p
+			if !stallTimer.Stop() {
+				<-stallTimer.C
+			}
+			stallTimer.Reset(healthStallTimeout)
+		case <-stallTimer.C:
+			send(newHealthSample(streamCtx, nil, nil))
+			prev = nil // ffmpeg restarts with a new output time
+			stallTimer.Reset(healthInterval)
+		}
+	}
+}
@@ -0,0 +1,64 @@
+package worker
+
+import (
+	"io"
+	"strings"
+	"testing"
+)
+
+const progressOutput = `frame=150
+fps=30.00
+stream_0_0_q=23.0
+bitrate=2412.3kbits/s
+total_size=1507840
+out_time_us=5000000
+out_time_ms=5000000
+out_time=00:00:05.000000
+dup_frames=2
+drop_frames=1
+speed=1.01x
+progress=continue
+frame=300
+fps=29.97
+bitrate=N/A
+out_time_us=10000000
+dup_frames=2
+drop_frames=4
+speed=N/A
+progress=end
+`
+
+func TestParseProgress(t *testing.T) {
+	out := make(chan ffmpegProgress, 2)
+	parseProgress(io.NopCloser(strings.NewReader(progressOutput)), out)
+	if len(out) != 2 {
+		t.Fatalf("expected 2 progress blocks, got %d", len(out))
+	}
+	p := <-out
+	if p.bitrate != 2412.3 || p.fps != 30 || p.dropFrames != 1 || p.dupFrames != 2 || p.speed != 1.01 || p.outTime != 5000000 {
+		t.Errorf("unexpected first block %+v", p)
+	}
+	p = <-out
+	if p.bitrate != 0 || p.speed != 0 || p.dropFrames != 4 || p.outTime != 10000000 {
+		t.Errorf("unexpected second block %+v", p)
+	}
+}
+
+func TestParseProgressDoesNotBlock(t *testing.T) {
+	out := make(chan ffmpegProgress) // nobody reads
+	parseProgress(io.NopCloser(strings.NewReader(progressOutput)), out)
+}
+
+func TestNewHealthSample(t *testing.T) {
+	ctx := &StreamContext{streamId: 3, streamVersion: "PRES"}
+	if s := newHealthSample(ctx, nil, nil); !s.Stalled || s.StreamID != 3 || s.Version != "PRES" {
+		t.Errorf("missing progress should be reported as stalled, got %+v", s)
+	}
+	prev := &ffmpegProgress{outTime: 5000000}
+	if s := newHealthSample(ctx, &ffmpegProgress{outTime: 5000000, bitrate: 10}, prev); !s.Stalled {
+		t.Error("progress without new output should be reported as stalled")
+	}
+	if s := newHealthSample(ctx, &ffmpegProgress{outTime: 10000000, bitrate: 2400}, prev); s.Stalled || s.Bitrate != 2400 {
+		t.Errorf("unexpected sample %+v", s)
+	}
+}
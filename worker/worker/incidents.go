@@ -0,0 +1,186 @@
+package worker
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"regexp"
+	"sync"
+	"time"
+
+	"github.com/TUM-Dev/gocast/worker/cfg"
+	"github.com/TUM-Dev/gocast/worker/pb"
+	log "github.com/sirupsen/logrus"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// Types of incidents, see model.StreamIncident
+const (
+	incidentBlack   = "black"
+	incidentFreeze  = "freeze"
+	incidentSilence = "silence"
+)
+
+// Durations a problem must last before it is reported as incident.
+// Slides are often shown for minutes, so only long freezes are reported.
+const (
+	blackThreshold   = 30 * time.Second
+	freezeThreshold  = 5 * time.Minute
+	silenceThreshold = time.Minute
+)
+
+// analysisOutput returns the ffmpeg output options that detect black screens, frozen images and silent audio in the live input.
+// blackdetect only logs black periods once they are over, so the starts and ends are printed from the frame metadata instead.
+func analysisOutput() []string {
+	video := "fps=2,scale=320:-2,blackdetect=d=0:pix_th=0.10," +
+		"metadata=mode=print:key=lavfi.black_start,metadata=mode=print:key=lavfi.black_end," +
+		fmt.Sprintf("freezedetect=n=-60dB:d=%.0f", freezeThreshold.Seconds())
+	audio := fmt.Sprintf("silencedetect=n=-50dB:d=%.0f", silenceThreshold.Seconds())
+	return []string{"-map", "0:v:0", "-map", "0:a:0?", "-vf", video, "-af", audio, "-f", "null", "-"}
+}
+
+// incidentRe matches the log lines of the detection filters, e.g. "[silencedetect @ 0x1] silence_start: 12.3",
+// "[freezedetect @ 0x1] lavfi.freezedetect.freeze_end: 20" or "[Parsed_metadata_4 @ 0x1] lavfi.black_start=1.5"
+var incidentRe = regexp.MustCompile(`(black|freeze|silence)_(start|end)[:=]`)
+
+// incidentDetector parses the log of ffmpeg and reports problems that last longer than their threshold.
+type incidentDetector struct {
+	report func(incidentType string, start time.Time, end *time.Time)
+
+	mutex   sync.Mutex
+	buf     []byte
+	pending map[string]*time.Timer // problems that didn't last long enough yet
+	open    map[string]time.Time   // reported incidents by their start
+}
+
+func newIncidentDetector(report func(incidentType string, start time.Time, end *time.Time)) *incidentDetector {
+	return &incidentDetector{report: report, pending: map[string]*time.Timer{}, open: map[string]time.Time{}}
+}
+
+// Write receives the log of ffmpeg and handles it line by line.
+func (d *incidentDetector) Write(p []byte) (int, error) {
+	d.mutex.Lock()
+	defer d.mutex.Unlock()
+	d.buf = append(d.buf, p...)
+	for {
+		i := bytes.IndexByte(d.buf, '\n')
+		if i < 0 {
+			break
+		}
+		d.handleLine(d.buf[:i])
+		d.buf = d.buf[i+1:]
+	}
+	return len(p), nil
+}
+
+func (d *incidentDetector) handleLine(line []byte) {
+	if bytes.Contains(line, []byte("black_duration")) {
+		return // summary of blackdetect, the metadata was handled already
+	}
+	m := incidentRe.FindSubmatch(line)
+	if m == nil {
+		return
+	}
+	incidentType := string(m[1])
+	if string(m[2]) == "start" {
+		d.started(incidentType)
+	} else {
+		d.ended(incidentType)
+	}
+}
+
+// started reports an incident once the problem lasted for its threshold.
+// freezedetect and silencedetect only log problems that lasted for the threshold already.
+func (d *incidentDetector) started(incidentType string) {
+	if _, ok := d.open[incidentType]; ok {
+		return
+	}
+	if _, ok := d.pending[incidentType]; ok {
+		return
+	}
+	if incidentType != incidentBlack {
+		threshold := freezeThreshold
+		if incidentType == incidentSilence {
+			threshold = silenceThreshold
+		}
+		d.open[incidentType] = time.Now().Add(-threshold)
+		d.report(incidentType, d.open[incidentType], nil)
+		return
+	}
+	start := time.Now()
+	d.pending[incidentType] = time.AfterFunc(blackThreshold, func() {
+		d.mutex.Lock()
+		defer d.mutex.Unlock()
+		if _, ok := d.pending[incidentType]; !ok {
+			return // ended in the meantime
+		}
+		delete(d.pending, incidentType)
+		d.open[incidentType] = start
+		d.report(incidentType, start, nil)
+	})
+}
+
+func (d *incidentDetector) ended(incidentType string) {
+	if t, ok := d.pending[incidentType]; ok {
+		t.Stop()
+		delete(d.pending, incidentType)
+	}
+	if start, ok := d.open[incidentType]; ok {
+		delete(d.open, incidentType)
+		end := time.Now()
+		d.report(incidentType, start, &end)
+	}
+}
+
+// Close resolves all open incidents, e.g. when ffmpeg exits.
+func (d *incidentDetector) Close() {
+	d.mutex.Lock()
+	defer d.mutex.Unlock()
+	for incidentType := range d.pending {
+		d.ended(incidentType)
+	}
+	for incidentType := range d.open {
+		d.ended(incidentType)
+	}
+}
+
+// incidentEvent is the start or end (if end is set) of an incident.
+type incidentEvent struct {
+	incidentType string
+	start        time.Time
+	end          *time.Time
+}
+
+// reportIncidents sends the incidents of a stream to tumlive in order until events is closed.
+func reportIncidents(streamCtx *StreamContext, events <-chan incidentEvent) {
+	for e := range events {
+		notifyIncident(streamCtx, e.incidentType, e.start, e.end)
+	}
+}
+
+// notifyIncident reports an incident of a stream to tumlive, end is nil if it just started.
+func notifyIncident(streamCtx *StreamContext, incidentType string, start time.Time, end *time.Time) {
+	req := &pb.StreamIncident{
+		WorkerID: cfg.WorkerID,
+		StreamID: streamCtx.streamId,
+		Version:  streamCtx.streamVersion,
+		Type:     incidentType,
+		Start:    timestamppb.New(start),
+	}
+	if end != nil {
+		req.End = timestamppb.New(*end)
+	}
+	log.WithFields(log.Fields{"stream": streamCtx.streamId, "version": streamCtx.streamVersion, "type": incidentType, "resolved": end != nil}).
+		Info("Stream incident")
+	client, conn, err := GetClient()
+	if err != nil {
+		log.WithError(err).Error("Unable to dial tumlive")
+		return
+	}
+	defer closeConnection(conn)
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+	if _, err = client.NotifyStreamIncident(ctx, req); err != nil {
+		log.WithError(err).Error("Could not notify stream incident")
+	}
+}
@@ -0,0 +1,58 @@
+package worker
+
+import (
+	"strings"
+	"testing"
+	"time"
+)
+
+type reportedIncident struct {
+	incidentType string
+	resolved     bool
+}
+
+func TestIncidentDetector(t *testing.T) {
+	var reported []reportedIncident
+	d := newIncidentDetector(func(incidentType string, start time.Time, end *time.Time) {
+		reported = append(reported, reportedIncident{incidentType, end != nil})
+	})
+
+	// lines may be split across writes
+	_, _ = d.Write([]byte("[silencedetect @ 0x55d] silence_st"))
+	_, _ = d.Write([]byte("art: 60.02\n[freezedetect @ 0x55e] lavfi.freezedetect.freeze_start: 12\n"))
+	_, _ = d.Write([]byte("[freezedetect @ 0x55e] lavfi.freezedetect.freeze_duration: 300\n[freezedetect @ 0x55e] lavfi.freezedetect.freeze_end: 312\n"))
+	_, _ = d.Write([]byte("[silencedetect @ 0x55d] silence_end: 130 | silence_duration: 70\n"))
+	expected := []reportedIncident{{"silence", false}, {"freeze", false}, {"freeze", true}, {"silence", true}}
+	if len(reported) != len(expected) {
+		t.Fatalf("expected %v, got %v", expected, reported)
+	}
+	for i := range expected {
+		if reported[i] != expected[i] {
+			t.Errorf("expected %v, got %v", expected, reported)
+		}
+	}
+
+	// short black periods aren't reported
+	reported = nil
+	_, _ = d.Write([]byte("[Parsed_metadata_3 @ 0x1] lavfi.black_start=1.5\n[Parsed_metadata_4 @ 0x1] lavfi.black_end=2\n"))
+	_, _ = d.Write([]byte("[blackdetect @ 0x1] black_start:1.5 black_end:2 black_duration:0.5\n"))
+	if len(reported) != 0 || len(d.pending) != 0 {
+		t.Errorf("expected no incident for a short black period, got %v", reported)
+	}
+
+	// incidents are resolved when ffmpeg exits
+	_, _ = d.Write([]byte("[silencedetect @ 0x55d] silence_start: 200\n"))
+	d.Close()
+	if len(reported) != 2 || !reported[1].resolved {
+		t.Errorf("expected incident to be resolved on close, got %v", reported)
+	}
+}
+
+func TestAnalysisOutput(t *testing.T) {
+	out := strings.Join(analysisOutput(), " ")
+	for _, filter := range []string{"blackdetect", "freezedetect=n=-60dB:d=300", "silencedetect=n=-50dB:d=60", "-f null -"} {
+		if !strings.Contains(out, filter) {
+			t.Errorf("expected %s in analysis output, got %s", filter, out)
+		}
+	}
+}
@@ -0,0 +1,32 @@
+package worker
+
+import (
+	"fmt"
+	"os/exec"
+	"strconv"
+
+	"github.com/TUM-Dev/gocast/worker/loudnorm"
+	"github.com/TUM-Dev/gocast/worker/pb"
+	log "github.com/sirupsen/logrus"
+)
+
+// measureLoudness runs the first pass of the loudness normalisation on the first audio stream of file.
+func measureLoudness(file string) (*loudnorm.Measurement, error) {
+	cmd := exec.Command("nice", append([]string{"-n", "10", "ffmpeg"}, loudnorm.MeasureArgs(file)...)...)
+	log.WithField("command", cmd.String()).Info("Measuring loudness")
+	output, err := cmd.CombinedOutput()
+	if err != nil {
+		return nil, fmt.Errorf("measure loudness: %w: %s", err, output)
+	}
+	return loudnorm.Parse(output)
+}
+
+// loudnessToProto returns the measured loudness for tumlive, nil if it wasn't measured.
+func loudnessToProto(m *loudnorm.Measurement) *pb.Loudness {
+	if m == nil {
+		return nil
+	}
+	integrated, _ := strconv.ParseFloat(m.InputI, 64)
+	truePeak, _ := strconv.ParseFloat(m.InputTP, 64)
+	return &pb.Loudness{Integrated: integrated, TruePeak: truePeak}
+}
@@ -0,0 +1,29 @@
+package worker
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/TUM-Dev/gocast/worker/loudnorm"
+)
+
+func TestLoudnessToProto(t *testing.T) {
+	measured := &loudnorm.Measurement{InputI: "-31.42", InputTP: "-6.03", InputLRA: "8.70", InputThresh: "-42.10", TargetOffset: "0.46"}
+	if p := loudnessToProto(measured); p.GetIntegrated() != -31.42 || p.GetTruePeak() != -6.03 {
+		t.Errorf("unexpected loudness for tumlive: %v", p)
+	}
+	if loudnessToProto(nil) != nil {
+		t.Error("expected no loudness for tumlive without measurement")
+	}
+}
+
+func TestBuildCommandLoudness(t *testing.T) {
+	measured := &loudnorm.Measurement{InputI: "-31.42", InputTP: "-6.03", InputLRA: "8.70", InputThresh: "-42.10", TargetOffset: "0.46"}
+	out := strings.Join(buildCommand(8, "in.ts", "out.mp4", nil, "COMB", false, measured).Args, " ")
+	if !strings.Contains(out, "-af "+loudnorm.Filter(measured)+" -ar 48000 out.mp4") {
+		t.Errorf("expected loudness to be normalised, got %s", out)
+	}
+	if out = strings.Join(buildCommand(8, "in.ts", "out.mp4", nil, "COMB", false, nil).Args, " "); strings.Contains(out, "loudnorm") {
+		t.Errorf("expected no normalisation without measurement, got %s", out)
+	}
+}
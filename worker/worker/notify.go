@@ -9,7 +9,6 @@ import (
 	"github.com/TUM-Dev/gocast/worker/pb"
 	log "github.com/sirupsen/logrus"
 	"google.golang.org/grpc"
-	"google.golang.org/grpc/credentials/insecure"
 )
 
 func closeConnection(conn *grpc.ClientConn) {
@@ -102,8 +101,9 @@ func NotifyStreamDone(streamCtx *StreamContext) {
 	ctx, cancel := context.WithTimeout(context.Background(), time.Minute)
 	defer cancel()
 	resp, err := client.NotifyStreamFinished(ctx, &pb.StreamFinished{
-		WorkerID: cfg.WorkerID,
-		StreamID: streamCtx.streamId,
+		WorkerID:   cfg.WorkerID,
+		StreamID:   streamCtx.streamId,
+		SourceType: streamCtx.streamVersion,
 	})
 	if err != nil || !resp.Ok {
 		log.WithError(err).Error("Could not notify stream finished")
@@ -125,6 +125,9 @@ func notifyTranscodingDone(streamCtx *StreamContext) {
 		FilePath:   streamCtx.getTranscodingFileName(),
 		Duration:   streamCtx.duration,
 		SourceType: streamCtx.streamVersion,
+		Partial:    streamCtx.isPartial(),
+		Part:       streamCtx.part,
+		Loudness:   loudnessToProto(streamCtx.loudness),
 	})
 	if err != nil || !resp.Ok {
 		log.WithError(err).Error("Could not notify stream finished")
@@ -176,7 +179,7 @@ func notifyThumbnailDone(streamCtx *StreamContext) {
 }
 
 func GetClient() (pb.FromWorkerClient, *grpc.ClientConn, error) {
-	conn, err := grpc.Dial(fmt.Sprintf("%s:50052", cfg.MainBase), grpc.WithTransportCredentials(insecure.NewCredentials()))
+	conn, err := grpc.Dial(fmt.Sprintf("%s:50052", cfg.MainBase), grpc.WithTransportCredentials(TransportCredentials()))
 	if err != nil {
 		return nil, nil, err
 	}
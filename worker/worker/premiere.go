@@ -10,15 +10,7 @@ import (
 )
 
 func streamPremiere(ctx *StreamContext) {
-	// we're a little paranoid about our input as we can't control it:
-	cmd := exec.Command(
-		"ffmpeg", "-re", "-i", ctx.sourceUrl,
-		"-pix_fmt", "yuv420p", "-vsync", "1", "-threads", "0", "-vcodec", "libx264",
-		"-r", "30", "-g", "60", "-sc_threshold", "0",
-		"-b:v", "2500k", "-bufsize", "3000k", "-maxrate", "3000k",
-		"-preset", "veryfast", "-profile:v", "baseline", "-tune", "film",
-		"-acodec", "aac", "-b:a", "128k", "-ac", "2", "-ar", "48000", "-af", "aresample=async=1:min_hard_comp=0.100000:first_pts=0",
-		"-f", "flv", fmt.Sprintf("%s%s", ctx.ingestServer, ctx.streamName))
+	cmd := exec.Command("ffmpeg", premiereArgs(ctx)...)
 	log.WithField("cmd", cmd.String()).Info("Starting premiere")
 	ffmpegErr, errFfmpegErrFile := os.OpenFile(fmt.Sprintf("%s/ffmpeg_%s.log", cfg.LogDir, ctx.getStreamName()), os.O_APPEND|os.O_WRONLY|os.O_CREATE, 0o644)
 	if errFfmpegErrFile == nil {
@@ -31,3 +23,24 @@ func streamPremiere(ctx *StreamContext) {
 		log.WithError(err).Error("Can't stream premiere")
 	}
 }
+
+// premiereArgs returns the ffmpeg arguments that push the file of a premiere to the ingest server in real time.
+func premiereArgs(ctx *StreamContext) []string {
+	profile := ctx.profile
+	bitrate := orDefault(profile.GetVideoBitrate(), defaultVideoBitrate)
+	// we're a little paranoid about our input as we can't control it:
+	args := []string{
+		"-re", "-i", ctx.sourceUrl,
+		"-pix_fmt", "yuv420p", "-vsync", "1", "-threads", "0", "-vcodec", "libx264",
+	}
+	args = append(args, scaleArgs(profile.GetHeight())...)
+	args = append(args,
+		"-r", "30", "-g", "60", "-sc_threshold", "0",
+		"-b:v", kbits(bitrate), "-bufsize", kbits(bitrate*6/5), "-maxrate", kbits(bitrate*6/5),
+		"-preset", orDefault(profile.GetPreset(), defaultLivePreset), "-profile:v", "baseline", "-tune", orDefault(profile.GetTune(), "film"),
+		"-acodec", "aac", "-b:a", kbits(orDefault(profile.GetAudioBitrate(), defaultAudioBitrate)))
+	args = append(args, audioFormatArgs(profile, 2, 48000)...)
+	return append(args,
+		"-af", "aresample=async=1:min_hard_comp=0.100000:first_pts=0",
+		"-f", "flv", fmt.Sprintf("%s%s", ctx.ingestServer, ctx.streamName))
+}
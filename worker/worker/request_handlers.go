@@ -15,6 +15,7 @@ import (
 	"time"
 
 	"github.com/TUM-Dev/gocast/worker/cfg"
+	"github.com/TUM-Dev/gocast/worker/loudnorm"
 	"github.com/TUM-Dev/gocast/worker/pb"
 	log "github.com/sirupsen/logrus"
 )
@@ -43,6 +44,7 @@ func HandlePremiere(request *pb.PremiereRequest) {
 		courseSlug:    "PREMIERE",
 		ingestServer:  request.IngestServer,
 		outUrl:        request.OutUrl,
+		profile:       request.GetProfile(),
 	}
 	// Register worker for premiere
 	regularStreams.addContext(streamCtx.streamId, streamCtx)
@@ -53,7 +55,8 @@ func HandlePremiere(request *pb.PremiereRequest) {
 	NotifyStreamDone(streamCtx)
 }
 
-func HandleSelfStream(request *pb.SelfStreamResponse, slug string) *StreamContext {
+// HandleSelfStream records and streams a self-stream published to the local mediamtx with protocol (rtmp, srt or webrtc).
+func HandleSelfStream(request *pb.SelfStreamResponse, slug string, protocol string) *StreamContext {
 	streamCtx := &StreamContext{
 		streamId:      request.GetStreamID(),
 		courseSlug:    request.GetCourseSlug(),
@@ -65,14 +68,32 @@ func HandleSelfStream(request *pb.SelfStreamResponse, slug string) *StreamContex
 		streamVersion: "COMB",
 		isSelfStream:  true,
 		ingestServer:  request.IngestServer,
-		sourceUrl:     "rtmp://localhost/" + slug,
+		sourceUrl:     selfStreamSource(protocol, slug),
 		streamName:    request.StreamName,
 		outUrl:        request.OutUrl,
+		renditions:    request.GetRenditions(),
+		lowLatency:    request.GetLowLatency(),
+		dvr:           request.GetDvr(),
+		dvrWindow:     request.GetDvrWindow(),
+		profile:       request.GetProfile(),
 	}
 	stream(streamCtx)
 	return streamCtx
 }
 
+// selfStreamSource returns the url the stream published to the local mediamtx can be read from.
+// SRT publishes are read over SRT as well, mediamtx can't remux every codec SRT carries to RTMP.
+func selfStreamSource(protocol string, slug string) string {
+	switch protocol {
+	case "srt":
+		return "srt://localhost:8890?streamid=read:" + slug
+	case "webrtc":
+		// browsers send opus audio which RTMP can't carry
+		return "rtsp://localhost:8554/" + slug
+	}
+	return "rtmp://localhost/" + slug
+}
+
 func HandleSelfStreamRecordEnd(ctx *StreamContext) {
 	S.startTranscoding(ctx.getStreamName())
 	err := transcode(ctx)
@@ -93,6 +114,9 @@ func HandleSelfStreamRecordEnd(ctx *StreamContext) {
 		upload(ctx)
 		notifyUploadDone(ctx)
 	}
+	if err = transcodeAudio(ctx); err != nil {
+		log.WithError(err).Error("Error transcoding audio")
+	}
 
 	S.startThumbnailGeneration(ctx)
 	defer S.endThumbnailGeneration(ctx)
@@ -174,6 +198,7 @@ func (s *safeStreams) endStreams(request *pb.EndStreamRequest) {
 	stream := s.streams[request.StreamID]
 	for _, streamContext := range stream {
 		streamContext.discardVoD = request.DiscardVoD
+		streamContext.failedOver = request.FailedOver
 		HandleStreamEnd(streamContext, false)
 	}
 	// All streams should be ended right now, so we can delete them
@@ -233,6 +258,13 @@ func HandleStreamRequest(request *pb.StreamRequest) {
 		ingestServer:  request.GetIngestServer(),
 		isSelfStream:  false,
 		outUrl:        request.GetOutUrl(),
+		renditions:    request.GetRenditions(),
+		lowLatency:    request.GetLowLatency(),
+		dvr:           request.GetDvr(),
+		dvrWindow:     request.GetDvrWindow(),
+		profile:       request.GetProfile(),
+		part:          request.GetPart(),
+		slideText:     request.GetSlideText(),
 	}
 
 	// Register worker for stream
@@ -255,10 +287,29 @@ func HandleStreamRequest(request *pb.StreamRequest) {
 		streamCtx.TranscodingSuccessful = true
 	}
 	S.endTranscoding(streamCtx.getStreamName())
+	if streamCtx.isPartial() {
+		// TUM-Live stitches all parts and publishes them as one VoD
+		notifyTranscodingDone(streamCtx)
+	} else {
+		publishRecording(streamCtx)
+	}
+	if streamCtx.TranscodingSuccessful {
+		err := markForDeletion(streamCtx)
+		if err != nil {
+			log.WithField("stream", streamCtx.streamId).WithError(err).Error("Error marking for deletion")
+		}
+	}
+}
+
+// publishRecording notifies TUM-Live about the transcoded recording of a stream, creates its thumbnails and audio,
+// uploads it if the VoD is published and detects silences.
+// The additional renditions of the VoD are encoded last, they take the longest by far.
+func publishRecording(streamCtx *StreamContext) {
 	notifyTranscodingDone(streamCtx)
+	defer transcodeCodecs(streamCtx)
 
 	if streamCtx.streamVersion == "COMB" {
-		err = transcodeAudio(streamCtx)
+		err := transcodeAudio(streamCtx)
 		if err != nil {
 			log.WithError(err).Error("Error transcoding audio")
 		}
@@ -266,7 +317,7 @@ func HandleStreamRequest(request *pb.StreamRequest) {
 
 	S.startThumbnailGeneration(streamCtx)
 	defer S.endThumbnailGeneration(streamCtx)
-	err = createThumbnailSprite(streamCtx, streamCtx.getTranscodingFileName())
+	err := createThumbnailSprite(streamCtx, streamCtx.getTranscodingFileName())
 	thumbSuccessful := true
 	if err != nil {
 		log.WithField("File", streamCtx.getThumbnailSpriteFileName()).WithError(err).Error("Creating thumbnail sprite failed")
@@ -281,11 +332,17 @@ func HandleStreamRequest(request *pb.StreamRequest) {
 		notifyThumbnailDone(streamCtx)
 	}
 
-	if request.PublishVoD {
+	if streamCtx.publishVoD {
 		upload(streamCtx)
 		notifyUploadDone(streamCtx)
 	}
 
+	if streamCtx.streamVersion == "PRES" {
+		if err := analyzeSlides(streamCtx); err != nil {
+			log.WithField("File", streamCtx.getTranscodingFileName()).WithError(err).Error("Analyzing slides failed.")
+		}
+	}
+
 	if streamCtx.streamVersion == "COMB" {
 		S.startSilenceDetection(streamCtx)
 		defer S.endSilenceDetection(streamCtx)
@@ -297,12 +354,6 @@ func HandleStreamRequest(request *pb.StreamRequest) {
 		}
 		notifySilenceResults(sd.Silences, streamCtx.streamId)
 	}
-	if streamCtx.TranscodingSuccessful {
-		err := markForDeletion(streamCtx)
-		if err != nil {
-			log.WithField("stream", streamCtx.streamId).WithError(err).Error("Error marking for deletion")
-		}
-	}
 }
 
 func GetStreamInfoForUploadReq(uploadKey string) (*pb.GetStreamInfoForUploadResponse, error) {
@@ -328,6 +379,7 @@ func HandleUploadRestReq(streamInfo *pb.GetStreamInfoForUploadResponse, localFil
 		streamVersion: streamInfo.VideoType,
 		publishVoD:    true,
 		recordingPath: &localFile,
+		slideText:     streamInfo.GetSlideText(),
 	}
 	log.WithFields(log.Fields{"stream": c.streamId, "course": c.courseSlug, "file": localFile}).Debug("Handling upload request")
 
@@ -474,6 +526,18 @@ type StreamContext struct {
 	outUrl       string // url the stream will be available at
 	discardVoD   bool   // whether the VoD should be discarded
 
+	part       uint32 // part of the recording if the stream failed over from another worker
+	failedOver bool   // whether the stream continues on another worker
+
+	renditions []*pb.Rendition // adaptive bitrate ladder pushed to the ingest server, a single rendition if empty
+	lowLatency bool            // whether the ingest server packages the stream as LL-HLS
+	dvr        bool            // whether the worker keeps a DVR playlist viewers can rewind in
+	dvrWindow  uint32          // seconds kept in the DVR playlist, 0 keeps the whole stream
+
+	profile   *pb.EncodingProfile   // encoder settings of the stream, the defaults of streamVersion if nil
+	loudness  *loudnorm.Measurement // loudness of the recording before transcoding, nil if it wasn't normalised
+	slideText bool                  // whether the full resolution frames of slide changes are saved for their text extraction
+
 	// calculated after stream:
 	duration      uint32 // duration of the stream in seconds
 	thumbInterval uint32 // interval between thumbnails in seconds
@@ -495,6 +559,12 @@ func (s StreamContext) getRecordingFileName() string {
 		s.getStreamName())
 }
 
+// getDvrDir returns the directory the DVR playlist and segments of a stream are written to.
+// example: /recordings/dvr/1234/COMB
+func (s StreamContext) getDvrDir() string {
+	return fmt.Sprintf("%s/dvr/%d/%s", cfg.TempDir, s.streamId, s.streamVersion)
+}
+
 func (s StreamContext) getRecordingTrashName() string {
 	fn := s.getRecordingFileName()
 	return filepath.Join(filepath.Dir(fn), ".trash", filepath.Base(fn))
@@ -547,10 +617,19 @@ func (s StreamContext) getThumbnailSpriteFileName() string {
 
 // getStreamName returns the stream name, used for the worker status
 func (s StreamContext) getStreamName() string {
-	return fmt.Sprintf("%s-%s%s",
+	name := fmt.Sprintf("%s-%s%s",
 		s.courseSlug,
 		s.startTime.Format("2006-01-02-15-04"),
 		s.streamVersion)
+	if s.part != 0 {
+		name += fmt.Sprintf("-part%d", s.part)
+	}
+	return name
+}
+
+// isPartial returns whether the recording is only a part of the VoD because the stream failed over between workers.
+func (s StreamContext) isPartial() bool {
+	return s.part != 0 || s.failedOver
 }
 
 var vodFileNameIllegal = regexp.MustCompile(`[^a-zA-Z0-9_\\.]+`)
@@ -36,6 +36,18 @@ func TestGetTranscodingFileName(t *testing.T) {
 	}
 }
 
+func TestGetTranscodingFileNamePart(t *testing.T) {
+	setup()
+	s.part = 1
+	transcodingNameShould := "/2021/W/eidi/2021-09-23_08-00/eidi-2021-09-23-08-00COMB-part1.mp4"
+	if got := s.getTranscodingFileName(); got != transcodingNameShould {
+		t.Errorf("Wrong transcoding name, should be %s but is %s", transcodingNameShould, got)
+	}
+	if !s.isPartial() {
+		t.Error("Recording of a failed over stream should be partial")
+	}
+}
+
 func TestGetRecordingFileName(t *testing.T) {
 	setup()
 	recordingNameShould := "/recordings/eidi-2021-09-23-08-00COMB.ts"
@@ -94,3 +106,15 @@ func TestStreamEndRequest(t *testing.T) {
 	case <-done:
 	}
 }
+
+func TestSelfStreamSource(t *testing.T) {
+	if got := selfStreamSource("rtmp", "eidi-1"); got != "rtmp://localhost/eidi-1" {
+		t.Errorf("Wrong rtmp source, should be rtmp://localhost/eidi-1 but is %s", got)
+	}
+	if got := selfStreamSource("srt", "eidi-1"); got != "srt://localhost:8890?streamid=read:eidi-1" {
+		t.Errorf("Wrong srt source, should be srt://localhost:8890?streamid=read:eidi-1 but is %s", got)
+	}
+	if got := selfStreamSource("webrtc", "eidi-1"); got != "rtsp://localhost:8554/eidi-1" {
+		t.Errorf("Wrong webrtc source, should be rtsp://localhost:8554/eidi-1 but is %s", got)
+	}
+}
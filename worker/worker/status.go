@@ -12,7 +12,6 @@ import (
 	"github.com/TUM-Dev/gocast/worker/worker/vmstat"
 	log "github.com/sirupsen/logrus"
 	"google.golang.org/grpc"
-	"google.golang.org/grpc/credentials/insecure"
 )
 
 var (
@@ -145,8 +144,7 @@ func (s *Status) endTranscodingAudio(name string) {
 }
 
 func (s *Status) SendHeartbeat() {
-	// WithInsecure: workerId used for authentication, all servers are inside their own VLAN to further improve security
-	clientConn, err := grpc.Dial(fmt.Sprintf("%s:50052", cfg.MainBase), grpc.WithTransportCredentials(insecure.NewCredentials()))
+	clientConn, err := grpc.Dial(fmt.Sprintf("%s:50052", cfg.MainBase), grpc.WithTransportCredentials(TransportCredentials()))
 	if err != nil {
 		log.WithError(err).Error("unable to dial for heartbeat")
 		return
@@ -171,6 +169,13 @@ func (s *Status) SendHeartbeat() {
 		Memory:   s.Stat.GetMemStr(),
 		Disk:     s.Stat.GetDiskStr(),
 		Uptime:   strings.ReplaceAll(time.Since(s.StartTime).Round(time.Minute).String(), "0s", ""),
+
+		CpuPercent:   s.Stat.CpuPercent,
+		MemAvailable: s.Stat.MemAvailable,
+		MemTotal:     s.Stat.MemTotal,
+		DiskUsed:     s.Stat.DiskUsed,
+		DiskTotal:    s.Stat.DiskTotal,
+		Tags:         cfg.Tags,
 	})
 	if err != nil {
 		log.WithError(err).Error("Sending Heartbeat failed")
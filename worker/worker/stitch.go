@@ -0,0 +1,93 @@
+package worker
+
+import (
+	"fmt"
+	"os"
+	"os/exec"
+	"strings"
+
+	"github.com/TUM-Dev/gocast/worker/cfg"
+	"github.com/TUM-Dev/gocast/worker/pb"
+	log "github.com/sirupsen/logrus"
+)
+
+// HandleStitchRequest concatenates the transcoded recording parts of a stream that failed over between workers
+// and publishes them like a regular recording.
+func HandleStitchRequest(request *pb.StitchRequest) {
+	streamCtx := &StreamContext{
+		streamId:      request.GetStreamID(),
+		courseSlug:    request.GetCourseSlug(),
+		teachingTerm:  request.GetCourseTerm(),
+		teachingYear:  request.GetCourseYear(),
+		startTime:     request.GetStart().AsTime().Local(),
+		endTime:       request.GetEnd().AsTime().Local(),
+		streamVersion: request.GetSourceType(),
+		publishVoD:    request.GetPublishVoD(),
+		profile:       request.GetProfile(),
+		slideText:     request.GetSlideText(),
+	}
+	log.WithFields(log.Fields{"stream": streamCtx.streamId, "parts": request.GetParts()}).Info("Stitching recording")
+	S.startTranscoding(streamCtx.getStreamName())
+	err := stitch(streamCtx, request.GetParts())
+	S.endTranscoding(streamCtx.getStreamName())
+	if err != nil {
+		NotifyTranscodingFailure(*streamCtx, err)
+		log.WithError(err).Error("Error while stitching")
+		return
+	}
+	streamCtx.TranscodingSuccessful = true
+	publishRecording(streamCtx)
+}
+
+// stitch concatenates the parts into the transcoding file of the stream and removes the parts afterwards.
+// The parts are encoded with the same settings, so they are concatenated without transcoding.
+func stitch(streamCtx *StreamContext, parts []string) error {
+	out := streamCtx.getTranscodingFileName()
+	if err := prepare(out); err != nil {
+		return err
+	}
+	list, err := os.CreateTemp(cfg.TempDir, "stitch-*.txt")
+	if err != nil {
+		return fmt.Errorf("create list of parts: %w", err)
+	}
+	defer os.Remove(list.Name())
+	for _, part := range parts {
+		// paths are quoted for the concat demuxer
+		if _, err = fmt.Fprintf(list, "file '%s'\n", strings.ReplaceAll(part, "'", `'\''`)); err != nil {
+			_ = list.Close()
+			return fmt.Errorf("write list of parts: %w", err)
+		}
+	}
+	if err = list.Close(); err != nil {
+		return fmt.Errorf("write list of parts: %w", err)
+	}
+
+	// the first part may be the output file itself
+	tmp := strings.TrimSuffix(out, ".mp4") + "-stitching.mp4"
+	cmd := exec.Command("ffmpeg", "-nostats", "-loglevel", "error", "-y",
+		"-f", "concat", "-safe", "0", "-i", list.Name(),
+		"-c", "copy", "-movflags", "+faststart", tmp)
+	log.WithField("command", cmd.String()).Info("Stitching")
+	if output, err := cmd.CombinedOutput(); err != nil {
+		_ = os.Remove(tmp)
+		return fmt.Errorf("stitch recording: %w: %s", err, output)
+	}
+	if err = os.Rename(tmp, out); err != nil {
+		return fmt.Errorf("move stitched recording: %w", err)
+	}
+	for _, part := range parts {
+		if part == out {
+			continue
+		}
+		if err := os.Remove(part); err != nil {
+			log.WithError(err).WithField("part", part).Warn("Can't remove stitched part")
+		}
+	}
+
+	duration, err := getDuration(out)
+	if err != nil {
+		return fmt.Errorf("probe duration: %w", err)
+	}
+	streamCtx.duration = uint32(duration)
+	return nil
+}
@@ -2,6 +2,7 @@ package worker
 
 import (
 	"fmt"
+	"io"
 	"os"
 	"os/exec"
 	"strings"
@@ -20,40 +21,69 @@ func stream(streamCtx *StreamContext) {
 		Info("streaming lecture hall")
 	S.startStream(streamCtx)
 	defer S.endStream(streamCtx)
+	if streamCtx.dvr {
+		if err := os.MkdirAll(streamCtx.getDvrDir(), 0o755); err != nil {
+			log.WithError(err).Error("Could not create dvr directory, streaming without dvr")
+			streamCtx.dvr = false
+		} else {
+			defer removeDvr(streamCtx)
+		}
+	}
+	progress := make(chan ffmpegProgress, 1)
+	healthDone := make(chan struct{})
+	go reportHealth(streamCtx, progress, healthDone)
+	defer close(healthDone)
+	incidents := make(chan incidentEvent, 16)
+	go reportIncidents(streamCtx, incidents)
+	defer close(incidents)
+	reportIncident := func(incidentType string, start time.Time, end *time.Time) {
+		select {
+		case incidents <- incidentEvent{incidentType: incidentType, start: start, end: end}:
+		default:
+			log.WithField("type", incidentType).Warn("Dropping stream incident, tumlive is too slow")
+		}
+	}
 	// in case ffmpeg dies retry until stream should be done.
 	lastErr := time.Now().Add(time.Minute * -1)
 	errCount := 0
 	for time.Now().Before(streamUntil) && !streamCtx.stopped {
-		var cmd *exec.Cmd
-
-		// todo: too much duplication
-		if strings.Contains(streamCtx.sourceUrl, "rtsp") {
-			cmd = exec.Command(
-				"sh", "-c",
-				`ffmpeg -hide_banner -nostats -rtsp_transport tcp -t `+fmt.Sprintf("%.0f", time.Until(streamUntil).Seconds())+ // timeout ffmpeg when stream is finished
-					" -i "+fmt.Sprintf(streamCtx.sourceUrl)+
-					` -map 0 -c copy -f mpegts - -c:v libx264 -preset veryfast -tune zerolatency -maxrate 2500k -bufsize 3000k -g 60 -r 30 -x264-params keyint=60:scenecut=0 -c:a aac -ar 44100 -b:a 128k `+
-					`-f flv `+fmt.Sprintf("%s/%s", streamCtx.ingestServer, streamCtx.streamName)+" >> "+streamCtx.getRecordingFileName())
-		} else {
-			cmd = exec.Command(
-				"sh", "-c",
-				`ffmpeg -hide_banner -nostats -rw_timeout 5000000 -t `+fmt.Sprintf("%.0f", time.Until(streamUntil).Seconds())+ // timeout ffmpeg when stream is finished
-					" -i "+fmt.Sprintf(streamCtx.sourceUrl)+
-					` -map 0 -c copy -f mpegts - -c:v libx264 -preset veryfast -tune zerolatency -maxrate 2500k -bufsize 3000k -g 60 -r 30 -x264-params keyint=60:scenecut=0 -c:a aac -ar 44100 -b:a 128k `+
-					`-f flv `+fmt.Sprintf("%s/%s", streamCtx.ingestServer, streamCtx.streamName)+" >> "+streamCtx.getRecordingFileName())
+		// ffmpeg writes the recording to stdout, which is appended to the recording file in case ffmpeg restarts
+		recording, err := os.OpenFile(streamCtx.getRecordingFileName(), os.O_APPEND|os.O_WRONLY|os.O_CREATE, 0o644)
+		if err != nil {
+			errorWithBackoff(&lastErr, "Could not open recording file", err)
+			continue
 		}
+		// ffmpeg writes its progress to fd 3, stderr stays in the log file
+		progressR, progressW, errProgress := os.Pipe()
+		if errProgress != nil {
+			log.WithError(errProgress).Warn("Could not create pipe for ffmpeg progress")
+		}
+		cmd := exec.Command("ffmpeg", streamArgs(streamCtx, time.Until(streamUntil), errProgress == nil)...)
+		cmd.Stdout = recording
 		// persist stream command in context, so it can be killed later
 		streamCtx.streamCmd = cmd
 		log.WithField("cmd", cmd.String()).Info("Starting stream")
+		// the log of ffmpeg contains the results of the live analysis
+		detector := newIncidentDetector(reportIncident)
 		ffmpegErr, errFfmpegErrFile := os.OpenFile(fmt.Sprintf("%s/ffmpeg_%s.log", cfg.LogDir, streamCtx.getStreamName()), os.O_APPEND|os.O_WRONLY|os.O_CREATE, 0o644)
 		if errFfmpegErrFile == nil {
-			cmd.Stderr = ffmpegErr
+			cmd.Stderr = io.MultiWriter(ffmpegErr, detector)
 		} else {
 			log.WithError(errFfmpegErrFile).Error("Could not create file for ffmpeg stdErr")
+			cmd.Stderr = detector
+		}
+		if errProgress == nil {
+			cmd.ExtraFiles = []*os.File{progressW}
+			go parseProgress(progressR, progress)
 		}
 		// Create a new pgid for the new process, so we don't kill the parent process when ending the stream
 		cmd.SysProcAttr = &syscall.SysProcAttr{Setpgid: true}
-		err := cmd.Run()
+		err = cmd.Run()
+		_ = recording.Close()
+		if errProgress == nil {
+			_ = progressW.Close() // parseProgress is done once ffmpeg closed its end as well
+		}
+		detector.Close()
 		if err != nil && !streamCtx.stopped {
 			errCount++
 			if errCount > 20 && strings.Contains(streamCtx.sourceUrl, "localhost") {
@@ -74,6 +104,72 @@ func stream(streamCtx *StreamContext) {
 	streamCtx.streamCmd = nil
 }
 
+// streamArgs returns the ffmpeg arguments that record the source of a stream for duration to stdout and push it
+// to the ingest server. If progress is set, ffmpeg reports its progress to fd 3.
+func streamArgs(streamCtx *StreamContext, duration time.Duration, progress bool) []string {
+	args := []string{"-hide_banner", "-nostats"}
+	if progress {
+		args = append(args, "-progress", "pipe:3", "-stats_period", fmt.Sprintf("%.0f", healthInterval.Seconds()))
+	}
+	if strings.Contains(streamCtx.sourceUrl, "rtsp") {
+		args = append(args, "-rtsp_transport", "tcp")
+	} else {
+		args = append(args, "-rw_timeout", "5000000")
+	}
+	args = append(args,
+		"-t", fmt.Sprintf("%.0f", duration.Seconds()), // timeout ffmpeg when stream is finished
+		"-i", streamCtx.sourceUrl,
+		"-map", "0", "-c", "copy", "-f", "mpegts", "-")
+	args = append(args, liveOutputs(streamCtx)...)
+	args = append(args, dvrOutput(streamCtx)...)
+	return append(args, analysisOutput()...)
+}
+
+// liveOutputs returns the ffmpeg output options that push the stream to the ingest server.
+// Every rendition of the ladder is pushed as <streamName>_<rendition>, the ingest server packages them
+// into a master playlist. Without a ladder or in low latency mode, a single rendition with the bitrate
+// of the encoding profile is pushed to <streamName>.
+func liveOutputs(streamCtx *StreamContext) []string {
+	profile := streamCtx.profile
+	preset := orDefault(profile.GetPreset(), defaultLivePreset)
+	audio := func(bitrate uint32) []string {
+		args := append([]string{"-c:a", "aac"}, audioFormatArgs(profile, 0, 44100)...)
+		return append(args, "-b:a", kbits(bitrate))
+	}
+	if streamCtx.lowLatency || len(streamCtx.renditions) == 0 {
+		bitrate := orDefault(profile.GetVideoBitrate(), defaultVideoBitrate)
+		// LL-HLS segments have to start with a keyframe, one second gops let the ingest server cut short segments
+		gop, bufsize := "60", bitrate*6/5
+		if streamCtx.lowLatency {
+			gop, bufsize = "30", bitrate
+		}
+		args := []string{"-c:v", "libx264", "-preset", preset, "-tune", "zerolatency"}
+		args = append(args, scaleArgs(profile.GetHeight())...)
+		args = append(args, "-maxrate", kbits(bitrate), "-bufsize", kbits(bufsize), "-g", gop, "-r", "30", "-x264-params", "keyint="+gop+":scenecut=0")
+		args = append(args, audio(orDefault(profile.GetAudioBitrate(), defaultAudioBitrate))...)
+		return append(args, "-f", "flv", fmt.Sprintf("%s/%s", streamCtx.ingestServer, streamCtx.streamName))
+	}
+	var args []string
+	for _, r := range streamCtx.renditions {
+		target := fmt.Sprintf("%s/%s_%s", streamCtx.ingestServer, streamCtx.streamName, r.GetName())
+		if r.GetHeight() == 0 {
+			args = append(args, "-map", "0:a:0", "-vn")
+			args = append(args, audio(r.GetAudioBitrate())...)
+			args = append(args, "-f", "flv", target)
+			continue
+		}
+		// all renditions use the same fixed gop so players can switch between them at every segment boundary
+		args = append(args,
+			"-map", "0:v:0", "-map", "0:a:0?",
+			"-c:v", "libx264", "-preset", preset, "-tune", "zerolatency", "-vf", fmt.Sprintf("scale=-2:%d", r.GetHeight()),
+			"-b:v", kbits(r.GetVideoBitrate()), "-maxrate", kbits(r.GetVideoBitrate()), "-bufsize", kbits(r.GetVideoBitrate()*6/5),
+			"-g", "60", "-r", "30", "-x264-params", "keyint=60:scenecut=0")
+		args = append(args, audio(r.GetAudioBitrate())...)
+		args = append(args, "-f", "flv", target)
+	}
+	return args
+}
+
 // errorWithBackoff updates lastError and sleeps for a second if the last error was within this second
 func errorWithBackoff(lastError *time.Time, msg string, err error) {
 	log.WithFields(log.Fields{"lastErr": lastError}).WithError(err).Error(msg)
@@ -84,3 +180,39 @@ func errorWithBackoff(lastError *time.Time, msg string, err error) {
 	now := time.Now()
 	*lastError = now
 }
+
+// dvrOutput returns the ffmpeg output options that write the DVR playlist of a stream, which viewers can rewind in
+// while the stream is running. The playlist is appended to if ffmpeg restarts and keeps the segments of
+// the last dvrWindow seconds or the whole stream.
+func dvrOutput(streamCtx *StreamContext) []string {
+	if !streamCtx.dvr {
+		return nil
+	}
+	flags := "append_list+discont_start+omit_endlist"
+	listSize := uint32(0)
+	var playlistType []string
+	if streamCtx.dvrWindow > 0 {
+		flags += "+delete_segments"
+		listSize = (streamCtx.dvrWindow + dvrSegmentDuration - 1) / dvrSegmentDuration
+	} else {
+		playlistType = []string{"-hls_playlist_type", "event"}
+	}
+	dir := streamCtx.getDvrDir()
+	args := []string{
+		"-map", "0:v:0", "-map", "0:a:0?", "-c", "copy",
+		"-f", "hls", "-hls_time", fmt.Sprintf("%d", dvrSegmentDuration), "-hls_list_size", fmt.Sprintf("%d", listSize), "-hls_flags", flags,
+	}
+	args = append(args, playlistType...)
+	// segments are named by their creation time, so edges never serve cached segments of a previous run of the stream
+	return append(args, "-strftime", "1", "-hls_segment_filename", dir+"/%Y%m%d%H%M%S.ts", dir+"/playlist.m3u8")
+}
+
+// dvrSegmentDuration is the target duration of DVR segments in seconds.
+const dvrSegmentDuration = 4
+
+// removeDvr deletes the DVR playlist and segments once the stream is over, the VoD replaces them.
+func removeDvr(streamCtx *StreamContext) {
+	if err := os.RemoveAll(streamCtx.getDvrDir()); err != nil {
+		log.WithError(err).Warn("Could not remove dvr directory")
+	}
+}
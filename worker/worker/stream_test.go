@@ -0,0 +1,99 @@
+package worker
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/TUM-Dev/gocast/worker/pb"
+)
+
+func TestLiveOutputsSingleRendition(t *testing.T) {
+	ctx := &StreamContext{ingestServer: "rtmp://ingest.example.com/live", streamName: "abc"}
+	out := strings.Join(liveOutputs(ctx), " ")
+	if !strings.HasSuffix(out, "-f flv rtmp://ingest.example.com/live/abc") {
+		t.Errorf("single rendition should be pushed to the stream name, got %s", out)
+	}
+	if !strings.Contains(out, "-maxrate 2500k") {
+		t.Errorf("single rendition should keep the 2500k maxrate, got %s", out)
+	}
+}
+
+func TestLiveOutputsProfile(t *testing.T) {
+	ctx := &StreamContext{
+		ingestServer: "rtmp://ingest.example.com/live",
+		streamName:   "abc",
+		profile:      &pb.EncodingProfile{VideoCodec: "libx265", VideoBitrate: 1500, Preset: "faster", Height: 720, AudioBitrate: 96},
+	}
+	out := strings.Join(liveOutputs(ctx), " ")
+	if !strings.Contains(out, "-c:v libx264 -preset faster") {
+		t.Errorf("live streams should always be pushed as h264 with the preset of the profile, got %s", out)
+	}
+	if !strings.Contains(out, "-vf scale=-2:720 -maxrate 1500k -bufsize 1800k") || !strings.Contains(out, "-b:a 96k") {
+		t.Errorf("single rendition should use the bitrates of the profile, got %s", out)
+	}
+}
+
+func TestStreamArgs(t *testing.T) {
+	ctx := &StreamContext{sourceUrl: "rtsp://10.0.0.4/extron3", ingestServer: "rtmp://ingest.example.com/live", streamName: "abc"}
+	args := streamArgs(ctx, time.Hour, true)
+	out := strings.Join(args, " ")
+	if !strings.HasPrefix(out, "-hide_banner -nostats -progress pipe:3 -stats_period 5 -rtsp_transport tcp -t 3600 -i rtsp://10.0.0.4/extron3 -map 0 -c copy -f mpegts - ") {
+		t.Errorf("unexpected input or recording arguments: %s", out)
+	}
+	if args[len(args)-1] != "-" || args[len(args)-2] != "null" {
+		t.Errorf("expected the live analysis as last output, got %s", out)
+	}
+}
+
+func TestLiveOutputsLowLatency(t *testing.T) {
+	ctx := &StreamContext{ingestServer: "rtmp://ingest.example.com/ll", streamName: "abc", lowLatency: true}
+	out := strings.Join(liveOutputs(ctx), " ")
+	if !strings.HasSuffix(out, "-f flv rtmp://ingest.example.com/ll/abc") {
+		t.Errorf("low latency stream should be pushed to the stream name, got %s", out)
+	}
+	if !strings.Contains(out, "-g 30") || !strings.Contains(out, "keyint=30") {
+		t.Errorf("low latency stream should use one second gops, got %s", out)
+	}
+}
+
+func TestLiveOutputsLadder(t *testing.T) {
+	ctx := &StreamContext{
+		ingestServer: "rtmp://ingest.example.com/live",
+		streamName:   "abc",
+		renditions: []*pb.Rendition{
+			{Name: "720p", Height: 720, VideoBitrate: 2500, AudioBitrate: 128},
+			{Name: "480p", Height: 480, VideoBitrate: 1000, AudioBitrate: 96},
+			{Name: "audio", AudioBitrate: 64},
+		},
+	}
+	out := strings.Join(liveOutputs(ctx), " ")
+	for _, target := range []string{"live/abc_720p", "live/abc_480p", "live/abc_audio"} {
+		if strings.Count(out, target) != 1 {
+			t.Errorf("expected exactly one output to %s, got %s", target, out)
+		}
+	}
+	if !strings.Contains(out, "scale=-2:480 -b:v 1000k -maxrate 1000k -bufsize 1200k") {
+		t.Errorf("480p rendition is missing its encoder settings, got %s", out)
+	}
+	if !strings.Contains(out, "-map 0:a:0 -vn -c:a aac -ar 44100 -b:a 64k -f flv rtmp://ingest.example.com/live/abc_audio") {
+		t.Errorf("audio rendition should not contain video, got %s", out)
+	}
+}
+
+func TestDvrOutput(t *testing.T) {
+	if out := dvrOutput(&StreamContext{streamId: 3, streamVersion: "COMB"}); len(out) != 0 {
+		t.Errorf("expected no dvr output without dvr, got %v", out)
+	}
+	out := strings.Join(dvrOutput(&StreamContext{streamId: 3, streamVersion: "COMB", dvr: true}), " ")
+	if !strings.Contains(out, "-hls_list_size 0") || !strings.Contains(out, "-hls_playlist_type event") || strings.Contains(out, "delete_segments") {
+		t.Errorf("dvr without window should keep the whole stream, got %s", out)
+	}
+	if !strings.HasSuffix(out, "/dvr/3/COMB/playlist.m3u8") {
+		t.Errorf("dvr playlist should be written to the dvr directory of the stream, got %s", out)
+	}
+	out = strings.Join(dvrOutput(&StreamContext{streamId: 3, streamVersion: "PRES", dvr: true, dvrWindow: 30 * 60}), " ")
+	if !strings.Contains(out, "-hls_list_size 450") || !strings.Contains(out, "+delete_segments") || strings.Contains(out, "-hls_playlist_type") {
+		t.Errorf("dvr with window should only keep the window, got %s", out)
+	}
+}
@@ -12,25 +12,32 @@ import (
 	"time"
 
 	"github.com/TUM-Dev/gocast/worker/cfg"
+	"github.com/TUM-Dev/gocast/worker/loudnorm"
 	"github.com/TUM-Dev/gocast/worker/pb"
 	log "github.com/sirupsen/logrus"
 )
 
-func buildCommand(niceness int, infile string, outfile string, tune string, crf int, self bool) *exec.Cmd {
+// buildCommand returns the command that transcodes infile to outfile with the encoding profile of the stream version.
+// Recordings of self streams are probed longer, their encoder settings are up to the streamer.
+// If the loudness of infile was measured, the audio is normalised with the second pass of loudnorm.
+func buildCommand(niceness int, infile string, outfile string, profile *pb.EncodingProfile, version string, self bool, measured *loudnorm.Measurement) *exec.Cmd {
 	c := []string{
 		"-n", fmt.Sprintf("%d", niceness),
 		"ffmpeg", "-nostats", "-loglevel", "error", "-y",
 		"-progress", "-",
-		"-i", infile,
-		"-vsync", "2", "-c:v", "libx264", "-level", "4.0", "-movflags", "+faststart",
-	}
-	if tune != "" {
-		c = append(c, "-tune", tune)
 	}
 	if self {
-		c = append(c, "-probesize 25M -analyzeduration 50M")
+		c = append(c, "-probesize", "25M", "-analyzeduration", "50M")
+	}
+	c = append(c, "-i", infile)
+	c = append(c, vodArgs(profile, version)...)
+	if measured != nil {
+		c = append(c, "-af", loudnorm.Filter(measured))
+		if profile.GetAudioSampleRate() == 0 {
+			c = append(c, "-ar", "48000") // loudnorm resamples to 192kHz
+		}
 	}
-	c = append(c, "-c:a", "aac", "-b:a", "128k", "-crf", fmt.Sprintf("%d", crf), outfile)
+	c = append(c, outfile)
 	return exec.Command("nice", c...)
 }
 
@@ -60,7 +67,6 @@ func transcode(streamCtx *StreamContext) error {
 	if err != nil {
 		return err
 	}
-	var cmd *exec.Cmd
 	// create command fitting its content with appropriate niceness:
 	in := streamCtx.getRecordingFileName()
 	inputTime, err := getDuration(in)
@@ -68,19 +74,20 @@ func transcode(streamCtx *StreamContext) error {
 		inputTime = 1
 	}
 
+	streamCtx.loudness, err = measureLoudness(in)
+	if err != nil {
+		log.WithError(err).Warn("Can't measure loudness, not normalising it")
+	}
+
 	out := streamCtx.getTranscodingFileName()
+	niceness := 10 // cameras and unknown sources get the least priority
 	switch streamCtx.streamVersion {
-	case "CAM":
-		// compress camera image slightly more
-		cmd = buildCommand(10, in, out, "", 26, streamCtx.isSelfStream)
 	case "PRES":
-		cmd = buildCommand(9, in, out, "stillimage", 20, streamCtx.isSelfStream)
+		niceness = 9
 	case "COMB":
-		cmd = buildCommand(8, in, out, "", 24, streamCtx.isSelfStream)
-	default:
-		// unknown source, use higher compression and less priority
-		cmd = buildCommand(10, in, out, "", 26, streamCtx.isSelfStream)
+		niceness = 8
 	}
+	cmd := buildCommand(niceness, in, out, streamCtx.profile, streamCtx.streamVersion, streamCtx.isSelfStream, streamCtx.loudness)
 	log.WithFields(log.Fields{"input": in, "output": out, "command": cmd.String()}).Info("Transcoding")
 	streamCtx.transcodingCmd = cmd
 	stderr, err := cmd.StderrPipe()
@@ -200,6 +207,10 @@ func reportProgress(stream *StreamContext, p chan int32) error {
 	}
 }
 
+// audioCodec is the codec of the audio-only rendition of the VoD, e.g. for podcasts.
+const audioCodec = "aac"
+
+// transcodeAudio extracts the audio-only rendition of the VoD and reports it to tumlive.
 func transcodeAudio(ctx *StreamContext) error {
 	S.startTranscodingAudio(ctx.getStreamName())
 	defer S.endTranscodingAudio(ctx.getStreamName())
@@ -210,7 +221,10 @@ func transcodeAudio(ctx *StreamContext) error {
 		"-y",
 		"-v", "quiet",
 		"-i", input,
-		"-c:a", "aac",
+		"-map", "0:a:0",
+		"-c:a", audioCodec,
+		"-b:a", "96k", // plenty for speech, keeps podcast downloads small
+		"-movflags", "+faststart", // podcast apps stream the file while downloading it
 		"-vn", output)
 	log.WithFields(log.Fields{"input": input, "output": output, "command": cmd.String()}).Info("Transcoding audio")
 
@@ -219,5 +233,6 @@ func transcodeAudio(ctx *StreamContext) error {
 		return fmt.Errorf("transcode stream audio: %w", fmt.Errorf("%w: %s", err, out))
 	}
 
+	notifyCodecDone(ctx, audioCodec, output)
 	return nil
 }
@@ -14,7 +14,13 @@ import (
 
 func upload(streamCtx *StreamContext) {
 	log.WithField("stream", streamCtx.getStreamName()).Info("Uploading stream")
-	err := post(streamCtx.getTranscodingFileName())
+	var err error
+	if streamCtx.loudness != nil {
+		// the VoD service doesn't normalise the loudness again
+		err = postForm(streamCtx.getTranscodingFileName(), map[string]string{"normalized": "true"})
+	} else {
+		err = post(streamCtx.getTranscodingFileName())
+	}
 	if err != nil {
 		log.WithField("stream", streamCtx.getStreamName()).WithError(err).Error("Error uploading stream")
 	}
@@ -22,6 +28,16 @@ func upload(streamCtx *StreamContext) {
 }
 
 func post(file string) error {
+	return postForm(file, nil)
+}
+
+// postCodec uploads the additional VoD rendition in file, the VoD service adds it to the package of the VoD named pkg.
+func postCodec(file string, codec string, pkg string) error {
+	return postForm(file, map[string]string{"codec": codec, "package": pkg})
+}
+
+// postForm uploads file with the fields of the LRZ form and extra.
+func postForm(file string, extra map[string]string) error {
 	client := &http.Client{
 		// 5 minutes timeout, some large files can take a while.
 		Timeout: time.Minute * 15,
@@ -46,6 +62,9 @@ func post(file string) error {
 			"subdir":      cfg.LrzSubDir,
 			"info":        "",
 		}
+		for name, value := range extra {
+			fields[name] = value
+		}
 
 		for name, value := range fields {
 			err = writeField(writer, name, value)
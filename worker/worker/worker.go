@@ -29,6 +29,7 @@ func Setup() {
 
 	c := cron.New()
 	_, _ = c.AddFunc("* * * * *", S.SendHeartbeat)
+	_, _ = c.AddFunc("0 * * * *", RenewCertificateIfDue)
 	_, _ = c.AddFunc("* * * * *", func() {
 		err := S.Stat.Update()
 		if err != nil {